@@ -0,0 +1,66 @@
+package permissionservice
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/zeromicro/go-zero/rest"
+	"google.golang.org/grpc"
+)
+
+// BuildCatalog 按go-zero路由定义生成路径权限目录，prefix为注册路由时rest.WithPrefix指定的前缀
+// 编码为 请求方法:完整路径，资源为路由路径的第一段，GET为read，其他方法为write
+// 只包含GET、POST、PUT、DELETE路由，重复的路由只保留一条
+func BuildCatalog(prefix string, routes ...[]rest.Route) []*PermissionCatalogEntry {
+	var catalog []*PermissionCatalogEntry
+	seen := make(map[string]bool)
+	for _, group := range routes {
+		for _, route := range group {
+			method := strings.ToUpper(route.Method)
+			switch method {
+			case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete:
+			default:
+				continue
+			}
+			fullPath := path.Join("/", prefix, route.Path)
+			code := method + ":" + fullPath
+			if seen[code] {
+				continue
+			}
+			seen[code] = true
+
+			action := "write"
+			if method == http.MethodGet {
+				action = "read"
+			}
+			catalog = append(catalog, &PermissionCatalogEntry{
+				Code:       code,
+				Name:       method + " " + fullPath,
+				Type:       "path",
+				Resource:   routeResource(route.Path),
+				Action:     action,
+				HttpMethod: method,
+			})
+		}
+	}
+	return catalog
+}
+
+// RegisterRoutes 按go-zero路由定义生成权限目录并以namespace注册，通常在服务启动时调用
+func RegisterRoutes(ctx context.Context, cli PermissionService, namespace, prefix string, routes []rest.Route, opts ...grpc.CallOption) (*RegisterPermissionsResponse, error) {
+	return cli.RegisterPermissions(ctx, &RegisterPermissionsRequest{
+		Namespace:   namespace,
+		Permissions: BuildCatalog(prefix, routes),
+	}, opts...)
+}
+
+// routeResource 路由路径的第一段，路径参数和根路径使用/
+func routeResource(routePath string) string {
+	segment, _, _ := strings.Cut(strings.Trim(routePath, "/"), "/")
+	if segment == "" || strings.HasPrefix(segment, ":") {
+		return "/"
+	}
+	return segment
+}
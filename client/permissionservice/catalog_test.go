@@ -0,0 +1,63 @@
+package permissionservice
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/zeromicro/go-zero/rest"
+)
+
+func TestBuildCatalog(t *testing.T) {
+	users := []rest.Route{
+		{Method: http.MethodGet, Path: "/users/:id"},
+		{Method: http.MethodPost, Path: "/users"},
+		{Method: http.MethodPatch, Path: "/users/:id"},
+		{Method: http.MethodGet, Path: "/:tenant/overview"},
+	}
+	orders := []rest.Route{
+		{Method: "delete", Path: "/orders/:id"},
+		{Method: http.MethodGet, Path: "/users/:id"},
+	}
+	catalog := BuildCatalog("/api/v1", users, orders)
+
+	// PATCH路由不生成权限，重复的路由只保留一条
+	want := []*PermissionCatalogEntry{
+		{Code: "GET:/api/v1/users/:id", Name: "GET /api/v1/users/:id", Type: "path", Resource: "users", Action: "read", HttpMethod: "GET"},
+		{Code: "POST:/api/v1/users", Name: "POST /api/v1/users", Type: "path", Resource: "users", Action: "write", HttpMethod: "POST"},
+		{Code: "GET:/api/v1/:tenant/overview", Name: "GET /api/v1/:tenant/overview", Type: "path", Resource: "/", Action: "read", HttpMethod: "GET"},
+		{Code: "DELETE:/api/v1/orders/:id", Name: "DELETE /api/v1/orders/:id", Type: "path", Resource: "orders", Action: "write", HttpMethod: "DELETE"},
+	}
+	if len(catalog) != len(want) {
+		t.Fatalf("catalog has %d entries, want %d: %v", len(catalog), len(want), catalog)
+	}
+	for i, w := range want {
+		got := catalog[i]
+		if got.Code != w.Code || got.Name != w.Name || got.Type != w.Type || got.Resource != w.Resource ||
+			got.Action != w.Action || got.HttpMethod != w.HttpMethod {
+			t.Fatalf("entry %d = %v, want %v", i, got, w)
+		}
+	}
+}
+
+func TestBuildCatalogWithoutPrefix(t *testing.T) {
+	catalog := BuildCatalog("", []rest.Route{{Method: http.MethodGet, Path: "/"}})
+	if len(catalog) != 1 || catalog[0].Code != "GET:/" || catalog[0].Resource != "/" {
+		t.Fatalf("unexpected catalog %v", catalog)
+	}
+}
+
+func TestRouteResource(t *testing.T) {
+	tests := map[string]string{
+		"/users":         "users",
+		"/users/:id":     "users",
+		"orders/:id/pay": "orders",
+		"/":              "/",
+		"":               "/",
+		"/:id":           "/",
+	}
+	for routePath, want := range tests {
+		if got := routeResource(routePath); got != want {
+			t.Fatalf("routeResource(%q) = %q, want %q", routePath, got, want)
+		}
+	}
+}
@@ -14,64 +14,223 @@ import (
 )
 
 type (
-	AssignRolePermissionRequest   = iam.AssignRolePermissionRequest
-	AssignRolePermissionResponse  = iam.AssignRolePermissionResponse
-	AssignRolePermissionsRequest  = iam.AssignRolePermissionsRequest
-	AssignRolePermissionsResponse = iam.AssignRolePermissionsResponse
-	AssignUserRoleRequest         = iam.AssignUserRoleRequest
-	AssignUserRoleResponse        = iam.AssignUserRoleResponse
-	AssignUserRolesRequest        = iam.AssignUserRolesRequest
-	AssignUserRolesResponse       = iam.AssignUserRolesResponse
-	ChangePasswordRequest         = iam.ChangePasswordRequest
-	ChangePasswordResponse        = iam.ChangePasswordResponse
-	CheckRolePermissionRequest    = iam.CheckRolePermissionRequest
-	CheckRolePermissionResponse   = iam.CheckRolePermissionResponse
-	CheckUserPermissionRequest    = iam.CheckUserPermissionRequest
-	CheckUserPermissionResponse   = iam.CheckUserPermissionResponse
-	CheckUserRoleRequest          = iam.CheckUserRoleRequest
-	CheckUserRoleResponse         = iam.CheckUserRoleResponse
-	CreatePermissionRequest       = iam.CreatePermissionRequest
-	CreatePermissionResponse      = iam.CreatePermissionResponse
-	CreateRoleRequest             = iam.CreateRoleRequest
-	CreateRoleResponse            = iam.CreateRoleResponse
-	CreateUserRequest             = iam.CreateUserRequest
-	CreateUserResponse            = iam.CreateUserResponse
-	DeletePermissionRequest       = iam.DeletePermissionRequest
-	DeletePermissionResponse      = iam.DeletePermissionResponse
-	DeleteRoleRequest             = iam.DeleteRoleRequest
-	DeleteRoleResponse            = iam.DeleteRoleResponse
-	DeleteUserRequest             = iam.DeleteUserRequest
-	DeleteUserResponse            = iam.DeleteUserResponse
-	GetPermissionRequest          = iam.GetPermissionRequest
-	GetRolePermissionsRequest     = iam.GetRolePermissionsRequest
-	GetRolePermissionsResponse    = iam.GetRolePermissionsResponse
-	GetRoleRequest                = iam.GetRoleRequest
-	GetUserByUsernameRequest      = iam.GetUserByUsernameRequest
-	GetUserRequest                = iam.GetUserRequest
-	GetUserRolesRequest           = iam.GetUserRolesRequest
-	GetUserRolesResponse          = iam.GetUserRolesResponse
-	ListPermissionsRequest        = iam.ListPermissionsRequest
-	ListPermissionsResponse       = iam.ListPermissionsResponse
-	ListRolesRequest              = iam.ListRolesRequest
-	ListRolesResponse             = iam.ListRolesResponse
-	ListUsersRequest              = iam.ListUsersRequest
-	ListUsersResponse             = iam.ListUsersResponse
-	Permission                    = iam.Permission
-	RemoveRolePermissionRequest   = iam.RemoveRolePermissionRequest
-	RemoveRolePermissionResponse  = iam.RemoveRolePermissionResponse
-	RemoveRolePermissionsRequest  = iam.RemoveRolePermissionsRequest
-	RemoveRolePermissionsResponse = iam.RemoveRolePermissionsResponse
-	RemoveUserRoleRequest         = iam.RemoveUserRoleRequest
-	RemoveUserRoleResponse        = iam.RemoveUserRoleResponse
-	RemoveUserRolesRequest        = iam.RemoveUserRolesRequest
-	RemoveUserRolesResponse       = iam.RemoveUserRolesResponse
-	Role                          = iam.Role
-	UpdatePermissionRequest       = iam.UpdatePermissionRequest
-	UpdateRoleRequest             = iam.UpdateRoleRequest
-	UpdateUserRequest             = iam.UpdateUserRequest
-	User                          = iam.User
-	VerifyPasswordRequest         = iam.VerifyPasswordRequest
-	VerifyPasswordResponse        = iam.VerifyPasswordResponse
+	AccessRequest                      = iam.AccessRequest
+	AccessRequestEvent                 = iam.AccessRequestEvent
+	AddGroupMembersRequest             = iam.AddGroupMembersRequest
+	AddGroupMembersResponse            = iam.AddGroupMembersResponse
+	AdminScope                         = iam.AdminScope
+	ApplyPolicyRequest                 = iam.ApplyPolicyRequest
+	ApprovalStep                       = iam.ApprovalStep
+	ApproveAccessRequestRequest        = iam.ApproveAccessRequestRequest
+	AssignGroupRolesRequest            = iam.AssignGroupRolesRequest
+	AssignGroupRolesResponse           = iam.AssignGroupRolesResponse
+	AssignRolePermissionRequest        = iam.AssignRolePermissionRequest
+	AssignRolePermissionResponse       = iam.AssignRolePermissionResponse
+	AssignRolePermissionsRequest       = iam.AssignRolePermissionsRequest
+	AssignRolePermissionsResponse      = iam.AssignRolePermissionsResponse
+	AssignUserRoleRequest              = iam.AssignUserRoleRequest
+	AssignUserRoleResponse             = iam.AssignUserRoleResponse
+	AssignUserRolesRequest             = iam.AssignUserRolesRequest
+	AssignUserRolesResponse            = iam.AssignUserRolesResponse
+	AuthenticateRequest                = iam.AuthenticateRequest
+	AuthenticateResponse               = iam.AuthenticateResponse
+	CancelAccessRequestRequest         = iam.CancelAccessRequestRequest
+	ChangePasswordRequest              = iam.ChangePasswordRequest
+	ChangePasswordResponse             = iam.ChangePasswordResponse
+	CheckRelationRequest               = iam.CheckRelationRequest
+	CheckRelationResponse              = iam.CheckRelationResponse
+	CheckRolePermissionRequest         = iam.CheckRolePermissionRequest
+	CheckRolePermissionResponse        = iam.CheckRolePermissionResponse
+	CheckUserPermissionRequest         = iam.CheckUserPermissionRequest
+	CheckUserPermissionResponse        = iam.CheckUserPermissionResponse
+	CheckUserResourcePermissionRequest = iam.CheckUserResourcePermissionRequest
+	CheckUserRoleRequest               = iam.CheckUserRoleRequest
+	CheckUserRoleResponse              = iam.CheckUserRoleResponse
+	CloseReviewCampaignRequest         = iam.CloseReviewCampaignRequest
+	CommentAccessRequestRequest        = iam.CommentAccessRequestRequest
+	ConfirmVerificationRequest         = iam.ConfirmVerificationRequest
+	ConfirmVerificationResponse        = iam.ConfirmVerificationResponse
+	ConstraintViolation                = iam.ConstraintViolation
+	CreateAccessRequestRequest         = iam.CreateAccessRequestRequest
+	CreateDepartmentRequest            = iam.CreateDepartmentRequest
+	CreateDepartmentResponse           = iam.CreateDepartmentResponse
+	CreateGroupRequest                 = iam.CreateGroupRequest
+	CreateGroupResponse                = iam.CreateGroupResponse
+	CreatePermissionRequest            = iam.CreatePermissionRequest
+	CreatePermissionResponse           = iam.CreatePermissionResponse
+	CreateResourceBindingRequest       = iam.CreateResourceBindingRequest
+	CreateResourceBindingResponse      = iam.CreateResourceBindingResponse
+	CreateReviewCampaignRequest        = iam.CreateReviewCampaignRequest
+	CreateRoleConstraintRequest        = iam.CreateRoleConstraintRequest
+	CreateRoleRequest                  = iam.CreateRoleRequest
+	CreateRoleResponse                 = iam.CreateRoleResponse
+	CreateSessionRequest               = iam.CreateSessionRequest
+	CreateUserRequest                  = iam.CreateUserRequest
+	CreateUserResponse                 = iam.CreateUserResponse
+	DecideReviewItemRequest            = iam.DecideReviewItemRequest
+	DeleteAdminScopeRequest            = iam.DeleteAdminScopeRequest
+	DeleteAdminScopeResponse           = iam.DeleteAdminScopeResponse
+	DeleteDepartmentRequest            = iam.DeleteDepartmentRequest
+	DeleteDepartmentResponse           = iam.DeleteDepartmentResponse
+	DeleteGroupRequest                 = iam.DeleteGroupRequest
+	DeleteGroupResponse                = iam.DeleteGroupResponse
+	DeletePermissionRequest            = iam.DeletePermissionRequest
+	DeletePermissionResponse           = iam.DeletePermissionResponse
+	DeleteRelationTuplesRequest        = iam.DeleteRelationTuplesRequest
+	DeleteRelationTuplesResponse       = iam.DeleteRelationTuplesResponse
+	DeleteResourceBindingRequest       = iam.DeleteResourceBindingRequest
+	DeleteResourceBindingResponse      = iam.DeleteResourceBindingResponse
+	DeleteRoleConstraintRequest        = iam.DeleteRoleConstraintRequest
+	DeleteRoleConstraintResponse       = iam.DeleteRoleConstraintResponse
+	DeleteRoleRequest                  = iam.DeleteRoleRequest
+	DeleteRoleResponse                 = iam.DeleteRoleResponse
+	DeleteUserRequest                  = iam.DeleteUserRequest
+	DeleteUserResponse                 = iam.DeleteUserResponse
+	Department                         = iam.Department
+	ExpandRelationRequest              = iam.ExpandRelationRequest
+	ExpandRelationResponse             = iam.ExpandRelationResponse
+	ExplainUserPermissionRequest       = iam.ExplainUserPermissionRequest
+	ExplainUserPermissionResponse      = iam.ExplainUserPermissionResponse
+	ExportPolicyRequest                = iam.ExportPolicyRequest
+	ExportPolicyResponse               = iam.ExportPolicyResponse
+	ExportReviewReportRequest          = iam.ExportReviewReportRequest
+	ExportReviewReportResponse         = iam.ExportReviewReportResponse
+	ExtendUserRoleRequest              = iam.ExtendUserRoleRequest
+	ExtendUserRoleResponse             = iam.ExtendUserRoleResponse
+	FailedCondition                    = iam.FailedCondition
+	GetAccessRequestRequest            = iam.GetAccessRequestRequest
+	GetAdminScopeRequest               = iam.GetAdminScopeRequest
+	GetDepartmentRequest               = iam.GetDepartmentRequest
+	GetGroupRequest                    = iam.GetGroupRequest
+	GetGroupRolesRequest               = iam.GetGroupRolesRequest
+	GetGroupRolesResponse              = iam.GetGroupRolesResponse
+	GetPermissionRequest               = iam.GetPermissionRequest
+	GetReviewCampaignRequest           = iam.GetReviewCampaignRequest
+	GetRoleApproversRequest            = iam.GetRoleApproversRequest
+	GetRoleApproversResponse           = iam.GetRoleApproversResponse
+	GetRolePermissionsRequest          = iam.GetRolePermissionsRequest
+	GetRolePermissionsResponse         = iam.GetRolePermissionsResponse
+	GetRoleRequest                     = iam.GetRoleRequest
+	GetUserByUsernameRequest           = iam.GetUserByUsernameRequest
+	GetUserDataScopeRequest            = iam.GetUserDataScopeRequest
+	GetUserDataScopeResponse           = iam.GetUserDataScopeResponse
+	GetUserPermissionsRequest          = iam.GetUserPermissionsRequest
+	GetUserPermissionsResponse         = iam.GetUserPermissionsResponse
+	GetUserRequest                     = iam.GetUserRequest
+	GetUserRolesRequest                = iam.GetUserRolesRequest
+	GetUserRolesResponse               = iam.GetUserRolesResponse
+	GrantTrace                         = iam.GrantTrace
+	GrantUsage                         = iam.GrantUsage
+	Group                              = iam.Group
+	ImpersonateRequest                 = iam.ImpersonateRequest
+	ImpersonateResponse                = iam.ImpersonateResponse
+	LdapSyncItem                       = iam.LdapSyncItem
+	ListAccessRequestsRequest          = iam.ListAccessRequestsRequest
+	ListAccessRequestsResponse         = iam.ListAccessRequestsResponse
+	ListDepartmentsRequest             = iam.ListDepartmentsRequest
+	ListDepartmentsResponse            = iam.ListDepartmentsResponse
+	ListGroupMembersRequest            = iam.ListGroupMembersRequest
+	ListGroupMembersResponse           = iam.ListGroupMembersResponse
+	ListGroupsRequest                  = iam.ListGroupsRequest
+	ListGroupsResponse                 = iam.ListGroupsResponse
+	ListObjectsRequest                 = iam.ListObjectsRequest
+	ListObjectsResponse                = iam.ListObjectsResponse
+	ListPermissionsRequest             = iam.ListPermissionsRequest
+	ListPermissionsResponse            = iam.ListPermissionsResponse
+	ListResourceAccessRequest          = iam.ListResourceAccessRequest
+	ListResourceBindingsResponse       = iam.ListResourceBindingsResponse
+	ListReviewCampaignsRequest         = iam.ListReviewCampaignsRequest
+	ListReviewCampaignsResponse        = iam.ListReviewCampaignsResponse
+	ListReviewItemsRequest             = iam.ListReviewItemsRequest
+	ListReviewItemsResponse            = iam.ListReviewItemsResponse
+	ListRoleConstraintsRequest         = iam.ListRoleConstraintsRequest
+	ListRoleConstraintsResponse        = iam.ListRoleConstraintsResponse
+	ListRolesRequest                   = iam.ListRolesRequest
+	ListRolesResponse                  = iam.ListRolesResponse
+	ListSubjectsRequest                = iam.ListSubjectsRequest
+	ListSubjectsResponse               = iam.ListSubjectsResponse
+	ListUnusedGrantsRequest            = iam.ListUnusedGrantsRequest
+	ListUnusedGrantsResponse           = iam.ListUnusedGrantsResponse
+	ListUnusedRolesRequest             = iam.ListUnusedRolesRequest
+	ListUnusedRolesResponse            = iam.ListUnusedRolesResponse
+	ListUserGroupsRequest              = iam.ListUserGroupsRequest
+	ListUserGroupsResponse             = iam.ListUserGroupsResponse
+	ListUserResourcesRequest           = iam.ListUserResourcesRequest
+	ListUserSessionsRequest            = iam.ListUserSessionsRequest
+	ListUserSessionsResponse           = iam.ListUserSessionsResponse
+	ListUsersRequest                   = iam.ListUsersRequest
+	ListUsersResponse                  = iam.ListUsersResponse
+	Permission                         = iam.Permission
+	PermissionCatalogEntry             = iam.PermissionCatalogEntry
+	PermissionConflict                 = iam.PermissionConflict
+	PermissionGrant                    = iam.PermissionGrant
+	PlanPolicyRequest                  = iam.PlanPolicyRequest
+	PolicyChange                       = iam.PolicyChange
+	PolicyMutation                     = iam.PolicyMutation
+	PolicyPlan                         = iam.PolicyPlan
+	RecommendRoleTrimRequest           = iam.RecommendRoleTrimRequest
+	RecommendRoleTrimResponse          = iam.RecommendRoleTrimResponse
+	RegisterPermissionsRequest         = iam.RegisterPermissionsRequest
+	RegisterPermissionsResponse        = iam.RegisterPermissionsResponse
+	RejectAccessRequestRequest         = iam.RejectAccessRequestRequest
+	RelationTuple                      = iam.RelationTuple
+	RemoveGroupMembersRequest          = iam.RemoveGroupMembersRequest
+	RemoveGroupMembersResponse         = iam.RemoveGroupMembersResponse
+	RemoveGroupRolesRequest            = iam.RemoveGroupRolesRequest
+	RemoveGroupRolesResponse           = iam.RemoveGroupRolesResponse
+	RemoveRolePermissionRequest        = iam.RemoveRolePermissionRequest
+	RemoveRolePermissionResponse       = iam.RemoveRolePermissionResponse
+	RemoveRolePermissionsRequest       = iam.RemoveRolePermissionsRequest
+	RemoveRolePermissionsResponse      = iam.RemoveRolePermissionsResponse
+	RemoveUserRoleRequest              = iam.RemoveUserRoleRequest
+	RemoveUserRoleResponse             = iam.RemoveUserRoleResponse
+	RemoveUserRolesRequest             = iam.RemoveUserRolesRequest
+	RemoveUserRolesResponse            = iam.RemoveUserRolesResponse
+	ResourceBinding                    = iam.ResourceBinding
+	ReviewCampaign                     = iam.ReviewCampaign
+	ReviewItem                         = iam.ReviewItem
+	ReviewProgress                     = iam.ReviewProgress
+	RevokeAllUserSessionsRequest       = iam.RevokeAllUserSessionsRequest
+	RevokeAllUserSessionsResponse      = iam.RevokeAllUserSessionsResponse
+	RevokeSessionRequest               = iam.RevokeSessionRequest
+	RevokeSessionResponse              = iam.RevokeSessionResponse
+	Role                               = iam.Role
+	RoleConstraint                     = iam.RoleConstraint
+	RoleTrace                          = iam.RoleTrace
+	RoleUsage                          = iam.RoleUsage
+	SendVerificationRequest            = iam.SendVerificationRequest
+	SendVerificationResponse           = iam.SendVerificationResponse
+	Session                            = iam.Session
+	SetAdminScopeRequest               = iam.SetAdminScopeRequest
+	SetRoleApproversRequest            = iam.SetRoleApproversRequest
+	SetRoleApproversResponse           = iam.SetRoleApproversResponse
+	SetRoleDataScopeRequest            = iam.SetRoleDataScopeRequest
+	SetRoleDataScopeResponse           = iam.SetRoleDataScopeResponse
+	SetUserDepartmentRequest           = iam.SetUserDepartmentRequest
+	SetUserDepartmentResponse          = iam.SetUserDepartmentResponse
+	SimulateChangeRequest              = iam.SimulateChangeRequest
+	SimulateChangeResponse             = iam.SimulateChangeResponse
+	Subject                            = iam.Subject
+	SubjectTree                        = iam.SubjectTree
+	SyncLdapUsersRequest               = iam.SyncLdapUsersRequest
+	SyncLdapUsersResponse              = iam.SyncLdapUsersResponse
+	TouchSessionRequest                = iam.TouchSessionRequest
+	TouchSessionResponse               = iam.TouchSessionResponse
+	TrimRecommendation                 = iam.TrimRecommendation
+	UpdateDepartmentRequest            = iam.UpdateDepartmentRequest
+	UpdateGroupRequest                 = iam.UpdateGroupRequest
+	UpdatePermissionRequest            = iam.UpdatePermissionRequest
+	UpdateRoleRequest                  = iam.UpdateRoleRequest
+	UpdateUserRequest                  = iam.UpdateUserRequest
+	User                               = iam.User
+	UserPermissionDiff                 = iam.UserPermissionDiff
+	UserTrace                          = iam.UserTrace
+	ValidateConstraintsRequest         = iam.ValidateConstraintsRequest
+	ValidateConstraintsResponse        = iam.ValidateConstraintsResponse
+	VerifyPasswordRequest              = iam.VerifyPasswordRequest
+	VerifyPasswordResponse             = iam.VerifyPasswordResponse
+	WriteRelationTuplesRequest         = iam.WriteRelationTuplesRequest
+	WriteRelationTuplesResponse        = iam.WriteRelationTuplesResponse
 
 	PermissionService interface {
 		// CreatePermission 创建新权限
@@ -84,6 +243,8 @@ type (
 		DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeletePermissionResponse, error)
 		// ListPermissions 分页查询权限列表
 		ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
+		// RegisterPermissions 服务按命名空间注册完整的权限目录，幂等地新建和更新权限，目录中不再包含的权限标记为弃用
+		RegisterPermissions(ctx context.Context, in *RegisterPermissionsRequest, opts ...grpc.CallOption) (*RegisterPermissionsResponse, error)
 	}
 
 	defaultPermissionService struct {
@@ -126,3 +287,9 @@ func (m *defaultPermissionService) ListPermissions(ctx context.Context, in *List
 	client := iam.NewPermissionServiceClient(m.cli.Conn())
 	return client.ListPermissions(ctx, in, opts...)
 }
+
+// RegisterPermissions 服务按命名空间注册完整的权限目录，幂等地新建和更新权限，目录中不再包含的权限标记为弃用
+func (m *defaultPermissionService) RegisterPermissions(ctx context.Context, in *RegisterPermissionsRequest, opts ...grpc.CallOption) (*RegisterPermissionsResponse, error) {
+	client := iam.NewPermissionServiceClient(m.cli.Conn())
+	return client.RegisterPermissions(ctx, in, opts...)
+}
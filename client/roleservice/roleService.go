@@ -14,64 +14,223 @@ import (
 )
 
 type (
-	AssignRolePermissionRequest   = iam.AssignRolePermissionRequest
-	AssignRolePermissionResponse  = iam.AssignRolePermissionResponse
-	AssignRolePermissionsRequest  = iam.AssignRolePermissionsRequest
-	AssignRolePermissionsResponse = iam.AssignRolePermissionsResponse
-	AssignUserRoleRequest         = iam.AssignUserRoleRequest
-	AssignUserRoleResponse        = iam.AssignUserRoleResponse
-	AssignUserRolesRequest        = iam.AssignUserRolesRequest
-	AssignUserRolesResponse       = iam.AssignUserRolesResponse
-	ChangePasswordRequest         = iam.ChangePasswordRequest
-	ChangePasswordResponse        = iam.ChangePasswordResponse
-	CheckRolePermissionRequest    = iam.CheckRolePermissionRequest
-	CheckRolePermissionResponse   = iam.CheckRolePermissionResponse
-	CheckUserPermissionRequest    = iam.CheckUserPermissionRequest
-	CheckUserPermissionResponse   = iam.CheckUserPermissionResponse
-	CheckUserRoleRequest          = iam.CheckUserRoleRequest
-	CheckUserRoleResponse         = iam.CheckUserRoleResponse
-	CreatePermissionRequest       = iam.CreatePermissionRequest
-	CreatePermissionResponse      = iam.CreatePermissionResponse
-	CreateRoleRequest             = iam.CreateRoleRequest
-	CreateRoleResponse            = iam.CreateRoleResponse
-	CreateUserRequest             = iam.CreateUserRequest
-	CreateUserResponse            = iam.CreateUserResponse
-	DeletePermissionRequest       = iam.DeletePermissionRequest
-	DeletePermissionResponse      = iam.DeletePermissionResponse
-	DeleteRoleRequest             = iam.DeleteRoleRequest
-	DeleteRoleResponse            = iam.DeleteRoleResponse
-	DeleteUserRequest             = iam.DeleteUserRequest
-	DeleteUserResponse            = iam.DeleteUserResponse
-	GetPermissionRequest          = iam.GetPermissionRequest
-	GetRolePermissionsRequest     = iam.GetRolePermissionsRequest
-	GetRolePermissionsResponse    = iam.GetRolePermissionsResponse
-	GetRoleRequest                = iam.GetRoleRequest
-	GetUserByUsernameRequest      = iam.GetUserByUsernameRequest
-	GetUserRequest                = iam.GetUserRequest
-	GetUserRolesRequest           = iam.GetUserRolesRequest
-	GetUserRolesResponse          = iam.GetUserRolesResponse
-	ListPermissionsRequest        = iam.ListPermissionsRequest
-	ListPermissionsResponse       = iam.ListPermissionsResponse
-	ListRolesRequest              = iam.ListRolesRequest
-	ListRolesResponse             = iam.ListRolesResponse
-	ListUsersRequest              = iam.ListUsersRequest
-	ListUsersResponse             = iam.ListUsersResponse
-	Permission                    = iam.Permission
-	RemoveRolePermissionRequest   = iam.RemoveRolePermissionRequest
-	RemoveRolePermissionResponse  = iam.RemoveRolePermissionResponse
-	RemoveRolePermissionsRequest  = iam.RemoveRolePermissionsRequest
-	RemoveRolePermissionsResponse = iam.RemoveRolePermissionsResponse
-	RemoveUserRoleRequest         = iam.RemoveUserRoleRequest
-	RemoveUserRoleResponse        = iam.RemoveUserRoleResponse
-	RemoveUserRolesRequest        = iam.RemoveUserRolesRequest
-	RemoveUserRolesResponse       = iam.RemoveUserRolesResponse
-	Role                          = iam.Role
-	UpdatePermissionRequest       = iam.UpdatePermissionRequest
-	UpdateRoleRequest             = iam.UpdateRoleRequest
-	UpdateUserRequest             = iam.UpdateUserRequest
-	User                          = iam.User
-	VerifyPasswordRequest         = iam.VerifyPasswordRequest
-	VerifyPasswordResponse        = iam.VerifyPasswordResponse
+	AccessRequest                      = iam.AccessRequest
+	AccessRequestEvent                 = iam.AccessRequestEvent
+	AddGroupMembersRequest             = iam.AddGroupMembersRequest
+	AddGroupMembersResponse            = iam.AddGroupMembersResponse
+	AdminScope                         = iam.AdminScope
+	ApplyPolicyRequest                 = iam.ApplyPolicyRequest
+	ApprovalStep                       = iam.ApprovalStep
+	ApproveAccessRequestRequest        = iam.ApproveAccessRequestRequest
+	AssignGroupRolesRequest            = iam.AssignGroupRolesRequest
+	AssignGroupRolesResponse           = iam.AssignGroupRolesResponse
+	AssignRolePermissionRequest        = iam.AssignRolePermissionRequest
+	AssignRolePermissionResponse       = iam.AssignRolePermissionResponse
+	AssignRolePermissionsRequest       = iam.AssignRolePermissionsRequest
+	AssignRolePermissionsResponse      = iam.AssignRolePermissionsResponse
+	AssignUserRoleRequest              = iam.AssignUserRoleRequest
+	AssignUserRoleResponse             = iam.AssignUserRoleResponse
+	AssignUserRolesRequest             = iam.AssignUserRolesRequest
+	AssignUserRolesResponse            = iam.AssignUserRolesResponse
+	AuthenticateRequest                = iam.AuthenticateRequest
+	AuthenticateResponse               = iam.AuthenticateResponse
+	CancelAccessRequestRequest         = iam.CancelAccessRequestRequest
+	ChangePasswordRequest              = iam.ChangePasswordRequest
+	ChangePasswordResponse             = iam.ChangePasswordResponse
+	CheckRelationRequest               = iam.CheckRelationRequest
+	CheckRelationResponse              = iam.CheckRelationResponse
+	CheckRolePermissionRequest         = iam.CheckRolePermissionRequest
+	CheckRolePermissionResponse        = iam.CheckRolePermissionResponse
+	CheckUserPermissionRequest         = iam.CheckUserPermissionRequest
+	CheckUserPermissionResponse        = iam.CheckUserPermissionResponse
+	CheckUserResourcePermissionRequest = iam.CheckUserResourcePermissionRequest
+	CheckUserRoleRequest               = iam.CheckUserRoleRequest
+	CheckUserRoleResponse              = iam.CheckUserRoleResponse
+	CloseReviewCampaignRequest         = iam.CloseReviewCampaignRequest
+	CommentAccessRequestRequest        = iam.CommentAccessRequestRequest
+	ConfirmVerificationRequest         = iam.ConfirmVerificationRequest
+	ConfirmVerificationResponse        = iam.ConfirmVerificationResponse
+	ConstraintViolation                = iam.ConstraintViolation
+	CreateAccessRequestRequest         = iam.CreateAccessRequestRequest
+	CreateDepartmentRequest            = iam.CreateDepartmentRequest
+	CreateDepartmentResponse           = iam.CreateDepartmentResponse
+	CreateGroupRequest                 = iam.CreateGroupRequest
+	CreateGroupResponse                = iam.CreateGroupResponse
+	CreatePermissionRequest            = iam.CreatePermissionRequest
+	CreatePermissionResponse           = iam.CreatePermissionResponse
+	CreateResourceBindingRequest       = iam.CreateResourceBindingRequest
+	CreateResourceBindingResponse      = iam.CreateResourceBindingResponse
+	CreateReviewCampaignRequest        = iam.CreateReviewCampaignRequest
+	CreateRoleConstraintRequest        = iam.CreateRoleConstraintRequest
+	CreateRoleRequest                  = iam.CreateRoleRequest
+	CreateRoleResponse                 = iam.CreateRoleResponse
+	CreateSessionRequest               = iam.CreateSessionRequest
+	CreateUserRequest                  = iam.CreateUserRequest
+	CreateUserResponse                 = iam.CreateUserResponse
+	DecideReviewItemRequest            = iam.DecideReviewItemRequest
+	DeleteAdminScopeRequest            = iam.DeleteAdminScopeRequest
+	DeleteAdminScopeResponse           = iam.DeleteAdminScopeResponse
+	DeleteDepartmentRequest            = iam.DeleteDepartmentRequest
+	DeleteDepartmentResponse           = iam.DeleteDepartmentResponse
+	DeleteGroupRequest                 = iam.DeleteGroupRequest
+	DeleteGroupResponse                = iam.DeleteGroupResponse
+	DeletePermissionRequest            = iam.DeletePermissionRequest
+	DeletePermissionResponse           = iam.DeletePermissionResponse
+	DeleteRelationTuplesRequest        = iam.DeleteRelationTuplesRequest
+	DeleteRelationTuplesResponse       = iam.DeleteRelationTuplesResponse
+	DeleteResourceBindingRequest       = iam.DeleteResourceBindingRequest
+	DeleteResourceBindingResponse      = iam.DeleteResourceBindingResponse
+	DeleteRoleConstraintRequest        = iam.DeleteRoleConstraintRequest
+	DeleteRoleConstraintResponse       = iam.DeleteRoleConstraintResponse
+	DeleteRoleRequest                  = iam.DeleteRoleRequest
+	DeleteRoleResponse                 = iam.DeleteRoleResponse
+	DeleteUserRequest                  = iam.DeleteUserRequest
+	DeleteUserResponse                 = iam.DeleteUserResponse
+	Department                         = iam.Department
+	ExpandRelationRequest              = iam.ExpandRelationRequest
+	ExpandRelationResponse             = iam.ExpandRelationResponse
+	ExplainUserPermissionRequest       = iam.ExplainUserPermissionRequest
+	ExplainUserPermissionResponse      = iam.ExplainUserPermissionResponse
+	ExportPolicyRequest                = iam.ExportPolicyRequest
+	ExportPolicyResponse               = iam.ExportPolicyResponse
+	ExportReviewReportRequest          = iam.ExportReviewReportRequest
+	ExportReviewReportResponse         = iam.ExportReviewReportResponse
+	ExtendUserRoleRequest              = iam.ExtendUserRoleRequest
+	ExtendUserRoleResponse             = iam.ExtendUserRoleResponse
+	FailedCondition                    = iam.FailedCondition
+	GetAccessRequestRequest            = iam.GetAccessRequestRequest
+	GetAdminScopeRequest               = iam.GetAdminScopeRequest
+	GetDepartmentRequest               = iam.GetDepartmentRequest
+	GetGroupRequest                    = iam.GetGroupRequest
+	GetGroupRolesRequest               = iam.GetGroupRolesRequest
+	GetGroupRolesResponse              = iam.GetGroupRolesResponse
+	GetPermissionRequest               = iam.GetPermissionRequest
+	GetReviewCampaignRequest           = iam.GetReviewCampaignRequest
+	GetRoleApproversRequest            = iam.GetRoleApproversRequest
+	GetRoleApproversResponse           = iam.GetRoleApproversResponse
+	GetRolePermissionsRequest          = iam.GetRolePermissionsRequest
+	GetRolePermissionsResponse         = iam.GetRolePermissionsResponse
+	GetRoleRequest                     = iam.GetRoleRequest
+	GetUserByUsernameRequest           = iam.GetUserByUsernameRequest
+	GetUserDataScopeRequest            = iam.GetUserDataScopeRequest
+	GetUserDataScopeResponse           = iam.GetUserDataScopeResponse
+	GetUserPermissionsRequest          = iam.GetUserPermissionsRequest
+	GetUserPermissionsResponse         = iam.GetUserPermissionsResponse
+	GetUserRequest                     = iam.GetUserRequest
+	GetUserRolesRequest                = iam.GetUserRolesRequest
+	GetUserRolesResponse               = iam.GetUserRolesResponse
+	GrantTrace                         = iam.GrantTrace
+	GrantUsage                         = iam.GrantUsage
+	Group                              = iam.Group
+	ImpersonateRequest                 = iam.ImpersonateRequest
+	ImpersonateResponse                = iam.ImpersonateResponse
+	LdapSyncItem                       = iam.LdapSyncItem
+	ListAccessRequestsRequest          = iam.ListAccessRequestsRequest
+	ListAccessRequestsResponse         = iam.ListAccessRequestsResponse
+	ListDepartmentsRequest             = iam.ListDepartmentsRequest
+	ListDepartmentsResponse            = iam.ListDepartmentsResponse
+	ListGroupMembersRequest            = iam.ListGroupMembersRequest
+	ListGroupMembersResponse           = iam.ListGroupMembersResponse
+	ListGroupsRequest                  = iam.ListGroupsRequest
+	ListGroupsResponse                 = iam.ListGroupsResponse
+	ListObjectsRequest                 = iam.ListObjectsRequest
+	ListObjectsResponse                = iam.ListObjectsResponse
+	ListPermissionsRequest             = iam.ListPermissionsRequest
+	ListPermissionsResponse            = iam.ListPermissionsResponse
+	ListResourceAccessRequest          = iam.ListResourceAccessRequest
+	ListResourceBindingsResponse       = iam.ListResourceBindingsResponse
+	ListReviewCampaignsRequest         = iam.ListReviewCampaignsRequest
+	ListReviewCampaignsResponse        = iam.ListReviewCampaignsResponse
+	ListReviewItemsRequest             = iam.ListReviewItemsRequest
+	ListReviewItemsResponse            = iam.ListReviewItemsResponse
+	ListRoleConstraintsRequest         = iam.ListRoleConstraintsRequest
+	ListRoleConstraintsResponse        = iam.ListRoleConstraintsResponse
+	ListRolesRequest                   = iam.ListRolesRequest
+	ListRolesResponse                  = iam.ListRolesResponse
+	ListSubjectsRequest                = iam.ListSubjectsRequest
+	ListSubjectsResponse               = iam.ListSubjectsResponse
+	ListUnusedGrantsRequest            = iam.ListUnusedGrantsRequest
+	ListUnusedGrantsResponse           = iam.ListUnusedGrantsResponse
+	ListUnusedRolesRequest             = iam.ListUnusedRolesRequest
+	ListUnusedRolesResponse            = iam.ListUnusedRolesResponse
+	ListUserGroupsRequest              = iam.ListUserGroupsRequest
+	ListUserGroupsResponse             = iam.ListUserGroupsResponse
+	ListUserResourcesRequest           = iam.ListUserResourcesRequest
+	ListUserSessionsRequest            = iam.ListUserSessionsRequest
+	ListUserSessionsResponse           = iam.ListUserSessionsResponse
+	ListUsersRequest                   = iam.ListUsersRequest
+	ListUsersResponse                  = iam.ListUsersResponse
+	Permission                         = iam.Permission
+	PermissionCatalogEntry             = iam.PermissionCatalogEntry
+	PermissionConflict                 = iam.PermissionConflict
+	PermissionGrant                    = iam.PermissionGrant
+	PlanPolicyRequest                  = iam.PlanPolicyRequest
+	PolicyChange                       = iam.PolicyChange
+	PolicyMutation                     = iam.PolicyMutation
+	PolicyPlan                         = iam.PolicyPlan
+	RecommendRoleTrimRequest           = iam.RecommendRoleTrimRequest
+	RecommendRoleTrimResponse          = iam.RecommendRoleTrimResponse
+	RegisterPermissionsRequest         = iam.RegisterPermissionsRequest
+	RegisterPermissionsResponse        = iam.RegisterPermissionsResponse
+	RejectAccessRequestRequest         = iam.RejectAccessRequestRequest
+	RelationTuple                      = iam.RelationTuple
+	RemoveGroupMembersRequest          = iam.RemoveGroupMembersRequest
+	RemoveGroupMembersResponse         = iam.RemoveGroupMembersResponse
+	RemoveGroupRolesRequest            = iam.RemoveGroupRolesRequest
+	RemoveGroupRolesResponse           = iam.RemoveGroupRolesResponse
+	RemoveRolePermissionRequest        = iam.RemoveRolePermissionRequest
+	RemoveRolePermissionResponse       = iam.RemoveRolePermissionResponse
+	RemoveRolePermissionsRequest       = iam.RemoveRolePermissionsRequest
+	RemoveRolePermissionsResponse      = iam.RemoveRolePermissionsResponse
+	RemoveUserRoleRequest              = iam.RemoveUserRoleRequest
+	RemoveUserRoleResponse             = iam.RemoveUserRoleResponse
+	RemoveUserRolesRequest             = iam.RemoveUserRolesRequest
+	RemoveUserRolesResponse            = iam.RemoveUserRolesResponse
+	ResourceBinding                    = iam.ResourceBinding
+	ReviewCampaign                     = iam.ReviewCampaign
+	ReviewItem                         = iam.ReviewItem
+	ReviewProgress                     = iam.ReviewProgress
+	RevokeAllUserSessionsRequest       = iam.RevokeAllUserSessionsRequest
+	RevokeAllUserSessionsResponse      = iam.RevokeAllUserSessionsResponse
+	RevokeSessionRequest               = iam.RevokeSessionRequest
+	RevokeSessionResponse              = iam.RevokeSessionResponse
+	Role                               = iam.Role
+	RoleConstraint                     = iam.RoleConstraint
+	RoleTrace                          = iam.RoleTrace
+	RoleUsage                          = iam.RoleUsage
+	SendVerificationRequest            = iam.SendVerificationRequest
+	SendVerificationResponse           = iam.SendVerificationResponse
+	Session                            = iam.Session
+	SetAdminScopeRequest               = iam.SetAdminScopeRequest
+	SetRoleApproversRequest            = iam.SetRoleApproversRequest
+	SetRoleApproversResponse           = iam.SetRoleApproversResponse
+	SetRoleDataScopeRequest            = iam.SetRoleDataScopeRequest
+	SetRoleDataScopeResponse           = iam.SetRoleDataScopeResponse
+	SetUserDepartmentRequest           = iam.SetUserDepartmentRequest
+	SetUserDepartmentResponse          = iam.SetUserDepartmentResponse
+	SimulateChangeRequest              = iam.SimulateChangeRequest
+	SimulateChangeResponse             = iam.SimulateChangeResponse
+	Subject                            = iam.Subject
+	SubjectTree                        = iam.SubjectTree
+	SyncLdapUsersRequest               = iam.SyncLdapUsersRequest
+	SyncLdapUsersResponse              = iam.SyncLdapUsersResponse
+	TouchSessionRequest                = iam.TouchSessionRequest
+	TouchSessionResponse               = iam.TouchSessionResponse
+	TrimRecommendation                 = iam.TrimRecommendation
+	UpdateDepartmentRequest            = iam.UpdateDepartmentRequest
+	UpdateGroupRequest                 = iam.UpdateGroupRequest
+	UpdatePermissionRequest            = iam.UpdatePermissionRequest
+	UpdateRoleRequest                  = iam.UpdateRoleRequest
+	UpdateUserRequest                  = iam.UpdateUserRequest
+	User                               = iam.User
+	UserPermissionDiff                 = iam.UserPermissionDiff
+	UserTrace                          = iam.UserTrace
+	ValidateConstraintsRequest         = iam.ValidateConstraintsRequest
+	ValidateConstraintsResponse        = iam.ValidateConstraintsResponse
+	VerifyPasswordRequest              = iam.VerifyPasswordRequest
+	VerifyPasswordResponse             = iam.VerifyPasswordResponse
+	WriteRelationTuplesRequest         = iam.WriteRelationTuplesRequest
+	WriteRelationTuplesResponse        = iam.WriteRelationTuplesResponse
 
 	RoleService interface {
 		// CreateRole 创建新角色
@@ -84,7 +243,7 @@ type (
 		DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
 		// ListRoles 分页查询角色列表
 		ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
-		// AssignRolePermission 为角色分配单个权限
+		// AssignRolePermission 为角色分配单个权限，可附带授权条件；拒绝授权优先于其他角色的允许授权
 		AssignRolePermission(ctx context.Context, in *AssignRolePermissionRequest, opts ...grpc.CallOption) (*AssignRolePermissionResponse, error)
 		// AssignRolePermissions 为角色批量分配权限
 		AssignRolePermissions(ctx context.Context, in *AssignRolePermissionsRequest, opts ...grpc.CallOption) (*AssignRolePermissionsResponse, error)
@@ -96,6 +255,22 @@ type (
 		GetRolePermissions(ctx context.Context, in *GetRolePermissionsRequest, opts ...grpc.CallOption) (*GetRolePermissionsResponse, error)
 		// CheckRolePermission 检查角色是否拥有指定权限
 		CheckRolePermission(ctx context.Context, in *CheckRolePermissionRequest, opts ...grpc.CallOption) (*CheckRolePermissionResponse, error)
+		// SetRoleDataScope 设置角色的数据权限范围，自定义范围时同时设置部门列表
+		SetRoleDataScope(ctx context.Context, in *SetRoleDataScopeRequest, opts ...grpc.CallOption) (*SetRoleDataScopeResponse, error)
+		// CreateRoleConstraint 创建职责分离约束，角色分配、用户组继承和访问申请授权时校验
+		CreateRoleConstraint(ctx context.Context, in *CreateRoleConstraintRequest, opts ...grpc.CallOption) (*RoleConstraint, error)
+		// DeleteRoleConstraint 删除职责分离约束
+		DeleteRoleConstraint(ctx context.Context, in *DeleteRoleConstraintRequest, opts ...grpc.CallOption) (*DeleteRoleConstraintResponse, error)
+		// ListRoleConstraints 查询所有职责分离约束
+		ListRoleConstraints(ctx context.Context, in *ListRoleConstraintsRequest, opts ...grpc.CallOption) (*ListRoleConstraintsResponse, error)
+		// ValidateConstraints 检查已有数据中违反职责分离约束的情况
+		ValidateConstraints(ctx context.Context, in *ValidateConstraintsRequest, opts ...grpc.CallOption) (*ValidateConstraintsResponse, error)
+		// ListUnusedGrants 查询最近N天内未被权限检查使用过的允许授权，可按角色或用户过滤
+		ListUnusedGrants(ctx context.Context, in *ListUnusedGrantsRequest, opts ...grpc.CallOption) (*ListUnusedGrantsResponse, error)
+		// ListUnusedRoles 查询最近N天内没有任何授权被权限检查使用过的角色
+		ListUnusedRoles(ctx context.Context, in *ListUnusedRolesRequest, opts ...grpc.CallOption) (*ListUnusedRolesResponse, error)
+		// RecommendRoleTrim 根据使用记录和授权覆盖关系，建议从角色中移除的权限
+		RecommendRoleTrim(ctx context.Context, in *RecommendRoleTrimRequest, opts ...grpc.CallOption) (*RecommendRoleTrimResponse, error)
 	}
 
 	defaultRoleService struct {
@@ -139,7 +314,7 @@ func (m *defaultRoleService) ListRoles(ctx context.Context, in *ListRolesRequest
 	return client.ListRoles(ctx, in, opts...)
 }
 
-// AssignRolePermission 为角色分配单个权限
+// AssignRolePermission 为角色分配单个权限，可附带授权条件；拒绝授权优先于其他角色的允许授权
 func (m *defaultRoleService) AssignRolePermission(ctx context.Context, in *AssignRolePermissionRequest, opts ...grpc.CallOption) (*AssignRolePermissionResponse, error) {
 	client := iam.NewRoleServiceClient(m.cli.Conn())
 	return client.AssignRolePermission(ctx, in, opts...)
@@ -174,3 +349,51 @@ func (m *defaultRoleService) CheckRolePermission(ctx context.Context, in *CheckR
 	client := iam.NewRoleServiceClient(m.cli.Conn())
 	return client.CheckRolePermission(ctx, in, opts...)
 }
+
+// SetRoleDataScope 设置角色的数据权限范围，自定义范围时同时设置部门列表
+func (m *defaultRoleService) SetRoleDataScope(ctx context.Context, in *SetRoleDataScopeRequest, opts ...grpc.CallOption) (*SetRoleDataScopeResponse, error) {
+	client := iam.NewRoleServiceClient(m.cli.Conn())
+	return client.SetRoleDataScope(ctx, in, opts...)
+}
+
+// CreateRoleConstraint 创建职责分离约束，角色分配、用户组继承和访问申请授权时校验
+func (m *defaultRoleService) CreateRoleConstraint(ctx context.Context, in *CreateRoleConstraintRequest, opts ...grpc.CallOption) (*RoleConstraint, error) {
+	client := iam.NewRoleServiceClient(m.cli.Conn())
+	return client.CreateRoleConstraint(ctx, in, opts...)
+}
+
+// DeleteRoleConstraint 删除职责分离约束
+func (m *defaultRoleService) DeleteRoleConstraint(ctx context.Context, in *DeleteRoleConstraintRequest, opts ...grpc.CallOption) (*DeleteRoleConstraintResponse, error) {
+	client := iam.NewRoleServiceClient(m.cli.Conn())
+	return client.DeleteRoleConstraint(ctx, in, opts...)
+}
+
+// ListRoleConstraints 查询所有职责分离约束
+func (m *defaultRoleService) ListRoleConstraints(ctx context.Context, in *ListRoleConstraintsRequest, opts ...grpc.CallOption) (*ListRoleConstraintsResponse, error) {
+	client := iam.NewRoleServiceClient(m.cli.Conn())
+	return client.ListRoleConstraints(ctx, in, opts...)
+}
+
+// ValidateConstraints 检查已有数据中违反职责分离约束的情况
+func (m *defaultRoleService) ValidateConstraints(ctx context.Context, in *ValidateConstraintsRequest, opts ...grpc.CallOption) (*ValidateConstraintsResponse, error) {
+	client := iam.NewRoleServiceClient(m.cli.Conn())
+	return client.ValidateConstraints(ctx, in, opts...)
+}
+
+// ListUnusedGrants 查询最近N天内未被权限检查使用过的允许授权，可按角色或用户过滤
+func (m *defaultRoleService) ListUnusedGrants(ctx context.Context, in *ListUnusedGrantsRequest, opts ...grpc.CallOption) (*ListUnusedGrantsResponse, error) {
+	client := iam.NewRoleServiceClient(m.cli.Conn())
+	return client.ListUnusedGrants(ctx, in, opts...)
+}
+
+// ListUnusedRoles 查询最近N天内没有任何授权被权限检查使用过的角色
+func (m *defaultRoleService) ListUnusedRoles(ctx context.Context, in *ListUnusedRolesRequest, opts ...grpc.CallOption) (*ListUnusedRolesResponse, error) {
+	client := iam.NewRoleServiceClient(m.cli.Conn())
+	return client.ListUnusedRoles(ctx, in, opts...)
+}
+
+// RecommendRoleTrim 根据使用记录和授权覆盖关系，建议从角色中移除的权限
+func (m *defaultRoleService) RecommendRoleTrim(ctx context.Context, in *RecommendRoleTrimRequest, opts ...grpc.CallOption) (*RecommendRoleTrimResponse, error) {
+	client := iam.NewRoleServiceClient(m.cli.Conn())
+	return client.RecommendRoleTrim(ctx, in, opts...)
+}
@@ -14,64 +14,223 @@ import (
 )
 
 type (
-	AssignRolePermissionRequest   = iam.AssignRolePermissionRequest
-	AssignRolePermissionResponse  = iam.AssignRolePermissionResponse
-	AssignRolePermissionsRequest  = iam.AssignRolePermissionsRequest
-	AssignRolePermissionsResponse = iam.AssignRolePermissionsResponse
-	AssignUserRoleRequest         = iam.AssignUserRoleRequest
-	AssignUserRoleResponse        = iam.AssignUserRoleResponse
-	AssignUserRolesRequest        = iam.AssignUserRolesRequest
-	AssignUserRolesResponse       = iam.AssignUserRolesResponse
-	ChangePasswordRequest         = iam.ChangePasswordRequest
-	ChangePasswordResponse        = iam.ChangePasswordResponse
-	CheckRolePermissionRequest    = iam.CheckRolePermissionRequest
-	CheckRolePermissionResponse   = iam.CheckRolePermissionResponse
-	CheckUserPermissionRequest    = iam.CheckUserPermissionRequest
-	CheckUserPermissionResponse   = iam.CheckUserPermissionResponse
-	CheckUserRoleRequest          = iam.CheckUserRoleRequest
-	CheckUserRoleResponse         = iam.CheckUserRoleResponse
-	CreatePermissionRequest       = iam.CreatePermissionRequest
-	CreatePermissionResponse      = iam.CreatePermissionResponse
-	CreateRoleRequest             = iam.CreateRoleRequest
-	CreateRoleResponse            = iam.CreateRoleResponse
-	CreateUserRequest             = iam.CreateUserRequest
-	CreateUserResponse            = iam.CreateUserResponse
-	DeletePermissionRequest       = iam.DeletePermissionRequest
-	DeletePermissionResponse      = iam.DeletePermissionResponse
-	DeleteRoleRequest             = iam.DeleteRoleRequest
-	DeleteRoleResponse            = iam.DeleteRoleResponse
-	DeleteUserRequest             = iam.DeleteUserRequest
-	DeleteUserResponse            = iam.DeleteUserResponse
-	GetPermissionRequest          = iam.GetPermissionRequest
-	GetRolePermissionsRequest     = iam.GetRolePermissionsRequest
-	GetRolePermissionsResponse    = iam.GetRolePermissionsResponse
-	GetRoleRequest                = iam.GetRoleRequest
-	GetUserByUsernameRequest      = iam.GetUserByUsernameRequest
-	GetUserRequest                = iam.GetUserRequest
-	GetUserRolesRequest           = iam.GetUserRolesRequest
-	GetUserRolesResponse          = iam.GetUserRolesResponse
-	ListPermissionsRequest        = iam.ListPermissionsRequest
-	ListPermissionsResponse       = iam.ListPermissionsResponse
-	ListRolesRequest              = iam.ListRolesRequest
-	ListRolesResponse             = iam.ListRolesResponse
-	ListUsersRequest              = iam.ListUsersRequest
-	ListUsersResponse             = iam.ListUsersResponse
-	Permission                    = iam.Permission
-	RemoveRolePermissionRequest   = iam.RemoveRolePermissionRequest
-	RemoveRolePermissionResponse  = iam.RemoveRolePermissionResponse
-	RemoveRolePermissionsRequest  = iam.RemoveRolePermissionsRequest
-	RemoveRolePermissionsResponse = iam.RemoveRolePermissionsResponse
-	RemoveUserRoleRequest         = iam.RemoveUserRoleRequest
-	RemoveUserRoleResponse        = iam.RemoveUserRoleResponse
-	RemoveUserRolesRequest        = iam.RemoveUserRolesRequest
-	RemoveUserRolesResponse       = iam.RemoveUserRolesResponse
-	Role                          = iam.Role
-	UpdatePermissionRequest       = iam.UpdatePermissionRequest
-	UpdateRoleRequest             = iam.UpdateRoleRequest
-	UpdateUserRequest             = iam.UpdateUserRequest
-	User                          = iam.User
-	VerifyPasswordRequest         = iam.VerifyPasswordRequest
-	VerifyPasswordResponse        = iam.VerifyPasswordResponse
+	AccessRequest                      = iam.AccessRequest
+	AccessRequestEvent                 = iam.AccessRequestEvent
+	AddGroupMembersRequest             = iam.AddGroupMembersRequest
+	AddGroupMembersResponse            = iam.AddGroupMembersResponse
+	AdminScope                         = iam.AdminScope
+	ApplyPolicyRequest                 = iam.ApplyPolicyRequest
+	ApprovalStep                       = iam.ApprovalStep
+	ApproveAccessRequestRequest        = iam.ApproveAccessRequestRequest
+	AssignGroupRolesRequest            = iam.AssignGroupRolesRequest
+	AssignGroupRolesResponse           = iam.AssignGroupRolesResponse
+	AssignRolePermissionRequest        = iam.AssignRolePermissionRequest
+	AssignRolePermissionResponse       = iam.AssignRolePermissionResponse
+	AssignRolePermissionsRequest       = iam.AssignRolePermissionsRequest
+	AssignRolePermissionsResponse      = iam.AssignRolePermissionsResponse
+	AssignUserRoleRequest              = iam.AssignUserRoleRequest
+	AssignUserRoleResponse             = iam.AssignUserRoleResponse
+	AssignUserRolesRequest             = iam.AssignUserRolesRequest
+	AssignUserRolesResponse            = iam.AssignUserRolesResponse
+	AuthenticateRequest                = iam.AuthenticateRequest
+	AuthenticateResponse               = iam.AuthenticateResponse
+	CancelAccessRequestRequest         = iam.CancelAccessRequestRequest
+	ChangePasswordRequest              = iam.ChangePasswordRequest
+	ChangePasswordResponse             = iam.ChangePasswordResponse
+	CheckRelationRequest               = iam.CheckRelationRequest
+	CheckRelationResponse              = iam.CheckRelationResponse
+	CheckRolePermissionRequest         = iam.CheckRolePermissionRequest
+	CheckRolePermissionResponse        = iam.CheckRolePermissionResponse
+	CheckUserPermissionRequest         = iam.CheckUserPermissionRequest
+	CheckUserPermissionResponse        = iam.CheckUserPermissionResponse
+	CheckUserResourcePermissionRequest = iam.CheckUserResourcePermissionRequest
+	CheckUserRoleRequest               = iam.CheckUserRoleRequest
+	CheckUserRoleResponse              = iam.CheckUserRoleResponse
+	CloseReviewCampaignRequest         = iam.CloseReviewCampaignRequest
+	CommentAccessRequestRequest        = iam.CommentAccessRequestRequest
+	ConfirmVerificationRequest         = iam.ConfirmVerificationRequest
+	ConfirmVerificationResponse        = iam.ConfirmVerificationResponse
+	ConstraintViolation                = iam.ConstraintViolation
+	CreateAccessRequestRequest         = iam.CreateAccessRequestRequest
+	CreateDepartmentRequest            = iam.CreateDepartmentRequest
+	CreateDepartmentResponse           = iam.CreateDepartmentResponse
+	CreateGroupRequest                 = iam.CreateGroupRequest
+	CreateGroupResponse                = iam.CreateGroupResponse
+	CreatePermissionRequest            = iam.CreatePermissionRequest
+	CreatePermissionResponse           = iam.CreatePermissionResponse
+	CreateResourceBindingRequest       = iam.CreateResourceBindingRequest
+	CreateResourceBindingResponse      = iam.CreateResourceBindingResponse
+	CreateReviewCampaignRequest        = iam.CreateReviewCampaignRequest
+	CreateRoleConstraintRequest        = iam.CreateRoleConstraintRequest
+	CreateRoleRequest                  = iam.CreateRoleRequest
+	CreateRoleResponse                 = iam.CreateRoleResponse
+	CreateSessionRequest               = iam.CreateSessionRequest
+	CreateUserRequest                  = iam.CreateUserRequest
+	CreateUserResponse                 = iam.CreateUserResponse
+	DecideReviewItemRequest            = iam.DecideReviewItemRequest
+	DeleteAdminScopeRequest            = iam.DeleteAdminScopeRequest
+	DeleteAdminScopeResponse           = iam.DeleteAdminScopeResponse
+	DeleteDepartmentRequest            = iam.DeleteDepartmentRequest
+	DeleteDepartmentResponse           = iam.DeleteDepartmentResponse
+	DeleteGroupRequest                 = iam.DeleteGroupRequest
+	DeleteGroupResponse                = iam.DeleteGroupResponse
+	DeletePermissionRequest            = iam.DeletePermissionRequest
+	DeletePermissionResponse           = iam.DeletePermissionResponse
+	DeleteRelationTuplesRequest        = iam.DeleteRelationTuplesRequest
+	DeleteRelationTuplesResponse       = iam.DeleteRelationTuplesResponse
+	DeleteResourceBindingRequest       = iam.DeleteResourceBindingRequest
+	DeleteResourceBindingResponse      = iam.DeleteResourceBindingResponse
+	DeleteRoleConstraintRequest        = iam.DeleteRoleConstraintRequest
+	DeleteRoleConstraintResponse       = iam.DeleteRoleConstraintResponse
+	DeleteRoleRequest                  = iam.DeleteRoleRequest
+	DeleteRoleResponse                 = iam.DeleteRoleResponse
+	DeleteUserRequest                  = iam.DeleteUserRequest
+	DeleteUserResponse                 = iam.DeleteUserResponse
+	Department                         = iam.Department
+	ExpandRelationRequest              = iam.ExpandRelationRequest
+	ExpandRelationResponse             = iam.ExpandRelationResponse
+	ExplainUserPermissionRequest       = iam.ExplainUserPermissionRequest
+	ExplainUserPermissionResponse      = iam.ExplainUserPermissionResponse
+	ExportPolicyRequest                = iam.ExportPolicyRequest
+	ExportPolicyResponse               = iam.ExportPolicyResponse
+	ExportReviewReportRequest          = iam.ExportReviewReportRequest
+	ExportReviewReportResponse         = iam.ExportReviewReportResponse
+	ExtendUserRoleRequest              = iam.ExtendUserRoleRequest
+	ExtendUserRoleResponse             = iam.ExtendUserRoleResponse
+	FailedCondition                    = iam.FailedCondition
+	GetAccessRequestRequest            = iam.GetAccessRequestRequest
+	GetAdminScopeRequest               = iam.GetAdminScopeRequest
+	GetDepartmentRequest               = iam.GetDepartmentRequest
+	GetGroupRequest                    = iam.GetGroupRequest
+	GetGroupRolesRequest               = iam.GetGroupRolesRequest
+	GetGroupRolesResponse              = iam.GetGroupRolesResponse
+	GetPermissionRequest               = iam.GetPermissionRequest
+	GetReviewCampaignRequest           = iam.GetReviewCampaignRequest
+	GetRoleApproversRequest            = iam.GetRoleApproversRequest
+	GetRoleApproversResponse           = iam.GetRoleApproversResponse
+	GetRolePermissionsRequest          = iam.GetRolePermissionsRequest
+	GetRolePermissionsResponse         = iam.GetRolePermissionsResponse
+	GetRoleRequest                     = iam.GetRoleRequest
+	GetUserByUsernameRequest           = iam.GetUserByUsernameRequest
+	GetUserDataScopeRequest            = iam.GetUserDataScopeRequest
+	GetUserDataScopeResponse           = iam.GetUserDataScopeResponse
+	GetUserPermissionsRequest          = iam.GetUserPermissionsRequest
+	GetUserPermissionsResponse         = iam.GetUserPermissionsResponse
+	GetUserRequest                     = iam.GetUserRequest
+	GetUserRolesRequest                = iam.GetUserRolesRequest
+	GetUserRolesResponse               = iam.GetUserRolesResponse
+	GrantTrace                         = iam.GrantTrace
+	GrantUsage                         = iam.GrantUsage
+	Group                              = iam.Group
+	ImpersonateRequest                 = iam.ImpersonateRequest
+	ImpersonateResponse                = iam.ImpersonateResponse
+	LdapSyncItem                       = iam.LdapSyncItem
+	ListAccessRequestsRequest          = iam.ListAccessRequestsRequest
+	ListAccessRequestsResponse         = iam.ListAccessRequestsResponse
+	ListDepartmentsRequest             = iam.ListDepartmentsRequest
+	ListDepartmentsResponse            = iam.ListDepartmentsResponse
+	ListGroupMembersRequest            = iam.ListGroupMembersRequest
+	ListGroupMembersResponse           = iam.ListGroupMembersResponse
+	ListGroupsRequest                  = iam.ListGroupsRequest
+	ListGroupsResponse                 = iam.ListGroupsResponse
+	ListObjectsRequest                 = iam.ListObjectsRequest
+	ListObjectsResponse                = iam.ListObjectsResponse
+	ListPermissionsRequest             = iam.ListPermissionsRequest
+	ListPermissionsResponse            = iam.ListPermissionsResponse
+	ListResourceAccessRequest          = iam.ListResourceAccessRequest
+	ListResourceBindingsResponse       = iam.ListResourceBindingsResponse
+	ListReviewCampaignsRequest         = iam.ListReviewCampaignsRequest
+	ListReviewCampaignsResponse        = iam.ListReviewCampaignsResponse
+	ListReviewItemsRequest             = iam.ListReviewItemsRequest
+	ListReviewItemsResponse            = iam.ListReviewItemsResponse
+	ListRoleConstraintsRequest         = iam.ListRoleConstraintsRequest
+	ListRoleConstraintsResponse        = iam.ListRoleConstraintsResponse
+	ListRolesRequest                   = iam.ListRolesRequest
+	ListRolesResponse                  = iam.ListRolesResponse
+	ListSubjectsRequest                = iam.ListSubjectsRequest
+	ListSubjectsResponse               = iam.ListSubjectsResponse
+	ListUnusedGrantsRequest            = iam.ListUnusedGrantsRequest
+	ListUnusedGrantsResponse           = iam.ListUnusedGrantsResponse
+	ListUnusedRolesRequest             = iam.ListUnusedRolesRequest
+	ListUnusedRolesResponse            = iam.ListUnusedRolesResponse
+	ListUserGroupsRequest              = iam.ListUserGroupsRequest
+	ListUserGroupsResponse             = iam.ListUserGroupsResponse
+	ListUserResourcesRequest           = iam.ListUserResourcesRequest
+	ListUserSessionsRequest            = iam.ListUserSessionsRequest
+	ListUserSessionsResponse           = iam.ListUserSessionsResponse
+	ListUsersRequest                   = iam.ListUsersRequest
+	ListUsersResponse                  = iam.ListUsersResponse
+	Permission                         = iam.Permission
+	PermissionCatalogEntry             = iam.PermissionCatalogEntry
+	PermissionConflict                 = iam.PermissionConflict
+	PermissionGrant                    = iam.PermissionGrant
+	PlanPolicyRequest                  = iam.PlanPolicyRequest
+	PolicyChange                       = iam.PolicyChange
+	PolicyMutation                     = iam.PolicyMutation
+	PolicyPlan                         = iam.PolicyPlan
+	RecommendRoleTrimRequest           = iam.RecommendRoleTrimRequest
+	RecommendRoleTrimResponse          = iam.RecommendRoleTrimResponse
+	RegisterPermissionsRequest         = iam.RegisterPermissionsRequest
+	RegisterPermissionsResponse        = iam.RegisterPermissionsResponse
+	RejectAccessRequestRequest         = iam.RejectAccessRequestRequest
+	RelationTuple                      = iam.RelationTuple
+	RemoveGroupMembersRequest          = iam.RemoveGroupMembersRequest
+	RemoveGroupMembersResponse         = iam.RemoveGroupMembersResponse
+	RemoveGroupRolesRequest            = iam.RemoveGroupRolesRequest
+	RemoveGroupRolesResponse           = iam.RemoveGroupRolesResponse
+	RemoveRolePermissionRequest        = iam.RemoveRolePermissionRequest
+	RemoveRolePermissionResponse       = iam.RemoveRolePermissionResponse
+	RemoveRolePermissionsRequest       = iam.RemoveRolePermissionsRequest
+	RemoveRolePermissionsResponse      = iam.RemoveRolePermissionsResponse
+	RemoveUserRoleRequest              = iam.RemoveUserRoleRequest
+	RemoveUserRoleResponse             = iam.RemoveUserRoleResponse
+	RemoveUserRolesRequest             = iam.RemoveUserRolesRequest
+	RemoveUserRolesResponse            = iam.RemoveUserRolesResponse
+	ResourceBinding                    = iam.ResourceBinding
+	ReviewCampaign                     = iam.ReviewCampaign
+	ReviewItem                         = iam.ReviewItem
+	ReviewProgress                     = iam.ReviewProgress
+	RevokeAllUserSessionsRequest       = iam.RevokeAllUserSessionsRequest
+	RevokeAllUserSessionsResponse      = iam.RevokeAllUserSessionsResponse
+	RevokeSessionRequest               = iam.RevokeSessionRequest
+	RevokeSessionResponse              = iam.RevokeSessionResponse
+	Role                               = iam.Role
+	RoleConstraint                     = iam.RoleConstraint
+	RoleTrace                          = iam.RoleTrace
+	RoleUsage                          = iam.RoleUsage
+	SendVerificationRequest            = iam.SendVerificationRequest
+	SendVerificationResponse           = iam.SendVerificationResponse
+	Session                            = iam.Session
+	SetAdminScopeRequest               = iam.SetAdminScopeRequest
+	SetRoleApproversRequest            = iam.SetRoleApproversRequest
+	SetRoleApproversResponse           = iam.SetRoleApproversResponse
+	SetRoleDataScopeRequest            = iam.SetRoleDataScopeRequest
+	SetRoleDataScopeResponse           = iam.SetRoleDataScopeResponse
+	SetUserDepartmentRequest           = iam.SetUserDepartmentRequest
+	SetUserDepartmentResponse          = iam.SetUserDepartmentResponse
+	SimulateChangeRequest              = iam.SimulateChangeRequest
+	SimulateChangeResponse             = iam.SimulateChangeResponse
+	Subject                            = iam.Subject
+	SubjectTree                        = iam.SubjectTree
+	SyncLdapUsersRequest               = iam.SyncLdapUsersRequest
+	SyncLdapUsersResponse              = iam.SyncLdapUsersResponse
+	TouchSessionRequest                = iam.TouchSessionRequest
+	TouchSessionResponse               = iam.TouchSessionResponse
+	TrimRecommendation                 = iam.TrimRecommendation
+	UpdateDepartmentRequest            = iam.UpdateDepartmentRequest
+	UpdateGroupRequest                 = iam.UpdateGroupRequest
+	UpdatePermissionRequest            = iam.UpdatePermissionRequest
+	UpdateRoleRequest                  = iam.UpdateRoleRequest
+	UpdateUserRequest                  = iam.UpdateUserRequest
+	User                               = iam.User
+	UserPermissionDiff                 = iam.UserPermissionDiff
+	UserTrace                          = iam.UserTrace
+	ValidateConstraintsRequest         = iam.ValidateConstraintsRequest
+	ValidateConstraintsResponse        = iam.ValidateConstraintsResponse
+	VerifyPasswordRequest              = iam.VerifyPasswordRequest
+	VerifyPasswordResponse             = iam.VerifyPasswordResponse
+	WriteRelationTuplesRequest         = iam.WriteRelationTuplesRequest
+	WriteRelationTuplesResponse        = iam.WriteRelationTuplesResponse
 
 	UserService interface {
 		// CreateUser 创建新用户
@@ -88,6 +247,8 @@ type (
 		AssignUserRole(ctx context.Context, in *AssignUserRoleRequest, opts ...grpc.CallOption) (*AssignUserRoleResponse, error)
 		// AssignUserRoles 为用户批量分配角色
 		AssignUserRoles(ctx context.Context, in *AssignUserRolesRequest, opts ...grpc.CallOption) (*AssignUserRolesResponse, error)
+		// ExtendUserRole 调整用户直接分配角色的失效时间
+		ExtendUserRole(ctx context.Context, in *ExtendUserRoleRequest, opts ...grpc.CallOption) (*ExtendUserRoleResponse, error)
 		// RemoveUserRole 移除用户的单个角色
 		RemoveUserRole(ctx context.Context, in *RemoveUserRoleRequest, opts ...grpc.CallOption) (*RemoveUserRoleResponse, error)
 		// RemoveUserRoles 批量移除用户的角色
@@ -96,14 +257,50 @@ type (
 		GetUserRoles(ctx context.Context, in *GetUserRolesRequest, opts ...grpc.CallOption) (*GetUserRolesResponse, error)
 		// CheckUserRole 检查用户是否拥有指定角色
 		CheckUserRole(ctx context.Context, in *CheckUserRoleRequest, opts ...grpc.CallOption) (*CheckUserRoleResponse, error)
-		// CheckUserPermission 检查用户是否具备指定权限
+		// CheckUserPermission 检查用户是否具备指定权限，带条件的授权根据请求上下文求值，任一角色拒绝即不具备
 		CheckUserPermission(ctx context.Context, in *CheckUserPermissionRequest, opts ...grpc.CallOption) (*CheckUserPermissionResponse, error)
+		// CheckUserResourcePermission 检查用户在指定资源上是否具备权限，合并全局角色与该资源上的绑定角色
+		CheckUserResourcePermission(ctx context.Context, in *CheckUserResourcePermissionRequest, opts ...grpc.CallOption) (*CheckUserPermissionResponse, error)
 		// GetUserByUsername 根据用户名获取用户详情
 		GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, opts ...grpc.CallOption) (*User, error)
-		// VerifyPassword 验证用户密码
+		// VerifyPassword 验证用户密码，与Authenticate共用失败次数和账号锁定
 		VerifyPassword(ctx context.Context, in *VerifyPasswordRequest, opts ...grpc.CallOption) (*VerifyPasswordResponse, error)
 		// ChangePassword 修改用户密码
 		ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+		// SyncLdapUsers 从LDAP目录同步用户及组角色映射
+		SyncLdapUsers(ctx context.Context, in *SyncLdapUsersRequest, opts ...grpc.CallOption) (*SyncLdapUsersResponse, error)
+		// CreateSession 登录成功后记录用户会话
+		CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*Session, error)
+		// TouchSession 刷新会话最后活跃时间，并返回会话是否仍有效
+		TouchSession(ctx context.Context, in *TouchSessionRequest, opts ...grpc.CallOption) (*TouchSessionResponse, error)
+		// ListUserSessions 分页查询用户的会话
+		ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*ListUserSessionsResponse, error)
+		// RevokeSession 撤销单个会话
+		RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+		// RevokeAllUserSessions 撤销用户的所有活跃会话
+		RevokeAllUserSessions(ctx context.Context, in *RevokeAllUserSessionsRequest, opts ...grpc.CallOption) (*RevokeAllUserSessionsResponse, error)
+		// Impersonate 管理员以指定用户身份访问，签发限时模拟凭证
+		Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*ImpersonateResponse, error)
+		// SendVerification 向用户邮箱或手机号发送验证码
+		SendVerification(ctx context.Context, in *SendVerificationRequest, opts ...grpc.CallOption) (*SendVerificationResponse, error)
+		// ConfirmVerification 校验验证码并标记邮箱或手机号已验证
+		ConfirmVerification(ctx context.Context, in *ConfirmVerificationRequest, opts ...grpc.CallOption) (*ConfirmVerificationResponse, error)
+		// Authenticate 使用用户名、邮箱或手机号加密码登录认证
+		Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+		// GetUserDataScope 获取用户的数据权限范围，合并用户所有角色的数据权限并解析为部门ID列表
+		GetUserDataScope(ctx context.Context, in *GetUserDataScopeRequest, opts ...grpc.CallOption) (*GetUserDataScopeResponse, error)
+		// GetUserPermissions 获取用户拥有的全部具体权限，通配授权展开为其覆盖的权限
+		GetUserPermissions(ctx context.Context, in *GetUserPermissionsRequest, opts ...grpc.CallOption) (*GetUserPermissionsResponse, error)
+		// SetAdminScope 设置委派管理员的管理范围，覆盖原有配置
+		SetAdminScope(ctx context.Context, in *SetAdminScopeRequest, opts ...grpc.CallOption) (*AdminScope, error)
+		// GetAdminScope 获取委派管理员的管理范围
+		GetAdminScope(ctx context.Context, in *GetAdminScopeRequest, opts ...grpc.CallOption) (*AdminScope, error)
+		// DeleteAdminScope 删除委派管理员的管理范围，删除后该用户不能再调用管理接口
+		DeleteAdminScope(ctx context.Context, in *DeleteAdminScopeRequest, opts ...grpc.CallOption) (*DeleteAdminScopeResponse, error)
+		// ExplainUserPermission 解释用户权限检查的决策过程，返回结构化的决策轨迹和可读文本
+		ExplainUserPermission(ctx context.Context, in *ExplainUserPermissionRequest, opts ...grpc.CallOption) (*ExplainUserPermissionResponse, error)
+		// SimulateChange 模拟一组策略变更，返回受影响用户有效权限的差异；只在内存中求值，不修改任何数据
+		SimulateChange(ctx context.Context, in *SimulateChangeRequest, opts ...grpc.CallOption) (*SimulateChangeResponse, error)
 	}
 
 	defaultUserService struct {
@@ -159,6 +356,12 @@ func (m *defaultUserService) AssignUserRoles(ctx context.Context, in *AssignUser
 	return client.AssignUserRoles(ctx, in, opts...)
 }
 
+// ExtendUserRole 调整用户直接分配角色的失效时间
+func (m *defaultUserService) ExtendUserRole(ctx context.Context, in *ExtendUserRoleRequest, opts ...grpc.CallOption) (*ExtendUserRoleResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.ExtendUserRole(ctx, in, opts...)
+}
+
 // RemoveUserRole 移除用户的单个角色
 func (m *defaultUserService) RemoveUserRole(ctx context.Context, in *RemoveUserRoleRequest, opts ...grpc.CallOption) (*RemoveUserRoleResponse, error) {
 	client := iam.NewUserServiceClient(m.cli.Conn())
@@ -183,19 +386,25 @@ func (m *defaultUserService) CheckUserRole(ctx context.Context, in *CheckUserRol
 	return client.CheckUserRole(ctx, in, opts...)
 }
 
-// CheckUserPermission 检查用户是否具备指定权限
+// CheckUserPermission 检查用户是否具备指定权限，带条件的授权根据请求上下文求值，任一角色拒绝即不具备
 func (m *defaultUserService) CheckUserPermission(ctx context.Context, in *CheckUserPermissionRequest, opts ...grpc.CallOption) (*CheckUserPermissionResponse, error) {
 	client := iam.NewUserServiceClient(m.cli.Conn())
 	return client.CheckUserPermission(ctx, in, opts...)
 }
 
+// CheckUserResourcePermission 检查用户在指定资源上是否具备权限，合并全局角色与该资源上的绑定角色
+func (m *defaultUserService) CheckUserResourcePermission(ctx context.Context, in *CheckUserResourcePermissionRequest, opts ...grpc.CallOption) (*CheckUserPermissionResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.CheckUserResourcePermission(ctx, in, opts...)
+}
+
 // GetUserByUsername 根据用户名获取用户详情
 func (m *defaultUserService) GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, opts ...grpc.CallOption) (*User, error) {
 	client := iam.NewUserServiceClient(m.cli.Conn())
 	return client.GetUserByUsername(ctx, in, opts...)
 }
 
-// VerifyPassword 验证用户密码
+// VerifyPassword 验证用户密码，与Authenticate共用失败次数和账号锁定
 func (m *defaultUserService) VerifyPassword(ctx context.Context, in *VerifyPasswordRequest, opts ...grpc.CallOption) (*VerifyPasswordResponse, error) {
 	client := iam.NewUserServiceClient(m.cli.Conn())
 	return client.VerifyPassword(ctx, in, opts...)
@@ -206,3 +415,105 @@ func (m *defaultUserService) ChangePassword(ctx context.Context, in *ChangePassw
 	client := iam.NewUserServiceClient(m.cli.Conn())
 	return client.ChangePassword(ctx, in, opts...)
 }
+
+// SyncLdapUsers 从LDAP目录同步用户及组角色映射
+func (m *defaultUserService) SyncLdapUsers(ctx context.Context, in *SyncLdapUsersRequest, opts ...grpc.CallOption) (*SyncLdapUsersResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.SyncLdapUsers(ctx, in, opts...)
+}
+
+// CreateSession 登录成功后记录用户会话
+func (m *defaultUserService) CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*Session, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.CreateSession(ctx, in, opts...)
+}
+
+// TouchSession 刷新会话最后活跃时间，并返回会话是否仍有效
+func (m *defaultUserService) TouchSession(ctx context.Context, in *TouchSessionRequest, opts ...grpc.CallOption) (*TouchSessionResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.TouchSession(ctx, in, opts...)
+}
+
+// ListUserSessions 分页查询用户的会话
+func (m *defaultUserService) ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*ListUserSessionsResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.ListUserSessions(ctx, in, opts...)
+}
+
+// RevokeSession 撤销单个会话
+func (m *defaultUserService) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.RevokeSession(ctx, in, opts...)
+}
+
+// RevokeAllUserSessions 撤销用户的所有活跃会话
+func (m *defaultUserService) RevokeAllUserSessions(ctx context.Context, in *RevokeAllUserSessionsRequest, opts ...grpc.CallOption) (*RevokeAllUserSessionsResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.RevokeAllUserSessions(ctx, in, opts...)
+}
+
+// Impersonate 管理员以指定用户身份访问，签发限时模拟凭证
+func (m *defaultUserService) Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*ImpersonateResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.Impersonate(ctx, in, opts...)
+}
+
+// SendVerification 向用户邮箱或手机号发送验证码
+func (m *defaultUserService) SendVerification(ctx context.Context, in *SendVerificationRequest, opts ...grpc.CallOption) (*SendVerificationResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.SendVerification(ctx, in, opts...)
+}
+
+// ConfirmVerification 校验验证码并标记邮箱或手机号已验证
+func (m *defaultUserService) ConfirmVerification(ctx context.Context, in *ConfirmVerificationRequest, opts ...grpc.CallOption) (*ConfirmVerificationResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.ConfirmVerification(ctx, in, opts...)
+}
+
+// Authenticate 使用用户名、邮箱或手机号加密码登录认证
+func (m *defaultUserService) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.Authenticate(ctx, in, opts...)
+}
+
+// GetUserDataScope 获取用户的数据权限范围，合并用户所有角色的数据权限并解析为部门ID列表
+func (m *defaultUserService) GetUserDataScope(ctx context.Context, in *GetUserDataScopeRequest, opts ...grpc.CallOption) (*GetUserDataScopeResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.GetUserDataScope(ctx, in, opts...)
+}
+
+// GetUserPermissions 获取用户拥有的全部具体权限，通配授权展开为其覆盖的权限
+func (m *defaultUserService) GetUserPermissions(ctx context.Context, in *GetUserPermissionsRequest, opts ...grpc.CallOption) (*GetUserPermissionsResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.GetUserPermissions(ctx, in, opts...)
+}
+
+// SetAdminScope 设置委派管理员的管理范围，覆盖原有配置
+func (m *defaultUserService) SetAdminScope(ctx context.Context, in *SetAdminScopeRequest, opts ...grpc.CallOption) (*AdminScope, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.SetAdminScope(ctx, in, opts...)
+}
+
+// GetAdminScope 获取委派管理员的管理范围
+func (m *defaultUserService) GetAdminScope(ctx context.Context, in *GetAdminScopeRequest, opts ...grpc.CallOption) (*AdminScope, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.GetAdminScope(ctx, in, opts...)
+}
+
+// DeleteAdminScope 删除委派管理员的管理范围，删除后该用户不能再调用管理接口
+func (m *defaultUserService) DeleteAdminScope(ctx context.Context, in *DeleteAdminScopeRequest, opts ...grpc.CallOption) (*DeleteAdminScopeResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.DeleteAdminScope(ctx, in, opts...)
+}
+
+// ExplainUserPermission 解释用户权限检查的决策过程，返回结构化的决策轨迹和可读文本
+func (m *defaultUserService) ExplainUserPermission(ctx context.Context, in *ExplainUserPermissionRequest, opts ...grpc.CallOption) (*ExplainUserPermissionResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.ExplainUserPermission(ctx, in, opts...)
+}
+
+// SimulateChange 模拟一组策略变更，返回受影响用户有效权限的差异；只在内存中求值，不修改任何数据
+func (m *defaultUserService) SimulateChange(ctx context.Context, in *SimulateChangeRequest, opts ...grpc.CallOption) (*SimulateChangeResponse, error) {
+	client := iam.NewUserServiceClient(m.cli.Conn())
+	return client.SimulateChange(ctx, in, opts...)
+}
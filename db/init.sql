@@ -4,19 +4,77 @@ CREATE SCHEMA IF NOT EXISTS iam;
 -- 1. IAM Service 表 - RBAC1 模型
 -- =========================================================
 
+-- 租户表
+CREATE TABLE iam.tenants
+(
+    id          BIGSERIAL PRIMARY KEY,
+    code        VARCHAR(50)  NOT NULL UNIQUE CHECK (LENGTH(TRIM(code)) > 0),
+    name        VARCHAR(100) NOT NULL CHECK (LENGTH(TRIM(name)) > 0),
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    disabled_at TIMESTAMPTZ,
+    deleted_at  TIMESTAMPTZ,
+    
+    -- 添加约束确保逻辑删除的数据不能被禁用
+    CONSTRAINT chk_tenants_deleted_not_disabled CHECK (
+        (deleted_at IS NULL) OR (disabled_at IS NULL)
+    ),
+    
+    -- 确保时间戳的逻辑性
+    CONSTRAINT chk_tenants_timestamps CHECK (
+        created_at <= updated_at AND
+        (disabled_at IS NULL OR disabled_at >= created_at) AND
+        (deleted_at IS NULL OR deleted_at >= created_at)
+    )
+);
+
+-- 部门表，使用物化路径存储组织树
+CREATE TABLE iam.departments
+(
+    id          BIGSERIAL PRIMARY KEY,
+    tenant_id   BIGINT        NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    parent_id   BIGINT        REFERENCES iam.departments (id),
+    path        VARCHAR(1000) NOT NULL DEFAULT '/',
+    name        VARCHAR(100)  NOT NULL CHECK (LENGTH(TRIM(name)) > 0),
+    code        VARCHAR(50)   NOT NULL CHECK (LENGTH(TRIM(code)) > 0),
+    sort_order  INTEGER       NOT NULL DEFAULT 0,
+    created_at  TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    deleted_at  TIMESTAMPTZ,
+    
+    -- 部门不能以自身为上级
+    CONSTRAINT chk_departments_parent CHECK (parent_id IS NULL OR parent_id <> id),
+    
+    -- 确保时间戳的逻辑性
+    CONSTRAINT chk_departments_timestamps CHECK (
+        created_at <= updated_at AND
+        (deleted_at IS NULL OR deleted_at >= created_at)
+    )
+);
+
 -- 用户表
 CREATE TABLE iam.users
 (
     id            BIGSERIAL PRIMARY KEY,
-    username      VARCHAR(50)  NOT NULL UNIQUE CHECK (LENGTH(TRIM(username)) > 0),
-    email         VARCHAR(255) UNIQUE CHECK (email ~* '^[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}$'),
+    tenant_id     BIGINT       NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    department_id BIGINT       REFERENCES iam.departments (id) ON DELETE SET NULL,
+    username      VARCHAR(50)  NOT NULL CHECK (LENGTH(TRIM(username)) > 0),
+    email         VARCHAR(255) CHECK (email ~* '^[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}$'),
     password_hash VARCHAR(255) NOT NULL,
     salt          VARCHAR(64)  NOT NULL,
     nickname      VARCHAR(100),
-    phone         VARCHAR(20) UNIQUE,
+    phone         VARCHAR(20),
+    source        VARCHAR(20)  NOT NULL DEFAULT 'local' CHECK (source IN ('local', 'ldap', 'scim')),
+    external_id   VARCHAR(255),
+    email_verified_at TIMESTAMPTZ,
+    phone_verified_at TIMESTAMPTZ,
+    password_changed_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    failed_login_attempts INTEGER     NOT NULL DEFAULT 0,
+    locked_until          TIMESTAMPTZ,
     created_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
     updated_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
     disabled_at   TIMESTAMPTZ,
+    disabled_reason VARCHAR(20) CHECK (disabled_reason IN ('ldap_sync')),
     deleted_at    TIMESTAMPTZ,
     
     -- 添加约束确保逻辑删除的数据不能被禁用
@@ -24,6 +82,21 @@ CREATE TABLE iam.users
         (deleted_at IS NULL) OR (disabled_at IS NULL)
     ),
     
+    -- 只有已禁用的用户才有禁用原因
+    CONSTRAINT chk_users_disabled_reason CHECK (
+        (disabled_at IS NOT NULL) OR (disabled_reason IS NULL)
+    ),
+    
+    -- 外部目录来源的用户必须有外部标识
+    CONSTRAINT chk_users_external_id CHECK (
+        (source = 'local') OR (external_id IS NOT NULL)
+    ),
+    
+    -- 用户名、邮箱、手机号在租户内唯一
+    CONSTRAINT uk_users_tenant_username UNIQUE (tenant_id, username),
+    CONSTRAINT uk_users_tenant_email UNIQUE (tenant_id, email),
+    CONSTRAINT uk_users_tenant_phone UNIQUE (tenant_id, phone),
+    
     -- 确保时间戳的逻辑性
     CONSTRAINT chk_users_timestamps CHECK (
         created_at <= updated_at AND
@@ -36,10 +109,12 @@ CREATE TABLE iam.users
 CREATE TABLE iam.roles
 (
     id          BIGSERIAL PRIMARY KEY,
-    name        VARCHAR(50)  NOT NULL UNIQUE CHECK (LENGTH(TRIM(name)) > 0),
-    code        VARCHAR(50)  NOT NULL UNIQUE CHECK (LENGTH(TRIM(code)) > 0),
+    tenant_id   BIGINT       NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    name        VARCHAR(50)  NOT NULL CHECK (LENGTH(TRIM(name)) > 0),
+    code        VARCHAR(50)  NOT NULL CHECK (LENGTH(TRIM(code)) > 0),
     description VARCHAR(255),
     sort_order  INTEGER      NOT NULL DEFAULT 0,
+    data_scope  VARCHAR(20)  NOT NULL DEFAULT 'self' CHECK (data_scope IN ('all', 'dept_and_child', 'dept', 'self', 'custom')),
     created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
     updated_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
     disabled_at TIMESTAMPTZ,
@@ -50,6 +125,10 @@ CREATE TABLE iam.roles
         (deleted_at IS NULL) OR (disabled_at IS NULL)
     ),
     
+    -- 角色编码和名称在租户内唯一
+    CONSTRAINT uk_roles_tenant_code UNIQUE (tenant_id, code),
+    CONSTRAINT uk_roles_tenant_name UNIQUE (tenant_id, name),
+    
     -- 确保时间戳的逻辑性
     CONSTRAINT chk_roles_timestamps CHECK (
         created_at <= updated_at AND
@@ -62,13 +141,16 @@ CREATE TABLE iam.roles
 CREATE TABLE iam.permissions
 (
     id          BIGSERIAL PRIMARY KEY,
+    tenant_id   BIGINT       REFERENCES iam.tenants (id),
     name        VARCHAR(100) NOT NULL CHECK (LENGTH(TRIM(name)) > 0),
-    code        VARCHAR(100) NOT NULL UNIQUE CHECK (LENGTH(TRIM(code)) > 0),
+    code        VARCHAR(100) NOT NULL CHECK (LENGTH(TRIM(code)) > 0),
     type        VARCHAR(20)  NOT NULL CHECK (type IN ('path', 'button', 'menu')),
     resource    VARCHAR(100) NOT NULL CHECK (LENGTH(TRIM(resource)) > 0),
     action      VARCHAR(50)  NOT NULL CHECK (LENGTH(TRIM(action)) > 0),
     http_method VARCHAR(10)  CHECK (http_method IN ('GET', 'POST', 'PUT', 'DELETE') OR http_method IS NULL),
     description VARCHAR(255),
+    namespace   VARCHAR(64),
+    deprecated_at TIMESTAMPTZ,
     created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
     updated_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
     
@@ -90,27 +172,391 @@ CREATE TABLE iam.permissions
 -- 用户角色关联表
 CREATE TABLE iam.user_roles
 (
-    id         BIGSERIAL PRIMARY KEY,
-    user_id    BIGINT      NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
-    role_id    BIGINT      NOT NULL REFERENCES iam.roles (id) ON DELETE CASCADE,
-    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-    created_by BIGINT      REFERENCES iam.users (id),
+    id          BIGSERIAL PRIMARY KEY,
+    tenant_id   BIGINT      NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    user_id     BIGINT      NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    role_id     BIGINT      NOT NULL REFERENCES iam.roles (id) ON DELETE CASCADE,
+    valid_from  TIMESTAMPTZ,
+    valid_until TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by  BIGINT      REFERENCES iam.users (id),
     
     -- 确保用户角色关联的唯一性
-    CONSTRAINT uk_user_roles UNIQUE (user_id, role_id)
+    CONSTRAINT uk_user_roles UNIQUE (user_id, role_id),
+    CONSTRAINT ck_user_roles_validity CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_until > valid_from)
 );
 
 -- 角色权限关联表
 CREATE TABLE iam.role_permissions
 (
     id            BIGSERIAL PRIMARY KEY,
+    tenant_id     BIGINT      NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
     role_id       BIGINT      NOT NULL REFERENCES iam.roles (id) ON DELETE CASCADE,
     permission_id BIGINT      NOT NULL REFERENCES iam.permissions (id) ON DELETE CASCADE,
+    effect        VARCHAR(10) NOT NULL DEFAULT 'allow' CHECK (effect IN ('allow', 'deny')),
+    condition     TEXT        NOT NULL DEFAULT '',
+    valid_from    TIMESTAMPTZ,
+    valid_until   TIMESTAMPTZ,
     created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     created_by    BIGINT      REFERENCES iam.users (id),
     
     -- 确保角色权限关联的唯一性
-    CONSTRAINT uk_role_permissions UNIQUE (role_id, permission_id)
+    CONSTRAINT uk_role_permissions UNIQUE (role_id, permission_id),
+    CONSTRAINT ck_role_permissions_validity CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_until > valid_from)
+);
+
+-- 资源级角色绑定表，用户仅在指定资源上拥有角色
+CREATE TABLE iam.resource_bindings
+(
+    id            BIGSERIAL PRIMARY KEY,
+    tenant_id     BIGINT       NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    user_id       BIGINT       NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    role_id       BIGINT       NOT NULL REFERENCES iam.roles (id) ON DELETE CASCADE,
+    resource_type VARCHAR(50)  NOT NULL CHECK (LENGTH(TRIM(resource_type)) > 0),
+    resource_id   VARCHAR(100) NOT NULL CHECK (LENGTH(TRIM(resource_id)) > 0),
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    created_by    BIGINT       REFERENCES iam.users (id),
+    
+    -- 确保资源绑定的唯一性
+    CONSTRAINT uk_resource_bindings UNIQUE (user_id, role_id, resource_type, resource_id)
+);
+
+-- 角色自定义数据权限部门表，仅data_scope为custom的角色使用
+CREATE TABLE iam.role_departments
+(
+    id            BIGSERIAL PRIMARY KEY,
+    role_id       BIGINT      NOT NULL REFERENCES iam.roles (id) ON DELETE CASCADE,
+    department_id BIGINT      NOT NULL REFERENCES iam.departments (id) ON DELETE CASCADE,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by    BIGINT      REFERENCES iam.users (id),
+    
+    -- 确保角色部门关联的唯一性
+    CONSTRAINT uk_role_departments UNIQUE (role_id, department_id)
+);
+
+-- 用户会话表
+CREATE TABLE iam.sessions
+(
+    id            BIGSERIAL PRIMARY KEY,
+    user_id       BIGINT       NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    client        VARCHAR(100),
+    ip            VARCHAR(45),
+    user_agent    VARCHAR(512),
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    last_seen_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    revoked_at    TIMESTAMPTZ,
+    revoke_reason VARCHAR(50),
+    
+    -- 确保时间戳的逻辑性
+    CONSTRAINT chk_sessions_timestamps CHECK (
+        created_at <= last_seen_at AND
+        (revoked_at IS NULL OR revoked_at >= created_at)
+    )
+);
+
+-- 验证码表
+CREATE TABLE iam.verification_codes
+(
+    id          BIGSERIAL PRIMARY KEY,
+    user_id     BIGINT       NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    channel     VARCHAR(10)  NOT NULL CHECK (channel IN ('email', 'phone')),
+    target      VARCHAR(255) NOT NULL,
+    code_hash   VARCHAR(64)  NOT NULL,
+    attempts    INTEGER      NOT NULL DEFAULT 0,
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    expires_at  TIMESTAMPTZ  NOT NULL,
+    consumed_at TIMESTAMPTZ,
+    
+    -- 确保时间戳的逻辑性
+    CONSTRAINT chk_verification_codes_timestamps CHECK (expires_at > created_at)
+);
+
+-- 模拟登录记录表
+CREATE TABLE iam.impersonations
+(
+    id         BIGSERIAL PRIMARY KEY,
+    actor_id   BIGINT       NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    subject_id BIGINT       NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    reason     VARCHAR(255) NOT NULL CHECK (LENGTH(TRIM(reason)) > 0),
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ  NOT NULL,
+    
+    -- 不能模拟自己
+    CONSTRAINT chk_impersonations_actor_subject CHECK (actor_id <> subject_id),
+    
+    -- 确保时间戳的逻辑性
+    CONSTRAINT chk_impersonations_timestamps CHECK (expires_at > created_at)
+);
+
+-- 审计日志表
+CREATE TABLE iam.audit_logs
+(
+    id               BIGSERIAL PRIMARY KEY,
+    actor_id         BIGINT,
+    subject_id       BIGINT,
+    impersonation_id BIGINT       REFERENCES iam.impersonations (id) ON DELETE SET NULL,
+    action           VARCHAR(100) NOT NULL CHECK (LENGTH(TRIM(action)) > 0),
+    result           VARCHAR(30)  NOT NULL,
+    detail           VARCHAR(500),
+    created_at       TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+-- 用户组表
+CREATE TABLE iam.groups
+(
+    id          BIGSERIAL PRIMARY KEY,
+    tenant_id   BIGINT       NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    name        VARCHAR(100) NOT NULL CHECK (LENGTH(TRIM(name)) > 0),
+    code        VARCHAR(50)  NOT NULL CHECK (LENGTH(TRIM(code)) > 0),
+    description VARCHAR(255),
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    deleted_at  TIMESTAMPTZ,
+    
+    -- 确保时间戳的逻辑性
+    CONSTRAINT chk_groups_timestamps CHECK (
+        created_at <= updated_at AND
+        (deleted_at IS NULL OR deleted_at >= created_at)
+    )
+);
+
+-- 用户组成员表
+CREATE TABLE iam.group_members
+(
+    id         BIGSERIAL PRIMARY KEY,
+    group_id   BIGINT      NOT NULL REFERENCES iam.groups (id) ON DELETE CASCADE,
+    user_id    BIGINT      NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT      REFERENCES iam.users (id),
+    
+    -- 确保用户组成员的唯一性
+    CONSTRAINT uk_group_members UNIQUE (group_id, user_id)
+);
+
+-- 用户组角色关联表
+CREATE TABLE iam.group_roles
+(
+    id         BIGSERIAL PRIMARY KEY,
+    group_id   BIGINT      NOT NULL REFERENCES iam.groups (id) ON DELETE CASCADE,
+    role_id    BIGINT      NOT NULL REFERENCES iam.roles (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT      REFERENCES iam.users (id),
+    
+    -- 确保用户组角色关联的唯一性
+    CONSTRAINT uk_group_roles UNIQUE (group_id, role_id)
+);
+
+-- 关系元组表，记录"主体与对象之间的关系"，如 document:readme#viewer@user:1
+CREATE TABLE iam.relation_tuples
+(
+    id                BIGSERIAL PRIMARY KEY,
+    tenant_id         BIGINT       NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    namespace         VARCHAR(64)  NOT NULL CHECK (LENGTH(TRIM(namespace)) > 0),
+    object_id         VARCHAR(128) NOT NULL CHECK (LENGTH(TRIM(object_id)) > 0),
+    relation          VARCHAR(64)  NOT NULL CHECK (LENGTH(TRIM(relation)) > 0),
+    subject_namespace VARCHAR(64)  NOT NULL CHECK (LENGTH(TRIM(subject_namespace)) > 0),
+    subject_id        VARCHAR(128) NOT NULL CHECK (LENGTH(TRIM(subject_id)) > 0),
+    subject_relation  VARCHAR(64)  NOT NULL DEFAULT '',
+    created_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    created_by        BIGINT       REFERENCES iam.users (id),
+    
+    -- 确保关系元组的唯一性
+    CONSTRAINT uk_relation_tuples UNIQUE (tenant_id, namespace, object_id, relation, subject_namespace, subject_id, subject_relation)
+);
+
+-- 角色审批人表，按步骤定义申请角色的审批链
+CREATE TABLE iam.role_approvers
+(
+    id          BIGSERIAL PRIMARY KEY,
+    tenant_id   BIGINT      NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    role_id     BIGINT      NOT NULL REFERENCES iam.roles (id) ON DELETE CASCADE,
+    step        INTEGER     NOT NULL CHECK (step > 0),
+    approver_id BIGINT      NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by  BIGINT      REFERENCES iam.users (id),
+    
+    -- 确保同一步骤审批人的唯一性
+    CONSTRAINT uk_role_approvers UNIQUE (role_id, step, approver_id)
+);
+
+-- 访问申请表，用户申请角色并经审批链逐级审批
+CREATE TABLE iam.access_requests
+(
+    id               BIGSERIAL PRIMARY KEY,
+    tenant_id        BIGINT       NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    requester_id     BIGINT       NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    role_id          BIGINT       NOT NULL REFERENCES iam.roles (id) ON DELETE CASCADE,
+    justification    VARCHAR(500) NOT NULL CHECK (LENGTH(TRIM(justification)) > 0),
+    duration_seconds BIGINT       NOT NULL DEFAULT 0 CHECK (duration_seconds >= 0),
+    status           VARCHAR(20)  NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'cancelled')),
+    current_step     INTEGER      NOT NULL DEFAULT 1 CHECK (current_step > 0),
+    total_steps      INTEGER      NOT NULL CHECK (total_steps > 0),
+    decided_at       TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    
+    -- 确保审批进度的逻辑性
+    CONSTRAINT chk_access_requests_step CHECK (current_step <= total_steps)
+);
+
+-- 访问申请事件表，记录申请的创建、审批、评论等历史
+CREATE TABLE iam.access_request_events
+(
+    id         BIGSERIAL PRIMARY KEY,
+    tenant_id  BIGINT        NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    request_id BIGINT        NOT NULL REFERENCES iam.access_requests (id) ON DELETE CASCADE,
+    actor_id   BIGINT        REFERENCES iam.users (id) ON DELETE SET NULL,
+    event_type VARCHAR(20)   NOT NULL CHECK (event_type IN ('created', 'approved', 'rejected', 'cancelled', 'commented', 'granted')),
+    step       INTEGER       NOT NULL DEFAULT 0,
+    comment    VARCHAR(1000) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+-- 职责分离约束表，限制角色的互斥持有和持有数量（RBAC2）
+CREATE TABLE iam.role_constraints
+(
+    id              BIGSERIAL PRIMARY KEY,
+    tenant_id       BIGINT       NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    code            VARCHAR(50)  NOT NULL CHECK (LENGTH(TRIM(code)) > 0),
+    name            VARCHAR(100) NOT NULL CHECK (LENGTH(TRIM(name)) > 0),
+    description     VARCHAR(255),
+    constraint_type VARCHAR(20)  NOT NULL CHECK (constraint_type IN ('exclusive', 'role_cardinality', 'user_cardinality')),
+    enforcement     VARCHAR(10)  NOT NULL DEFAULT 'static' CHECK (enforcement IN ('static', 'dynamic')),
+    max_count       INTEGER      NOT NULL DEFAULT 1 CHECK (max_count > 0),
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    created_by      BIGINT       REFERENCES iam.users (id),
+    
+    -- 约束编码在租户内唯一
+    CONSTRAINT uk_role_constraints_tenant_code UNIQUE (tenant_id, code)
+);
+
+-- 职责分离约束角色表，记录约束涉及的角色集合
+CREATE TABLE iam.role_constraint_roles
+(
+    id            BIGSERIAL PRIMARY KEY,
+    tenant_id     BIGINT      NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    constraint_id BIGINT      NOT NULL REFERENCES iam.role_constraints (id) ON DELETE CASCADE,
+    role_id       BIGINT      NOT NULL REFERENCES iam.roles (id) ON DELETE CASCADE,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    
+    -- 确保约束角色的唯一性
+    CONSTRAINT uk_role_constraint_roles UNIQUE (constraint_id, role_id)
+);
+
+-- 管理范围表，限定委派管理员可管理的用户和可分配的角色
+CREATE TABLE iam.admin_scopes
+(
+    id            BIGSERIAL PRIMARY KEY,
+    tenant_id     BIGINT      NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    admin_id      BIGINT      NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    department_id BIGINT      REFERENCES iam.departments (id) ON DELETE CASCADE,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by    BIGINT      REFERENCES iam.users (id),
+    
+    -- 每个管理员在租户内只有一个管理范围
+    CONSTRAINT uk_admin_scopes_tenant_admin UNIQUE (tenant_id, admin_id)
+);
+
+-- 管理范围可分配角色表
+CREATE TABLE iam.admin_scope_roles
+(
+    id         BIGSERIAL PRIMARY KEY,
+    tenant_id  BIGINT      NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    scope_id   BIGINT      NOT NULL REFERENCES iam.admin_scopes (id) ON DELETE CASCADE,
+    role_id    BIGINT      NOT NULL REFERENCES iam.roles (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    
+    -- 确保可分配角色的唯一性
+    CONSTRAINT uk_admin_scope_roles UNIQUE (scope_id, role_id)
+);
+
+-- 访问复核活动表，定期复核选定角色的持有人是否仍需保留角色
+CREATE TABLE iam.review_campaigns
+(
+    id              BIGSERIAL PRIMARY KEY,
+    tenant_id       BIGINT       NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    name            VARCHAR(100) NOT NULL CHECK (LENGTH(TRIM(name)) > 0),
+    description     VARCHAR(500) NOT NULL DEFAULT '',
+    reviewer_source VARCHAR(20)  NOT NULL CHECK (reviewer_source IN ('role_owners', 'managers')),
+    status          VARCHAR(20)  NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'closed')),
+    due_at          TIMESTAMPTZ,
+    closed_at       TIMESTAMPTZ,
+    closed_by       BIGINT       REFERENCES iam.users (id) ON DELETE SET NULL,
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    created_by      BIGINT       REFERENCES iam.users (id) ON DELETE SET NULL,
+    
+    -- 确保关闭状态与关闭时间一致
+    CONSTRAINT chk_review_campaigns_closed CHECK ((status = 'closed') = (closed_at IS NOT NULL))
+);
+
+-- 访问复核条目表，活动创建时对用户直接分配的角色做快照，每个条目记录一次保留或撤销决定
+CREATE TABLE iam.review_items
+(
+    id             BIGSERIAL PRIMARY KEY,
+    tenant_id      BIGINT        NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    campaign_id    BIGINT        NOT NULL REFERENCES iam.review_campaigns (id) ON DELETE CASCADE,
+    user_id        BIGINT        NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    role_id        BIGINT        NOT NULL REFERENCES iam.roles (id) ON DELETE CASCADE,
+    granted_at     TIMESTAMPTZ   NOT NULL,
+    valid_from     TIMESTAMPTZ,
+    valid_until    TIMESTAMPTZ,
+    decision       VARCHAR(20)   NOT NULL DEFAULT 'pending' CHECK (decision IN ('pending', 'keep', 'revoke')),
+    comment        VARCHAR(1000) NOT NULL DEFAULT '',
+    decided_by     BIGINT        REFERENCES iam.users (id) ON DELETE SET NULL,
+    decided_at     TIMESTAMPTZ,
+    outcome        VARCHAR(20)   NOT NULL DEFAULT '' CHECK (outcome IN ('', 'kept', 'unreviewed', 'revoked', 'already_removed', 'failed')),
+    outcome_detail VARCHAR(500)  NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    
+    -- 同一活动中每个用户角色只复核一次
+    CONSTRAINT uk_review_items UNIQUE (campaign_id, user_id, role_id)
+);
+
+-- 访问复核条目的复核人表，任一复核人都可以作出决定
+CREATE TABLE iam.review_item_reviewers
+(
+    id          BIGSERIAL PRIMARY KEY,
+    tenant_id   BIGINT      NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    item_id     BIGINT      NOT NULL REFERENCES iam.review_items (id) ON DELETE CASCADE,
+    reviewer_id BIGINT      NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    
+    -- 确保复核人的唯一性
+    CONSTRAINT uk_review_item_reviewers UNIQUE (item_id, reviewer_id)
+);
+
+-- 用户权限使用记录表，记录用户最近一次通过某个授权的权限检查
+CREATE TABLE iam.permission_usage_users
+(
+    id            BIGSERIAL PRIMARY KEY,
+    tenant_id     BIGINT      NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    user_id       BIGINT      NOT NULL REFERENCES iam.users (id) ON DELETE CASCADE,
+    permission_id BIGINT      NOT NULL REFERENCES iam.permissions (id) ON DELETE CASCADE,
+    last_used_at  TIMESTAMPTZ NOT NULL,
+    use_count     BIGINT      NOT NULL DEFAULT 0,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    
+    -- 每个用户的每个授权权限只保留一条记录
+    CONSTRAINT uk_permission_usage_users UNIQUE (user_id, permission_id)
+);
+
+-- 角色权限使用记录表，记录角色的授权最近一次使权限检查通过的时间
+CREATE TABLE iam.permission_usage_roles
+(
+    id            BIGSERIAL PRIMARY KEY,
+    tenant_id     BIGINT      NOT NULL DEFAULT 1 REFERENCES iam.tenants (id),
+    role_id       BIGINT      NOT NULL REFERENCES iam.roles (id) ON DELETE CASCADE,
+    permission_id BIGINT      NOT NULL REFERENCES iam.permissions (id) ON DELETE CASCADE,
+    last_used_at  TIMESTAMPTZ NOT NULL,
+    use_count     BIGINT      NOT NULL DEFAULT 0,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    
+    -- 每个角色的每个授权权限只保留一条记录
+    CONSTRAINT uk_permission_usage_roles UNIQUE (role_id, permission_id)
 );
 
 -- =========================================================
@@ -130,6 +576,18 @@ $$ language 'plpgsql';
 -- 3. 触发器
 -- =========================================================
 
+-- 租户表触发器
+CREATE TRIGGER trigger_update_tenants_updated_at
+    BEFORE UPDATE ON iam.tenants
+    FOR EACH ROW
+    EXECUTE FUNCTION iam.update_updated_at_column();
+
+-- 部门表触发器
+CREATE TRIGGER trigger_update_departments_updated_at
+    BEFORE UPDATE ON iam.departments
+    FOR EACH ROW
+    EXECUTE FUNCTION iam.update_updated_at_column();
+
 -- 用户表触发器
 CREATE TRIGGER trigger_update_users_updated_at
     BEFORE UPDATE ON iam.users
@@ -148,22 +606,72 @@ CREATE TRIGGER trigger_update_permissions_updated_at
     FOR EACH ROW
     EXECUTE FUNCTION iam.update_updated_at_column();
 
+-- 用户组表触发器
+CREATE TRIGGER trigger_update_groups_updated_at
+    BEFORE UPDATE ON iam.groups
+    FOR EACH ROW
+    EXECUTE FUNCTION iam.update_updated_at_column();
+
+-- 访问申请表触发器
+CREATE TRIGGER trigger_update_access_requests_updated_at
+    BEFORE UPDATE ON iam.access_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION iam.update_updated_at_column();
+
+-- 职责分离约束表触发器
+CREATE TRIGGER trigger_update_role_constraints_updated_at
+    BEFORE UPDATE ON iam.role_constraints
+    FOR EACH ROW
+    EXECUTE FUNCTION iam.update_updated_at_column();
+
+-- 管理范围表触发器
+CREATE TRIGGER trigger_update_admin_scopes_updated_at
+    BEFORE UPDATE ON iam.admin_scopes
+    FOR EACH ROW
+    EXECUTE FUNCTION iam.update_updated_at_column();
+
+-- 访问复核活动表触发器
+CREATE TRIGGER trigger_update_review_campaigns_updated_at
+    BEFORE UPDATE ON iam.review_campaigns
+    FOR EACH ROW
+    EXECUTE FUNCTION iam.update_updated_at_column();
+
+-- 访问复核条目表触发器
+CREATE TRIGGER trigger_update_review_items_updated_at
+    BEFORE UPDATE ON iam.review_items
+    FOR EACH ROW
+    EXECUTE FUNCTION iam.update_updated_at_column();
+
 -- =========================================================
 -- 4. 索引优化
 -- =========================================================
 
+-- 租户表索引
+CREATE INDEX idx_tenants_active ON iam.tenants (id) WHERE deleted_at IS NULL AND disabled_at IS NULL;
+
+-- 部门表索引
+CREATE UNIQUE INDEX uk_departments_code ON iam.departments (tenant_id, code) WHERE deleted_at IS NULL;
+CREATE INDEX idx_departments_parent_id ON iam.departments (tenant_id, parent_id, sort_order) WHERE deleted_at IS NULL;
+CREATE INDEX idx_departments_path ON iam.departments (path varchar_pattern_ops) WHERE deleted_at IS NULL;
+
 -- 用户表索引
-CREATE INDEX idx_users_username ON iam.users (username) WHERE deleted_at IS NULL;
-CREATE INDEX idx_users_email ON iam.users (email) WHERE deleted_at IS NULL;
+CREATE INDEX idx_users_department_id ON iam.users (department_id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_users_tenant_id ON iam.users (tenant_id, created_at DESC) WHERE deleted_at IS NULL;
+CREATE INDEX idx_users_username ON iam.users (tenant_id, username) WHERE deleted_at IS NULL;
+CREATE INDEX idx_users_email ON iam.users (tenant_id, email) WHERE deleted_at IS NULL;
 CREATE INDEX idx_users_active ON iam.users (id) WHERE deleted_at IS NULL AND disabled_at IS NULL;
 CREATE INDEX idx_users_created_at ON iam.users (created_at);
 CREATE INDEX idx_users_updated_at ON iam.users (updated_at);
 CREATE INDEX idx_users_deleted_at ON iam.users (deleted_at) WHERE deleted_at IS NOT NULL;
 CREATE INDEX idx_users_disabled_at ON iam.users (disabled_at) WHERE disabled_at IS NOT NULL;
+CREATE INDEX idx_users_source ON iam.users (source) WHERE deleted_at IS NULL;
+CREATE INDEX idx_users_email_lower ON iam.users (tenant_id, lower(email)) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX uk_users_source_external_id ON iam.users (tenant_id, source, external_id) WHERE external_id IS NOT NULL AND deleted_at IS NULL;
 
 -- 角色表索引
-CREATE INDEX idx_roles_name ON iam.roles (name) WHERE deleted_at IS NULL;
-CREATE INDEX idx_roles_code ON iam.roles (code) WHERE deleted_at IS NULL;
+CREATE INDEX idx_roles_tenant_id ON iam.roles (tenant_id, sort_order) WHERE deleted_at IS NULL;
+CREATE INDEX idx_roles_name ON iam.roles (tenant_id, name) WHERE deleted_at IS NULL;
+CREATE INDEX idx_roles_code ON iam.roles (tenant_id, code) WHERE deleted_at IS NULL;
 CREATE INDEX idx_roles_sort_order ON iam.roles (sort_order) WHERE deleted_at IS NULL;
 CREATE INDEX idx_roles_active ON iam.roles (id) WHERE deleted_at IS NULL AND disabled_at IS NULL;
 CREATE INDEX idx_roles_created_at ON iam.roles (created_at);
@@ -172,6 +680,10 @@ CREATE INDEX idx_roles_deleted_at ON iam.roles (deleted_at) WHERE deleted_at IS
 CREATE INDEX idx_roles_disabled_at ON iam.roles (disabled_at) WHERE disabled_at IS NOT NULL;
 
 -- 权限表索引
+-- 全局系统权限的编码全局唯一，租户自定义权限的编码在租户内唯一
+CREATE UNIQUE INDEX uk_permissions_global_code ON iam.permissions (code) WHERE tenant_id IS NULL;
+CREATE UNIQUE INDEX uk_permissions_tenant_code ON iam.permissions (tenant_id, code) WHERE tenant_id IS NOT NULL;
+CREATE INDEX idx_permissions_tenant_id ON iam.permissions (tenant_id) WHERE tenant_id IS NOT NULL;
 CREATE INDEX idx_permissions_code ON iam.permissions (code);
 CREATE INDEX idx_permissions_type ON iam.permissions (type);
 CREATE INDEX idx_permissions_resource ON iam.permissions (resource);
@@ -180,44 +692,158 @@ CREATE INDEX idx_permissions_http_method ON iam.permissions (http_method) WHERE
 CREATE INDEX idx_permissions_resource_action ON iam.permissions (resource, action);
 CREATE INDEX idx_permissions_type_resource ON iam.permissions (type, resource);
 CREATE INDEX idx_permissions_path_method ON iam.permissions (resource, http_method) WHERE type = 'path';
+CREATE INDEX idx_permissions_namespace ON iam.permissions (namespace) WHERE namespace IS NOT NULL;
 CREATE INDEX idx_permissions_created_at ON iam.permissions (created_at);
 CREATE INDEX idx_permissions_updated_at ON iam.permissions (updated_at);
 
 -- 用户角色关联表索引
+CREATE INDEX idx_user_roles_tenant_id ON iam.user_roles (tenant_id);
 CREATE INDEX idx_user_roles_user_id ON iam.user_roles (user_id);
 CREATE INDEX idx_user_roles_role_id ON iam.user_roles (role_id);
 CREATE INDEX idx_user_roles_created_at ON iam.user_roles (created_at);
+CREATE INDEX idx_user_roles_valid_until ON iam.user_roles (valid_until) WHERE valid_until IS NOT NULL;
 
 -- 角色权限关联表索引
+CREATE INDEX idx_role_permissions_tenant_id ON iam.role_permissions (tenant_id);
 CREATE INDEX idx_role_permissions_role_id ON iam.role_permissions (role_id);
 CREATE INDEX idx_role_permissions_permission_id ON iam.role_permissions (permission_id);
 CREATE INDEX idx_role_permissions_created_at ON iam.role_permissions (created_at);
+CREATE INDEX idx_role_permissions_valid_until ON iam.role_permissions (valid_until) WHERE valid_until IS NOT NULL;
+
+-- 资源绑定表索引
+CREATE INDEX idx_resource_bindings_resource ON iam.resource_bindings (tenant_id, resource_type, resource_id);
+CREATE INDEX idx_resource_bindings_user_id ON iam.resource_bindings (user_id, resource_type);
+CREATE INDEX idx_resource_bindings_role_id ON iam.resource_bindings (role_id);
+
+-- 角色部门关联表索引
+CREATE INDEX idx_role_departments_role_id ON iam.role_departments (role_id);
+CREATE INDEX idx_role_departments_department_id ON iam.role_departments (department_id);
+
+-- 用户会话表索引
+CREATE INDEX idx_sessions_user_id ON iam.sessions (user_id, created_at DESC);
+CREATE INDEX idx_sessions_active ON iam.sessions (user_id) WHERE revoked_at IS NULL;
+
+-- 验证码表索引
+CREATE INDEX idx_verification_codes_pending ON iam.verification_codes (user_id, channel, created_at DESC) WHERE consumed_at IS NULL;
+
+-- 模拟登录记录表索引
+CREATE INDEX idx_impersonations_actor_id ON iam.impersonations (actor_id, created_at DESC);
+CREATE INDEX idx_impersonations_subject_id ON iam.impersonations (subject_id, created_at DESC);
+
+-- 审计日志表索引
+CREATE INDEX idx_audit_logs_actor_id ON iam.audit_logs (actor_id, created_at DESC);
+CREATE INDEX idx_audit_logs_subject_id ON iam.audit_logs (subject_id, created_at DESC);
+CREATE INDEX idx_audit_logs_impersonation_id ON iam.audit_logs (impersonation_id) WHERE impersonation_id IS NOT NULL;
+CREATE INDEX idx_audit_logs_created_at ON iam.audit_logs (created_at);
+
+-- 用户组表索引
+CREATE UNIQUE INDEX uk_groups_code ON iam.groups (tenant_id, code) WHERE deleted_at IS NULL;
+CREATE INDEX idx_groups_name ON iam.groups (tenant_id, name) WHERE deleted_at IS NULL;
+CREATE INDEX idx_groups_created_at ON iam.groups (created_at);
+
+-- 用户组成员表索引
+CREATE INDEX idx_group_members_user_id ON iam.group_members (user_id);
+CREATE INDEX idx_group_members_group_id ON iam.group_members (group_id, created_at);
+
+-- 用户组角色关联表索引
+CREATE INDEX idx_group_roles_group_id ON iam.group_roles (group_id);
+CREATE INDEX idx_group_roles_role_id ON iam.group_roles (role_id);
+
+-- 关系元组表索引
+CREATE INDEX idx_relation_tuples_subject ON iam.relation_tuples (tenant_id, subject_namespace, subject_id, subject_relation);
+CREATE INDEX idx_relation_tuples_namespace_relation ON iam.relation_tuples (tenant_id, namespace, relation);
+
+-- 角色审批人表索引
+CREATE INDEX idx_role_approvers_approver_id ON iam.role_approvers (approver_id, role_id, step);
+
+-- 访问申请表索引
+CREATE UNIQUE INDEX uk_access_requests_pending ON iam.access_requests (requester_id, role_id) WHERE status = 'pending';
+CREATE INDEX idx_access_requests_requester_id ON iam.access_requests (tenant_id, requester_id, created_at DESC);
+CREATE INDEX idx_access_requests_status ON iam.access_requests (tenant_id, status, role_id, current_step);
+
+-- 访问申请事件表索引
+CREATE INDEX idx_access_request_events_request_id ON iam.access_request_events (request_id, created_at);
+
+-- 职责分离约束角色表索引
+CREATE INDEX idx_role_constraint_roles_role_id ON iam.role_constraint_roles (role_id);
+
+-- 管理范围可分配角色表索引
+CREATE INDEX idx_admin_scope_roles_role_id ON iam.admin_scope_roles (role_id);
+
+-- 访问复核活动表索引
+CREATE INDEX idx_review_campaigns_status ON iam.review_campaigns (tenant_id, status, created_at DESC);
+
+-- 访问复核条目表索引
+CREATE INDEX idx_review_items_campaign_decision ON iam.review_items (campaign_id, decision);
+CREATE INDEX idx_review_items_user_role ON iam.review_items (user_id, role_id);
+
+-- 访问复核条目复核人表索引
+CREATE INDEX idx_review_item_reviewers_reviewer_id ON iam.review_item_reviewers (reviewer_id, item_id);
+
+-- 权限使用记录表索引
+CREATE INDEX idx_permission_usage_users_last_used ON iam.permission_usage_users (tenant_id, last_used_at);
+CREATE INDEX idx_permission_usage_roles_last_used ON iam.permission_usage_roles (tenant_id, last_used_at);
 
 -- =========================================================
 -- 5. 表和字段注释
 -- =========================================================
 
+-- 租户表注释
+COMMENT ON TABLE iam.tenants IS '租户表，不同租户的用户、角色和自定义权限相互隔离';
+COMMENT ON COLUMN iam.tenants.id IS '主键ID';
+COMMENT ON COLUMN iam.tenants.code IS '租户编码，唯一标识';
+COMMENT ON COLUMN iam.tenants.name IS '租户名称';
+COMMENT ON COLUMN iam.tenants.created_at IS '创建时间';
+COMMENT ON COLUMN iam.tenants.updated_at IS '更新时间，通过触发器自动维护';
+COMMENT ON COLUMN iam.tenants.disabled_at IS '禁用时间，NULL表示未禁用';
+COMMENT ON COLUMN iam.tenants.deleted_at IS '软删除时间，NULL表示未删除';
+
+-- 部门表注释
+COMMENT ON TABLE iam.departments IS '部门表，以物化路径存储组织树，用于数据权限范围计算';
+COMMENT ON COLUMN iam.departments.id IS '主键ID';
+COMMENT ON COLUMN iam.departments.tenant_id IS '所属租户ID，外键关联tenants表';
+COMMENT ON COLUMN iam.departments.parent_id IS '上级部门ID，NULL表示顶级部门';
+COMMENT ON COLUMN iam.departments.path IS '祖先路径，形如/1/3/，不含自身，顶级部门为/';
+COMMENT ON COLUMN iam.departments.name IS '部门名称';
+COMMENT ON COLUMN iam.departments.code IS '部门编码，租户内未删除的部门中唯一';
+COMMENT ON COLUMN iam.departments.sort_order IS '同级部门排序顺序';
+COMMENT ON COLUMN iam.departments.created_at IS '创建时间';
+COMMENT ON COLUMN iam.departments.updated_at IS '更新时间，通过触发器自动维护';
+COMMENT ON COLUMN iam.departments.deleted_at IS '软删除时间，NULL表示未删除';
+
 -- 用户表注释
 COMMENT ON TABLE iam.users IS '用户表，存储系统用户基本信息';
 COMMENT ON COLUMN iam.users.id IS '主键ID';
-COMMENT ON COLUMN iam.users.username IS '用户名，唯一标识';
-COMMENT ON COLUMN iam.users.email IS '邮箱地址，唯一标识';
+COMMENT ON COLUMN iam.users.tenant_id IS '所属租户ID，外键关联tenants表';
+COMMENT ON COLUMN iam.users.department_id IS '所属部门ID，外键关联departments表，NULL表示未分配部门';
+COMMENT ON COLUMN iam.users.username IS '用户名，租户内唯一';
+COMMENT ON COLUMN iam.users.email IS '邮箱地址，租户内唯一';
 COMMENT ON COLUMN iam.users.password_hash IS '密码哈希值，使用加盐哈希算法存储';
 COMMENT ON COLUMN iam.users.salt IS '密码加盐值，用于增强密码安全性';
 COMMENT ON COLUMN iam.users.nickname IS '用户昵称';
-COMMENT ON COLUMN iam.users.phone IS '手机号码';
+COMMENT ON COLUMN iam.users.phone IS '手机号码，租户内唯一';
+COMMENT ON COLUMN iam.users.source IS '用户来源：local-本地用户，ldap-LDAP目录同步用户，scim-SCIM接口开通用户';
+COMMENT ON COLUMN iam.users.external_id IS '外部系统中的唯一标识（如LDAP DN、SCIM externalId），本地用户为NULL';
+COMMENT ON COLUMN iam.users.email_verified_at IS '邮箱验证时间，NULL表示未验证，邮箱变更时清空';
+COMMENT ON COLUMN iam.users.phone_verified_at IS '手机号验证时间，NULL表示未验证，手机号变更时清空';
+COMMENT ON COLUMN iam.users.password_changed_at IS '密码最后修改时间，用于判断密码是否过期';
+COMMENT ON COLUMN iam.users.failed_login_attempts IS '连续登录失败次数，登录成功或锁定后清零';
+COMMENT ON COLUMN iam.users.locked_until IS '账号锁定截止时间，NULL或早于当前时间表示未锁定';
 COMMENT ON COLUMN iam.users.created_at IS '创建时间';
 COMMENT ON COLUMN iam.users.updated_at IS '更新时间，通过触发器自动维护';
 COMMENT ON COLUMN iam.users.disabled_at IS '禁用时间，NULL表示未禁用';
+COMMENT ON COLUMN iam.users.disabled_reason IS '禁用原因：NULL-手动禁用，ldap_sync-LDAP同步时因不在目录中而禁用';
 COMMENT ON COLUMN iam.users.deleted_at IS '软删除时间，NULL表示未删除';
 
 -- 角色表注释
 COMMENT ON TABLE iam.roles IS '角色表，定义系统角色';
 COMMENT ON COLUMN iam.roles.id IS '主键ID';
-COMMENT ON COLUMN iam.roles.name IS '角色名称';
-COMMENT ON COLUMN iam.roles.code IS '角色编码，唯一标识';
+COMMENT ON COLUMN iam.roles.tenant_id IS '所属租户ID，外键关联tenants表';
+COMMENT ON COLUMN iam.roles.name IS '角色名称，租户内唯一';
+COMMENT ON COLUMN iam.roles.code IS '角色编码，租户内唯一';
 COMMENT ON COLUMN iam.roles.description IS '角色描述';
 COMMENT ON COLUMN iam.roles.sort_order IS '排序顺序';
+COMMENT ON COLUMN iam.roles.data_scope IS '数据权限范围：all-全部数据，dept_and_child-本部门及以下，dept-仅本部门，self-仅本人，custom-自定义部门';
 COMMENT ON COLUMN iam.roles.created_at IS '创建时间';
 COMMENT ON COLUMN iam.roles.updated_at IS '更新时间，通过触发器自动维护';
 COMMENT ON COLUMN iam.roles.disabled_at IS '禁用时间，NULL表示未禁用';
@@ -226,37 +852,282 @@ COMMENT ON COLUMN iam.roles.deleted_at IS '软删除时间，NULL表示未删除
 -- 权限表注释
 COMMENT ON TABLE iam.permissions IS '权限表，定义系统权限';
 COMMENT ON COLUMN iam.permissions.id IS '主键ID';
+COMMENT ON COLUMN iam.permissions.tenant_id IS '所属租户ID，NULL表示所有租户共享的全局系统权限';
 COMMENT ON COLUMN iam.permissions.name IS '权限名称';
-COMMENT ON COLUMN iam.permissions.code IS '权限编码，唯一标识';
+COMMENT ON COLUMN iam.permissions.code IS '权限编码，全局权限中唯一，租户自定义权限在租户内唯一且不与全局权限重复';
 COMMENT ON COLUMN iam.permissions.type IS '权限类型：path-API路径权限，button-按钮权限，menu-菜单权限';
 COMMENT ON COLUMN iam.permissions.resource IS '资源标识';
 COMMENT ON COLUMN iam.permissions.action IS '操作类型';
 COMMENT ON COLUMN iam.permissions.http_method IS 'HTTP方法：GET、POST、PUT、DELETE，仅path类型权限需要';
 COMMENT ON COLUMN iam.permissions.description IS '权限描述';
+COMMENT ON COLUMN iam.permissions.namespace IS '注册该权限的服务命名空间，NULL表示手工创建的权限';
+COMMENT ON COLUMN iam.permissions.deprecated_at IS '弃用时间，服务注册的权限目录中不再包含该权限时设置，NULL表示未弃用';
 COMMENT ON COLUMN iam.permissions.created_at IS '创建时间';
 COMMENT ON COLUMN iam.permissions.updated_at IS '更新时间，通过触发器自动维护';
 
 -- 用户角色关联表注释
 COMMENT ON TABLE iam.user_roles IS '用户角色关联表，实现用户与角色的多对多关系';
 COMMENT ON COLUMN iam.user_roles.id IS '主键ID';
+COMMENT ON COLUMN iam.user_roles.tenant_id IS '所属租户ID，与用户和角色所属租户一致';
 COMMENT ON COLUMN iam.user_roles.user_id IS '用户ID，外键关联users表';
 COMMENT ON COLUMN iam.user_roles.role_id IS '角色ID，外键关联roles表';
+COMMENT ON COLUMN iam.user_roles.valid_from IS '生效时间，为空表示立即生效';
+COMMENT ON COLUMN iam.user_roles.valid_until IS '失效时间，为空表示永久有效，过期后由后台任务清理';
 COMMENT ON COLUMN iam.user_roles.created_at IS '关联创建时间';
 COMMENT ON COLUMN iam.user_roles.created_by IS '创建人ID';
 
 -- 角色权限关联表注释
 COMMENT ON TABLE iam.role_permissions IS '角色权限关联表，实现角色与权限的多对多关系';
 COMMENT ON COLUMN iam.role_permissions.id IS '主键ID';
+COMMENT ON COLUMN iam.role_permissions.tenant_id IS '所属租户ID，与角色所属租户一致';
 COMMENT ON COLUMN iam.role_permissions.role_id IS '角色ID，外键关联roles表';
 COMMENT ON COLUMN iam.role_permissions.permission_id IS '权限ID，外键关联permissions表';
+COMMENT ON COLUMN iam.role_permissions.effect IS '授权效果：allow-允许，deny-拒绝，拒绝优先于允许';
+COMMENT ON COLUMN iam.role_permissions.condition IS '授权条件表达式（CEL），为空表示无条件授予';
+COMMENT ON COLUMN iam.role_permissions.valid_from IS '生效时间，为空表示立即生效';
+COMMENT ON COLUMN iam.role_permissions.valid_until IS '失效时间，为空表示永久有效，过期后由后台任务清理';
 COMMENT ON COLUMN iam.role_permissions.created_at IS '关联创建时间';
 COMMENT ON COLUMN iam.role_permissions.created_by IS '创建人ID';
 
+-- 资源绑定表注释
+COMMENT ON TABLE iam.resource_bindings IS '资源级角色绑定表，用户仅在指定资源上拥有角色的权限';
+COMMENT ON COLUMN iam.resource_bindings.id IS '主键ID';
+COMMENT ON COLUMN iam.resource_bindings.tenant_id IS '所属租户ID，与用户和角色所属租户一致';
+COMMENT ON COLUMN iam.resource_bindings.user_id IS '用户ID，外键关联users表';
+COMMENT ON COLUMN iam.resource_bindings.role_id IS '角色ID，外键关联roles表';
+COMMENT ON COLUMN iam.resource_bindings.resource_type IS '资源类型，如project';
+COMMENT ON COLUMN iam.resource_bindings.resource_id IS '资源ID，*表示该类型的所有资源';
+COMMENT ON COLUMN iam.resource_bindings.created_at IS '绑定创建时间';
+COMMENT ON COLUMN iam.resource_bindings.created_by IS '创建人ID';
+
+-- 角色部门关联表注释
+COMMENT ON TABLE iam.role_departments IS '角色自定义数据权限部门表，data_scope为custom时生效';
+COMMENT ON COLUMN iam.role_departments.id IS '主键ID';
+COMMENT ON COLUMN iam.role_departments.role_id IS '角色ID，外键关联roles表';
+COMMENT ON COLUMN iam.role_departments.department_id IS '部门ID，外键关联departments表';
+COMMENT ON COLUMN iam.role_departments.created_at IS '关联创建时间';
+COMMENT ON COLUMN iam.role_departments.created_by IS '创建人ID';
+
+-- 用户会话表注释
+COMMENT ON TABLE iam.sessions IS '用户会话表，记录用户登录会话及撤销状态';
+COMMENT ON COLUMN iam.sessions.id IS '主键ID';
+COMMENT ON COLUMN iam.sessions.user_id IS '用户ID，外键关联users表';
+COMMENT ON COLUMN iam.sessions.client IS '客户端标识';
+COMMENT ON COLUMN iam.sessions.ip IS '客户端IP';
+COMMENT ON COLUMN iam.sessions.user_agent IS '客户端User-Agent';
+COMMENT ON COLUMN iam.sessions.created_at IS '会话创建时间';
+COMMENT ON COLUMN iam.sessions.last_seen_at IS '最后活跃时间';
+COMMENT ON COLUMN iam.sessions.revoked_at IS '撤销时间，NULL表示会话有效';
+COMMENT ON COLUMN iam.sessions.revoke_reason IS '撤销原因';
+
+-- 验证码表注释
+COMMENT ON TABLE iam.verification_codes IS '验证码表，记录邮箱和手机号验证码';
+COMMENT ON COLUMN iam.verification_codes.id IS '主键ID';
+COMMENT ON COLUMN iam.verification_codes.user_id IS '用户ID，外键关联users表';
+COMMENT ON COLUMN iam.verification_codes.channel IS '验证渠道：email-邮箱，phone-手机号';
+COMMENT ON COLUMN iam.verification_codes.target IS '发送目标，即发送时的邮箱或手机号';
+COMMENT ON COLUMN iam.verification_codes.code_hash IS '验证码SHA-256哈希值';
+COMMENT ON COLUMN iam.verification_codes.attempts IS '已尝试校验次数';
+COMMENT ON COLUMN iam.verification_codes.created_at IS '创建时间';
+COMMENT ON COLUMN iam.verification_codes.expires_at IS '过期时间';
+COMMENT ON COLUMN iam.verification_codes.consumed_at IS '使用或作废时间，NULL表示待使用';
+
+-- 模拟登录记录表注释
+COMMENT ON TABLE iam.impersonations IS '模拟登录记录表，记录管理员以其他用户身份访问的授权';
+COMMENT ON COLUMN iam.impersonations.id IS '主键ID';
+COMMENT ON COLUMN iam.impersonations.actor_id IS '发起模拟的管理员ID';
+COMMENT ON COLUMN iam.impersonations.subject_id IS '被模拟的用户ID';
+COMMENT ON COLUMN iam.impersonations.reason IS '模拟原因';
+COMMENT ON COLUMN iam.impersonations.created_at IS '创建时间';
+COMMENT ON COLUMN iam.impersonations.expires_at IS '凭证过期时间';
+
+-- 审计日志表注释
+COMMENT ON TABLE iam.audit_logs IS '审计日志表，记录敏感操作及模拟登录期间的所有调用';
+COMMENT ON COLUMN iam.audit_logs.id IS '主键ID';
+COMMENT ON COLUMN iam.audit_logs.actor_id IS '实际操作人ID';
+COMMENT ON COLUMN iam.audit_logs.subject_id IS '操作所代表的用户ID，模拟登录时为被模拟用户';
+COMMENT ON COLUMN iam.audit_logs.impersonation_id IS '模拟登录记录ID，非模拟调用为NULL';
+COMMENT ON COLUMN iam.audit_logs.action IS '操作，如rpc方法名';
+COMMENT ON COLUMN iam.audit_logs.result IS '操作结果，gRPC状态码';
+COMMENT ON COLUMN iam.audit_logs.detail IS '操作详情';
+COMMENT ON COLUMN iam.audit_logs.created_at IS '记录时间';
+
+-- 用户组表注释
+COMMENT ON TABLE iam.groups IS '用户组表，按部门等维度组织用户，组内成员继承组的角色';
+COMMENT ON COLUMN iam.groups.id IS '主键ID';
+COMMENT ON COLUMN iam.groups.tenant_id IS '所属租户ID，外键关联tenants表';
+COMMENT ON COLUMN iam.groups.name IS '用户组名称';
+COMMENT ON COLUMN iam.groups.code IS '用户组编码，租户内未删除的用户组中唯一';
+COMMENT ON COLUMN iam.groups.description IS '用户组描述';
+COMMENT ON COLUMN iam.groups.created_at IS '创建时间';
+COMMENT ON COLUMN iam.groups.updated_at IS '更新时间，通过触发器自动维护';
+COMMENT ON COLUMN iam.groups.deleted_at IS '软删除时间，NULL表示未删除';
+
+-- 用户组成员表注释
+COMMENT ON TABLE iam.group_members IS '用户组成员表，实现用户与用户组的多对多关系';
+COMMENT ON COLUMN iam.group_members.id IS '主键ID';
+COMMENT ON COLUMN iam.group_members.group_id IS '用户组ID，外键关联groups表';
+COMMENT ON COLUMN iam.group_members.user_id IS '用户ID，外键关联users表';
+COMMENT ON COLUMN iam.group_members.created_at IS '加入时间';
+COMMENT ON COLUMN iam.group_members.created_by IS '创建人ID';
+
+-- 用户组角色关联表注释
+COMMENT ON TABLE iam.group_roles IS '用户组角色关联表，组内成员继承这些角色';
+COMMENT ON COLUMN iam.group_roles.id IS '主键ID';
+COMMENT ON COLUMN iam.group_roles.group_id IS '用户组ID，外键关联groups表';
+COMMENT ON COLUMN iam.group_roles.role_id IS '角色ID，外键关联roles表';
+COMMENT ON COLUMN iam.group_roles.created_at IS '关联创建时间';
+COMMENT ON COLUMN iam.group_roles.created_by IS '创建人ID';
+
+-- 关系元组表注释
+COMMENT ON TABLE iam.relation_tuples IS '关系元组表，基于关系的访问控制（ReBAC）数据，关系的推导规则由命名空间配置定义';
+COMMENT ON COLUMN iam.relation_tuples.id IS '主键ID';
+COMMENT ON COLUMN iam.relation_tuples.tenant_id IS '所属租户ID';
+COMMENT ON COLUMN iam.relation_tuples.namespace IS '对象所属命名空间，如folder、document';
+COMMENT ON COLUMN iam.relation_tuples.object_id IS '对象ID';
+COMMENT ON COLUMN iam.relation_tuples.relation IS '关系名称，如owner、viewer';
+COMMENT ON COLUMN iam.relation_tuples.subject_namespace IS '主体命名空间，user表示用户，role表示角色，也可以是其他对象命名空间';
+COMMENT ON COLUMN iam.relation_tuples.subject_id IS '主体ID，用户为用户ID，角色为角色编码';
+COMMENT ON COLUMN iam.relation_tuples.subject_relation IS '主体关系，为空表示具体主体，非空表示主体集合（如 folder:x#viewer、role:teacher#member）';
+COMMENT ON COLUMN iam.relation_tuples.created_at IS '元组创建时间';
+COMMENT ON COLUMN iam.relation_tuples.created_by IS '创建人ID';
+
+COMMENT ON TABLE iam.role_approvers IS '角色审批人表，定义申请角色时的审批链，同一步骤的任一审批人同意即进入下一步';
+COMMENT ON COLUMN iam.role_approvers.id IS '主键ID';
+COMMENT ON COLUMN iam.role_approvers.tenant_id IS '所属租户ID，与角色所属租户一致';
+COMMENT ON COLUMN iam.role_approvers.role_id IS '角色ID，外键关联roles表';
+COMMENT ON COLUMN iam.role_approvers.step IS '审批步骤，从1开始';
+COMMENT ON COLUMN iam.role_approvers.approver_id IS '审批人用户ID';
+COMMENT ON COLUMN iam.role_approvers.created_at IS '创建时间';
+COMMENT ON COLUMN iam.role_approvers.created_by IS '创建人ID';
+
+COMMENT ON TABLE iam.access_requests IS '访问申请表，审批通过后自动为申请人分配角色';
+COMMENT ON COLUMN iam.access_requests.id IS '主键ID';
+COMMENT ON COLUMN iam.access_requests.tenant_id IS '所属租户ID';
+COMMENT ON COLUMN iam.access_requests.requester_id IS '申请人用户ID';
+COMMENT ON COLUMN iam.access_requests.role_id IS '申请的角色ID';
+COMMENT ON COLUMN iam.access_requests.justification IS '申请理由';
+COMMENT ON COLUMN iam.access_requests.duration_seconds IS '申请的授权时长（秒），0表示永久';
+COMMENT ON COLUMN iam.access_requests.status IS '申请状态：pending-审批中，approved-已通过，rejected-已拒绝，cancelled-已撤回';
+COMMENT ON COLUMN iam.access_requests.current_step IS '当前审批步骤';
+COMMENT ON COLUMN iam.access_requests.total_steps IS '申请创建时审批链的总步骤数';
+COMMENT ON COLUMN iam.access_requests.decided_at IS '申请结束时间';
+COMMENT ON COLUMN iam.access_requests.created_at IS '申请创建时间';
+COMMENT ON COLUMN iam.access_requests.updated_at IS '申请更新时间';
+
+COMMENT ON TABLE iam.access_request_events IS '访问申请事件表，记录申请的完整处理历史';
+COMMENT ON COLUMN iam.access_request_events.id IS '主键ID';
+COMMENT ON COLUMN iam.access_request_events.tenant_id IS '所属租户ID';
+COMMENT ON COLUMN iam.access_request_events.request_id IS '访问申请ID，外键关联access_requests表';
+COMMENT ON COLUMN iam.access_request_events.actor_id IS '操作人用户ID';
+COMMENT ON COLUMN iam.access_request_events.event_type IS '事件类型：created-创建，approved-审批通过，rejected-拒绝，cancelled-撤回，commented-评论，granted-已授予角色';
+COMMENT ON COLUMN iam.access_request_events.step IS '事件发生时的审批步骤';
+COMMENT ON COLUMN iam.access_request_events.comment IS '审批意见或评论内容';
+COMMENT ON COLUMN iam.access_request_events.created_at IS '事件时间';
+
+COMMENT ON TABLE iam.role_constraints IS '职责分离约束表，角色分配、用户组继承和访问申请授权时校验';
+COMMENT ON COLUMN iam.role_constraints.id IS '主键ID';
+COMMENT ON COLUMN iam.role_constraints.tenant_id IS '所属租户ID';
+COMMENT ON COLUMN iam.role_constraints.code IS '约束编码，租户内唯一';
+COMMENT ON COLUMN iam.role_constraints.name IS '约束名称';
+COMMENT ON COLUMN iam.role_constraints.description IS '约束描述';
+COMMENT ON COLUMN iam.role_constraints.constraint_type IS '约束类型：exclusive-用户最多持有角色集合中max_count个角色，role_cardinality-集合中每个角色最多max_count个持有人，user_cardinality-用户最多持有max_count个角色';
+COMMENT ON COLUMN iam.role_constraints.enforcement IS '校验方式：static-所有未过期的授权都计入，dynamic-仅有效期重叠的授权计入';
+COMMENT ON COLUMN iam.role_constraints.max_count IS '允许的最大数量';
+COMMENT ON COLUMN iam.role_constraints.created_at IS '创建时间';
+COMMENT ON COLUMN iam.role_constraints.updated_at IS '更新时间';
+COMMENT ON COLUMN iam.role_constraints.created_by IS '创建人ID';
+
+COMMENT ON TABLE iam.role_constraint_roles IS '职责分离约束角色表';
+COMMENT ON COLUMN iam.role_constraint_roles.id IS '主键ID';
+COMMENT ON COLUMN iam.role_constraint_roles.tenant_id IS '所属租户ID';
+COMMENT ON COLUMN iam.role_constraint_roles.constraint_id IS '约束ID，外键关联role_constraints表';
+COMMENT ON COLUMN iam.role_constraint_roles.role_id IS '角色ID，外键关联roles表';
+COMMENT ON COLUMN iam.role_constraint_roles.created_at IS '创建时间';
+
+COMMENT ON TABLE iam.admin_scopes IS '管理范围表，委派管理员只能管理所辖部门内的用户，只能分配和移除可分配角色';
+COMMENT ON COLUMN iam.admin_scopes.id IS '主键ID';
+COMMENT ON COLUMN iam.admin_scopes.tenant_id IS '所属租户ID';
+COMMENT ON COLUMN iam.admin_scopes.admin_id IS '委派管理员用户ID';
+COMMENT ON COLUMN iam.admin_scopes.department_id IS '所辖部门ID，包含其所有下级部门，NULL表示不限部门';
+COMMENT ON COLUMN iam.admin_scopes.created_at IS '创建时间';
+COMMENT ON COLUMN iam.admin_scopes.updated_at IS '更新时间';
+COMMENT ON COLUMN iam.admin_scopes.created_by IS '创建人ID';
+
+COMMENT ON TABLE iam.admin_scope_roles IS '管理范围可分配角色表';
+COMMENT ON COLUMN iam.admin_scope_roles.id IS '主键ID';
+COMMENT ON COLUMN iam.admin_scope_roles.tenant_id IS '所属租户ID';
+COMMENT ON COLUMN iam.admin_scope_roles.scope_id IS '管理范围ID，外键关联admin_scopes表';
+COMMENT ON COLUMN iam.admin_scope_roles.role_id IS '可分配的角色ID，外键关联roles表';
+COMMENT ON COLUMN iam.admin_scope_roles.created_at IS '创建时间';
+
+COMMENT ON TABLE iam.review_campaigns IS '访问复核活动表，定期复核选定角色的持有人，关闭时自动撤销复核人决定撤销的角色';
+COMMENT ON COLUMN iam.review_campaigns.id IS '主键ID';
+COMMENT ON COLUMN iam.review_campaigns.tenant_id IS '所属租户ID';
+COMMENT ON COLUMN iam.review_campaigns.name IS '活动名称';
+COMMENT ON COLUMN iam.review_campaigns.description IS '活动描述';
+COMMENT ON COLUMN iam.review_campaigns.reviewer_source IS '复核人来源：role_owners-角色审批人，managers-管理范围覆盖用户且可分配该角色的委派管理员';
+COMMENT ON COLUMN iam.review_campaigns.status IS '活动状态：open-进行中，closed-已关闭';
+COMMENT ON COLUMN iam.review_campaigns.due_at IS '复核截止时间，仅供提醒，不会自动关闭活动';
+COMMENT ON COLUMN iam.review_campaigns.closed_at IS '关闭时间';
+COMMENT ON COLUMN iam.review_campaigns.closed_by IS '关闭人用户ID';
+COMMENT ON COLUMN iam.review_campaigns.created_at IS '创建时间';
+COMMENT ON COLUMN iam.review_campaigns.updated_at IS '更新时间';
+COMMENT ON COLUMN iam.review_campaigns.created_by IS '创建人ID';
+
+COMMENT ON TABLE iam.review_items IS '访问复核条目表，活动创建时用户直接分配角色的快照';
+COMMENT ON COLUMN iam.review_items.id IS '主键ID';
+COMMENT ON COLUMN iam.review_items.tenant_id IS '所属租户ID';
+COMMENT ON COLUMN iam.review_items.campaign_id IS '复核活动ID，外键关联review_campaigns表';
+COMMENT ON COLUMN iam.review_items.user_id IS '角色持有人用户ID';
+COMMENT ON COLUMN iam.review_items.role_id IS '复核的角色ID';
+COMMENT ON COLUMN iam.review_items.granted_at IS '快照时角色的分配时间';
+COMMENT ON COLUMN iam.review_items.valid_from IS '快照时角色分配的生效时间';
+COMMENT ON COLUMN iam.review_items.valid_until IS '快照时角色分配的失效时间';
+COMMENT ON COLUMN iam.review_items.decision IS '复核决定：pending-待复核，keep-保留，revoke-撤销';
+COMMENT ON COLUMN iam.review_items.comment IS '复核意见';
+COMMENT ON COLUMN iam.review_items.decided_by IS '作出决定的复核人用户ID';
+COMMENT ON COLUMN iam.review_items.decided_at IS '决定时间';
+COMMENT ON COLUMN iam.review_items.outcome IS '活动关闭时的处理结果：kept-保留，unreviewed-未复核，revoked-已撤销，already_removed-角色已被移除，failed-撤销失败';
+COMMENT ON COLUMN iam.review_items.outcome_detail IS '处理结果说明，如撤销失败的原因';
+COMMENT ON COLUMN iam.review_items.created_at IS '创建时间';
+COMMENT ON COLUMN iam.review_items.updated_at IS '更新时间';
+
+COMMENT ON TABLE iam.review_item_reviewers IS '访问复核条目复核人表';
+COMMENT ON COLUMN iam.review_item_reviewers.id IS '主键ID';
+COMMENT ON COLUMN iam.review_item_reviewers.tenant_id IS '所属租户ID';
+COMMENT ON COLUMN iam.review_item_reviewers.item_id IS '复核条目ID，外键关联review_items表';
+COMMENT ON COLUMN iam.review_item_reviewers.reviewer_id IS '复核人用户ID';
+COMMENT ON COLUMN iam.review_item_reviewers.created_at IS '创建时间';
+
+COMMENT ON TABLE iam.permission_usage_users IS '用户权限使用记录表，由权限检查异步批量写入';
+COMMENT ON COLUMN iam.permission_usage_users.id IS '主键ID';
+COMMENT ON COLUMN iam.permission_usage_users.tenant_id IS '所属租户ID';
+COMMENT ON COLUMN iam.permission_usage_users.user_id IS '用户ID';
+COMMENT ON COLUMN iam.permission_usage_users.permission_id IS '使检查通过的授权权限ID，层级和通配授权记录授权本身的权限';
+COMMENT ON COLUMN iam.permission_usage_users.last_used_at IS '最近使用时间';
+COMMENT ON COLUMN iam.permission_usage_users.use_count IS '累计使用次数';
+COMMENT ON COLUMN iam.permission_usage_users.created_at IS '首次使用时间';
+
+COMMENT ON TABLE iam.permission_usage_roles IS '角色权限使用记录表，由权限检查异步批量写入';
+COMMENT ON COLUMN iam.permission_usage_roles.id IS '主键ID';
+COMMENT ON COLUMN iam.permission_usage_roles.tenant_id IS '所属租户ID';
+COMMENT ON COLUMN iam.permission_usage_roles.role_id IS '角色ID';
+COMMENT ON COLUMN iam.permission_usage_roles.permission_id IS '使检查通过的授权权限ID';
+COMMENT ON COLUMN iam.permission_usage_roles.last_used_at IS '最近使用时间';
+COMMENT ON COLUMN iam.permission_usage_roles.use_count IS '累计使用次数';
+COMMENT ON COLUMN iam.permission_usage_roles.created_at IS '首次使用时间';
+
 -- =========================================================
 -- 6. 初始化数据
 -- =========================================================
 
--- 插入默认权限
+-- 插入默认租户，未携带租户信息的调用归属该租户
+INSERT INTO iam.tenants (id, code, name) VALUES
+(1, 'default', '默认租户');
+SELECT setval('iam.tenants_id_seq', (SELECT MAX(id) FROM iam.tenants));
+
+-- 插入默认权限（全局系统权限，所有租户共享）
 -- Path类型权限（API路径权限）
 -- 用户管理相关API权限
 INSERT INTO iam.permissions (name, code, type, resource, action, http_method, description) VALUES
@@ -310,6 +1181,70 @@ INSERT INTO iam.permissions (name, code, type, resource, action, http_method, de
 ('查询用户最终权限', 'GET:/advanced/users/:id/permissions', 'path', 'advanced', 'read', 'GET', '查询用户最终权限的API权限'),
 ('校验用户权限', 'POST:/advanced/auth/check-permission', 'path', 'advanced', 'read', 'POST', '校验用户是否拥有指定权限的API权限');
 
+-- IAM服务内部权限，启用RpcAuth后每个IAM接口对应其中一个权限编码
+INSERT INTO iam.permissions (name, code, type, resource, action, description) VALUES
+('模拟用户登录', 'iam:user:impersonate', 'button', 'user', 'impersonate', '以其他用户身份访问系统，用于排查权限问题'),
+('IAM全部接口权限', 'iam:*', 'button', 'iam', '*', '调用IAM全部接口，授予内置的IAM超级管理员角色'),
+('创建用户', 'iam:user:create', 'button', 'user', 'create', '调用CreateUser接口'),
+('查询用户', 'iam:user:read', 'button', 'user', 'read', '调用GetUser、ListUsers、GetUserByUsername、GetUserRoles接口'),
+('更新用户', 'iam:user:update', 'button', 'user', 'update', '调用UpdateUser接口'),
+('删除用户', 'iam:user:delete', 'button', 'user', 'delete', '调用DeleteUser接口'),
+('分配用户角色', 'iam:user:assign-role', 'button', 'user', 'assign-role', '调用分配、延期和移除用户角色的接口'),
+('校验用户权限', 'iam:user:check', 'button', 'user', 'check', '调用CheckUserRole、CheckUserPermission、ExplainUserPermission等校验类接口'),
+('认证用户', 'iam:user:authenticate', 'button', 'user', 'authenticate', '调用Authenticate、VerifyPassword接口'),
+('修改用户密码', 'iam:user:change-password', 'button', 'user', 'change-password', '调用ChangePassword接口'),
+('验证邮箱和手机号', 'iam:user:verify', 'button', 'user', 'verify', '调用SendVerification、ConfirmVerification接口'),
+('同步目录用户', 'iam:user:sync', 'button', 'user', 'sync', '调用SyncLdapUsers接口'),
+('创建会话', 'iam:session:create', 'button', 'session', 'create', '调用CreateSession接口'),
+('查询会话', 'iam:session:read', 'button', 'session', 'read', '调用ListUserSessions接口'),
+('刷新会话', 'iam:session:touch', 'button', 'session', 'touch', '调用TouchSession接口'),
+('撤销会话', 'iam:session:revoke', 'button', 'session', 'revoke', '调用RevokeSession、RevokeAllUserSessions接口'),
+('查询管理范围', 'iam:admin-scope:read', 'button', 'admin-scope', 'read', '调用GetAdminScope接口'),
+('设置管理范围', 'iam:admin-scope:write', 'button', 'admin-scope', 'write', '调用SetAdminScope、DeleteAdminScope接口'),
+('创建角色', 'iam:role:create', 'button', 'role', 'create', '调用CreateRole接口'),
+('查询角色', 'iam:role:read', 'button', 'role', 'read', '调用GetRole、ListRoles、GetRolePermissions、CheckRolePermission接口'),
+('更新角色', 'iam:role:update', 'button', 'role', 'update', '调用UpdateRole、SetRoleDataScope接口'),
+('删除角色', 'iam:role:delete', 'button', 'role', 'delete', '调用DeleteRole接口'),
+('分配角色权限', 'iam:role:grant-permission', 'button', 'role', 'grant-permission', '调用分配和移除角色权限的接口'),
+('查询职责分离约束', 'iam:role-constraint:read', 'button', 'role-constraint', 'read', '调用ListRoleConstraints、ValidateConstraints接口'),
+('管理职责分离约束', 'iam:role-constraint:write', 'button', 'role-constraint', 'write', '调用CreateRoleConstraint、DeleteRoleConstraint接口'),
+('查询权限使用情况', 'iam:usage:read', 'button', 'usage', 'read', '调用ListUnusedGrants、ListUnusedRoles、RecommendRoleTrim接口'),
+('创建权限', 'iam:permission:create', 'button', 'permission', 'create', '调用CreatePermission接口'),
+('查询权限', 'iam:permission:read', 'button', 'permission', 'read', '调用GetPermission、ListPermissions接口'),
+('更新权限', 'iam:permission:update', 'button', 'permission', 'update', '调用UpdatePermission接口'),
+('删除权限', 'iam:permission:delete', 'button', 'permission', 'delete', '调用DeletePermission接口'),
+('注册服务权限目录', 'iam:permission:register', 'button', 'permission', 'register', '调用RegisterPermissions接口，服务按命名空间注册自身的权限目录'),
+('模拟策略变更', 'iam:policy:simulate', 'button', 'policy', 'simulate', '调用SimulateChange接口，预览策略变更对用户有效权限的影响'),
+('创建用户组', 'iam:group:create', 'button', 'group', 'create', '调用CreateGroup接口'),
+('查询用户组', 'iam:group:read', 'button', 'group', 'read', '调用查询用户组、成员和用户组角色的接口'),
+('更新用户组', 'iam:group:update', 'button', 'group', 'update', '调用UpdateGroup接口'),
+('删除用户组', 'iam:group:delete', 'button', 'group', 'delete', '调用DeleteGroup接口'),
+('管理用户组成员', 'iam:group:manage-members', 'button', 'group', 'manage-members', '调用AddGroupMembers、RemoveGroupMembers接口'),
+('分配用户组角色', 'iam:group:assign-role', 'button', 'group', 'assign-role', '调用AssignGroupRoles、RemoveGroupRoles接口'),
+('查询资源绑定', 'iam:binding:read', 'button', 'binding', 'read', '调用ListResourceAccess、ListUserResources接口'),
+('管理资源绑定', 'iam:binding:write', 'button', 'binding', 'write', '调用CreateResourceBinding、DeleteResourceBinding接口'),
+('创建部门', 'iam:department:create', 'button', 'department', 'create', '调用CreateDepartment接口'),
+('查询部门', 'iam:department:read', 'button', 'department', 'read', '调用GetDepartment、ListDepartments接口'),
+('更新部门', 'iam:department:update', 'button', 'department', 'update', '调用UpdateDepartment接口'),
+('删除部门', 'iam:department:delete', 'button', 'department', 'delete', '调用DeleteDepartment接口'),
+('设置用户部门', 'iam:department:assign-user', 'button', 'department', 'assign-user', '调用SetUserDepartment接口'),
+('查询关系', 'iam:relation:read', 'button', 'relation', 'read', '调用Check、Expand、ListObjects、ListSubjects接口'),
+('管理关系', 'iam:relation:write', 'button', 'relation', 'write', '调用WriteRelationTuples、DeleteRelationTuples接口'),
+('查询角色审批人', 'iam:role-approver:read', 'button', 'role-approver', 'read', '调用GetRoleApprovers接口'),
+('设置角色审批人', 'iam:role-approver:write', 'button', 'role-approver', 'write', '调用SetRoleApprovers接口'),
+('提交访问申请', 'iam:access-request:create', 'button', 'access-request', 'create', '调用CreateAccessRequest接口'),
+('查询访问申请', 'iam:access-request:read', 'button', 'access-request', 'read', '调用GetAccessRequest、ListAccessRequests接口'),
+('审批访问申请', 'iam:access-request:approve', 'button', 'access-request', 'approve', '调用ApproveAccessRequest、RejectAccessRequest接口'),
+('撤回访问申请', 'iam:access-request:cancel', 'button', 'access-request', 'cancel', '调用CancelAccessRequest接口'),
+('评论访问申请', 'iam:access-request:comment', 'button', 'access-request', 'comment', '调用CommentAccessRequest接口'),
+('创建访问复核活动', 'iam:access-review:create', 'button', 'access-review', 'create', '调用CreateReviewCampaign接口'),
+('查询访问复核', 'iam:access-review:read', 'button', 'access-review', 'read', '调用GetReviewCampaign、ListReviewCampaigns、ListReviewItems、ExportReviewReport接口'),
+('作出复核决定', 'iam:access-review:decide', 'button', 'access-review', 'decide', '调用DecideReviewItem接口'),
+('关闭访问复核活动', 'iam:access-review:close', 'button', 'access-review', 'close', '调用CloseReviewCampaign接口'),
+('导出策略清单', 'iam:policy:export', 'button', 'policy', 'export', '调用ExportPolicy接口'),
+('计算策略清单差异', 'iam:policy:plan', 'button', 'policy', 'plan', '调用PlanPolicy接口'),
+('应用策略清单', 'iam:policy:apply', 'button', 'policy', 'apply', '调用ApplyPolicy接口');
+
 -- Button类型权限（按钮权限）
 INSERT INTO iam.permissions (name, code, type, resource, action, description) VALUES
 ('用户新增按钮', 'user:create:button', 'button', 'user', 'create', '用户管理页面新增按钮权限');
@@ -325,12 +1260,22 @@ INSERT INTO iam.roles (name, code, description, sort_order) VALUES
 ('学生', 'student', '学生角色，拥有学生管理权限', 3),
 ('访客', 'guest', '访客角色，拥有访客权限', 3);
 
+-- 插入内置的IAM超级管理员角色，通过 iam:* 授权调用IAM全部接口
+INSERT INTO iam.roles (name, code, description, sort_order) VALUES
+('IAM超级管理员', 'iam_admin', '内置的IAM超级管理员，可调用IAM全部接口，用于初始化和托管IAM自身', 0);
+
 -- 为管理员角色分配所有权限
 INSERT INTO iam.role_permissions (role_id, permission_id)
 SELECT r.id, p.id
 FROM iam.roles r, iam.permissions p
 WHERE r.code = 'admin';
 
+-- 为IAM超级管理员角色分配IAM全部接口权限
+INSERT INTO iam.role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM iam.roles r, iam.permissions p
+WHERE r.code = 'iam_admin' AND p.code = 'iam:*';
+
 
 -- 创建默认超级管理员用户
 -- 默认密码: 123123，salt: randomsalt123456789012345678901，password_hash使用bcrypt算法
@@ -341,4 +1286,4 @@ INSERT INTO iam.users (username, password_hash, salt, nickname) VALUES
 INSERT INTO iam.user_roles (user_id, role_id)
 SELECT u.id, r.id
 FROM iam.users u, iam.roles r
-WHERE u.username = 'admin' AND r.code = 'admin';
\ No newline at end of file
+WHERE u.username = 'admin' AND r.code IN ('admin', 'iam_admin');
\ No newline at end of file
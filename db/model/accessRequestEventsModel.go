@@ -0,0 +1,56 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 访问申请事件类型
+const (
+	AccessRequestEventCreated   = "created"   // 创建申请
+	AccessRequestEventApproved  = "approved"  // 某一步审批通过
+	AccessRequestEventRejected  = "rejected"  // 审批拒绝
+	AccessRequestEventCancelled = "cancelled" // 申请人撤回
+	AccessRequestEventCommented = "commented" // 评论
+	AccessRequestEventGranted   = "granted"   // 审批链全部通过后已授予角色
+)
+
+var _ AccessRequestEventsModel = (*customAccessRequestEventsModel)(nil)
+
+type (
+	// AccessRequestEventsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customAccessRequestEventsModel.
+	AccessRequestEventsModel interface {
+		accessRequestEventsModel
+		FindByRequestId(ctx context.Context, requestId int64) ([]*AccessRequestEvents, error) // 查询申请的事件历史，按时间排序
+	}
+
+	customAccessRequestEventsModel struct {
+		*defaultAccessRequestEventsModel
+	}
+)
+
+// NewAccessRequestEventsModel returns a model for the database table.
+func NewAccessRequestEventsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) AccessRequestEventsModel {
+	return &customAccessRequestEventsModel{
+		defaultAccessRequestEventsModel: newAccessRequestEventsModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，事件归属当前调用的租户
+func (m *customAccessRequestEventsModel) Insert(ctx context.Context, data *AccessRequestEvents) (sql.Result, error) {
+	data.TenantId = TenantIdFromContext(ctx)
+	return m.defaultAccessRequestEventsModel.Insert(ctx, data)
+}
+
+// FindByRequestId 查询申请的事件历史，按时间排序
+func (m *customAccessRequestEventsModel) FindByRequestId(ctx context.Context, requestId int64) ([]*AccessRequestEvents, error) {
+	query := fmt.Sprintf("select %s from %s where request_id = $1 and tenant_id = $2 order by created_at, id", accessRequestEventsRows, m.table)
+	var resp []*AccessRequestEvents
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, requestId, TenantIdFromContext(ctx))
+	return resp, err
+}
@@ -0,0 +1,164 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 访问申请状态
+const (
+	AccessRequestPending   = "pending"   // 审批中
+	AccessRequestApproved  = "approved"  // 已通过
+	AccessRequestRejected  = "rejected"  // 已拒绝
+	AccessRequestCancelled = "cancelled" // 已撤回
+)
+
+var _ AccessRequestsModel = (*customAccessRequestsModel)(nil)
+
+type (
+	// AccessRequestsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customAccessRequestsModel.
+	AccessRequestsModel interface {
+		accessRequestsModel
+		FindPendingByRequesterRole(ctx context.Context, requesterId, roleId int64) (*AccessRequests, error)                    // 查询申请人对角色审批中的申请
+		FindWithFilters(ctx context.Context, requesterId int64, status string, limit, offset int32) ([]*AccessRequests, error) // 按申请人和状态分页查询，条件为零值时不过滤
+		CountWithFilters(ctx context.Context, requesterId int64, status string) (int64, error)                                 // 按申请人和状态统计数量
+		FindPendingByApprover(ctx context.Context, approverId int64, limit, offset int32) ([]*AccessRequests, error)           // 分页查询当前步骤等待指定审批人处理的申请
+		CountPendingByApprover(ctx context.Context, approverId int64) (int64, error)                                           // 统计当前步骤等待指定审批人处理的申请数量
+		Transition(ctx context.Context, data *AccessRequests, fromStep int64) (bool, error)                                    // 推进审批中的申请，申请已被他人处理时返回false
+	}
+
+	customAccessRequestsModel struct {
+		*defaultAccessRequestsModel
+	}
+)
+
+// NewAccessRequestsModel returns a model for the database table.
+func NewAccessRequestsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) AccessRequestsModel {
+	return &customAccessRequestsModel{
+		defaultAccessRequestsModel: newAccessRequestsModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，申请归属当前调用的租户，并使用RETURNING子句获取插入后的ID
+func (m *customAccessRequestsModel) Insert(ctx context.Context, data *AccessRequests) (sql.Result, error) {
+	var insertedID int64
+	data.TenantId = TenantIdFromContext(ctx)
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id", m.table, accessRequestsRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.TenantId, data.RequesterId, data.RoleId, data.Justification, data.DurationSeconds, data.Status, data.CurrentStep, data.TotalSteps, data.DecidedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+
+	// 清除相关缓存
+	iamAccessRequestsIdKey := fmt.Sprintf("%s%v", cacheIamAccessRequestsIdPrefix, insertedID)
+	_ = m.DelCacheCtx(ctx, iamAccessRequestsIdKey)
+
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// FindOne 重写FindOne方法，校验申请属于当前租户
+func (m *customAccessRequestsModel) FindOne(ctx context.Context, id int64) (*AccessRequests, error) {
+	resp, err := m.defaultAccessRequestsModel.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
+// FindPendingByRequesterRole 查询申请人对角色审批中的申请，同一时间只允许存在一个
+func (m *customAccessRequestsModel) FindPendingByRequesterRole(ctx context.Context, requesterId, roleId int64) (*AccessRequests, error) {
+	query := fmt.Sprintf("select %s from %s where requester_id = $1 and role_id = $2 and tenant_id = $3 and status = $4 limit 1", accessRequestsRows, m.table)
+	var resp AccessRequests
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, requesterId, roleId, TenantIdFromContext(ctx), AccessRequestPending)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// filterClause 构建申请人和状态过滤条件
+func (m *customAccessRequestsModel) filterClause(ctx context.Context, requesterId int64, status string) (string, []any) {
+	conditions := []string{"tenant_id = $1"}
+	args := []any{TenantIdFromContext(ctx)}
+	if requesterId > 0 {
+		args = append(args, requesterId)
+		conditions = append(conditions, fmt.Sprintf("requester_id = $%d", len(args)))
+	}
+	if status != "" {
+		args = append(args, status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	return strings.Join(conditions, " and "), args
+}
+
+// FindWithFilters 按申请人和状态分页查询申请，按创建时间倒序
+func (m *customAccessRequestsModel) FindWithFilters(ctx context.Context, requesterId int64, status string, limit, offset int32) ([]*AccessRequests, error) {
+	where, args := m.filterClause(ctx, requesterId, status)
+	query := fmt.Sprintf("select %s from %s where %s order by created_at desc, id desc limit $%d offset $%d", accessRequestsRows, m.table, where, len(args)+1, len(args)+2)
+	var resp []*AccessRequests
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, append(args, limit, offset)...)
+	return resp, err
+}
+
+// CountWithFilters 按申请人和状态统计申请数量
+func (m *customAccessRequestsModel) CountWithFilters(ctx context.Context, requesterId int64, status string) (int64, error) {
+	where, args := m.filterClause(ctx, requesterId, status)
+	query := fmt.Sprintf("select count(1) from %s where %s", m.table, where)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
+	return count, err
+}
+
+// FindPendingByApprover 分页查询当前步骤等待指定审批人处理的申请，按创建时间排序
+func (m *customAccessRequestsModel) FindPendingByApprover(ctx context.Context, approverId int64, limit, offset int32) ([]*AccessRequests, error) {
+	query := fmt.Sprintf(`select %s from %s r
+		where r.tenant_id = $1 and r.status = $2 and exists (
+			select 1 from "iam"."role_approvers" ra
+			where ra.role_id = r.role_id and ra.step = r.current_step and ra.approver_id = $3
+		) order by r.created_at, r.id limit $4 offset $5`, accessRequestsRows, m.table)
+	var resp []*AccessRequests
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx), AccessRequestPending, approverId, limit, offset)
+	return resp, err
+}
+
+// CountPendingByApprover 统计当前步骤等待指定审批人处理的申请数量
+func (m *customAccessRequestsModel) CountPendingByApprover(ctx context.Context, approverId int64) (int64, error) {
+	query := fmt.Sprintf(`select count(1) from %s r
+		where r.tenant_id = $1 and r.status = $2 and exists (
+			select 1 from "iam"."role_approvers" ra
+			where ra.role_id = r.role_id and ra.step = r.current_step and ra.approver_id = $3
+		)`, m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, TenantIdFromContext(ctx), AccessRequestPending, approverId)
+	return count, err
+}
+
+// Transition 将审批中且仍处于fromStep的申请更新为data中的状态、步骤和结束时间
+// 并发审批时只有一个操作能成功，其余返回false
+func (m *customAccessRequestsModel) Transition(ctx context.Context, data *AccessRequests, fromStep int64) (bool, error) {
+	iamAccessRequestsIdKey := fmt.Sprintf("%s%v", cacheIamAccessRequestsIdPrefix, data.Id)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set status = $1, current_step = $2, decided_at = $3 where id = $4 and tenant_id = $5 and status = $6 and current_step = $7", m.table)
+		return conn.ExecCtx(ctx, query, data.Status, data.CurrentStep, data.DecidedAt, data.Id, TenantIdFromContext(ctx), AccessRequestPending, fromStep)
+	}, iamAccessRequestsIdKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ AdminScopeRolesModel = (*customAdminScopeRolesModel)(nil)
+
+type (
+	// AdminScopeRolesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customAdminScopeRolesModel.
+	AdminScopeRolesModel interface {
+		adminScopeRolesModel
+		FindByScopeId(ctx context.Context, scopeId int64) ([]*AdminScopeRoles, error) // 查询管理范围的可分配角色
+		ReplaceRoles(ctx context.Context, scopeId int64, roleIds []int64) error       // 替换管理范围的可分配角色
+	}
+
+	customAdminScopeRolesModel struct {
+		*defaultAdminScopeRolesModel
+	}
+)
+
+// NewAdminScopeRolesModel returns a model for the database table.
+func NewAdminScopeRolesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) AdminScopeRolesModel {
+	return &customAdminScopeRolesModel{
+		defaultAdminScopeRolesModel: newAdminScopeRolesModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，可分配角色归属当前调用的租户
+func (m *customAdminScopeRolesModel) Insert(ctx context.Context, data *AdminScopeRoles) (sql.Result, error) {
+	data.TenantId = TenantIdFromContext(ctx)
+	return m.defaultAdminScopeRolesModel.Insert(ctx, data)
+}
+
+// FindByScopeId 查询管理范围的可分配角色，按角色排序
+func (m *customAdminScopeRolesModel) FindByScopeId(ctx context.Context, scopeId int64) ([]*AdminScopeRoles, error) {
+	query := fmt.Sprintf("select %s from %s where scope_id = $1 and tenant_id = $2 order by role_id", adminScopeRolesRows, m.table)
+	var resp []*AdminScopeRoles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, scopeId, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// ReplaceRoles 替换管理范围的可分配角色
+func (m *customAdminScopeRolesModel) ReplaceRoles(ctx context.Context, scopeId int64, roleIds []int64) error {
+	// 先查询现有角色以清除缓存
+	existing, err := m.FindByScopeId(ctx, scopeId)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		keys := make([]string, 0, len(existing)*2)
+		for _, sr := range existing {
+			keys = append(keys, fmt.Sprintf("%s%v", cacheIamAdminScopeRolesIdPrefix, sr.Id))
+			keys = append(keys, fmt.Sprintf("%s%v:%v", cacheIamAdminScopeRolesScopeIdRoleIdPrefix, sr.ScopeId, sr.RoleId))
+		}
+		_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+			query := fmt.Sprintf("delete from %s where scope_id = $1 and tenant_id = $2", m.table)
+			return conn.ExecCtx(ctx, query, scopeId, TenantIdFromContext(ctx))
+		}, keys...)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, roleId := range roleIds {
+		if _, err := m.Insert(ctx, &AdminScopeRoles{ScopeId: scopeId, RoleId: roleId}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ AdminScopesModel = (*customAdminScopesModel)(nil)
+
+type (
+	// AdminScopesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customAdminScopesModel.
+	AdminScopesModel interface {
+		adminScopesModel
+		FindByAdminId(ctx context.Context, adminId int64) (*AdminScopes, error) // 查询管理员在当前租户的管理范围
+		FindAll(ctx context.Context) ([]*AdminScopes, error)                    // 查询当前租户的全部管理范围
+	}
+
+	customAdminScopesModel struct {
+		*defaultAdminScopesModel
+	}
+)
+
+// NewAdminScopesModel returns a model for the database table.
+func NewAdminScopesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) AdminScopesModel {
+	return &customAdminScopesModel{
+		defaultAdminScopesModel: newAdminScopesModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，管理范围归属当前调用的租户，并使用RETURNING子句获取插入后的ID
+func (m *customAdminScopesModel) Insert(ctx context.Context, data *AdminScopes) (sql.Result, error) {
+	var insertedID int64
+	data.TenantId = TenantIdFromContext(ctx)
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4) RETURNING id", m.table, adminScopesRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.TenantId, data.AdminId, data.DepartmentId, data.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+
+	// 清除相关缓存
+	iamAdminScopesIdKey := fmt.Sprintf("%s%v", cacheIamAdminScopesIdPrefix, insertedID)
+	iamAdminScopesTenantIdAdminIdKey := fmt.Sprintf("%s%v:%v", cacheIamAdminScopesTenantIdAdminIdPrefix, data.TenantId, data.AdminId)
+	_ = m.DelCacheCtx(ctx, iamAdminScopesIdKey, iamAdminScopesTenantIdAdminIdKey)
+
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// FindOne 重写FindOne方法，校验管理范围属于当前租户
+func (m *customAdminScopesModel) FindOne(ctx context.Context, id int64) (*AdminScopes, error) {
+	resp, err := m.defaultAdminScopesModel.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
+// FindByAdminId 查询管理员在当前租户的管理范围
+func (m *customAdminScopesModel) FindByAdminId(ctx context.Context, adminId int64) (*AdminScopes, error) {
+	return m.FindOneByTenantIdAdminId(ctx, TenantIdFromContext(ctx), adminId)
+}
+
+// FindAll 查询当前租户的全部管理范围，按管理员排序
+func (m *customAdminScopesModel) FindAll(ctx context.Context) ([]*AdminScopes, error) {
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 order by admin_id", adminScopesRows, m.table)
+	var resp []*AdminScopes
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx))
+	return resp, err
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ AuditLogsModel = (*customAuditLogsModel)(nil)
+
+type (
+	// AuditLogsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customAuditLogsModel.
+	AuditLogsModel interface {
+		auditLogsModel
+		FindByImpersonationId(ctx context.Context, impersonationId int64) ([]*AuditLogs, error) // 查询模拟登录期间的审计记录
+	}
+
+	customAuditLogsModel struct {
+		*defaultAuditLogsModel
+	}
+)
+
+// NewAuditLogsModel returns a model for the database table.
+func NewAuditLogsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) AuditLogsModel {
+	return &customAuditLogsModel{
+		defaultAuditLogsModel: newAuditLogsModel(conn, c, opts...),
+	}
+}
+
+// FindByImpersonationId 查询模拟登录期间的审计记录
+func (m *customAuditLogsModel) FindByImpersonationId(ctx context.Context, impersonationId int64) ([]*AuditLogs, error) {
+	query := fmt.Sprintf("select %s from %s where impersonation_id = $1 order by created_at, id", auditLogsRows, m.table)
+	var resp []*AuditLogs
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, impersonationId)
+	return resp, err
+}
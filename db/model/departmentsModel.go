@@ -0,0 +1,219 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// DepartmentRootPath 顶级部门的祖先路径
+const DepartmentRootPath = "/"
+
+var _ DepartmentsModel = (*customDepartmentsModel)(nil)
+
+type (
+	// DepartmentsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customDepartmentsModel.
+	DepartmentsModel interface {
+		departmentsModel
+		FindActiveById(ctx context.Context, id int64) (*Departments, error)       // 按ID查询未删除的部门
+		FindActiveByIds(ctx context.Context, ids []int64) ([]*Departments, error) // 按ID批量查询未删除的部门
+		SoftDelete(ctx context.Context, id int64) error                           // 软删除部门
+		MoveSubtree(ctx context.Context, oldPrefix, newPrefix string) error       // 将祖先路径以oldPrefix开头的部门改为以newPrefix开头
+
+		ExistsByCode(ctx context.Context, code string, excludeId int64) (bool, error) // 检查部门编码是否存在（排除指定ID）
+		CountChildren(ctx context.Context, id int64) (int64, error)                   // 统计未删除的直接下级部门数量
+		CountMembers(ctx context.Context, id int64) (int64, error)                    // 统计部门中未删除的用户数量
+
+		FindAllActive(ctx context.Context) ([]*Departments, error)                        // 查询租户内所有未删除的部门，按路径和排序顺序排列
+		FindActiveByParentId(ctx context.Context, parentId int64) ([]*Departments, error) // 查询直接下级部门，parentId为0时查询顶级部门
+		FindSubtreeIds(ctx context.Context, ids []int64) ([]int64, error)                 // 查询指定部门及其所有下级部门的ID
+	}
+
+	customDepartmentsModel struct {
+		*defaultDepartmentsModel
+	}
+)
+
+// NewDepartmentsModel returns a model for the database table.
+func NewDepartmentsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) DepartmentsModel {
+	return &customDepartmentsModel{
+		defaultDepartmentsModel: newDepartmentsModel(conn, c, opts...),
+	}
+}
+
+// ChildPath 返回该部门的下级部门所使用的祖先路径
+func (d *Departments) ChildPath() string {
+	return fmt.Sprintf("%s%d/", d.Path, d.Id)
+}
+
+// Insert 重写Insert方法，使用PostgreSQL的RETURNING子句获取插入后的ID
+func (m *customDepartmentsModel) Insert(ctx context.Context, data *Departments) (sql.Result, error) {
+	var insertedID int64
+	// 部门归属当前调用的租户
+	data.TenantId = TenantIdFromContext(ctx)
+	if data.Path == "" {
+		data.Path = DepartmentRootPath
+	}
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7) RETURNING id", m.table, departmentsRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.TenantId, data.ParentId, data.Path, data.Name, data.Code, data.SortOrder, data.DeletedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// FindActiveById 按ID查询未删除的部门
+func (m *customDepartmentsModel) FindActiveById(ctx context.Context, id int64) (*Departments, error) {
+	resp, err := m.FindOne(ctx, id)
+	switch {
+	case err == nil:
+		if resp.TenantId != TenantIdFromContext(ctx) || resp.DeletedAt.Valid {
+			return nil, ErrNotFound
+		}
+		return resp, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindActiveByIds 按ID批量查询未删除的部门
+func (m *customDepartmentsModel) FindActiveByIds(ctx context.Context, ids []int64) ([]*Departments, error) {
+	if len(ids) == 0 {
+		return []*Departments{}, nil
+	}
+
+	// 构建占位符
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids)+1)
+	args[0] = TenantIdFromContext(ctx)
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = id
+	}
+
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and id IN (%s) and deleted_at IS NULL order by id", departmentsRows, m.table, strings.Join(placeholders, ","))
+	var resp []*Departments
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// SoftDelete 软删除部门
+func (m *customDepartmentsModel) SoftDelete(ctx context.Context, id int64) error {
+	one, err := m.FindActiveById(ctx, id)
+	if err != nil {
+		return err
+	}
+	one.DeletedAt.Valid = true
+	one.DeletedAt.Time = time.Now()
+	return m.Update(ctx, one)
+}
+
+// MoveSubtree 将祖先路径以oldPrefix开头的部门改为以newPrefix开头，用于部门调整上级后同步其所有下级部门
+func (m *customDepartmentsModel) MoveSubtree(ctx context.Context, oldPrefix, newPrefix string) error {
+	tenantId := TenantIdFromContext(ctx)
+
+	// 先查询受影响的部门以清除缓存
+	query := fmt.Sprintf("select id from %s where tenant_id = $1 and path LIKE $2", m.table)
+	var ids []int64
+	if err := m.QueryRowsNoCacheCtx(ctx, &ids, query, tenantId, oldPrefix+"%"); err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheIamDepartmentsIdPrefix, id))
+	}
+
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		updateQuery := fmt.Sprintf("update %s set path = $3::text || substr(path, $4::int) where tenant_id = $1 and path LIKE $2", m.table)
+		return conn.ExecCtx(ctx, updateQuery, tenantId, oldPrefix+"%", newPrefix, len(oldPrefix)+1)
+	}, keys...)
+	return err
+}
+
+// ExistsByCode 检查部门编码是否存在（排除指定ID）
+func (m *customDepartmentsModel) ExistsByCode(ctx context.Context, code string, excludeId int64) (bool, error) {
+	query := fmt.Sprintf("select count(1) from %s where code = $1 and id != $2 and tenant_id = $3 and deleted_at IS NULL", m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, code, excludeId, TenantIdFromContext(ctx))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CountChildren 统计未删除的直接下级部门数量
+func (m *customDepartmentsModel) CountChildren(ctx context.Context, id int64) (int64, error) {
+	query := fmt.Sprintf("select count(1) from %s where parent_id = $1 and tenant_id = $2 and deleted_at IS NULL", m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, id, TenantIdFromContext(ctx))
+	return count, err
+}
+
+// CountMembers 统计部门中未删除的用户数量
+func (m *customDepartmentsModel) CountMembers(ctx context.Context, id int64) (int64, error) {
+	query := `select count(1) from "iam"."users" where department_id = $1 and tenant_id = $2 and deleted_at IS NULL`
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, id, TenantIdFromContext(ctx))
+	return count, err
+}
+
+// FindAllActive 查询租户内所有未删除的部门，按路径和排序顺序排列，上级部门总在下级部门之前
+func (m *customDepartmentsModel) FindAllActive(ctx context.Context) ([]*Departments, error) {
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and deleted_at IS NULL order by path, sort_order, id", departmentsRows, m.table)
+	var resp []*Departments
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// FindActiveByParentId 查询直接下级部门，parentId为0时查询顶级部门
+func (m *customDepartmentsModel) FindActiveByParentId(ctx context.Context, parentId int64) ([]*Departments, error) {
+	var resp []*Departments
+	if parentId == 0 {
+		query := fmt.Sprintf("select %s from %s where tenant_id = $1 and parent_id IS NULL and deleted_at IS NULL order by sort_order, id", departmentsRows, m.table)
+		err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx))
+		return resp, err
+	}
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and parent_id = $2 and deleted_at IS NULL order by sort_order, id", departmentsRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx), parentId)
+	return resp, err
+}
+
+// FindSubtreeIds 查询指定部门及其所有下级部门的ID（仅未删除的部门）
+func (m *customDepartmentsModel) FindSubtreeIds(ctx context.Context, ids []int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return []int64{}, nil
+	}
+
+	// 构建占位符
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids)+1)
+	args[0] = TenantIdFromContext(ctx)
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = id
+	}
+
+	// 下级部门的祖先路径以上级部门的路径加自身ID为前缀
+	query := fmt.Sprintf(`select distinct d.id from %s d
+		join %s p on d.id = p.id or d.path LIKE p.path || p.id || '/%%'
+		where p.tenant_id = $1 and p.id IN (%s) and p.deleted_at IS NULL and d.deleted_at IS NULL
+		order by d.id`, m.table, m.table, strings.Join(placeholders, ","))
+	var resp []int64
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
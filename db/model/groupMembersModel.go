@@ -0,0 +1,182 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ GroupMembersModel = (*customGroupMembersModel)(nil)
+
+type (
+	// GroupMembersModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customGroupMembersModel.
+	GroupMembersModel interface {
+		groupMembersModel
+		AddMembers(ctx context.Context, groupId int64, userIds []int64, createdBy sql.NullInt64) error // 批量添加用户组成员，已存在的成员跳过
+		RemoveMembers(ctx context.Context, groupId int64, userIds []int64) error                       // 批量移除用户组成员
+		RemoveAllGroupMembers(ctx context.Context, groupId int64) error                                // 移除用户组的所有成员
+
+		FindMemberUsers(ctx context.Context, groupId int64, limit, offset int32) ([]*Users, error) // 分页查询用户组成员（未删除，包含已禁用）
+		CountMemberUsers(ctx context.Context, groupId int64) (int64, error)                        // 统计用户组成员数量
+		FindUserGroups(ctx context.Context, userId int64, limit, offset int32) ([]*Groups, error)  // 分页查询用户所属的用户组（未删除）
+		CountUserGroups(ctx context.Context, userId int64) (int64, error)                          // 统计用户所属的用户组数量
+		FindUserIdsByGroupId(ctx context.Context, groupId int64) ([]int64, error)                  // 查询用户组所有成员的用户ID
+	}
+
+	customGroupMembersModel struct {
+		*defaultGroupMembersModel
+	}
+)
+
+// NewGroupMembersModel returns a model for the database table.
+func NewGroupMembersModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) GroupMembersModel {
+	return &customGroupMembersModel{
+		defaultGroupMembersModel: newGroupMembersModel(conn, c, opts...),
+	}
+}
+
+// AddMembers 批量添加用户组成员，已存在的成员跳过
+func (m *customGroupMembersModel) AddMembers(ctx context.Context, groupId int64, userIds []int64, createdBy sql.NullInt64) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	// 查询已存在的成员
+	query := fmt.Sprintf("select %s from %s where group_id = $1", groupMembersRows, m.table)
+	var existing []*GroupMembers
+	if err := m.QueryRowsNoCacheCtx(ctx, &existing, query, groupId); err != nil {
+		return err
+	}
+	existingMap := make(map[int64]bool, len(existing))
+	for _, gm := range existing {
+		existingMap[gm.UserId] = true
+	}
+
+	// 只插入不存在的成员
+	for _, userId := range userIds {
+		if existingMap[userId] {
+			continue
+		}
+		existingMap[userId] = true
+		member := &GroupMembers{
+			GroupId:   groupId,
+			UserId:    userId,
+			CreatedBy: createdBy,
+		}
+		if _, err := m.Insert(ctx, member); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveMembers 批量移除用户组成员
+func (m *customGroupMembersModel) RemoveMembers(ctx context.Context, groupId int64, userIds []int64) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	// 构建占位符
+	placeholders := make([]string, len(userIds))
+	args := make([]interface{}, len(userIds)+1)
+	args[0] = groupId
+	for i, userId := range userIds {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = userId
+	}
+
+	// 先查询要删除的记录以清除缓存
+	query := fmt.Sprintf("select %s from %s where group_id = $1 and user_id IN (%s)", groupMembersRows, m.table, strings.Join(placeholders, ","))
+	var toDelete []*GroupMembers
+	if err := m.QueryRowsNoCacheCtx(ctx, &toDelete, query, args...); err != nil {
+		return err
+	}
+	if len(toDelete) == 0 {
+		return nil
+	}
+
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		deleteQuery := fmt.Sprintf("delete from %s where group_id = $1 and user_id IN (%s)", m.table, strings.Join(placeholders, ","))
+		return conn.ExecCtx(ctx, deleteQuery, args...)
+	}, m.cacheKeys(toDelete)...)
+	return err
+}
+
+// RemoveAllGroupMembers 移除用户组的所有成员
+func (m *customGroupMembersModel) RemoveAllGroupMembers(ctx context.Context, groupId int64) error {
+	query := fmt.Sprintf("select %s from %s where group_id = $1", groupMembersRows, m.table)
+	var toDelete []*GroupMembers
+	if err := m.QueryRowsNoCacheCtx(ctx, &toDelete, query, groupId); err != nil {
+		return err
+	}
+	if len(toDelete) == 0 {
+		return nil
+	}
+
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		deleteQuery := fmt.Sprintf("delete from %s where group_id = $1", m.table)
+		return conn.ExecCtx(ctx, deleteQuery, groupId)
+	}, m.cacheKeys(toDelete)...)
+	return err
+}
+
+// FindMemberUsers 分页查询用户组成员（未删除，包含已禁用）
+func (m *customGroupMembersModel) FindMemberUsers(ctx context.Context, groupId int64, limit, offset int32) ([]*Users, error) {
+	query := fmt.Sprintf(`select %s from "iam"."users"
+		where id IN (select user_id from %s where group_id = $1) and tenant_id = $2 and deleted_at IS NULL
+		order by id limit $3 offset $4`, usersRows, m.table)
+	var resp []*Users
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, groupId, TenantIdFromContext(ctx), limit, offset)
+	return resp, err
+}
+
+// CountMemberUsers 统计用户组成员数量
+func (m *customGroupMembersModel) CountMemberUsers(ctx context.Context, groupId int64) (int64, error) {
+	query := fmt.Sprintf(`select count(1) from "iam"."users"
+		where id IN (select user_id from %s where group_id = $1) and tenant_id = $2 and deleted_at IS NULL`, m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, groupId, TenantIdFromContext(ctx))
+	return count, err
+}
+
+// FindUserGroups 分页查询用户所属的用户组（未删除）
+func (m *customGroupMembersModel) FindUserGroups(ctx context.Context, userId int64, limit, offset int32) ([]*Groups, error) {
+	query := fmt.Sprintf(`select %s from "iam"."groups"
+		where id IN (select group_id from %s where user_id = $1) and tenant_id = $2 and deleted_at IS NULL
+		order by id limit $3 offset $4`, groupsRows, m.table)
+	var resp []*Groups
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, TenantIdFromContext(ctx), limit, offset)
+	return resp, err
+}
+
+// CountUserGroups 统计用户所属的用户组数量
+func (m *customGroupMembersModel) CountUserGroups(ctx context.Context, userId int64) (int64, error) {
+	query := fmt.Sprintf(`select count(1) from "iam"."groups"
+		where id IN (select group_id from %s where user_id = $1) and tenant_id = $2 and deleted_at IS NULL`, m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, userId, TenantIdFromContext(ctx))
+	return count, err
+}
+
+// cacheKeys 构建成员记录的缓存键
+func (m *customGroupMembersModel) cacheKeys(members []*GroupMembers) []string {
+	keys := make([]string, 0, len(members)*2)
+	for _, gm := range members {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheIamGroupMembersIdPrefix, gm.Id))
+		keys = append(keys, fmt.Sprintf("%s%v:%v", cacheIamGroupMembersGroupIdUserIdPrefix, gm.GroupId, gm.UserId))
+	}
+	return keys
+}
+
+// FindUserIdsByGroupId 查询用户组所有成员的用户ID
+func (m *customGroupMembersModel) FindUserIdsByGroupId(ctx context.Context, groupId int64) ([]int64, error) {
+	query := fmt.Sprintf("select user_id from %s where group_id = $1 order by user_id", m.table)
+	var resp []int64
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, groupId)
+	return resp, err
+}
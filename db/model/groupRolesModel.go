@@ -0,0 +1,218 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ GroupRolesModel = (*customGroupRolesModel)(nil)
+
+type (
+	// GroupRolesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customGroupRolesModel.
+	GroupRolesModel interface {
+		groupRolesModel
+		AssignRoles(ctx context.Context, groupId int64, roleIds []int64, createdBy sql.NullInt64) error // 为用户组批量分配角色，已存在的跳过
+		RemoveRoles(ctx context.Context, groupId int64, roleIds []int64) error                          // 批量移除用户组的角色
+		RemoveAllGroupRoles(ctx context.Context, groupId int64) error                                   // 移除用户组的所有角色
+
+		FindRolesByGroupId(ctx context.Context, groupId int64) ([]*GroupRoles, error) // 查询用户组的所有角色
+		FindByUserId(ctx context.Context, userId int64) ([]*GroupRoles, error)        // 查询用户通过所属用户组继承的角色（仅未删除的用户组）
+		CountGroupsByRoleId(ctx context.Context, roleId int64) (int64, error)         // 统计使用指定角色的用户组数量（仅未删除的用户组）
+
+		FindInheritedByUserIds(ctx context.Context, userIds []int64) ([]*InheritedRole, error) // 批量查询多个用户通过用户组继承的角色
+		FindInheritedByRoleIds(ctx context.Context, roleIds []int64) ([]*InheritedRole, error) // 批量查询通过用户组继承多个角色的用户
+		FindAllInherited(ctx context.Context) ([]*InheritedRole, error)                        // 查询当前租户所有通过用户组继承的角色
+	}
+
+	customGroupRolesModel struct {
+		*defaultGroupRolesModel
+	}
+
+	// InheritedRole 用户通过用户组继承的角色，同一角色经多个用户组继承时只返回一条
+	InheritedRole struct {
+		UserId int64 `db:"user_id"` // 用户ID
+		RoleId int64 `db:"role_id"` // 角色ID
+	}
+)
+
+// NewGroupRolesModel returns a model for the database table.
+func NewGroupRolesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) GroupRolesModel {
+	return &customGroupRolesModel{
+		defaultGroupRolesModel: newGroupRolesModel(conn, c, opts...),
+	}
+}
+
+// AssignRoles 为用户组批量分配角色，已存在的跳过
+func (m *customGroupRolesModel) AssignRoles(ctx context.Context, groupId int64, roleIds []int64, createdBy sql.NullInt64) error {
+	if len(roleIds) == 0 {
+		return nil
+	}
+
+	// 查询已存在的角色关联
+	existing, err := m.FindRolesByGroupId(ctx, groupId)
+	if err != nil {
+		return err
+	}
+	existingMap := make(map[int64]bool, len(existing))
+	for _, gr := range existing {
+		existingMap[gr.RoleId] = true
+	}
+
+	// 只插入不存在的角色关联
+	for _, roleId := range roleIds {
+		if existingMap[roleId] {
+			continue
+		}
+		existingMap[roleId] = true
+		groupRole := &GroupRoles{
+			GroupId:   groupId,
+			RoleId:    roleId,
+			CreatedBy: createdBy,
+		}
+		if _, err := m.Insert(ctx, groupRole); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveRoles 批量移除用户组的角色
+func (m *customGroupRolesModel) RemoveRoles(ctx context.Context, groupId int64, roleIds []int64) error {
+	if len(roleIds) == 0 {
+		return nil
+	}
+
+	// 构建占位符
+	placeholders := make([]string, len(roleIds))
+	args := make([]interface{}, len(roleIds)+1)
+	args[0] = groupId
+	for i, roleId := range roleIds {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = roleId
+	}
+
+	// 先查询要删除的记录以清除缓存
+	query := fmt.Sprintf("select %s from %s where group_id = $1 and role_id IN (%s)", groupRolesRows, m.table, strings.Join(placeholders, ","))
+	var toDelete []*GroupRoles
+	if err := m.QueryRowsNoCacheCtx(ctx, &toDelete, query, args...); err != nil {
+		return err
+	}
+	if len(toDelete) == 0 {
+		return nil
+	}
+
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		deleteQuery := fmt.Sprintf("delete from %s where group_id = $1 and role_id IN (%s)", m.table, strings.Join(placeholders, ","))
+		return conn.ExecCtx(ctx, deleteQuery, args...)
+	}, m.cacheKeys(toDelete)...)
+	return err
+}
+
+// RemoveAllGroupRoles 移除用户组的所有角色
+func (m *customGroupRolesModel) RemoveAllGroupRoles(ctx context.Context, groupId int64) error {
+	toDelete, err := m.FindRolesByGroupId(ctx, groupId)
+	if err != nil {
+		return err
+	}
+	if len(toDelete) == 0 {
+		return nil
+	}
+
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		deleteQuery := fmt.Sprintf("delete from %s where group_id = $1", m.table)
+		return conn.ExecCtx(ctx, deleteQuery, groupId)
+	}, m.cacheKeys(toDelete)...)
+	return err
+}
+
+// FindRolesByGroupId 查询用户组的所有角色
+func (m *customGroupRolesModel) FindRolesByGroupId(ctx context.Context, groupId int64) ([]*GroupRoles, error) {
+	query := fmt.Sprintf("select %s from %s where group_id = $1 order by created_at", groupRolesRows, m.table)
+	var resp []*GroupRoles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, groupId)
+	return resp, err
+}
+
+// FindByUserId 查询用户通过所属用户组继承的角色（仅当前租户未删除的用户组）
+func (m *customGroupRolesModel) FindByUserId(ctx context.Context, userId int64) ([]*GroupRoles, error) {
+	query := fmt.Sprintf(`select %s from %s
+		where group_id IN (
+			select gm.group_id from "iam"."group_members" gm
+			join "iam"."groups" g on g.id = gm.group_id
+			where gm.user_id = $1 and g.tenant_id = $2 and g.deleted_at IS NULL
+		) order by group_id, created_at`, groupRolesRows, m.table)
+	var resp []*GroupRoles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// CountGroupsByRoleId 统计使用指定角色的用户组数量（仅未删除的用户组）
+func (m *customGroupRolesModel) CountGroupsByRoleId(ctx context.Context, roleId int64) (int64, error) {
+	query := fmt.Sprintf(`select count(1) from %s gr
+		join "iam"."groups" g on g.id = gr.group_id
+		where gr.role_id = $1 and g.tenant_id = $2 and g.deleted_at IS NULL`, m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, roleId, TenantIdFromContext(ctx))
+	return count, err
+}
+
+// cacheKeys 构建角色关联记录的缓存键
+func (m *customGroupRolesModel) cacheKeys(groupRoles []*GroupRoles) []string {
+	keys := make([]string, 0, len(groupRoles)*2)
+	for _, gr := range groupRoles {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheIamGroupRolesIdPrefix, gr.Id))
+		keys = append(keys, fmt.Sprintf("%s%v:%v", cacheIamGroupRolesGroupIdRoleIdPrefix, gr.GroupId, gr.RoleId))
+	}
+	return keys
+}
+
+// inheritedRolesQuery 用户通过当前租户未删除的用户组继承角色的查询，条件由调用方追加
+const inheritedRolesQuery = `select distinct gm.user_id, gr.role_id from "iam"."group_members" gm
+		join "iam"."group_roles" gr on gr.group_id = gm.group_id
+		join "iam"."groups" g on g.id = gm.group_id
+		where g.tenant_id = $1 and g.deleted_at IS NULL`
+
+// FindInheritedByUserIds 批量查询多个用户通过用户组继承的角色
+func (m *customGroupRolesModel) FindInheritedByUserIds(ctx context.Context, userIds []int64) ([]*InheritedRole, error) {
+	return m.findInheritedIn(ctx, "gm.user_id", userIds)
+}
+
+// FindInheritedByRoleIds 批量查询通过用户组继承多个角色的用户
+func (m *customGroupRolesModel) FindInheritedByRoleIds(ctx context.Context, roleIds []int64) ([]*InheritedRole, error) {
+	return m.findInheritedIn(ctx, "gr.role_id", roleIds)
+}
+
+// findInheritedIn 按指定列批量查询继承的角色
+func (m *customGroupRolesModel) findInheritedIn(ctx context.Context, column string, ids []int64) ([]*InheritedRole, error) {
+	if len(ids) == 0 {
+		return []*InheritedRole{}, nil
+	}
+
+	// 构建占位符
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids)+1)
+	args[0] = TenantIdFromContext(ctx)
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = id
+	}
+
+	query := fmt.Sprintf("%s and %s IN (%s) order by gm.user_id, gr.role_id", inheritedRolesQuery, column, strings.Join(placeholders, ","))
+	var resp []*InheritedRole
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// FindAllInherited 查询当前租户所有通过用户组继承的角色
+func (m *customGroupRolesModel) FindAllInherited(ctx context.Context) ([]*InheritedRole, error) {
+	query := inheritedRolesQuery + " order by gm.user_id, gr.role_id"
+	var resp []*InheritedRole
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx))
+	return resp, err
+}
@@ -0,0 +1,158 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ GroupsModel = (*customGroupsModel)(nil)
+
+type (
+	// GroupsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customGroupsModel.
+	GroupsModel interface {
+		groupsModel
+		FindActiveById(ctx context.Context, id int64) (*Groups, error)       // 按ID查询未删除的用户组
+		FindActiveByIds(ctx context.Context, ids []int64) ([]*Groups, error) // 按ID批量查询未删除的用户组
+		SoftDelete(ctx context.Context, id int64) error                      // 软删除用户组
+
+		ExistsByCode(ctx context.Context, code string, excludeId int64) (bool, error) // 检查用户组编码是否存在（排除指定ID）
+
+		FindActiveWithPagination(ctx context.Context, limit, offset int32) ([]*Groups, error)              // 分页查询未删除的用户组
+		CountActive(ctx context.Context) (int64, error)                                                    // 统计未删除的用户组数量
+		SearchActiveByKeyword(ctx context.Context, keyword string, limit, offset int32) ([]*Groups, error) // 按关键词搜索用户组
+		CountActiveByKeyword(ctx context.Context, keyword string) (int64, error)                           // 统计搜索结果数量
+	}
+
+	customGroupsModel struct {
+		*defaultGroupsModel
+	}
+)
+
+// NewGroupsModel returns a model for the database table.
+func NewGroupsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) GroupsModel {
+	return &customGroupsModel{
+		defaultGroupsModel: newGroupsModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，使用PostgreSQL的RETURNING子句获取插入后的ID
+func (m *customGroupsModel) Insert(ctx context.Context, data *Groups) (sql.Result, error) {
+	var insertedID int64
+	// 用户组归属当前调用的租户
+	data.TenantId = TenantIdFromContext(ctx)
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5) RETURNING id", m.table, groupsRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.TenantId, data.Name, data.Code, data.Description, data.DeletedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// FindActiveById 按ID查询未删除的用户组
+func (m *customGroupsModel) FindActiveById(ctx context.Context, id int64) (*Groups, error) {
+	iamGroupsIdKey := fmt.Sprintf("%s%v", cacheIamGroupsIdPrefix, id)
+	var resp Groups
+	err := m.QueryRowCtx(ctx, &resp, iamGroupsIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where id = $1 and deleted_at IS NULL limit 1", groupsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch {
+	case err == nil:
+		if resp.TenantId != TenantIdFromContext(ctx) {
+			return nil, ErrNotFound
+		}
+		return &resp, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindActiveByIds 按ID批量查询未删除的用户组
+func (m *customGroupsModel) FindActiveByIds(ctx context.Context, ids []int64) ([]*Groups, error) {
+	if len(ids) == 0 {
+		return []*Groups{}, nil
+	}
+
+	// 构建占位符
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids)+1)
+	args[0] = TenantIdFromContext(ctx)
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = id
+	}
+
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and id IN (%s) and deleted_at IS NULL order by id", groupsRows, m.table, strings.Join(placeholders, ","))
+	var resp []*Groups
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// SoftDelete 软删除用户组
+func (m *customGroupsModel) SoftDelete(ctx context.Context, id int64) error {
+	one, err := m.FindActiveById(ctx, id)
+	if err != nil {
+		return err
+	}
+	one.DeletedAt.Valid = true
+	one.DeletedAt.Time = time.Now()
+	return m.Update(ctx, one)
+}
+
+// ExistsByCode 检查用户组编码是否存在（排除指定ID）
+func (m *customGroupsModel) ExistsByCode(ctx context.Context, code string, excludeId int64) (bool, error) {
+	query := fmt.Sprintf("select count(1) from %s where code = $1 and id != $2 and tenant_id = $3 and deleted_at IS NULL", m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, code, excludeId, TenantIdFromContext(ctx))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// FindActiveWithPagination 分页查询未删除的用户组
+func (m *customGroupsModel) FindActiveWithPagination(ctx context.Context, limit, offset int32) ([]*Groups, error) {
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and deleted_at IS NULL order by created_at, id limit $2 offset $3", groupsRows, m.table)
+	var resp []*Groups
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx), limit, offset)
+	return resp, err
+}
+
+// CountActive 统计未删除的用户组数量
+func (m *customGroupsModel) CountActive(ctx context.Context) (int64, error) {
+	query := fmt.Sprintf("select count(1) from %s where tenant_id = $1 and deleted_at IS NULL", m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, TenantIdFromContext(ctx))
+	return count, err
+}
+
+// SearchActiveByKeyword 按关键词搜索用户组（名称、编码、描述）
+func (m *customGroupsModel) SearchActiveByKeyword(ctx context.Context, keyword string, limit, offset int32) ([]*Groups, error) {
+	query := fmt.Sprintf("select %s from %s where tenant_id = $2 and deleted_at IS NULL AND (name ILIKE $1 OR code ILIKE $1 OR description ILIKE $1) order by created_at, id limit $3 offset $4", groupsRows, m.table)
+	var resp []*Groups
+	keywordPattern := "%" + keyword + "%"
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, keywordPattern, TenantIdFromContext(ctx), limit, offset)
+	return resp, err
+}
+
+// CountActiveByKeyword 按关键词统计用户组数量
+func (m *customGroupsModel) CountActiveByKeyword(ctx context.Context, keyword string) (int64, error) {
+	query := fmt.Sprintf("select count(1) from %s where tenant_id = $2 and deleted_at IS NULL AND (name ILIKE $1 OR code ILIKE $1 OR description ILIKE $1)", m.table)
+	var count int64
+	keywordPattern := "%" + keyword + "%"
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, keywordPattern, TenantIdFromContext(ctx))
+	return count, err
+}
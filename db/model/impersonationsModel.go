@@ -0,0 +1,62 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImpersonationsModel = (*customImpersonationsModel)(nil)
+
+type (
+	// ImpersonationsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImpersonationsModel.
+	ImpersonationsModel interface {
+		impersonationsModel
+		FindActiveById(ctx context.Context, id int64) (*Impersonations, error) // 查询未过期的模拟登录记录
+	}
+
+	customImpersonationsModel struct {
+		*defaultImpersonationsModel
+	}
+)
+
+// NewImpersonationsModel returns a model for the database table.
+func NewImpersonationsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImpersonationsModel {
+	return &customImpersonationsModel{
+		defaultImpersonationsModel: newImpersonationsModel(conn, c, opts...),
+	}
+}
+
+// Insert 插入模拟登录记录并返回ID
+func (m *customImpersonationsModel) Insert(ctx context.Context, data *Impersonations) (sql.Result, error) {
+	var insertedID int64
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4) RETURNING id", m.table, impersonationsRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.ActorId, data.SubjectId, data.Reason, data.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// FindActiveById 查询未过期的模拟登录记录
+func (m *customImpersonationsModel) FindActiveById(ctx context.Context, id int64) (*Impersonations, error) {
+	query := fmt.Sprintf("select %s from %s where id = $1 and expires_at > NOW() limit 1", impersonationsRows, m.table)
+	var resp Impersonations
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, id)
+	switch {
+	case err == nil:
+		return &resp, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
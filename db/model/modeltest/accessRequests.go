@@ -0,0 +1,88 @@
+package modeltest
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// AccessRequests 内存中的访问申请表
+type AccessRequests struct {
+	model.AccessRequestsModel
+	Rows []*model.AccessRequests
+}
+
+// Row 按ID取出表中的申请，不存在时返回nil
+func (m *AccessRequests) Row(id int64) *model.AccessRequests {
+	for _, r := range m.Rows {
+		if r.Id == id {
+			return r
+		}
+	}
+	return nil
+}
+
+func (m *AccessRequests) FindOne(_ context.Context, id int64) (*model.AccessRequests, error) {
+	r := m.Row(id)
+	if r == nil {
+		return nil, model.ErrNotFound
+	}
+	c := *r
+	return &c, nil
+}
+
+// Transition 申请仍处于审批中且步骤未变时才更新
+func (m *AccessRequests) Transition(_ context.Context, data *model.AccessRequests, fromStep int64) (bool, error) {
+	r := m.Row(data.Id)
+	if r == nil || r.Status != model.AccessRequestPending || r.CurrentStep != fromStep {
+		return false, nil
+	}
+	r.Status, r.CurrentStep, r.DecidedAt = data.Status, data.CurrentStep, data.DecidedAt
+	return true, nil
+}
+
+// AccessRequestEvents 内存中的访问申请事件表
+type AccessRequestEvents struct {
+	model.AccessRequestEventsModel
+	Rows []*model.AccessRequestEvents
+}
+
+func (m *AccessRequestEvents) Insert(_ context.Context, data *model.AccessRequestEvents) (sql.Result, error) {
+	data.Id = int64(len(m.Rows) + 1)
+	data.CreatedAt = time.Now()
+	c := *data
+	m.Rows = append(m.Rows, &c)
+	return result(data.Id), nil
+}
+
+func (m *AccessRequestEvents) FindByRequestId(_ context.Context, requestId int64) ([]*model.AccessRequestEvents, error) {
+	var res []*model.AccessRequestEvents
+	for _, e := range m.Rows {
+		if e.RequestId == requestId {
+			c := *e
+			res = append(res, &c)
+		}
+	}
+	return res, nil
+}
+
+// RoleApprovers 内存中的角色审批链表
+type RoleApprovers struct {
+	model.RoleApproversModel
+	Rows []*model.RoleApprovers
+}
+
+func (m *RoleApprovers) IsApprover(_ context.Context, roleId, step, approverId int64) (bool, error) {
+	return slices.ContainsFunc(m.Rows, func(a *model.RoleApprovers) bool {
+		return a.RoleId == roleId && a.Step == step && a.ApproverId == approverId
+	}), nil
+}
+
+func (m *RoleApprovers) IsRoleApprover(_ context.Context, roleId, approverId int64) (bool, error) {
+	return slices.ContainsFunc(m.Rows, func(a *model.RoleApprovers) bool {
+		return a.RoleId == roleId && a.ApproverId == approverId
+	}), nil
+}
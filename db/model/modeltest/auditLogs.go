@@ -0,0 +1,22 @@
+package modeltest
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// AuditLogs 内存中的审计日志表，按写入顺序保存
+type AuditLogs struct {
+	model.AuditLogsModel
+	Rows []*model.AuditLogs
+}
+
+func (m *AuditLogs) Insert(_ context.Context, data *model.AuditLogs) (sql.Result, error) {
+	data.Id = int64(len(m.Rows) + 1)
+	data.CreatedAt = time.Now()
+	m.Rows = append(m.Rows, data)
+	return result(data.Id), nil
+}
@@ -0,0 +1,86 @@
+package modeltest
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// Departments 内存中的部门表，Path按祖先路径规则填写，如部门3的上级为1时Path为/1/
+type Departments struct {
+	model.DepartmentsModel
+	Rows []*model.Departments
+}
+
+func (m *Departments) active(id int64) *model.Departments {
+	for _, d := range m.Rows {
+		if d.Id == id && !d.DeletedAt.Valid {
+			return d
+		}
+	}
+	return nil
+}
+
+func (m *Departments) FindActiveById(_ context.Context, id int64) (*model.Departments, error) {
+	d := m.active(id)
+	if d == nil {
+		return nil, model.ErrNotFound
+	}
+	c := *d
+	return &c, nil
+}
+
+func (m *Departments) FindActiveByIds(_ context.Context, ids []int64) ([]*model.Departments, error) {
+	res := []*model.Departments{}
+	for _, d := range m.Rows {
+		if slices.Contains(ids, d.Id) && !d.DeletedAt.Valid {
+			c := *d
+			res = append(res, &c)
+		}
+	}
+	slices.SortFunc(res, func(a, b *model.Departments) int { return int(a.Id - b.Id) })
+	return res, nil
+}
+
+// FindSubtreeIds 下级部门的祖先路径以上级部门的路径加自身ID为前缀
+func (m *Departments) FindSubtreeIds(_ context.Context, ids []int64) ([]int64, error) {
+	res := []int64{}
+	for _, id := range ids {
+		root := m.active(id)
+		if root == nil {
+			continue
+		}
+		prefix := root.Path + strconv.FormatInt(root.Id, 10) + "/"
+		for _, d := range m.Rows {
+			if (d.Id == root.Id || strings.HasPrefix(d.Path, prefix)) && !d.DeletedAt.Valid {
+				res = append(res, d.Id)
+			}
+		}
+	}
+	slices.Sort(res)
+	return slices.Compact(res), nil
+}
+
+// RoleDepartments 内存中的角色自定义数据权限部门表
+type RoleDepartments struct {
+	model.RoleDepartmentsModel
+	Rows []*model.RoleDepartments
+}
+
+func (m *RoleDepartments) FindByRoleId(ctx context.Context, roleId int64) ([]*model.RoleDepartments, error) {
+	return m.FindByRoleIds(ctx, []int64{roleId})
+}
+
+func (m *RoleDepartments) FindByRoleIds(_ context.Context, roleIds []int64) ([]*model.RoleDepartments, error) {
+	var res []*model.RoleDepartments
+	for _, rd := range m.Rows {
+		if slices.Contains(roleIds, rd.RoleId) {
+			c := *rd
+			res = append(res, &c)
+		}
+	}
+	return res, nil
+}
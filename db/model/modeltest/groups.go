@@ -0,0 +1,228 @@
+package modeltest
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// Groups 内存中的用户组表，查询返回副本以模拟数据库
+type Groups struct {
+	model.GroupsModel
+	Rows []*model.Groups
+}
+
+// Row 按ID取出表中的用户组，包括已删除的用户组，不存在时返回nil
+func (m *Groups) Row(id int64) *model.Groups {
+	for _, g := range m.Rows {
+		if g.Id == id {
+			return g
+		}
+	}
+	return nil
+}
+
+// active 用户组是否未删除，未在表中登记的用户组视为未删除
+func (m *Groups) active(id int64) bool {
+	if m == nil {
+		return true
+	}
+	g := m.Row(id)
+	return g == nil || !g.DeletedAt.Valid
+}
+
+func (m *Groups) FindActiveById(_ context.Context, id int64) (*model.Groups, error) {
+	g := m.Row(id)
+	if g == nil || g.DeletedAt.Valid {
+		return nil, model.ErrNotFound
+	}
+	c := *g
+	return &c, nil
+}
+
+func (m *Groups) SoftDelete(_ context.Context, id int64) error {
+	if g := m.Row(id); g != nil && !g.DeletedAt.Valid {
+		g.DeletedAt = NullTime(time.Now())
+	}
+	return nil
+}
+
+// GroupMembers 内存中的用户组成员表
+type GroupMembers struct {
+	model.GroupMembersModel
+	Rows []*model.GroupMembers
+}
+
+// Members 取出用户组的成员ID
+func (m *GroupMembers) Members(groupId int64) []int64 {
+	var res []int64
+	for _, gm := range m.Rows {
+		if gm.GroupId == groupId {
+			res = append(res, gm.UserId)
+		}
+	}
+	return res
+}
+
+// groupsOf 用户所属的用户组ID
+func (m *GroupMembers) groupsOf(userId int64) []int64 {
+	var res []int64
+	if m == nil {
+		return res
+	}
+	for _, gm := range m.Rows {
+		if gm.UserId == userId {
+			res = append(res, gm.GroupId)
+		}
+	}
+	return res
+}
+
+// AddMembers 已存在的成员跳过
+func (m *GroupMembers) AddMembers(_ context.Context, groupId int64, userIds []int64, createdBy sql.NullInt64) error {
+	for _, userId := range userIds {
+		if !slices.Contains(m.Members(groupId), userId) {
+			m.Rows = append(m.Rows, &model.GroupMembers{
+				Id: int64(len(m.Rows) + 1), GroupId: groupId, UserId: userId, CreatedAt: time.Now(), CreatedBy: createdBy,
+			})
+		}
+	}
+	return nil
+}
+
+func (m *GroupMembers) RemoveMembers(_ context.Context, groupId int64, userIds []int64) error {
+	m.Rows = slices.DeleteFunc(m.Rows, func(gm *model.GroupMembers) bool {
+		return gm.GroupId == groupId && slices.Contains(userIds, gm.UserId)
+	})
+	return nil
+}
+
+func (m *GroupMembers) RemoveAllGroupMembers(_ context.Context, groupId int64) error {
+	m.Rows = slices.DeleteFunc(m.Rows, func(gm *model.GroupMembers) bool { return gm.GroupId == groupId })
+	return nil
+}
+
+func (m *GroupMembers) FindUserIdsByGroupId(_ context.Context, groupId int64) ([]int64, error) {
+	return m.Members(groupId), nil
+}
+
+// GroupRoles 内存中的用户组角色表，通过Members和Groups计算成员继承的角色
+type GroupRoles struct {
+	model.GroupRolesModel
+	Rows    []*model.GroupRoles
+	Members *GroupMembers
+	Groups  *Groups // 为空时视为所有用户组均未删除
+}
+
+// Roles 取出用户组的角色ID
+func (m *GroupRoles) Roles(groupId int64) []int64 {
+	var res []int64
+	for _, gr := range m.Rows {
+		if gr.GroupId == groupId {
+			res = append(res, gr.RoleId)
+		}
+	}
+	return res
+}
+
+func (m *GroupRoles) AssignRoles(_ context.Context, groupId int64, roleIds []int64, createdBy sql.NullInt64) error {
+	for _, roleId := range roleIds {
+		if !slices.Contains(m.Roles(groupId), roleId) {
+			m.Rows = append(m.Rows, &model.GroupRoles{
+				Id: int64(len(m.Rows) + 1), GroupId: groupId, RoleId: roleId, CreatedAt: time.Now(), CreatedBy: createdBy,
+			})
+		}
+	}
+	return nil
+}
+
+func (m *GroupRoles) RemoveRoles(_ context.Context, groupId int64, roleIds []int64) error {
+	m.Rows = slices.DeleteFunc(m.Rows, func(gr *model.GroupRoles) bool {
+		return gr.GroupId == groupId && slices.Contains(roleIds, gr.RoleId)
+	})
+	return nil
+}
+
+func (m *GroupRoles) RemoveAllGroupRoles(_ context.Context, groupId int64) error {
+	m.Rows = slices.DeleteFunc(m.Rows, func(gr *model.GroupRoles) bool { return gr.GroupId == groupId })
+	return nil
+}
+
+func (m *GroupRoles) FindRolesByGroupId(_ context.Context, groupId int64) ([]*model.GroupRoles, error) {
+	var res []*model.GroupRoles
+	for _, gr := range m.Rows {
+		if gr.GroupId == groupId {
+			c := *gr
+			res = append(res, &c)
+		}
+	}
+	return res, nil
+}
+
+// FindByUserId 按用户组顺序返回用户继承的角色，忽略已删除的用户组
+func (m *GroupRoles) FindByUserId(_ context.Context, userId int64) ([]*model.GroupRoles, error) {
+	groupIds := m.Members.groupsOf(userId)
+	slices.Sort(groupIds)
+	var res []*model.GroupRoles
+	for _, groupId := range groupIds {
+		if !m.Groups.active(groupId) {
+			continue
+		}
+		for _, gr := range m.Rows {
+			if gr.GroupId == groupId {
+				c := *gr
+				res = append(res, &c)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (m *GroupRoles) CountGroupsByRoleId(_ context.Context, roleId int64) (int64, error) {
+	var count int64
+	for _, gr := range m.Rows {
+		if gr.RoleId == roleId && m.Groups.active(gr.GroupId) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *GroupRoles) FindInheritedByUserIds(_ context.Context, userIds []int64) ([]*model.InheritedRole, error) {
+	return m.inherited(func(ir *model.InheritedRole) bool { return slices.Contains(userIds, ir.UserId) }), nil
+}
+
+func (m *GroupRoles) FindInheritedByRoleIds(_ context.Context, roleIds []int64) ([]*model.InheritedRole, error) {
+	return m.inherited(func(ir *model.InheritedRole) bool { return slices.Contains(roleIds, ir.RoleId) }), nil
+}
+
+func (m *GroupRoles) FindAllInherited(context.Context) ([]*model.InheritedRole, error) {
+	return m.inherited(func(*model.InheritedRole) bool { return true }), nil
+}
+
+// inherited 按用户、角色排序返回经未删除的用户组继承的角色，同一角色只返回一条
+func (m *GroupRoles) inherited(match func(ir *model.InheritedRole) bool) []*model.InheritedRole {
+	var res []*model.InheritedRole
+	for _, gm := range m.Members.Rows {
+		if !m.Groups.active(gm.GroupId) {
+			continue
+		}
+		for _, gr := range m.Rows {
+			ir := &model.InheritedRole{UserId: gm.UserId, RoleId: gr.RoleId}
+			if gr.GroupId != gm.GroupId || !match(ir) || slices.ContainsFunc(res, func(o *model.InheritedRole) bool { return *o == *ir }) {
+				continue
+			}
+			res = append(res, ir)
+		}
+	}
+	slices.SortFunc(res, func(a, b *model.InheritedRole) int {
+		if a.UserId != b.UserId {
+			return int(a.UserId - b.UserId)
+		}
+		return int(a.RoleId - b.RoleId)
+	})
+	return res
+}
@@ -0,0 +1,48 @@
+// Package modeltest 提供模型接口的内存实现，供各包的单元测试共用。
+//
+// 每个内存表嵌入对应的模型接口，只实现测试用到的方法，调用未实现的方法会panic。
+// 查询按SQL语义过滤Rows中的记录，测试可以直接读写Rows构造数据和断言结果。
+package modeltest
+
+import (
+	"database/sql"
+	"time"
+)
+
+// result 模拟Insert返回的sql.Result
+type result int64
+
+func (r result) LastInsertId() (int64, error) {
+	return int64(r), nil
+}
+
+func (r result) RowsAffected() (int64, error) {
+	return 1, nil
+}
+
+// NullInt64 有效的sql.NullInt64
+func NullInt64(v int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: v, Valid: true}
+}
+
+// NullString 有效的sql.NullString
+func NullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: true}
+}
+
+// NullTime 有效的sql.NullTime
+func NullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: true}
+}
+
+// page 按limit和offset截取分页结果
+func page[T any](rows []T, limit, offset int32) []T {
+	if int(offset) >= len(rows) {
+		return nil
+	}
+	rows = rows[offset:]
+	if limit >= 0 && int(limit) < len(rows) {
+		rows = rows[:limit]
+	}
+	return rows
+}
@@ -0,0 +1,58 @@
+package modeltest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// PermissionUsageUsers 内存中的用户授权使用记录表，Touch按唯一键累加
+type PermissionUsageUsers struct {
+	model.PermissionUsageUsersModel
+	mu   sync.Mutex
+	Rows []*model.PermissionUsageUsers
+}
+
+func (m *PermissionUsageUsers) Touch(_ context.Context, data *model.PermissionUsageUsers) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, u := range m.Rows {
+		if u.UserId == data.UserId && u.PermissionId == data.PermissionId {
+			if data.LastUsedAt.After(u.LastUsedAt) {
+				u.LastUsedAt = data.LastUsedAt
+			}
+			u.UseCount += data.UseCount
+			return nil
+		}
+	}
+	c := *data
+	c.Id = int64(len(m.Rows) + 1)
+	m.Rows = append(m.Rows, &c)
+	return nil
+}
+
+// PermissionUsageRoles 内存中的角色授权使用记录表，Touch按唯一键累加
+type PermissionUsageRoles struct {
+	model.PermissionUsageRolesModel
+	mu   sync.Mutex
+	Rows []*model.PermissionUsageRoles
+}
+
+func (m *PermissionUsageRoles) Touch(_ context.Context, data *model.PermissionUsageRoles) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, r := range m.Rows {
+		if r.RoleId == data.RoleId && r.PermissionId == data.PermissionId {
+			if data.LastUsedAt.After(r.LastUsedAt) {
+				r.LastUsedAt = data.LastUsedAt
+			}
+			r.UseCount += data.UseCount
+			return nil
+		}
+	}
+	c := *data
+	c.Id = int64(len(m.Rows) + 1)
+	m.Rows = append(m.Rows, &c)
+	return nil
+}
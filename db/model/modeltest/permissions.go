@@ -0,0 +1,165 @@
+package modeltest
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// Permissions 内存中的权限表
+type Permissions struct {
+	model.PermissionsModel
+	Rows []*model.Permissions
+}
+
+// Row 按ID取出表中的权限，不存在时返回nil
+func (m *Permissions) Row(id int64) *model.Permissions {
+	for _, p := range m.Rows {
+		if p.Id == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// RowByCode 按编码取出表中的权限，不区分租户，不存在时返回nil
+func (m *Permissions) RowByCode(code string) *model.Permissions {
+	for _, p := range m.Rows {
+		if p.Code == code {
+			return p
+		}
+	}
+	return nil
+}
+
+// visible 权限是否为全局权限或属于指定租户
+func visible(p *model.Permissions, tenantId int64) bool {
+	return !p.TenantId.Valid || p.TenantId.Int64 == tenantId
+}
+
+// Insert 默认租户插入全局权限，其他租户插入租户自定义权限
+func (m *Permissions) Insert(ctx context.Context, data *model.Permissions) (sql.Result, error) {
+	var id int64
+	for _, p := range m.Rows {
+		id = max(id, p.Id)
+	}
+	data.Id = id + 1
+	data.TenantId = sql.NullInt64{}
+	if tenantId := model.TenantIdFromContext(ctx); tenantId != model.DefaultTenantId {
+		data.TenantId = NullInt64(tenantId)
+	}
+	c := *data
+	m.Rows = append(m.Rows, &c)
+	return result(data.Id), nil
+}
+
+func (m *Permissions) Update(_ context.Context, data *model.Permissions) error {
+	if p := m.Row(data.Id); p != nil {
+		*p = *data
+	}
+	return nil
+}
+
+// ExistsByCode 默认租户检查所有租户的编码，其他租户只检查可见的权限
+func (m *Permissions) ExistsByCode(ctx context.Context, code string, excludeId int64) (bool, error) {
+	tenantId := model.TenantIdFromContext(ctx)
+	for _, p := range m.Rows {
+		if p.Code == code && p.Id != excludeId && (tenantId == model.DefaultTenantId || visible(p, tenantId)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *Permissions) find(match func(p *model.Permissions) bool) (*model.Permissions, error) {
+	for _, p := range m.Rows {
+		if match(p) {
+			c := *p
+			return &c, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m *Permissions) FindOne(_ context.Context, id int64) (*model.Permissions, error) {
+	return m.find(func(p *model.Permissions) bool { return p.Id == id })
+}
+
+func (m *Permissions) FindOneByCode(_ context.Context, code string) (*model.Permissions, error) {
+	return m.find(func(p *model.Permissions) bool { return p.Code == code })
+}
+
+func (m *Permissions) FindByIds(ctx context.Context, ids []int64) ([]*model.Permissions, error) {
+	tenantId := model.TenantIdFromContext(ctx)
+	var res []*model.Permissions
+	for _, p := range m.Rows {
+		if slices.Contains(ids, p.Id) && visible(p, tenantId) {
+			c := *p
+			res = append(res, &c)
+		}
+	}
+	return res, nil
+}
+
+// FindAllVisible 返回全局权限和当前租户的权限，按编码排序
+func (m *Permissions) FindAllVisible(ctx context.Context) ([]*model.Permissions, error) {
+	tenantId := model.TenantIdFromContext(ctx)
+	var res []*model.Permissions
+	for _, p := range m.Rows {
+		if visible(p, tenantId) {
+			c := *p
+			res = append(res, &c)
+		}
+	}
+	slices.SortFunc(res, func(a, b *model.Permissions) int { return strings.Compare(a.Code, b.Code) })
+	return res, nil
+}
+
+// RolePermissions 内存中的角色权限关联表，查询只返回当前生效的授权
+// 设置Roles时FindByRoleIds与数据库查询一致，不返回已禁用或已删除角色的授权
+type RolePermissions struct {
+	model.RolePermissionsModel
+	Rows  []*model.RolePermissions
+	Roles *Roles
+}
+
+func (m *RolePermissions) filter(match func(rp *model.RolePermissions) bool) []*model.RolePermissions {
+	now := time.Now()
+	var res []*model.RolePermissions
+	for _, rp := range m.Rows {
+		if match(rp) && rp.Validity().Effective(now) {
+			c := *rp
+			res = append(res, &c)
+		}
+	}
+	return res
+}
+
+func (m *RolePermissions) FindByRoleId(_ context.Context, roleId int64) ([]*model.RolePermissions, error) {
+	return m.filter(func(rp *model.RolePermissions) bool { return rp.RoleId == roleId }), nil
+}
+
+func (m *RolePermissions) FindByRoleIds(_ context.Context, roleIds []int64) ([]*model.RolePermissions, error) {
+	return m.filter(func(rp *model.RolePermissions) bool {
+		return slices.Contains(roleIds, rp.RoleId) && m.Roles.active(rp.RoleId)
+	}), nil
+}
+
+func (m *RolePermissions) FindAllByRoleIds(_ context.Context, roleIds []int64) ([]*model.RolePermissions, error) {
+	var res []*model.RolePermissions
+	for _, rp := range m.Rows {
+		if slices.Contains(roleIds, rp.RoleId) {
+			c := *rp
+			res = append(res, &c)
+		}
+	}
+	return res, nil
+}
+
+func (m *RolePermissions) FindRolesByPermissionId(_ context.Context, permissionId int64) ([]*model.RolePermissions, error) {
+	return m.filter(func(rp *model.RolePermissions) bool { return rp.PermissionId == permissionId }), nil
+}
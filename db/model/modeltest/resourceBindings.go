@@ -0,0 +1,148 @@
+package modeltest
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// ResourceBindings 内存中的资源绑定表，通过Users和Roles过滤已删除用户和失效角色的绑定
+type ResourceBindings struct {
+	model.ResourceBindingsModel
+	Rows  []*model.ResourceBindings
+	Users *Users // 为空时视为所有用户均未删除
+	Roles *Roles // 为空时视为所有角色均有效
+}
+
+// Row 按ID取出表中的绑定，不存在时返回nil
+func (m *ResourceBindings) Row(id int64) *model.ResourceBindings {
+	for _, b := range m.Rows {
+		if b.Id == id {
+			return b
+		}
+	}
+	return nil
+}
+
+func (m *ResourceBindings) filter(match func(b *model.ResourceBindings) bool) []*model.ResourceBindings {
+	var res []*model.ResourceBindings
+	for _, b := range m.Rows {
+		if match(b) {
+			c := *b
+			res = append(res, &c)
+		}
+	}
+	return res
+}
+
+func (m *ResourceBindings) Insert(_ context.Context, data *model.ResourceBindings) (sql.Result, error) {
+	var id int64
+	for _, b := range m.Rows {
+		id = max(id, b.Id)
+	}
+	data.Id = id + 1
+	data.CreatedAt = time.Now()
+	c := *data
+	m.Rows = append(m.Rows, &c)
+	return result(data.Id), nil
+}
+
+func (m *ResourceBindings) FindOne(_ context.Context, id int64) (*model.ResourceBindings, error) {
+	res := m.filter(func(b *model.ResourceBindings) bool { return b.Id == id })
+	if len(res) == 0 {
+		return nil, model.ErrNotFound
+	}
+	return res[0], nil
+}
+
+func (m *ResourceBindings) FindOneByUserIdRoleIdResourceTypeResourceId(_ context.Context, userId int64, roleId int64, resourceType string, resourceId string) (*model.ResourceBindings, error) {
+	res := m.filter(func(b *model.ResourceBindings) bool {
+		return b.UserId == userId && b.RoleId == roleId && b.ResourceType == resourceType && b.ResourceId == resourceId
+	})
+	if len(res) == 0 {
+		return nil, model.ErrNotFound
+	}
+	return res[0], nil
+}
+
+func (m *ResourceBindings) Delete(_ context.Context, id int64) error {
+	m.Rows = slices.DeleteFunc(m.Rows, func(b *model.ResourceBindings) bool { return b.Id == id })
+	return nil
+}
+
+func (m *ResourceBindings) FindByUserResource(_ context.Context, userId int64, resourceType, resourceId string) ([]*model.ResourceBindings, error) {
+	return m.filter(func(b *model.ResourceBindings) bool {
+		return b.UserId == userId && b.ResourceType == resourceType && matchResource(b, resourceId)
+	}), nil
+}
+
+func (m *ResourceBindings) CountByRoleId(_ context.Context, roleId int64) (int64, error) {
+	return int64(len(m.filter(func(b *model.ResourceBindings) bool { return b.RoleId == roleId }))), nil
+}
+
+// FindByResource 按用户、角色、ID排序
+func (m *ResourceBindings) FindByResource(_ context.Context, resourceType, resourceId string, roleIds []int64, limit, offset int32) ([]*model.ResourceBindings, error) {
+	res := m.byResource(resourceType, resourceId, roleIds)
+	slices.SortFunc(res, func(a, b *model.ResourceBindings) int {
+		if a.UserId != b.UserId {
+			return int(a.UserId - b.UserId)
+		}
+		if a.RoleId != b.RoleId {
+			return int(a.RoleId - b.RoleId)
+		}
+		return int(a.Id - b.Id)
+	})
+	return page(res, limit, offset), nil
+}
+
+func (m *ResourceBindings) CountByResource(_ context.Context, resourceType, resourceId string, roleIds []int64) (int64, error) {
+	return int64(len(m.byResource(resourceType, resourceId, roleIds))), nil
+}
+
+// FindByUserId 按资源类型、资源ID、角色排序
+func (m *ResourceBindings) FindByUserId(_ context.Context, userId int64, resourceType string, roleIds []int64, limit, offset int32) ([]*model.ResourceBindings, error) {
+	res := m.byUser(userId, resourceType, roleIds)
+	slices.SortFunc(res, func(a, b *model.ResourceBindings) int {
+		if c := strings.Compare(a.ResourceType, b.ResourceType); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.ResourceId, b.ResourceId); c != 0 {
+			return c
+		}
+		return int(a.RoleId - b.RoleId)
+	})
+	return page(res, limit, offset), nil
+}
+
+func (m *ResourceBindings) CountByUserId(_ context.Context, userId int64, resourceType string, roleIds []int64) (int64, error) {
+	return int64(len(m.byUser(userId, resourceType, roleIds))), nil
+}
+
+func (m *ResourceBindings) byResource(resourceType, resourceId string, roleIds []int64) []*model.ResourceBindings {
+	return m.filter(func(b *model.ResourceBindings) bool {
+		return b.ResourceType == resourceType && matchResource(b, resourceId) && m.Users.active(b.UserId) && m.matchRole(b, roleIds)
+	})
+}
+
+func (m *ResourceBindings) byUser(userId int64, resourceType string, roleIds []int64) []*model.ResourceBindings {
+	return m.filter(func(b *model.ResourceBindings) bool {
+		return b.UserId == userId && (resourceType == "" || b.ResourceType == resourceType) && m.matchRole(b, roleIds)
+	})
+}
+
+// matchRole 只保留有效角色的绑定，roleIds非nil时按角色过滤，空列表不匹配任何绑定
+func (m *ResourceBindings) matchRole(b *model.ResourceBindings, roleIds []int64) bool {
+	if !m.Roles.active(b.RoleId) {
+		return false
+	}
+	return roleIds == nil || slices.Contains(roleIds, b.RoleId)
+}
+
+// matchResource 绑定是否作用于指定资源，包含该资源类型的通配绑定
+func matchResource(b *model.ResourceBindings, resourceId string) bool {
+	return b.ResourceId == resourceId || b.ResourceId == model.ResourceWildcard
+}
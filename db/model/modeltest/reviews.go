@@ -0,0 +1,141 @@
+package modeltest
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// ReviewCampaigns 内存中的访问复核活动表
+type ReviewCampaigns struct {
+	model.ReviewCampaignsModel
+	Rows []*model.ReviewCampaigns
+}
+
+// Row 按ID取出表中的活动，不存在时返回nil
+func (m *ReviewCampaigns) Row(id int64) *model.ReviewCampaigns {
+	for _, c := range m.Rows {
+		if c.Id == id {
+			return c
+		}
+	}
+	return nil
+}
+
+func (m *ReviewCampaigns) FindOne(_ context.Context, id int64) (*model.ReviewCampaigns, error) {
+	c := m.Row(id)
+	if c == nil {
+		return nil, model.ErrNotFound
+	}
+	res := *c
+	return &res, nil
+}
+
+// Close 只关闭进行中的活动
+func (m *ReviewCampaigns) Close(_ context.Context, data *model.ReviewCampaigns) (bool, error) {
+	c := m.Row(data.Id)
+	if c == nil || c.Status != model.ReviewCampaignOpen {
+		return false, nil
+	}
+	c.Status, c.ClosedAt, c.ClosedBy = model.ReviewCampaignClosed, data.ClosedAt, data.ClosedBy
+	return true, nil
+}
+
+// ReviewItems 内存中的访问复核条目表，Campaigns用于判断活动是否进行中
+type ReviewItems struct {
+	model.ReviewItemsModel
+	Rows      []*model.ReviewItems
+	Campaigns *ReviewCampaigns
+}
+
+// Row 按ID取出表中的条目，不存在时返回nil
+func (m *ReviewItems) Row(id int64) *model.ReviewItems {
+	for _, item := range m.Rows {
+		if item.Id == id {
+			return item
+		}
+	}
+	return nil
+}
+
+func (m *ReviewItems) FindOne(_ context.Context, id int64) (*model.ReviewItems, error) {
+	item := m.Row(id)
+	if item == nil {
+		return nil, model.ErrNotFound
+	}
+	c := *item
+	return &c, nil
+}
+
+func (m *ReviewItems) FindByCampaignId(_ context.Context, campaignId int64) ([]*model.ReviewItems, error) {
+	var res []*model.ReviewItems
+	for _, item := range m.Rows {
+		if item.CampaignId == campaignId {
+			c := *item
+			res = append(res, &c)
+		}
+	}
+	slices.SortFunc(res, func(a, b *model.ReviewItems) int { return int(a.Id - b.Id) })
+	return res, nil
+}
+
+func (m *ReviewItems) CountByDecision(_ context.Context, campaignId int64) ([]*model.ReviewDecisionCount, error) {
+	var res []*model.ReviewDecisionCount
+	for _, item := range m.Rows {
+		if item.CampaignId != campaignId {
+			continue
+		}
+		i := slices.IndexFunc(res, func(c *model.ReviewDecisionCount) bool { return c.Decision == item.Decision })
+		if i < 0 {
+			res = append(res, &model.ReviewDecisionCount{Decision: item.Decision})
+			i = len(res) - 1
+		}
+		res[i].Count++
+	}
+	return res, nil
+}
+
+// Decide 活动进行中且条目尚未处理时才更新
+func (m *ReviewItems) Decide(_ context.Context, data *model.ReviewItems) (bool, error) {
+	item := m.Row(data.Id)
+	if item == nil || item.Outcome != "" {
+		return false, nil
+	}
+	if c := m.Campaigns.Row(item.CampaignId); c == nil || c.Status != model.ReviewCampaignOpen {
+		return false, nil
+	}
+	item.Decision, item.Comment, item.DecidedBy, item.DecidedAt = data.Decision, data.Comment, data.DecidedBy, data.DecidedAt
+	return true, nil
+}
+
+// SetOutcome 已记录的结果不会被覆盖
+func (m *ReviewItems) SetOutcome(_ context.Context, data *model.ReviewItems) error {
+	if item := m.Row(data.Id); item != nil && item.Outcome == "" {
+		item.Outcome, item.OutcomeDetail = data.Outcome, data.OutcomeDetail
+	}
+	return nil
+}
+
+// ReviewItemReviewers 内存中的复核条目复核人表
+type ReviewItemReviewers struct {
+	model.ReviewItemReviewersModel
+	Rows []*model.ReviewItemReviewers
+}
+
+func (m *ReviewItemReviewers) FindByItemIds(_ context.Context, itemIds []int64) ([]*model.ReviewItemReviewers, error) {
+	var res []*model.ReviewItemReviewers
+	for _, r := range m.Rows {
+		if slices.Contains(itemIds, r.ItemId) {
+			c := *r
+			res = append(res, &c)
+		}
+	}
+	return res, nil
+}
+
+func (m *ReviewItemReviewers) IsReviewer(_ context.Context, itemId, reviewerId int64) (bool, error) {
+	return slices.ContainsFunc(m.Rows, func(r *model.ReviewItemReviewers) bool {
+		return r.ItemId == itemId && r.ReviewerId == reviewerId
+	}), nil
+}
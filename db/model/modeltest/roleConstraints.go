@@ -0,0 +1,69 @@
+package modeltest
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// RoleConstraints 内存中的职责分离约束表
+type RoleConstraints struct {
+	model.RoleConstraintsModel
+	Rows  []*model.RoleConstraints
+	Locks int // Locked的调用次数
+}
+
+func (m *RoleConstraints) FindOne(_ context.Context, id int64) (*model.RoleConstraints, error) {
+	for _, c := range m.Rows {
+		if c.Id == id {
+			r := *c
+			return &r, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+// FindAll 按编码排序
+func (m *RoleConstraints) FindAll(context.Context) ([]*model.RoleConstraints, error) {
+	res := make([]*model.RoleConstraints, 0, len(m.Rows))
+	for _, c := range m.Rows {
+		r := *c
+		res = append(res, &r)
+	}
+	slices.SortFunc(res, func(a, b *model.RoleConstraints) int { return strings.Compare(a.Code, b.Code) })
+	return res, nil
+}
+
+// Locked 直接执行fn，记录执行次数
+func (m *RoleConstraints) Locked(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.Locks++
+	return fn(ctx)
+}
+
+// RoleConstraintRoles 内存中的约束角色集合表
+type RoleConstraintRoles struct {
+	model.RoleConstraintRolesModel
+	Rows []*model.RoleConstraintRoles
+}
+
+func (m *RoleConstraintRoles) FindAll(context.Context) ([]*model.RoleConstraintRoles, error) {
+	res := make([]*model.RoleConstraintRoles, 0, len(m.Rows))
+	for _, cr := range m.Rows {
+		r := *cr
+		res = append(res, &r)
+	}
+	return res, nil
+}
+
+func (m *RoleConstraintRoles) FindByConstraintId(_ context.Context, constraintId int64) ([]*model.RoleConstraintRoles, error) {
+	var res []*model.RoleConstraintRoles
+	for _, cr := range m.Rows {
+		if cr.ConstraintId == constraintId {
+			r := *cr
+			res = append(res, &r)
+		}
+	}
+	return res, nil
+}
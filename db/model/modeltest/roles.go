@@ -0,0 +1,81 @@
+package modeltest
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// Roles 内存中的角色表，查询返回副本以模拟数据库
+type Roles struct {
+	model.RolesModel
+	Rows []*model.Roles
+}
+
+// Row 按ID取出表中的角色，包括已删除的角色，不存在时返回nil
+func (m *Roles) Row(id int64) *model.Roles {
+	for _, r := range m.Rows {
+		if r.Id == id {
+			return r
+		}
+	}
+	return nil
+}
+
+func (m *Roles) find(match func(r *model.Roles) bool) (*model.Roles, error) {
+	for _, r := range m.Rows {
+		if match(r) {
+			c := *r
+			return &c, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m *Roles) FindOne(_ context.Context, id int64) (*model.Roles, error) {
+	return m.find(func(r *model.Roles) bool { return r.Id == id })
+}
+
+func (m *Roles) FindById(_ context.Context, id int64) (*model.Roles, error) {
+	return m.find(func(r *model.Roles) bool { return r.Id == id })
+}
+
+func (m *Roles) FindActiveById(_ context.Context, id int64) (*model.Roles, error) {
+	return m.find(func(r *model.Roles) bool { return r.Id == id && !r.DeletedAt.Valid && !r.DisabledAt.Valid })
+}
+
+func (m *Roles) FindActiveByCode(_ context.Context, code string) (*model.Roles, error) {
+	return m.find(func(r *model.Roles) bool { return r.Code == code && !r.DeletedAt.Valid && !r.DisabledAt.Valid })
+}
+
+func (m *Roles) Disable(_ context.Context, id int64) error {
+	if r := m.Row(id); r != nil && !r.DisabledAt.Valid {
+		r.DisabledAt = NullTime(time.Now())
+	}
+	return nil
+}
+
+func (m *Roles) Enable(_ context.Context, id int64) error {
+	if r := m.Row(id); r != nil {
+		r.DisabledAt = sql.NullTime{}
+	}
+	return nil
+}
+
+func (m *Roles) Touch(_ context.Context, id int64) error {
+	if r := m.Row(id); r != nil {
+		r.UpdatedAt = time.Now()
+	}
+	return nil
+}
+
+// active 角色是否未删除且未禁用，未在表中登记的角色视为有效
+func (m *Roles) active(id int64) bool {
+	if m == nil {
+		return true
+	}
+	r := m.Row(id)
+	return r == nil || (!r.DeletedAt.Valid && !r.DisabledAt.Valid)
+}
@@ -0,0 +1,109 @@
+package modeltest
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// Sessions 内存中的会话表
+type Sessions struct {
+	model.SessionsModel
+	Rows []*model.Sessions
+}
+
+// Row 按ID取出表中的会话，不存在时返回nil
+func (m *Sessions) Row(id int64) *model.Sessions {
+	for _, s := range m.Rows {
+		if s.Id == id {
+			return s
+		}
+	}
+	return nil
+}
+
+func (m *Sessions) Insert(_ context.Context, data *model.Sessions) (sql.Result, error) {
+	var id int64
+	for _, s := range m.Rows {
+		id = max(id, s.Id)
+	}
+	data.Id = id + 1
+	now := time.Now()
+	data.CreatedAt, data.LastSeenAt = now, now
+	c := *data
+	m.Rows = append(m.Rows, &c)
+	return result(data.Id), nil
+}
+
+func (m *Sessions) FindOne(_ context.Context, id int64) (*model.Sessions, error) {
+	s := m.Row(id)
+	if s == nil {
+		return nil, model.ErrNotFound
+	}
+	c := *s
+	return &c, nil
+}
+
+func (m *Sessions) FindActiveById(_ context.Context, id int64) (*model.Sessions, error) {
+	s := m.Row(id)
+	if s == nil || s.RevokedAt.Valid {
+		return nil, model.ErrNotFound
+	}
+	c := *s
+	return &c, nil
+}
+
+// FindByUserId 按创建时间倒序分页
+func (m *Sessions) FindByUserId(_ context.Context, userId int64, includeRevoked bool, limit, offset int32) ([]*model.Sessions, error) {
+	var res []*model.Sessions
+	for _, s := range m.Rows {
+		if s.UserId == userId && (includeRevoked || !s.RevokedAt.Valid) {
+			c := *s
+			res = append(res, &c)
+		}
+	}
+	slices.SortStableFunc(res, func(a, b *model.Sessions) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return int(b.Id - a.Id)
+	})
+	return page(res, limit, offset), nil
+}
+
+func (m *Sessions) CountByUserId(ctx context.Context, userId int64, includeRevoked bool) (int64, error) {
+	res, _ := m.FindByUserId(ctx, userId, includeRevoked, -1, 0)
+	return int64(len(res)), nil
+}
+
+func (m *Sessions) Touch(_ context.Context, id int64) (bool, error) {
+	s := m.Row(id)
+	if s == nil || s.RevokedAt.Valid {
+		return false, nil
+	}
+	s.LastSeenAt = time.Now()
+	return true, nil
+}
+
+func (m *Sessions) Revoke(_ context.Context, id int64, reason string) (bool, error) {
+	s := m.Row(id)
+	if s == nil || s.RevokedAt.Valid {
+		return false, nil
+	}
+	s.RevokedAt, s.RevokeReason = NullTime(time.Now()), NullString(reason)
+	return true, nil
+}
+
+func (m *Sessions) RevokeAllByUserId(_ context.Context, userId int64, reason string) (int64, error) {
+	var revoked int64
+	for _, s := range m.Rows {
+		if s.UserId == userId && !s.RevokedAt.Valid {
+			s.RevokedAt, s.RevokeReason = NullTime(time.Now()), NullString(reason)
+			revoked++
+		}
+	}
+	return revoked, nil
+}
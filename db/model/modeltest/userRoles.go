@@ -0,0 +1,194 @@
+package modeltest
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// UserRoles 内存中的用户角色关联表，查询只返回当前生效的关联
+type UserRoles struct {
+	model.UserRolesModel
+	Rows []*model.UserRoles
+}
+
+// Roles 取出用户直接分配的角色ID
+func (m *UserRoles) Roles(userId int64) []int64 {
+	var res []int64
+	for _, ur := range m.Rows {
+		if ur.UserId == userId {
+			res = append(res, ur.RoleId)
+		}
+	}
+	return res
+}
+
+func (m *UserRoles) filter(match func(ur *model.UserRoles) bool) []*model.UserRoles {
+	var res []*model.UserRoles
+	for _, ur := range m.Rows {
+		if match(ur) {
+			c := *ur
+			res = append(res, &c)
+		}
+	}
+	return res
+}
+
+func (m *UserRoles) FindRolesByUserId(_ context.Context, userId int64) ([]*model.UserRoles, error) {
+	now := time.Now()
+	return m.filter(func(ur *model.UserRoles) bool { return ur.UserId == userId && ur.Validity().Effective(now) }), nil
+}
+
+func (m *UserRoles) FindUsersByRoleId(_ context.Context, roleId int64) ([]*model.UserRoles, error) {
+	now := time.Now()
+	return m.filter(func(ur *model.UserRoles) bool { return ur.RoleId == roleId && ur.Validity().Effective(now) }), nil
+}
+
+func (m *UserRoles) FindRolesByUserIds(_ context.Context, userIds []int64) ([]*model.UserRoles, error) {
+	now := time.Now()
+	return m.filter(func(ur *model.UserRoles) bool {
+		return slices.Contains(userIds, ur.UserId) && ur.Validity().Effective(now)
+	}), nil
+}
+
+func (m *UserRoles) FindUsersByRoleIds(_ context.Context, roleIds []int64) ([]*model.UserRoles, error) {
+	now := time.Now()
+	return m.filter(func(ur *model.UserRoles) bool {
+		return slices.Contains(roleIds, ur.RoleId) && ur.Validity().Effective(now)
+	}), nil
+}
+
+func (m *UserRoles) FindAllByUserId(_ context.Context, userId int64) ([]*model.UserRoles, error) {
+	return m.filter(func(ur *model.UserRoles) bool { return ur.UserId == userId }), nil
+}
+
+func (m *UserRoles) FindOneByUserIdRoleId(_ context.Context, userId int64, roleId int64) (*model.UserRoles, error) {
+	res := m.filter(func(ur *model.UserRoles) bool { return ur.UserId == userId && ur.RoleId == roleId })
+	if len(res) == 0 {
+		return nil, model.ErrNotFound
+	}
+	return res[0], nil
+}
+
+func (m *UserRoles) HasRole(ctx context.Context, userId, roleId int64) (bool, error) {
+	ur, err := m.FindOneByUserIdRoleId(ctx, userId, roleId)
+	if err != nil {
+		return false, nil
+	}
+	return ur.Validity().Effective(time.Now()), nil
+}
+
+func (m *UserRoles) CountUsersByRoleId(ctx context.Context, roleId int64) (int64, error) {
+	res, _ := m.FindUsersByRoleId(ctx, roleId)
+	return int64(len(res)), nil
+}
+
+func (m *UserRoles) AssignRole(ctx context.Context, userId, roleId int64, createdBy sql.NullInt64, validity model.Validity) error {
+	return m.AssignRoles(ctx, userId, []int64{roleId}, createdBy, validity)
+}
+
+// AssignRoles 已存在的关联合并有效期
+func (m *UserRoles) AssignRoles(_ context.Context, userId int64, roleIds []int64, createdBy sql.NullInt64, validity model.Validity) error {
+	for _, roleId := range roleIds {
+		merged := validity
+		ur := m.row(userId, roleId)
+		if ur == nil {
+			ur = m.insert(userId, roleId, createdBy)
+		} else {
+			merged = ur.Validity().Widen(validity, time.Now())
+		}
+		ur.ValidFrom, ur.ValidUntil = merged.From, merged.Until
+	}
+	return nil
+}
+
+func (m *UserRoles) UpdateValidity(_ context.Context, data *model.UserRoles, validity model.Validity) error {
+	data.ValidFrom, data.ValidUntil = validity.From, validity.Until
+	if ur := m.row(data.UserId, data.RoleId); ur != nil {
+		ur.ValidFrom, ur.ValidUntil = validity.From, validity.Until
+	}
+	return nil
+}
+
+// FindExpired 按失效时间返回已过期的关联
+func (m *UserRoles) FindExpired(_ context.Context, limit int) ([]*model.UserRoles, error) {
+	now := time.Now()
+	res := m.filter(func(ur *model.UserRoles) bool { return ur.Validity().Expired(now) })
+	slices.SortFunc(res, func(a, b *model.UserRoles) int { return a.ValidUntil.Time.Compare(b.ValidUntil.Time) })
+	return page(res, int32(limit), 0), nil
+}
+
+func (m *UserRoles) DeleteExpired(_ context.Context, data *model.UserRoles) (bool, error) {
+	now := time.Now()
+	n := len(m.Rows)
+	m.Rows = slices.DeleteFunc(m.Rows, func(ur *model.UserRoles) bool { return ur.Id == data.Id && ur.Validity().Expired(now) })
+	return len(m.Rows) < n, nil
+}
+
+func (m *UserRoles) RemoveRole(ctx context.Context, userId, roleId int64) error {
+	return m.RemoveRoles(ctx, userId, []int64{roleId})
+}
+
+func (m *UserRoles) RemoveRoles(_ context.Context, userId int64, roleIds []int64) error {
+	m.Rows = slices.DeleteFunc(m.Rows, func(ur *model.UserRoles) bool {
+		return ur.UserId == userId && slices.Contains(roleIds, ur.RoleId)
+	})
+	return nil
+}
+
+func (m *UserRoles) RemoveAllUserRoles(_ context.Context, userId int64) error {
+	m.Rows = slices.DeleteFunc(m.Rows, func(ur *model.UserRoles) bool { return ur.UserId == userId })
+	return nil
+}
+
+func (m *UserRoles) ReplaceUserRoles(ctx context.Context, userId int64, roleIds []int64, createdBy sql.NullInt64) error {
+	_ = m.RemoveAllUserRoles(ctx, userId)
+	return m.AssignRoles(ctx, userId, roleIds, createdBy, model.Validity{})
+}
+
+func (m *UserRoles) FindUnexpiredByUserIds(_ context.Context, userIds []int64) ([]*model.UserRoles, error) {
+	return m.unexpired(func(ur *model.UserRoles) bool { return slices.Contains(userIds, ur.UserId) }), nil
+}
+
+func (m *UserRoles) FindUnexpiredByRoleIds(_ context.Context, roleIds []int64) ([]*model.UserRoles, error) {
+	return m.unexpired(func(ur *model.UserRoles) bool { return slices.Contains(roleIds, ur.RoleId) }), nil
+}
+
+func (m *UserRoles) FindAllUnexpired(context.Context) ([]*model.UserRoles, error) {
+	return m.unexpired(func(*model.UserRoles) bool { return true }), nil
+}
+
+// unexpired 按用户、角色排序返回未过期的关联，包括尚未生效的关联
+func (m *UserRoles) unexpired(match func(ur *model.UserRoles) bool) []*model.UserRoles {
+	now := time.Now()
+	res := m.filter(func(ur *model.UserRoles) bool { return match(ur) && !ur.Validity().Expired(now) })
+	slices.SortFunc(res, func(a, b *model.UserRoles) int {
+		if a.UserId != b.UserId {
+			return int(a.UserId - b.UserId)
+		}
+		return int(a.RoleId - b.RoleId)
+	})
+	return res
+}
+
+func (m *UserRoles) row(userId, roleId int64) *model.UserRoles {
+	for _, ur := range m.Rows {
+		if ur.UserId == userId && ur.RoleId == roleId {
+			return ur
+		}
+	}
+	return nil
+}
+
+func (m *UserRoles) insert(userId, roleId int64, createdBy sql.NullInt64) *model.UserRoles {
+	var id int64
+	for _, ur := range m.Rows {
+		id = max(id, ur.Id)
+	}
+	ur := &model.UserRoles{Id: id + 1, UserId: userId, RoleId: roleId, CreatedAt: time.Now(), CreatedBy: createdBy}
+	m.Rows = append(m.Rows, ur)
+	return ur
+}
@@ -0,0 +1,209 @@
+package modeltest
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// Users 内存中的用户表，查询返回副本以模拟数据库
+type Users struct {
+	model.UsersModel
+	Rows    []*model.Users
+	Lookups int // FindActiveById的调用次数
+}
+
+// Row 按ID取出表中的用户，包括已删除的用户，不存在时返回nil
+func (m *Users) Row(id int64) *model.Users {
+	for _, u := range m.Rows {
+		if u.Id == id {
+			return u
+		}
+	}
+	return nil
+}
+
+// RowByUsername 按用户名取出表中的用户，不存在时返回nil
+func (m *Users) RowByUsername(username string) *model.Users {
+	for _, u := range m.Rows {
+		if u.Username == username && !u.DeletedAt.Valid {
+			return u
+		}
+	}
+	return nil
+}
+
+func (m *Users) find(match func(u *model.Users) bool) (*model.Users, error) {
+	for _, u := range m.Rows {
+		if match(u) {
+			c := *u
+			return &c, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m *Users) Insert(_ context.Context, data *model.Users) (sql.Result, error) {
+	var id int64
+	for _, u := range m.Rows {
+		id = max(id, u.Id)
+	}
+	data.Id = id + 1
+	if data.CreatedAt.IsZero() {
+		data.CreatedAt = time.Now()
+	}
+	c := *data
+	m.Rows = append(m.Rows, &c)
+	return result(data.Id), nil
+}
+
+func (m *Users) FindOne(_ context.Context, id int64) (*model.Users, error) {
+	return m.find(func(u *model.Users) bool { return u.Id == id })
+}
+
+func (m *Users) FindById(_ context.Context, id int64) (*model.Users, error) {
+	return m.find(func(u *model.Users) bool { return u.Id == id && !u.DeletedAt.Valid })
+}
+
+func (m *Users) FindActiveById(_ context.Context, id int64) (*model.Users, error) {
+	m.Lookups++
+	return m.find(func(u *model.Users) bool { return u.Id == id && !u.DeletedAt.Valid && !u.DisabledAt.Valid })
+}
+
+func (m *Users) FindByUsername(_ context.Context, username string) (*model.Users, error) {
+	return m.find(func(u *model.Users) bool { return u.Username == username && !u.DeletedAt.Valid })
+}
+
+func (m *Users) FindByExternalId(_ context.Context, source, externalId string) (*model.Users, error) {
+	return m.find(func(u *model.Users) bool {
+		return u.Source == source && u.ExternalId.String == externalId && !u.DeletedAt.Valid
+	})
+}
+
+func (m *Users) FindBySource(_ context.Context, source string) ([]*model.Users, error) {
+	var res []*model.Users
+	for _, u := range m.Rows {
+		if u.Source == source && !u.DeletedAt.Valid {
+			c := *u
+			res = append(res, &c)
+		}
+	}
+	return res, nil
+}
+
+func (m *Users) ExistsByUsername(_ context.Context, username string, excludeId int64) (bool, error) {
+	u := m.RowByUsername(username)
+	return u != nil && u.Id != excludeId, nil
+}
+
+func (m *Users) ExistsByEmail(_ context.Context, email string, excludeId int64) (bool, error) {
+	for _, u := range m.Rows {
+		if u.Email.String == email && u.Id != excludeId && !u.DeletedAt.Valid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *Users) ExistsByPhone(_ context.Context, phone string, excludeId int64) (bool, error) {
+	for _, u := range m.Rows {
+		if u.Phone.String == phone && u.Id != excludeId && !u.DeletedAt.Valid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *Users) Update(_ context.Context, data *model.Users) error {
+	u := m.Row(data.Id)
+	if u == nil {
+		return model.ErrNotFound
+	}
+	*u = *data
+	return nil
+}
+
+func (m *Users) Delete(_ context.Context, id int64) error {
+	if u := m.Row(id); u != nil {
+		u.DeletedAt = NullTime(time.Now())
+	}
+	return nil
+}
+
+func (m *Users) Disable(_ context.Context, id int64) error {
+	if u := m.Row(id); u != nil {
+		if !u.DisabledAt.Valid {
+			u.DisabledAt = NullTime(time.Now())
+		}
+		u.DisabledReason = sql.NullString{}
+	}
+	return nil
+}
+
+func (m *Users) DisableWithReason(_ context.Context, id int64, reason string) error {
+	if u := m.Row(id); u != nil && !u.DisabledAt.Valid {
+		u.DisabledAt = NullTime(time.Now())
+		u.DisabledReason = NullString(reason)
+	}
+	return nil
+}
+
+func (m *Users) Enable(_ context.Context, id int64) error {
+	if u := m.Row(id); u != nil {
+		u.DisabledAt = sql.NullTime{}
+		u.DisabledReason = sql.NullString{}
+	}
+	return nil
+}
+
+func (m *Users) MarkEmailVerified(_ context.Context, id int64, email string) (bool, error) {
+	u := m.Row(id)
+	if u == nil || u.DeletedAt.Valid || u.Email.String != email {
+		return false, nil
+	}
+	u.EmailVerifiedAt = NullTime(time.Now())
+	return true, nil
+}
+
+func (m *Users) MarkPhoneVerified(_ context.Context, id int64, phone string) (bool, error) {
+	u := m.Row(id)
+	if u == nil || u.DeletedAt.Valid || u.Phone.String != phone {
+		return false, nil
+	}
+	u.PhoneVerifiedAt = NullTime(time.Now())
+	return true, nil
+}
+
+// RecordLoginFailure 达到上限时锁定账号并清零计数
+func (m *Users) RecordLoginFailure(_ context.Context, id int64, maxAttempts, lockSeconds int64) (*model.Users, error) {
+	u := m.Row(id)
+	if u == nil || u.DeletedAt.Valid {
+		return nil, model.ErrNotFound
+	}
+	u.FailedLoginAttempts++
+	if maxAttempts > 0 && u.FailedLoginAttempts >= maxAttempts {
+		u.FailedLoginAttempts = 0
+		u.LockedUntil = NullTime(time.Now().Add(time.Duration(lockSeconds) * time.Second))
+	}
+	c := *u
+	return &c, nil
+}
+
+func (m *Users) ResetLoginFailures(_ context.Context, id int64) error {
+	if u := m.Row(id); u != nil {
+		u.FailedLoginAttempts = 0
+		u.LockedUntil = sql.NullTime{}
+	}
+	return nil
+}
+
+// active 用户是否未删除，未在表中登记的用户视为未删除
+func (m *Users) active(id int64) bool {
+	if m == nil {
+		return true
+	}
+	u := m.Row(id)
+	return u == nil || !u.DeletedAt.Valid
+}
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PermissionUsageRolesModel = (*customPermissionUsageRolesModel)(nil)
+
+type (
+	// PermissionUsageRolesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPermissionUsageRolesModel.
+	PermissionUsageRolesModel interface {
+		permissionUsageRolesModel
+		Touch(ctx context.Context, data *PermissionUsageRoles) error                                                      // 累加使用记录，不存在时创建
+		FindByRoleId(ctx context.Context, roleId int64) ([]*PermissionUsageRoles, error)                                  // 查询角色的全部使用记录
+		FindUnusedGrants(ctx context.Context, roleId int64, since time.Time, limit, offset int32) ([]*UnusedGrant, error) // 分页查询since之后未使用的允许授权，roleId为0时不过滤
+		CountUnusedGrants(ctx context.Context, roleId int64, since time.Time) (int64, error)                              // 统计since之后未使用的允许授权数量
+		FindUnusedRoles(ctx context.Context, since time.Time, limit, offset int32) ([]*UnusedRole, error)                 // 分页查询since之后没有任何授权被使用的角色
+		CountUnusedRoles(ctx context.Context, since time.Time) (int64, error)                                             // 统计since之后没有任何授权被使用的角色数量
+	}
+
+	customPermissionUsageRolesModel struct {
+		*defaultPermissionUsageRolesModel
+	}
+
+	// UnusedGrant 未使用的角色授权
+	UnusedGrant struct {
+		RoleId       int64        `db:"role_id"`       // 角色ID
+		PermissionId int64        `db:"permission_id"` // 权限ID
+		GrantedAt    time.Time    `db:"granted_at"`    // 授权时间
+		LastUsedAt   sql.NullTime `db:"last_used_at"`  // 最近使用时间，为空表示从未使用
+		UseCount     int64        `db:"use_count"`     // 累计使用次数
+	}
+
+	// UnusedRole 未使用的角色
+	UnusedRole struct {
+		RoleId     int64        `db:"role_id"`      // 角色ID
+		Code       string       `db:"code"`         // 角色编码
+		Name       string       `db:"name"`         // 角色名称
+		CreatedAt  time.Time    `db:"created_at"`   // 角色创建时间
+		LastUsedAt sql.NullTime `db:"last_used_at"` // 角色任一授权的最近使用时间，为空表示从未使用
+	}
+)
+
+// NewPermissionUsageRolesModel returns a model for the database table.
+func NewPermissionUsageRolesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) PermissionUsageRolesModel {
+	return &customPermissionUsageRolesModel{
+		defaultPermissionUsageRolesModel: newPermissionUsageRolesModel(conn, c, opts...),
+	}
+}
+
+// Touch 按data累加角色授权的使用记录：最近使用时间取较晚者，使用次数相加
+// 使用记录由后台批量写入，租户取data.TenantId而不是调用上下文
+func (m *customPermissionUsageRolesModel) Touch(ctx context.Context, data *PermissionUsageRoles) error {
+	iamPermissionUsageRolesRoleIdPermissionIdKey := fmt.Sprintf("%s%v:%v", cacheIamPermissionUsageRolesRoleIdPermissionIdPrefix, data.RoleId, data.PermissionId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf(`insert into %s as t (tenant_id, role_id, permission_id, last_used_at, use_count) values ($1, $2, $3, $4, $5)
+			on conflict (role_id, permission_id) do update set
+				last_used_at = greatest(t.last_used_at, excluded.last_used_at),
+				use_count = t.use_count + excluded.use_count`, m.table)
+		return conn.ExecCtx(ctx, query, data.TenantId, data.RoleId, data.PermissionId, data.LastUsedAt, data.UseCount)
+	}, iamPermissionUsageRolesRoleIdPermissionIdKey)
+	return err
+}
+
+// FindByRoleId 查询角色的全部使用记录
+func (m *customPermissionUsageRolesModel) FindByRoleId(ctx context.Context, roleId int64) ([]*PermissionUsageRoles, error) {
+	query := fmt.Sprintf("select %s from %s where role_id = $1 and tenant_id = $2", permissionUsageRolesRows, m.table)
+	var resp []*PermissionUsageRoles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, roleId, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// unusedGrantsFrom 构建未使用授权的查询来源：未删除角色当前生效的允许授权，授权早于since且since之后未使用
+func (m *customPermissionUsageRolesModel) unusedGrantsFrom(ctx context.Context, roleId int64, since time.Time) (string, []any) {
+	from := fmt.Sprintf(`from "iam"."role_permissions" rp
+		join "iam"."roles" r on r.id = rp.role_id and r.deleted_at IS NULL
+		left join %s u on u.role_id = rp.role_id and u.permission_id = rp.permission_id
+		where rp.tenant_id = $1 and rp.effect = $2 and rp.created_at < $3 and (u.last_used_at IS NULL or u.last_used_at < $3) and %s`, m.table, GrantEffectiveClause)
+	args := []any{TenantIdFromContext(ctx), EffectAllow, since}
+	if roleId > 0 {
+		args = append(args, roleId)
+		from += fmt.Sprintf(" and rp.role_id = $%d", len(args))
+	}
+	return from, args
+}
+
+// FindUnusedGrants 分页查询since之后未使用的允许授权，按角色和权限排序
+func (m *customPermissionUsageRolesModel) FindUnusedGrants(ctx context.Context, roleId int64, since time.Time, limit, offset int32) ([]*UnusedGrant, error) {
+	from, args := m.unusedGrantsFrom(ctx, roleId, since)
+	query := fmt.Sprintf("select rp.role_id, rp.permission_id, rp.created_at as granted_at, u.last_used_at, coalesce(u.use_count, 0) as use_count %s order by rp.role_id, rp.permission_id limit $%d offset $%d", from, len(args)+1, len(args)+2)
+	var resp []*UnusedGrant
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, append(args, limit, offset)...)
+	return resp, err
+}
+
+// CountUnusedGrants 统计since之后未使用的允许授权数量
+func (m *customPermissionUsageRolesModel) CountUnusedGrants(ctx context.Context, roleId int64, since time.Time) (int64, error) {
+	from, args := m.unusedGrantsFrom(ctx, roleId, since)
+	query := fmt.Sprintf("select count(1) %s", from)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
+	return count, err
+}
+
+// unusedRolesQuery 查询早于since创建且since之后没有任何授权被使用的未删除角色
+func (m *customPermissionUsageRolesModel) unusedRolesQuery() string {
+	return fmt.Sprintf(`select r.id as role_id, r.code, r.name, r.created_at, max(u.last_used_at) as last_used_at
+		from "iam"."roles" r
+		left join %s u on u.role_id = r.id
+		where r.tenant_id = $1 and r.deleted_at IS NULL and r.created_at < $2
+		group by r.id, r.code, r.name, r.created_at
+		having max(u.last_used_at) IS NULL or max(u.last_used_at) < $2`, m.table)
+}
+
+// FindUnusedRoles 分页查询since之后没有任何授权被使用的角色，按角色ID排序
+func (m *customPermissionUsageRolesModel) FindUnusedRoles(ctx context.Context, since time.Time, limit, offset int32) ([]*UnusedRole, error) {
+	query := fmt.Sprintf("%s order by r.id limit $3 offset $4", m.unusedRolesQuery())
+	var resp []*UnusedRole
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx), since, limit, offset)
+	return resp, err
+}
+
+// CountUnusedRoles 统计since之后没有任何授权被使用的角色数量
+func (m *customPermissionUsageRolesModel) CountUnusedRoles(ctx context.Context, since time.Time) (int64, error) {
+	query := fmt.Sprintf("select count(1) from (%s) t", m.unusedRolesQuery())
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, TenantIdFromContext(ctx), since)
+	return count, err
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PermissionUsageUsersModel = (*customPermissionUsageUsersModel)(nil)
+
+type (
+	// PermissionUsageUsersModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPermissionUsageUsersModel.
+	PermissionUsageUsersModel interface {
+		permissionUsageUsersModel
+		Touch(ctx context.Context, data *PermissionUsageUsers) error                     // 累加使用记录，不存在时创建
+		FindByUserId(ctx context.Context, userId int64) ([]*PermissionUsageUsers, error) // 查询用户的全部使用记录
+	}
+
+	customPermissionUsageUsersModel struct {
+		*defaultPermissionUsageUsersModel
+	}
+)
+
+// NewPermissionUsageUsersModel returns a model for the database table.
+func NewPermissionUsageUsersModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) PermissionUsageUsersModel {
+	return &customPermissionUsageUsersModel{
+		defaultPermissionUsageUsersModel: newPermissionUsageUsersModel(conn, c, opts...),
+	}
+}
+
+// Touch 按data累加用户授权的使用记录：最近使用时间取较晚者，使用次数相加
+// 使用记录由后台批量写入，租户取data.TenantId而不是调用上下文
+func (m *customPermissionUsageUsersModel) Touch(ctx context.Context, data *PermissionUsageUsers) error {
+	iamPermissionUsageUsersUserIdPermissionIdKey := fmt.Sprintf("%s%v:%v", cacheIamPermissionUsageUsersUserIdPermissionIdPrefix, data.UserId, data.PermissionId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf(`insert into %s as t (tenant_id, user_id, permission_id, last_used_at, use_count) values ($1, $2, $3, $4, $5)
+			on conflict (user_id, permission_id) do update set
+				last_used_at = greatest(t.last_used_at, excluded.last_used_at),
+				use_count = t.use_count + excluded.use_count`, m.table)
+		return conn.ExecCtx(ctx, query, data.TenantId, data.UserId, data.PermissionId, data.LastUsedAt, data.UseCount)
+	}, iamPermissionUsageUsersUserIdPermissionIdKey)
+	return err
+}
+
+// FindByUserId 查询用户的全部使用记录
+func (m *customPermissionUsageUsersModel) FindByUserId(ctx context.Context, userId int64) ([]*PermissionUsageUsers, error) {
+	query := fmt.Sprintf("select %s from %s where user_id = $1 and tenant_id = $2", permissionUsageUsersRows, m.table)
+	var resp []*PermissionUsageUsers
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, TenantIdFromContext(ctx))
+	return resp, err
+}
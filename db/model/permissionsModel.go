@@ -3,10 +3,12 @@ package model
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
@@ -20,6 +22,8 @@ type (
 		FindByType(ctx context.Context, permType string) ([]*Permissions, error)                      // 按类型查询权限
 		FindByResource(ctx context.Context, resource string) ([]*Permissions, error)                  // 按资源查询权限
 		FindByResourceAndAction(ctx context.Context, resource, action string) ([]*Permissions, error) // 按资源和操作查询权限
+		FindAllVisible(ctx context.Context) ([]*Permissions, error)                                   // 查询当前租户可见的全部权限
+		FindByIds(ctx context.Context, ids []int64) ([]*Permissions, error)                           // 按ID批量查询当前租户可见的权限
 		FindByCodePattern(ctx context.Context, pattern string) ([]*Permissions, error)                // 按编码模式查询权限
 
 		ExistsByCode(ctx context.Context, code string, excludeId int64) (bool, error)                                                                // 检查编码是否存在（排除指定ID）
@@ -78,13 +82,29 @@ func NewPermissionsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Opt
 	}
 }
 
+// IsGlobal 是否为所有租户共享的全局系统权限
+func (p *Permissions) IsGlobal() bool {
+	return !p.TenantId.Valid
+}
+
+// permissionVisibleClause 权限可见范围：全局系统权限和当前租户的自定义权限，argIndex为租户ID参数的占位符序号
+func permissionVisibleClause(argIndex int) string {
+	return fmt.Sprintf("(tenant_id IS NULL or tenant_id = $%d)", argIndex)
+}
+
 // Insert 插入权限并返回ID
+// 默认租户创建的权限为全局系统权限，其他租户创建的权限仅在本租户内可见
 func (m *customPermissionsModel) Insert(ctx context.Context, data *Permissions) (sql.Result, error) {
 	var insertedID int64
+	if tenantId := TenantIdFromContext(ctx); tenantId != DefaultTenantId {
+		data.TenantId = sql.NullInt64{Int64: tenantId, Valid: true}
+	} else {
+		data.TenantId = sql.NullInt64{}
+	}
 	iamPermissionsCodeKey := fmt.Sprintf("%s%v", cacheIamPermissionsCodePrefix, data.Code)
 	iamPermissionsResourceActionTypeHttpMethodKey := fmt.Sprintf("%s%v:%v:%v:%v", cacheIamPermissionsResourceActionTypeHttpMethodPrefix, data.Resource, data.Action, data.Type, data.HttpMethod)
 
-	err := m.QueryRowNoCacheCtx(ctx, &insertedID, fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7) RETURNING id", m.table, permissionsRowsExpectAutoSet), data.Name, data.Code, data.Type, data.Resource, data.Action, data.HttpMethod, data.Description)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id", m.table, permissionsRowsExpectAutoSet), data.TenantId, data.Name, data.Code, data.Type, data.Resource, data.Action, data.HttpMethod, data.Description, data.Namespace, data.DeprecatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -99,43 +119,106 @@ func (m *customPermissionsModel) Insert(ctx context.Context, data *Permissions)
 	return &customResult{insertedID: insertedID}, nil
 }
 
+// FindOne 重写FindOne方法，其他租户的自定义权限视为不存在
+func (m *customPermissionsModel) FindOne(ctx context.Context, id int64) (*Permissions, error) {
+	resp, err := m.defaultPermissionsModel.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId.Valid && resp.TenantId.Int64 != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
+// FindOneByCode 重写FindOneByCode方法，在全局系统权限和当前租户的自定义权限中查询
+// 租户自定义权限的编码不与全局权限重复，因此最多命中一条记录
+func (m *customPermissionsModel) FindOneByCode(ctx context.Context, code string) (*Permissions, error) {
+	query := fmt.Sprintf("select %s from %s where code = $1 and %s order by tenant_id nulls first limit 1", permissionsRows, m.table, permissionVisibleClause(2))
+	var resp Permissions
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, code, TenantIdFromContext(ctx))
+	switch {
+	case err == nil:
+		return &resp, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
 // FindByType 按类型查询权限
 func (m *customPermissionsModel) FindByType(ctx context.Context, permType string) ([]*Permissions, error) {
-	query := fmt.Sprintf("select %s from %s where type = $1 order by created_at", permissionsRows, m.table)
+	query := fmt.Sprintf("select %s from %s where type = $1 and %s order by created_at", permissionsRows, m.table, permissionVisibleClause(2))
 	var resp []*Permissions
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, permType)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, permType, TenantIdFromContext(ctx))
 	return resp, err
 }
 
 // FindByResource 按资源查询权限
 func (m *customPermissionsModel) FindByResource(ctx context.Context, resource string) ([]*Permissions, error) {
-	query := fmt.Sprintf("select %s from %s where resource = $1 order by created_at", permissionsRows, m.table)
+	query := fmt.Sprintf("select %s from %s where resource = $1 and %s order by created_at", permissionsRows, m.table, permissionVisibleClause(2))
 	var resp []*Permissions
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, resource)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, resource, TenantIdFromContext(ctx))
 	return resp, err
 }
 
 // FindByResourceAndAction 按资源和操作查询权限
 func (m *customPermissionsModel) FindByResourceAndAction(ctx context.Context, resource, action string) ([]*Permissions, error) {
-	query := fmt.Sprintf("select %s from %s where resource = $1 and action = $2 order by created_at", permissionsRows, m.table)
+	query := fmt.Sprintf("select %s from %s where resource = $1 and action = $2 and %s order by created_at", permissionsRows, m.table, permissionVisibleClause(3))
+	var resp []*Permissions
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, resource, action, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// FindAllVisible 查询当前租户可见的全部权限
+func (m *customPermissionsModel) FindAllVisible(ctx context.Context) ([]*Permissions, error) {
+	query := fmt.Sprintf("select %s from %s where %s order by code", permissionsRows, m.table, permissionVisibleClause(1))
+	var resp []*Permissions
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// FindByIds 按ID批量查询当前租户可见的权限，不存在或不可见的ID被忽略
+func (m *customPermissionsModel) FindByIds(ctx context.Context, ids []int64) ([]*Permissions, error) {
+	if len(ids) == 0 {
+		return []*Permissions{}, nil
+	}
+
+	// 构建占位符，$1为租户ID
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids)+1)
+	args[0] = TenantIdFromContext(ctx)
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = id
+	}
+
+	query := fmt.Sprintf("select %s from %s where id IN (%s) and %s order by id", permissionsRows, m.table, strings.Join(placeholders, ","), permissionVisibleClause(1))
 	var resp []*Permissions
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, resource, action)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
 	return resp, err
 }
 
 // FindByCodePattern 按编码模式查询权限
 func (m *customPermissionsModel) FindByCodePattern(ctx context.Context, pattern string) ([]*Permissions, error) {
-	query := fmt.Sprintf("select %s from %s where code LIKE $1 order by created_at", permissionsRows, m.table)
+	query := fmt.Sprintf("select %s from %s where code LIKE $1 and %s order by created_at", permissionsRows, m.table, permissionVisibleClause(2))
 	var resp []*Permissions
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, pattern)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, pattern, TenantIdFromContext(ctx))
 	return resp, err
 }
 
 // ExistsByCode 检查编码是否存在（排除指定ID）
+// 租户自定义权限不能与全局权限及本租户权限重复；全局权限不能与任何租户的权限重复
 func (m *customPermissionsModel) ExistsByCode(ctx context.Context, code string, excludeId int64) (bool, error) {
 	query := fmt.Sprintf("select count(1) from %s where code = $1 and id != $2", m.table)
+	args := []interface{}{code, excludeId}
+	if tenantId := TenantIdFromContext(ctx); tenantId != DefaultTenantId {
+		query += " and " + permissionVisibleClause(3)
+		args = append(args, tenantId)
+	}
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, code, excludeId)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
 	if err != nil {
 		return false, err
 	}
@@ -144,9 +227,9 @@ func (m *customPermissionsModel) ExistsByCode(ctx context.Context, code string,
 
 // ExistsByResourceActionType 检查权限组合是否存在
 func (m *customPermissionsModel) ExistsByResourceActionType(ctx context.Context, resource, action, permType string, httpMethod sql.NullString, excludeId int64) (bool, error) {
-	query := fmt.Sprintf("select count(1) from %s where resource = $1 and action = $2 and type = $3 and http_method = $4 and id != $5", m.table)
+	query := fmt.Sprintf("select count(1) from %s where resource = $1 and action = $2 and type = $3 and http_method = $4 and id != $5 and %s", m.table, permissionVisibleClause(6))
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, resource, action, permType, httpMethod, excludeId)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, resource, action, permType, httpMethod, excludeId, TenantIdFromContext(ctx))
 	if err != nil {
 		return false, err
 	}
@@ -155,17 +238,17 @@ func (m *customPermissionsModel) ExistsByResourceActionType(ctx context.Context,
 
 // FindWithPagination 分页查询权限
 func (m *customPermissionsModel) FindWithPagination(ctx context.Context, limit, offset int32) ([]*Permissions, error) {
-	query := fmt.Sprintf("select %s from %s order by created_at desc limit $1 offset $2", permissionsRows, m.table)
+	query := fmt.Sprintf("select %s from %s where %s order by created_at desc limit $2 offset $3", permissionsRows, m.table, permissionVisibleClause(1))
 	var resp []*Permissions
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, limit, offset)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx), limit, offset)
 	return resp, err
 }
 
 // CountAll 统计权限总数
 func (m *customPermissionsModel) CountAll(ctx context.Context) (int64, error) {
-	query := fmt.Sprintf("select count(1) from %s", m.table)
+	query := fmt.Sprintf("select count(1) from %s where %s", m.table, permissionVisibleClause(1))
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, TenantIdFromContext(ctx))
 	return count, err
 }
 
@@ -177,10 +260,10 @@ func (m *customPermissionsModel) SearchByKeyword(ctx context.Context, keyword st
 
 	keywordPattern := "%" + keyword + "%"
 	query := fmt.Sprintf(`select %s from %s 
-		where name ILIKE $1 or code ILIKE $1 or description ILIKE $1 or resource ILIKE $1 or action ILIKE $1
-		order by created_at desc limit $2 offset $3`, permissionsRows, m.table)
+		where (name ILIKE $1 or code ILIKE $1 or description ILIKE $1 or resource ILIKE $1 or action ILIKE $1) and %s
+		order by created_at desc limit $3 offset $4`, permissionsRows, m.table, permissionVisibleClause(2))
 	var resp []*Permissions
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, keywordPattern, limit, offset)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, keywordPattern, TenantIdFromContext(ctx), limit, offset)
 	return resp, err
 }
 
@@ -192,17 +275,18 @@ func (m *customPermissionsModel) CountByKeyword(ctx context.Context, keyword str
 
 	keywordPattern := "%" + keyword + "%"
 	query := fmt.Sprintf(`select count(1) from %s 
-		where name ILIKE $1 or code ILIKE $1 or description ILIKE $1 or resource ILIKE $1 or action ILIKE $1`, m.table)
+		where (name ILIKE $1 or code ILIKE $1 or description ILIKE $1 or resource ILIKE $1 or action ILIKE $1) and %s`, m.table, permissionVisibleClause(2))
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, keywordPattern)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, keywordPattern, TenantIdFromContext(ctx))
 	return count, err
 }
 
 // SearchWithFilters 带过滤条件的搜索
 func (m *customPermissionsModel) SearchWithFilters(ctx context.Context, keyword, resource, action string, limit, offset int32) ([]*Permissions, error) {
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
+	// 仅包含全局系统权限和当前租户的自定义权限
+	conditions := []string{permissionVisibleClause(1)}
+	args := []interface{}{TenantIdFromContext(ctx)}
+	argIndex := 2
 
 	// 构建搜索条件
 	if strings.TrimSpace(keyword) != "" {
@@ -225,10 +309,7 @@ func (m *customPermissionsModel) SearchWithFilters(ctx context.Context, keyword,
 	}
 
 	// 构建查询语句
-	var whereClause string
-	if len(conditions) > 0 {
-		whereClause = "where " + strings.Join(conditions, " and ")
-	}
+	whereClause := "where " + strings.Join(conditions, " and ")
 
 	query := fmt.Sprintf("select %s from %s %s order by created_at desc limit $%d offset $%d",
 		permissionsRows, m.table, whereClause, argIndex, argIndex+1)
@@ -241,9 +322,10 @@ func (m *customPermissionsModel) SearchWithFilters(ctx context.Context, keyword,
 
 // CountWithFilters 统计过滤结果数量
 func (m *customPermissionsModel) CountWithFilters(ctx context.Context, keyword, resource, action string) (int64, error) {
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
+	// 仅包含全局系统权限和当前租户的自定义权限
+	conditions := []string{permissionVisibleClause(1)}
+	args := []interface{}{TenantIdFromContext(ctx)}
+	argIndex := 2
 
 	// 构建搜索条件
 	if strings.TrimSpace(keyword) != "" {
@@ -266,10 +348,7 @@ func (m *customPermissionsModel) CountWithFilters(ctx context.Context, keyword,
 	}
 
 	// 构建查询语句
-	var whereClause string
-	if len(conditions) > 0 {
-		whereClause = "where " + strings.Join(conditions, " and ")
-	}
+	whereClause := "where " + strings.Join(conditions, " and ")
 
 	query := fmt.Sprintf("select count(1) from %s %s", m.table, whereClause)
 	var count int64
@@ -45,16 +45,19 @@ type (
 	}
 
 	Permissions struct {
-		Id          int64          `db:"id"`          // 主键ID
-		Name        string         `db:"name"`        // 权限名称
-		Code        string         `db:"code"`        // 权限编码，唯一标识
-		Type        string         `db:"type"`        // 权限类型：path-API路径权限，button-按钮权限，menu-菜单权限
-		Resource    string         `db:"resource"`    // 资源标识
-		Action      string         `db:"action"`      // 操作类型
-		HttpMethod  sql.NullString `db:"http_method"` // HTTP方法：GET、POST、PUT、DELETE，仅path类型权限需要
-		Description sql.NullString `db:"description"` // 权限描述
-		CreatedAt   time.Time      `db:"created_at"`  // 创建时间
-		UpdatedAt   time.Time      `db:"updated_at"`  // 更新时间，通过触发器自动维护
+		Id           int64          `db:"id"`            // 主键ID
+		TenantId     sql.NullInt64  `db:"tenant_id"`     // 所属租户ID，NULL表示所有租户共享的全局系统权限
+		Name         string         `db:"name"`          // 权限名称
+		Code         string         `db:"code"`          // 权限编码，全局权限中唯一，租户自定义权限在租户内唯一且不与全局权限重复
+		Type         string         `db:"type"`          // 权限类型：path-API路径权限，button-按钮权限，menu-菜单权限
+		Resource     string         `db:"resource"`      // 资源标识
+		Action       string         `db:"action"`        // 操作类型
+		HttpMethod   sql.NullString `db:"http_method"`   // HTTP方法：GET、POST、PUT、DELETE，仅path类型权限需要
+		Description  sql.NullString `db:"description"`   // 权限描述
+		Namespace    sql.NullString `db:"namespace"`     // 注册该权限的服务命名空间，NULL表示手工创建的权限
+		DeprecatedAt sql.NullTime   `db:"deprecated_at"` // 弃用时间，服务注册的权限目录中不再包含该权限时设置，NULL表示未弃用
+		CreatedAt    time.Time      `db:"created_at"`    // 创建时间
+		UpdatedAt    time.Time      `db:"updated_at"`    // 更新时间，通过触发器自动维护
 	}
 )
 
@@ -143,8 +146,8 @@ func (m *defaultPermissionsModel) Insert(ctx context.Context, data *Permissions)
 	iamPermissionsIdKey := fmt.Sprintf("%s%v", cacheIamPermissionsIdPrefix, data.Id)
 	iamPermissionsResourceActionTypeHttpMethodKey := fmt.Sprintf("%s%v:%v:%v:%v", cacheIamPermissionsResourceActionTypeHttpMethodPrefix, data.Resource, data.Action, data.Type, data.HttpMethod)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7)", m.table, permissionsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.Name, data.Code, data.Type, data.Resource, data.Action, data.HttpMethod, data.Description)
+		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)", m.table, permissionsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.TenantId, data.Name, data.Code, data.Type, data.Resource, data.Action, data.HttpMethod, data.Description, data.Namespace, data.DeprecatedAt)
 	}, iamPermissionsCodeKey, iamPermissionsIdKey, iamPermissionsResourceActionTypeHttpMethodKey)
 	return ret, err
 }
@@ -160,7 +163,7 @@ func (m *defaultPermissionsModel) Update(ctx context.Context, newData *Permissio
 	iamPermissionsResourceActionTypeHttpMethodKey := fmt.Sprintf("%s%v:%v:%v:%v", cacheIamPermissionsResourceActionTypeHttpMethodPrefix, data.Resource, data.Action, data.Type, data.HttpMethod)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where id = $1", m.table, permissionsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.Id, newData.Name, newData.Code, newData.Type, newData.Resource, newData.Action, newData.HttpMethod, newData.Description)
+		return conn.ExecCtx(ctx, query, newData.Id, newData.TenantId, newData.Name, newData.Code, newData.Type, newData.Resource, newData.Action, newData.HttpMethod, newData.Description, newData.Namespace, newData.DeprecatedAt)
 	}, iamPermissionsCodeKey, iamPermissionsIdKey, iamPermissionsResourceActionTypeHttpMethodKey)
 	return err
 }
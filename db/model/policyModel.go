@@ -0,0 +1,283 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PolicyModel = (*customPolicyModel)(nil)
+
+// ErrPolicyConflict 事务中要更新或删除的记录已被其他调用修改或删除，或待删除的角色已被重新使用
+var ErrPolicyConflict = errors.New("policy changed concurrently")
+
+type (
+	// PolicyModel 策略清单落库模型，在一个事务内执行权限、角色、角色授权和用户角色的变更
+	PolicyModel interface {
+		Transact(ctx context.Context, fn func(ctx context.Context, tx *PolicyTx) error) error // 在事务中执行fn，提交后清除变更涉及的缓存
+	}
+
+	customPolicyModel struct {
+		sqlc.CachedConn
+	}
+
+	// PolicyTx 策略变更事务，记录变更涉及的缓存键，事务提交后统一清除
+	// 事务内的写入不经过缓存，回滚时缓存保持不变
+	PolicyTx struct {
+		session sqlx.Session
+		keys    []string
+	}
+)
+
+// NewPolicyModel 创建策略清单落库模型
+func NewPolicyModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) PolicyModel {
+	return &customPolicyModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+	}
+}
+
+// Transact 在事务中执行fn，fn返回错误时回滚，提交后清除变更涉及的缓存
+func (m *customPolicyModel) Transact(ctx context.Context, fn func(ctx context.Context, tx *PolicyTx) error) error {
+	tx := &PolicyTx{}
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		tx.session = session
+		return fn(ctx, tx)
+	})
+	if err != nil {
+		return err
+	}
+	if len(tx.keys) == 0 {
+		return nil
+	}
+	return m.DelCacheCtx(ctx, tx.keys...)
+}
+
+// Lock 获取当前租户的策略事务锁，同一租户的策略应用串行执行，锁在事务结束时释放
+func (tx *PolicyTx) Lock(ctx context.Context) error {
+	_, err := tx.session.ExecCtx(ctx, "select pg_advisory_xact_lock(hashtext('iam.policy'), $1::int)", TenantIdFromContext(ctx))
+	return err
+}
+
+// LockConstraints 获取当前租户的职责分离事务锁，与单独的角色授予互斥，事务内的约束校验到提交期间不会插入其他授予
+func (tx *PolicyTx) LockConstraints(ctx context.Context) error {
+	_, err := tx.session.ExecCtx(ctx, roleConstraintsLockQuery, TenantIdFromContext(ctx))
+	return err
+}
+
+// exec 执行更新或删除，未影响任何记录时返回ErrPolicyConflict
+func (tx *PolicyTx) exec(ctx context.Context, query string, args ...any) error {
+	result, err := tx.session.ExecCtx(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrPolicyConflict
+	}
+	return nil
+}
+
+// InsertPermission 创建权限，默认租户创建全局系统权限，其他租户创建本租户的自定义权限
+func (tx *PolicyTx) InsertPermission(ctx context.Context, data *Permissions) error {
+	if tenantId := TenantIdFromContext(ctx); tenantId != DefaultTenantId {
+		data.TenantId = sql.NullInt64{Int64: tenantId, Valid: true}
+	} else {
+		data.TenantId = sql.NullInt64{}
+	}
+	query := fmt.Sprintf("insert into %s (tenant_id, name, code, type, resource, action, http_method, description) values ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id", `"iam"."permissions"`)
+	if err := tx.session.QueryRowCtx(ctx, &data.Id, query, data.TenantId, data.Name, data.Code, data.Type, data.Resource, data.Action, data.HttpMethod, data.Description); err != nil {
+		return err
+	}
+	tx.addPermissionKeys(data)
+	return nil
+}
+
+// UpdatePermission 更新权限，old为更新前的记录，用于清除旧的唯一键缓存
+func (tx *PolicyTx) UpdatePermission(ctx context.Context, old, data *Permissions) error {
+	query := fmt.Sprintf("update %s set name = $1, type = $2, resource = $3, action = $4, http_method = $5, description = $6 where id = $7", `"iam"."permissions"`)
+	if err := tx.exec(ctx, query, data.Name, data.Type, data.Resource, data.Action, data.HttpMethod, data.Description, data.Id); err != nil {
+		return err
+	}
+	tx.addPermissionKeys(old)
+	tx.addPermissionKeys(data)
+	return nil
+}
+
+// DeletePermission 删除权限，角色授权随外键级联删除
+func (tx *PolicyTx) DeletePermission(ctx context.Context, data *Permissions) error {
+	query := fmt.Sprintf("delete from %s where id = $1", `"iam"."permissions"`)
+	if err := tx.exec(ctx, query, data.Id); err != nil {
+		return err
+	}
+	tx.addPermissionKeys(data)
+	return nil
+}
+
+// InsertRole 创建角色，角色归属当前调用的租户
+func (tx *PolicyTx) InsertRole(ctx context.Context, data *Roles) error {
+	data.TenantId = TenantIdFromContext(ctx)
+	if data.DataScope == "" {
+		data.DataScope = DataScopeSelf
+	}
+	query := fmt.Sprintf("insert into %s (tenant_id, name, code, description, sort_order, data_scope) values ($1, $2, $3, $4, $5, $6) RETURNING id", `"iam"."roles"`)
+	if err := tx.session.QueryRowCtx(ctx, &data.Id, query, data.TenantId, data.Name, data.Code, data.Description, data.SortOrder, data.DataScope); err != nil {
+		return err
+	}
+	tx.addRoleKeys(data)
+	return nil
+}
+
+// UpdateRole 更新角色并恢复已删除的角色，数据权限范围不是自定义时清空自定义部门
+func (tx *PolicyTx) UpdateRole(ctx context.Context, old, data *Roles) error {
+	query := fmt.Sprintf("update %s set name = $1, description = $2, sort_order = $3, data_scope = $4, deleted_at = NULL where id = $5 and tenant_id = $6", `"iam"."roles"`)
+	if err := tx.exec(ctx, query, data.Name, data.Description, data.SortOrder, data.DataScope, data.Id, TenantIdFromContext(ctx)); err != nil {
+		return err
+	}
+	if data.DataScope != DataScopeCustom {
+		if err := tx.deleteRoleDepartments(ctx, data.Id); err != nil {
+			return err
+		}
+	}
+	tx.addRoleKeys(old)
+	tx.addRoleKeys(data)
+	return nil
+}
+
+// SoftDeleteRole 软删除角色，同时删除角色的授权和自定义数据权限部门
+// 角色仍被用户、未删除的用户组或资源绑定使用时不删除，返回ErrPolicyConflict
+func (tx *PolicyTx) SoftDeleteRole(ctx context.Context, data *Roles) error {
+	query := fmt.Sprintf(`update %s r set deleted_at = NOW() where r.id = $1 and r.tenant_id = $2 and r.deleted_at IS NULL
+		and not exists (select 1 from "iam"."user_roles" where role_id = r.id and tenant_id = $2)
+		and not exists (select 1 from "iam"."group_roles" gr join "iam"."groups" g on g.id = gr.group_id
+			where gr.role_id = r.id and g.tenant_id = $2 and g.deleted_at IS NULL)
+		and not exists (select 1 from "iam"."resource_bindings" where role_id = r.id and tenant_id = $2)`, `"iam"."roles"`)
+	if err := tx.exec(ctx, query, data.Id, TenantIdFromContext(ctx)); err != nil {
+		return err
+	}
+	var grants []*RolePermissions
+	query = fmt.Sprintf("select %s from %s where role_id = $1 and tenant_id = $2", rolePermissionsRows, `"iam"."role_permissions"`)
+	if err := tx.session.QueryRowsCtx(ctx, &grants, query, data.Id, TenantIdFromContext(ctx)); err != nil {
+		return err
+	}
+	for _, grant := range grants {
+		if err := tx.DeleteGrant(ctx, grant); err != nil {
+			return err
+		}
+	}
+	if err := tx.deleteRoleDepartments(ctx, data.Id); err != nil {
+		return err
+	}
+	tx.addRoleKeys(data)
+	return nil
+}
+
+// InsertGrant 创建角色授权，授权归属当前调用的租户
+func (tx *PolicyTx) InsertGrant(ctx context.Context, data *RolePermissions) error {
+	data.TenantId = TenantIdFromContext(ctx)
+	if data.Effect == "" {
+		data.Effect = EffectAllow
+	}
+	query := fmt.Sprintf("insert into %s (tenant_id, role_id, permission_id, effect, condition, valid_from, valid_until, created_by) values ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id", `"iam"."role_permissions"`)
+	if err := tx.session.QueryRowCtx(ctx, &data.Id, query, data.TenantId, data.RoleId, data.PermissionId, data.Effect, data.Condition, data.ValidFrom, data.ValidUntil, data.CreatedBy); err != nil {
+		return err
+	}
+	tx.addGrantKeys(data)
+	return nil
+}
+
+// UpdateGrant 更新角色授权的效果、条件和有效期
+func (tx *PolicyTx) UpdateGrant(ctx context.Context, data *RolePermissions) error {
+	query := fmt.Sprintf("update %s set effect = $1, condition = $2, valid_from = $3, valid_until = $4 where id = $5 and tenant_id = $6", `"iam"."role_permissions"`)
+	if err := tx.exec(ctx, query, data.Effect, data.Condition, data.ValidFrom, data.ValidUntil, data.Id, TenantIdFromContext(ctx)); err != nil {
+		return err
+	}
+	tx.addGrantKeys(data)
+	return nil
+}
+
+// DeleteGrant 删除角色授权
+func (tx *PolicyTx) DeleteGrant(ctx context.Context, data *RolePermissions) error {
+	query := fmt.Sprintf("delete from %s where id = $1 and tenant_id = $2", `"iam"."role_permissions"`)
+	if err := tx.exec(ctx, query, data.Id, TenantIdFromContext(ctx)); err != nil {
+		return err
+	}
+	tx.addGrantKeys(data)
+	return nil
+}
+
+// InsertUserRole 为用户分配角色，关联归属当前调用的租户
+func (tx *PolicyTx) InsertUserRole(ctx context.Context, data *UserRoles) error {
+	data.TenantId = TenantIdFromContext(ctx)
+	query := fmt.Sprintf("insert into %s (tenant_id, user_id, role_id, valid_from, valid_until, created_by) values ($1, $2, $3, $4, $5, $6) RETURNING id", `"iam"."user_roles"`)
+	if err := tx.session.QueryRowCtx(ctx, &data.Id, query, data.TenantId, data.UserId, data.RoleId, data.ValidFrom, data.ValidUntil, data.CreatedBy); err != nil {
+		return err
+	}
+	tx.addUserRoleKeys(data)
+	return nil
+}
+
+// DeleteUserRole 移除用户的角色
+func (tx *PolicyTx) DeleteUserRole(ctx context.Context, data *UserRoles) error {
+	query := fmt.Sprintf("delete from %s where id = $1 and tenant_id = $2", `"iam"."user_roles"`)
+	if err := tx.exec(ctx, query, data.Id, TenantIdFromContext(ctx)); err != nil {
+		return err
+	}
+	tx.addUserRoleKeys(data)
+	return nil
+}
+
+// deleteRoleDepartments 删除角色的自定义数据权限部门
+func (tx *PolicyTx) deleteRoleDepartments(ctx context.Context, roleId int64) error {
+	var departments []*RoleDepartments
+	query := fmt.Sprintf("select %s from %s where role_id = $1", roleDepartmentsRows, `"iam"."role_departments"`)
+	if err := tx.session.QueryRowsCtx(ctx, &departments, query, roleId); err != nil {
+		return err
+	}
+	if len(departments) == 0 {
+		return nil
+	}
+	query = fmt.Sprintf("delete from %s where role_id = $1", `"iam"."role_departments"`)
+	if _, err := tx.session.ExecCtx(ctx, query, roleId); err != nil {
+		return err
+	}
+	for _, department := range departments {
+		tx.keys = append(tx.keys,
+			fmt.Sprintf("%s%v", cacheIamRoleDepartmentsIdPrefix, department.Id),
+			fmt.Sprintf("%s%v:%v", cacheIamRoleDepartmentsRoleIdDepartmentIdPrefix, department.RoleId, department.DepartmentId))
+	}
+	return nil
+}
+
+func (tx *PolicyTx) addPermissionKeys(data *Permissions) {
+	tx.keys = append(tx.keys,
+		fmt.Sprintf("%s%v", cacheIamPermissionsIdPrefix, data.Id),
+		fmt.Sprintf("%s%v", cacheIamPermissionsCodePrefix, data.Code),
+		fmt.Sprintf("%s%v:%v:%v:%v", cacheIamPermissionsResourceActionTypeHttpMethodPrefix, data.Resource, data.Action, data.Type, data.HttpMethod))
+}
+
+func (tx *PolicyTx) addRoleKeys(data *Roles) {
+	tx.keys = append(tx.keys,
+		fmt.Sprintf("%s%v", cacheIamRolesIdPrefix, data.Id),
+		fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdCodePrefix, data.TenantId, data.Code),
+		fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdNamePrefix, data.TenantId, data.Name))
+}
+
+func (tx *PolicyTx) addGrantKeys(data *RolePermissions) {
+	tx.keys = append(tx.keys,
+		fmt.Sprintf("%s%v", cacheIamRolePermissionsIdPrefix, data.Id),
+		fmt.Sprintf("%s%v:%v", cacheIamRolePermissionsRoleIdPermissionIdPrefix, data.RoleId, data.PermissionId))
+}
+
+func (tx *PolicyTx) addUserRoleKeys(data *UserRoles) {
+	tx.keys = append(tx.keys,
+		fmt.Sprintf("%s%v", cacheIamUserRolesIdPrefix, data.Id),
+		fmt.Sprintf("%s%v:%v", cacheIamUserRolesUserIdRoleIdPrefix, data.UserId, data.RoleId))
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ RelationTuplesModel = (*customRelationTuplesModel)(nil)
+
+type (
+	// RelationTuplesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customRelationTuplesModel.
+	RelationTuplesModel interface {
+		relationTuplesModel
+		FindByObjectRelation(ctx context.Context, namespace, objectId, relation string) ([]*RelationTuples, error) // 查询对象上指定关系的所有元组
+		FindObjectIds(ctx context.Context, namespace string) ([]string, error)                                     // 查询命名空间中出现过的所有对象ID，包含作为主体出现的对象
+	}
+
+	customRelationTuplesModel struct {
+		*defaultRelationTuplesModel
+	}
+)
+
+// NewRelationTuplesModel returns a model for the database table.
+func NewRelationTuplesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) RelationTuplesModel {
+	return &customRelationTuplesModel{
+		defaultRelationTuplesModel: newRelationTuplesModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，元组归属当前调用的租户，并使用RETURNING子句获取插入后的ID
+func (m *customRelationTuplesModel) Insert(ctx context.Context, data *RelationTuples) (sql.Result, error) {
+	var insertedID int64
+	data.TenantId = TenantIdFromContext(ctx)
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id", m.table, relationTuplesRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.TenantId, data.Namespace, data.ObjectId, data.Relation, data.SubjectNamespace, data.SubjectId, data.SubjectRelation, data.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+
+	// 清除相关缓存
+	iamRelationTuplesIdKey := fmt.Sprintf("%s%v", cacheIamRelationTuplesIdPrefix, insertedID)
+	iamRelationTuplesTenantIdNamespaceObjectIdRelationSubjectNamespaceSubjectIdSubjectRelationKey := fmt.Sprintf("%s%v:%v:%v:%v:%v:%v:%v", cacheIamRelationTuplesTenantIdNamespaceObjectIdRelationSubjectNamespaceSubjectIdSubjectRelationPrefix, data.TenantId, data.Namespace, data.ObjectId, data.Relation, data.SubjectNamespace, data.SubjectId, data.SubjectRelation)
+	_ = m.DelCacheCtx(ctx, iamRelationTuplesIdKey, iamRelationTuplesTenantIdNamespaceObjectIdRelationSubjectNamespaceSubjectIdSubjectRelationKey)
+
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// FindOne 重写FindOne方法，校验元组属于当前租户
+func (m *customRelationTuplesModel) FindOne(ctx context.Context, id int64) (*RelationTuples, error) {
+	resp, err := m.defaultRelationTuplesModel.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
+// FindByObjectRelation 查询对象上指定关系的所有元组
+func (m *customRelationTuplesModel) FindByObjectRelation(ctx context.Context, namespace, objectId, relation string) ([]*RelationTuples, error) {
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and namespace = $2 and object_id = $3 and relation = $4 order by id", relationTuplesRows, m.table)
+	var resp []*RelationTuples
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx), namespace, objectId, relation)
+	return resp, err
+}
+
+// FindObjectIds 查询命名空间中出现过的所有对象ID，包含仅作为主体出现的对象（如只被当作上级目录引用的folder）
+func (m *customRelationTuplesModel) FindObjectIds(ctx context.Context, namespace string) ([]string, error) {
+	query := fmt.Sprintf(`select object_id from %s where tenant_id = $1 and namespace = $2
+		union
+		select subject_id from %s where tenant_id = $1 and subject_namespace = $2
+		order by 1`, m.table, m.table)
+	var resp []string
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx), namespace)
+	return resp, err
+}
@@ -0,0 +1,180 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// ResourceWildcard 资源ID通配符，绑定到该类型的所有资源
+const ResourceWildcard = "*"
+
+var _ ResourceBindingsModel = (*customResourceBindingsModel)(nil)
+
+type (
+	// ResourceBindingsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customResourceBindingsModel.
+	ResourceBindingsModel interface {
+		resourceBindingsModel
+		FindByUserResource(ctx context.Context, userId int64, resourceType, resourceId string) ([]*ResourceBindings, error) // 查询用户在指定资源上的绑定，包含通配绑定
+		CountByRoleId(ctx context.Context, roleId int64) (int64, error)                                                     // 统计使用指定角色的资源绑定数量
+
+		// roleIds为nil时不按角色过滤，仅返回有效角色和未删除用户的绑定
+		FindByResource(ctx context.Context, resourceType, resourceId string, roleIds []int64, limit, offset int32) ([]*ResourceBindings, error) // 分页查询能访问指定资源的绑定，包含通配绑定
+		CountByResource(ctx context.Context, resourceType, resourceId string, roleIds []int64) (int64, error)                                   // 统计能访问指定资源的绑定数量
+		FindByUserId(ctx context.Context, userId int64, resourceType string, roleIds []int64, limit, offset int32) ([]*ResourceBindings, error) // 分页查询用户的资源绑定，resourceType为空时不按类型过滤
+		CountByUserId(ctx context.Context, userId int64, resourceType string, roleIds []int64) (int64, error)                                   // 统计用户的资源绑定数量
+	}
+
+	customResourceBindingsModel struct {
+		*defaultResourceBindingsModel
+	}
+)
+
+// NewResourceBindingsModel returns a model for the database table.
+func NewResourceBindingsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ResourceBindingsModel {
+	return &customResourceBindingsModel{
+		defaultResourceBindingsModel: newResourceBindingsModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，绑定归属当前调用的租户，并使用RETURNING子句获取插入后的ID
+func (m *customResourceBindingsModel) Insert(ctx context.Context, data *ResourceBindings) (sql.Result, error) {
+	var insertedID int64
+	data.TenantId = TenantIdFromContext(ctx)
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6) RETURNING id", m.table, resourceBindingsRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.TenantId, data.UserId, data.RoleId, data.ResourceType, data.ResourceId, data.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+
+	// 清除相关缓存
+	iamResourceBindingsIdKey := fmt.Sprintf("%s%v", cacheIamResourceBindingsIdPrefix, insertedID)
+	iamResourceBindingsUserIdRoleIdResourceTypeResourceIdKey := fmt.Sprintf("%s%v:%v:%v:%v", cacheIamResourceBindingsUserIdRoleIdResourceTypeResourceIdPrefix, data.UserId, data.RoleId, data.ResourceType, data.ResourceId)
+	_ = m.DelCacheCtx(ctx, iamResourceBindingsIdKey, iamResourceBindingsUserIdRoleIdResourceTypeResourceIdKey)
+
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// FindOne 重写FindOne方法，校验绑定属于当前租户
+func (m *customResourceBindingsModel) FindOne(ctx context.Context, id int64) (*ResourceBindings, error) {
+	resp, err := m.defaultResourceBindingsModel.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
+// FindOneByUserIdRoleIdResourceTypeResourceId 重写唯一键查询方法，校验绑定属于当前租户
+func (m *customResourceBindingsModel) FindOneByUserIdRoleIdResourceTypeResourceId(ctx context.Context, userId int64, roleId int64, resourceType string, resourceId string) (*ResourceBindings, error) {
+	resp, err := m.defaultResourceBindingsModel.FindOneByUserIdRoleIdResourceTypeResourceId(ctx, userId, roleId, resourceType, resourceId)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
+// FindByUserResource 查询用户在指定资源上的绑定，包含该资源类型的通配绑定
+func (m *customResourceBindingsModel) FindByUserResource(ctx context.Context, userId int64, resourceType, resourceId string) ([]*ResourceBindings, error) {
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and user_id = $2 and resource_type = $3 and resource_id IN ($4, $5) order by created_at", resourceBindingsRows, m.table)
+	var resp []*ResourceBindings
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx), userId, resourceType, resourceId, ResourceWildcard)
+	return resp, err
+}
+
+// CountByRoleId 统计使用指定角色的资源绑定数量
+func (m *customResourceBindingsModel) CountByRoleId(ctx context.Context, roleId int64) (int64, error) {
+	query := fmt.Sprintf("select count(1) from %s where role_id = $1 and tenant_id = $2", m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, roleId, TenantIdFromContext(ctx))
+	return count, err
+}
+
+// FindByResource 分页查询能访问指定资源的绑定，包含该资源类型的通配绑定
+func (m *customResourceBindingsModel) FindByResource(ctx context.Context, resourceType, resourceId string, roleIds []int64, limit, offset int32) ([]*ResourceBindings, error) {
+	whereClause, args := m.resourceConditions(ctx, resourceType, resourceId, roleIds)
+	query := fmt.Sprintf("select %s from %s where %s order by user_id, role_id, id limit $%d offset $%d", resourceBindingsRows, m.table, whereClause, len(args)+1, len(args)+2)
+	var resp []*ResourceBindings
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, append(args, limit, offset)...)
+	return resp, err
+}
+
+// CountByResource 统计能访问指定资源的绑定数量
+func (m *customResourceBindingsModel) CountByResource(ctx context.Context, resourceType, resourceId string, roleIds []int64) (int64, error) {
+	whereClause, args := m.resourceConditions(ctx, resourceType, resourceId, roleIds)
+	query := fmt.Sprintf("select count(1) from %s where %s", m.table, whereClause)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
+	return count, err
+}
+
+// FindByUserId 分页查询用户的资源绑定，resourceType为空时不按类型过滤
+func (m *customResourceBindingsModel) FindByUserId(ctx context.Context, userId int64, resourceType string, roleIds []int64, limit, offset int32) ([]*ResourceBindings, error) {
+	whereClause, args := m.userConditions(ctx, userId, resourceType, roleIds)
+	query := fmt.Sprintf("select %s from %s where %s order by resource_type, resource_id, role_id limit $%d offset $%d", resourceBindingsRows, m.table, whereClause, len(args)+1, len(args)+2)
+	var resp []*ResourceBindings
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, append(args, limit, offset)...)
+	return resp, err
+}
+
+// CountByUserId 统计用户的资源绑定数量
+func (m *customResourceBindingsModel) CountByUserId(ctx context.Context, userId int64, resourceType string, roleIds []int64) (int64, error) {
+	whereClause, args := m.userConditions(ctx, userId, resourceType, roleIds)
+	query := fmt.Sprintf("select count(1) from %s where %s", m.table, whereClause)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
+	return count, err
+}
+
+// resourceConditions 构建按资源查询绑定的条件
+func (m *customResourceBindingsModel) resourceConditions(ctx context.Context, resourceType, resourceId string, roleIds []int64) (string, []any) {
+	conditions := []string{
+		"tenant_id = $1",
+		"resource_type = $2",
+		"resource_id IN ($3, $4)",
+		`user_id IN (select id from "iam"."users" where deleted_at IS NULL)`,
+	}
+	args := []any{TenantIdFromContext(ctx), resourceType, resourceId, ResourceWildcard}
+	return m.withRoleConditions(conditions, args, roleIds)
+}
+
+// userConditions 构建按用户查询绑定的条件
+func (m *customResourceBindingsModel) userConditions(ctx context.Context, userId int64, resourceType string, roleIds []int64) (string, []any) {
+	conditions := []string{"tenant_id = $1", "user_id = $2"}
+	args := []any{TenantIdFromContext(ctx), userId}
+	if resourceType != "" {
+		args = append(args, resourceType)
+		conditions = append(conditions, fmt.Sprintf("resource_type = $%d", len(args)))
+	}
+	return m.withRoleConditions(conditions, args, roleIds)
+}
+
+// withRoleConditions 追加角色过滤条件，只保留有效角色的绑定
+func (m *customResourceBindingsModel) withRoleConditions(conditions []string, args []any, roleIds []int64) (string, []any) {
+	conditions = append(conditions, `role_id IN (select id from "iam"."roles" where deleted_at IS NULL and disabled_at IS NULL)`)
+	if roleIds != nil {
+		if len(roleIds) == 0 {
+			conditions = append(conditions, "false")
+		} else {
+			placeholders := make([]string, len(roleIds))
+			for i, roleId := range roleIds {
+				args = append(args, roleId)
+				placeholders[i] = fmt.Sprintf("$%d", len(args))
+			}
+			conditions = append(conditions, fmt.Sprintf("role_id IN (%s)", strings.Join(placeholders, ",")))
+		}
+	}
+	return strings.Join(conditions, " and "), args
+}
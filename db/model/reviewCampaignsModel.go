@@ -0,0 +1,121 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 访问复核活动状态
+const (
+	ReviewCampaignOpen   = "open"   // 进行中
+	ReviewCampaignClosed = "closed" // 已关闭
+)
+
+// 访问复核的复核人来源
+const (
+	ReviewerSourceRoleOwners = "role_owners" // 角色审批链中的审批人
+	ReviewerSourceManagers   = "managers"    // 管理范围覆盖持有人且可分配该角色的委派管理员
+)
+
+var _ ReviewCampaignsModel = (*customReviewCampaignsModel)(nil)
+
+type (
+	// ReviewCampaignsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customReviewCampaignsModel.
+	ReviewCampaignsModel interface {
+		reviewCampaignsModel
+		FindWithFilters(ctx context.Context, status string, limit, offset int32) ([]*ReviewCampaigns, error) // 按状态分页查询，状态为空时不过滤
+		CountWithFilters(ctx context.Context, status string) (int64, error)                                  // 按状态统计数量
+		Close(ctx context.Context, data *ReviewCampaigns) (bool, error)                                      // 关闭进行中的活动，活动已被关闭时返回false
+	}
+
+	customReviewCampaignsModel struct {
+		*defaultReviewCampaignsModel
+	}
+)
+
+// NewReviewCampaignsModel returns a model for the database table.
+func NewReviewCampaignsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ReviewCampaignsModel {
+	return &customReviewCampaignsModel{
+		defaultReviewCampaignsModel: newReviewCampaignsModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，活动归属当前调用的租户，并使用RETURNING子句获取插入后的ID
+func (m *customReviewCampaignsModel) Insert(ctx context.Context, data *ReviewCampaigns) (sql.Result, error) {
+	var insertedID int64
+	data.TenantId = TenantIdFromContext(ctx)
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id", m.table, reviewCampaignsRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.TenantId, data.Name, data.Description, data.ReviewerSource, data.Status, data.DueAt, data.ClosedAt, data.ClosedBy, data.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+
+	// 清除相关缓存
+	iamReviewCampaignsIdKey := fmt.Sprintf("%s%v", cacheIamReviewCampaignsIdPrefix, insertedID)
+	_ = m.DelCacheCtx(ctx, iamReviewCampaignsIdKey)
+
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// FindOne 重写FindOne方法，校验活动属于当前租户
+func (m *customReviewCampaignsModel) FindOne(ctx context.Context, id int64) (*ReviewCampaigns, error) {
+	resp, err := m.defaultReviewCampaignsModel.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
+// filterClause 构建状态过滤条件
+func (m *customReviewCampaignsModel) filterClause(ctx context.Context, status string) (string, []any) {
+	where := "tenant_id = $1"
+	args := []any{TenantIdFromContext(ctx)}
+	if status != "" {
+		args = append(args, status)
+		where += fmt.Sprintf(" and status = $%d", len(args))
+	}
+	return where, args
+}
+
+// FindWithFilters 按状态分页查询活动，按创建时间倒序
+func (m *customReviewCampaignsModel) FindWithFilters(ctx context.Context, status string, limit, offset int32) ([]*ReviewCampaigns, error) {
+	where, args := m.filterClause(ctx, status)
+	query := fmt.Sprintf("select %s from %s where %s order by created_at desc, id desc limit $%d offset $%d", reviewCampaignsRows, m.table, where, len(args)+1, len(args)+2)
+	var resp []*ReviewCampaigns
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, append(args, limit, offset)...)
+	return resp, err
+}
+
+// CountWithFilters 按状态统计活动数量
+func (m *customReviewCampaignsModel) CountWithFilters(ctx context.Context, status string) (int64, error) {
+	where, args := m.filterClause(ctx, status)
+	query := fmt.Sprintf("select count(1) from %s where %s", m.table, where)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
+	return count, err
+}
+
+// Close 将进行中的活动更新为已关闭，记录data中的关闭时间和关闭人
+// 并发关闭时只有一个操作能成功，其余返回false
+func (m *customReviewCampaignsModel) Close(ctx context.Context, data *ReviewCampaigns) (bool, error) {
+	iamReviewCampaignsIdKey := fmt.Sprintf("%s%v", cacheIamReviewCampaignsIdPrefix, data.Id)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set status = $1, closed_at = $2, closed_by = $3 where id = $4 and tenant_id = $5 and status = $6", m.table)
+		return conn.ExecCtx(ctx, query, ReviewCampaignClosed, data.ClosedAt, data.ClosedBy, data.Id, TenantIdFromContext(ctx), ReviewCampaignOpen)
+	}, iamReviewCampaignsIdKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
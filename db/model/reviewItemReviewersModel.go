@@ -0,0 +1,97 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ReviewItemReviewersModel = (*customReviewItemReviewersModel)(nil)
+
+type (
+	// ReviewItemReviewersModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customReviewItemReviewersModel.
+	ReviewItemReviewersModel interface {
+		reviewItemReviewersModel
+		AddReviewers(ctx context.Context, itemId int64, reviewerIds []int64) error          // 为条目添加复核人
+		FindByItemIds(ctx context.Context, itemIds []int64) ([]*ReviewItemReviewers, error) // 批量查询多个条目的复核人
+		IsReviewer(ctx context.Context, itemId, reviewerId int64) (bool, error)             // 检查用户是否为条目的复核人
+	}
+
+	customReviewItemReviewersModel struct {
+		*defaultReviewItemReviewersModel
+	}
+)
+
+// NewReviewItemReviewersModel returns a model for the database table.
+func NewReviewItemReviewersModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ReviewItemReviewersModel {
+	return &customReviewItemReviewersModel{
+		defaultReviewItemReviewersModel: newReviewItemReviewersModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，复核人归属当前调用的租户
+func (m *customReviewItemReviewersModel) Insert(ctx context.Context, data *ReviewItemReviewers) (sql.Result, error) {
+	data.TenantId = TenantIdFromContext(ctx)
+	return m.defaultReviewItemReviewersModel.Insert(ctx, data)
+}
+
+// FindOneByItemIdReviewerId 重写FindOneByItemIdReviewerId方法，校验复核人属于当前租户
+func (m *customReviewItemReviewersModel) FindOneByItemIdReviewerId(ctx context.Context, itemId int64, reviewerId int64) (*ReviewItemReviewers, error) {
+	resp, err := m.defaultReviewItemReviewersModel.FindOneByItemIdReviewerId(ctx, itemId, reviewerId)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
+// AddReviewers 为条目添加复核人
+func (m *customReviewItemReviewersModel) AddReviewers(ctx context.Context, itemId int64, reviewerIds []int64) error {
+	for _, reviewerId := range reviewerIds {
+		if _, err := m.Insert(ctx, &ReviewItemReviewers{ItemId: itemId, ReviewerId: reviewerId}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindByItemIds 批量查询多个条目的复核人，按条目和复核人排序
+func (m *customReviewItemReviewersModel) FindByItemIds(ctx context.Context, itemIds []int64) ([]*ReviewItemReviewers, error) {
+	if len(itemIds) == 0 {
+		return []*ReviewItemReviewers{}, nil
+	}
+
+	// 构建占位符
+	placeholders := make([]string, len(itemIds))
+	args := make([]interface{}, len(itemIds)+1)
+	args[0] = TenantIdFromContext(ctx)
+	for i, itemId := range itemIds {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = itemId
+	}
+
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and item_id IN (%s) order by item_id, reviewer_id", reviewItemReviewersRows, m.table, strings.Join(placeholders, ","))
+	var resp []*ReviewItemReviewers
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// IsReviewer 检查用户是否为条目的复核人
+func (m *customReviewItemReviewersModel) IsReviewer(ctx context.Context, itemId, reviewerId int64) (bool, error) {
+	_, err := m.FindOneByItemIdReviewerId(ctx, itemId, reviewerId)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
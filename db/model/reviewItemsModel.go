@@ -0,0 +1,175 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 访问复核决定
+const (
+	ReviewDecisionPending = "pending" // 待复核
+	ReviewDecisionKeep    = "keep"    // 保留
+	ReviewDecisionRevoke  = "revoke"  // 撤销
+)
+
+// 访问复核条目在活动关闭时的处理结果
+const (
+	ReviewOutcomeKept           = "kept"            // 决定保留，角色不变
+	ReviewOutcomeUnreviewed     = "unreviewed"      // 未复核，角色不变
+	ReviewOutcomeRevoked        = "revoked"         // 已撤销角色
+	ReviewOutcomeAlreadyRemoved = "already_removed" // 关闭前角色已被移除
+	ReviewOutcomeFailed         = "failed"          // 撤销失败
+)
+
+var _ ReviewItemsModel = (*customReviewItemsModel)(nil)
+
+type (
+	// ReviewItemsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customReviewItemsModel.
+	ReviewItemsModel interface {
+		reviewItemsModel
+		FindByCampaignId(ctx context.Context, campaignId int64) ([]*ReviewItems, error)                                                  // 查询活动的全部条目
+		FindWithFilters(ctx context.Context, campaignId, reviewerId int64, decision string, limit, offset int32) ([]*ReviewItems, error) // 按复核人和决定分页查询活动的条目，条件为零值时不过滤
+		CountWithFilters(ctx context.Context, campaignId, reviewerId int64, decision string) (int64, error)                              // 按复核人和决定统计活动的条目数量
+		CountByDecision(ctx context.Context, campaignId int64) ([]*ReviewDecisionCount, error)                                           // 按决定统计活动的条目数量
+		Decide(ctx context.Context, data *ReviewItems) (bool, error)                                                                     // 记录复核决定，活动已关闭或条目已处理时返回false
+		SetOutcome(ctx context.Context, data *ReviewItems) error                                                                         // 记录活动关闭时的处理结果，已记录的结果不会被覆盖
+	}
+
+	customReviewItemsModel struct {
+		*defaultReviewItemsModel
+	}
+
+	// ReviewDecisionCount 按决定统计的条目数量
+	ReviewDecisionCount struct {
+		Decision string `db:"decision"` // 复核决定
+		Count    int64  `db:"count"`    // 条目数量
+	}
+)
+
+// NewReviewItemsModel returns a model for the database table.
+func NewReviewItemsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ReviewItemsModel {
+	return &customReviewItemsModel{
+		defaultReviewItemsModel: newReviewItemsModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，条目归属当前调用的租户，并使用RETURNING子句获取插入后的ID
+func (m *customReviewItemsModel) Insert(ctx context.Context, data *ReviewItems) (sql.Result, error) {
+	var insertedID int64
+	data.TenantId = TenantIdFromContext(ctx)
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING id", m.table, reviewItemsRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.TenantId, data.CampaignId, data.UserId, data.RoleId, data.GrantedAt, data.ValidFrom, data.ValidUntil, data.Decision, data.Comment, data.DecidedBy, data.DecidedAt, data.Outcome, data.OutcomeDetail)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+
+	// 清除相关缓存
+	_ = m.DelCacheCtx(ctx, m.cacheKeys(data)...)
+
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// FindOne 重写FindOne方法，校验条目属于当前租户
+func (m *customReviewItemsModel) FindOne(ctx context.Context, id int64) (*ReviewItems, error) {
+	resp, err := m.defaultReviewItemsModel.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
+// FindByCampaignId 查询活动的全部条目，按角色和用户排序
+func (m *customReviewItemsModel) FindByCampaignId(ctx context.Context, campaignId int64) ([]*ReviewItems, error) {
+	query := fmt.Sprintf("select %s from %s where campaign_id = $1 and tenant_id = $2 order by role_id, user_id", reviewItemsRows, m.table)
+	var resp []*ReviewItems
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, campaignId, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// filterClause 构建复核人和决定过滤条件
+func (m *customReviewItemsModel) filterClause(ctx context.Context, campaignId, reviewerId int64, decision string) (string, []any) {
+	conditions := []string{"i.campaign_id = $1", "i.tenant_id = $2"}
+	args := []any{campaignId, TenantIdFromContext(ctx)}
+	if reviewerId > 0 {
+		args = append(args, reviewerId)
+		conditions = append(conditions, fmt.Sprintf(`exists (
+			select 1 from "iam"."review_item_reviewers" r where r.item_id = i.id and r.reviewer_id = $%d
+		)`, len(args)))
+	}
+	if decision != "" {
+		args = append(args, decision)
+		conditions = append(conditions, fmt.Sprintf("i.decision = $%d", len(args)))
+	}
+	return strings.Join(conditions, " and "), args
+}
+
+// FindWithFilters 按复核人和决定分页查询活动的条目，按角色和用户排序
+func (m *customReviewItemsModel) FindWithFilters(ctx context.Context, campaignId, reviewerId int64, decision string, limit, offset int32) ([]*ReviewItems, error) {
+	where, args := m.filterClause(ctx, campaignId, reviewerId, decision)
+	query := fmt.Sprintf("select %s from %s i where %s order by i.role_id, i.user_id limit $%d offset $%d", reviewItemsRows, m.table, where, len(args)+1, len(args)+2)
+	var resp []*ReviewItems
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, append(args, limit, offset)...)
+	return resp, err
+}
+
+// CountWithFilters 按复核人和决定统计活动的条目数量
+func (m *customReviewItemsModel) CountWithFilters(ctx context.Context, campaignId, reviewerId int64, decision string) (int64, error) {
+	where, args := m.filterClause(ctx, campaignId, reviewerId, decision)
+	query := fmt.Sprintf("select count(1) from %s i where %s", m.table, where)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
+	return count, err
+}
+
+// CountByDecision 按决定统计活动的条目数量，没有条目的决定不出现在结果中
+func (m *customReviewItemsModel) CountByDecision(ctx context.Context, campaignId int64) ([]*ReviewDecisionCount, error) {
+	query := fmt.Sprintf("select decision, count(1) as count from %s where campaign_id = $1 and tenant_id = $2 group by decision", m.table)
+	var resp []*ReviewDecisionCount
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, campaignId, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// Decide 记录data中的复核决定、意见、复核人和决定时间，活动进行中且条目尚未处理时才能更新
+// 活动已关闭或条目已在关闭过程中处理时返回false
+func (m *customReviewItemsModel) Decide(ctx context.Context, data *ReviewItems) (bool, error) {
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf(`update %s i set decision = $1, comment = $2, decided_by = $3, decided_at = $4
+			where i.id = $5 and i.tenant_id = $6 and i.outcome = '' and exists (
+				select 1 from "iam"."review_campaigns" c where c.id = i.campaign_id and c.status = $7
+			)`, m.table)
+		return conn.ExecCtx(ctx, query, data.Decision, data.Comment, data.DecidedBy, data.DecidedAt, data.Id, TenantIdFromContext(ctx), ReviewCampaignOpen)
+	}, m.cacheKeys(data)...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// SetOutcome 记录data中活动关闭时的处理结果，已记录的结果不会被覆盖
+func (m *customReviewItemsModel) SetOutcome(ctx context.Context, data *ReviewItems) error {
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set outcome = $1, outcome_detail = $2 where id = $3 and tenant_id = $4 and outcome = ''", m.table)
+		return conn.ExecCtx(ctx, query, data.Outcome, data.OutcomeDetail, data.Id, TenantIdFromContext(ctx))
+	}, m.cacheKeys(data)...)
+	return err
+}
+
+// cacheKeys 构建条目的缓存键
+func (m *customReviewItemsModel) cacheKeys(data *ReviewItems) []string {
+	return []string{
+		fmt.Sprintf("%s%v", cacheIamReviewItemsIdPrefix, data.Id),
+		fmt.Sprintf("%s%v:%v:%v", cacheIamReviewItemsCampaignIdUserIdRoleIdPrefix, data.CampaignId, data.UserId, data.RoleId),
+	}
+}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ RoleApproversModel = (*customRoleApproversModel)(nil)
+
+type (
+	// RoleApproversModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customRoleApproversModel.
+	RoleApproversModel interface {
+		roleApproversModel
+		FindByRoleId(ctx context.Context, roleId int64) ([]*RoleApprovers, error)                               // 查询角色的审批链，按步骤排序
+		ReplaceRoleApprovers(ctx context.Context, roleId int64, steps [][]int64, createdBy sql.NullInt64) error // 替换角色的审批链，steps[i]为第i+1步的审批人
+		IsApprover(ctx context.Context, roleId, step, approverId int64) (bool, error)                           // 检查用户是否为角色指定步骤的审批人
+		IsRoleApprover(ctx context.Context, roleId, approverId int64) (bool, error)                             // 检查用户是否为角色任一步骤的审批人
+		CountSteps(ctx context.Context, roleId int64) (int64, error)                                            // 统计角色审批链的步骤数
+	}
+
+	customRoleApproversModel struct {
+		*defaultRoleApproversModel
+	}
+)
+
+// NewRoleApproversModel returns a model for the database table.
+func NewRoleApproversModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) RoleApproversModel {
+	return &customRoleApproversModel{
+		defaultRoleApproversModel: newRoleApproversModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，审批人归属当前调用的租户
+func (m *customRoleApproversModel) Insert(ctx context.Context, data *RoleApprovers) (sql.Result, error) {
+	data.TenantId = TenantIdFromContext(ctx)
+	return m.defaultRoleApproversModel.Insert(ctx, data)
+}
+
+// FindOneByRoleIdStepApproverId 重写FindOneByRoleIdStepApproverId方法，校验审批人属于当前租户
+func (m *customRoleApproversModel) FindOneByRoleIdStepApproverId(ctx context.Context, roleId int64, step int64, approverId int64) (*RoleApprovers, error) {
+	resp, err := m.defaultRoleApproversModel.FindOneByRoleIdStepApproverId(ctx, roleId, step, approverId)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
+// FindByRoleId 查询角色的审批链，按步骤排序
+func (m *customRoleApproversModel) FindByRoleId(ctx context.Context, roleId int64) ([]*RoleApprovers, error) {
+	query := fmt.Sprintf("select %s from %s where role_id = $1 and tenant_id = $2 order by step, approver_id", roleApproversRows, m.table)
+	var resp []*RoleApprovers
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, roleId, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// ReplaceRoleApprovers 替换角色的审批链，steps为空时清空审批链
+func (m *customRoleApproversModel) ReplaceRoleApprovers(ctx context.Context, roleId int64, steps [][]int64, createdBy sql.NullInt64) error {
+	// 先查询现有审批人以清除缓存
+	existing, err := m.FindByRoleId(ctx, roleId)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		keys := make([]string, 0, len(existing)*2)
+		for _, ra := range existing {
+			keys = append(keys, fmt.Sprintf("%s%v", cacheIamRoleApproversIdPrefix, ra.Id))
+			keys = append(keys, fmt.Sprintf("%s%v:%v:%v", cacheIamRoleApproversRoleIdStepApproverIdPrefix, ra.RoleId, ra.Step, ra.ApproverId))
+		}
+		_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+			query := fmt.Sprintf("delete from %s where role_id = $1 and tenant_id = $2", m.table)
+			return conn.ExecCtx(ctx, query, roleId, TenantIdFromContext(ctx))
+		}, keys...)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, approverIds := range steps {
+		for _, approverId := range approverIds {
+			_, err := m.Insert(ctx, &RoleApprovers{
+				RoleId:     roleId,
+				Step:       int64(i + 1),
+				ApproverId: approverId,
+				CreatedBy:  createdBy,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IsApprover 检查用户是否为角色指定步骤的审批人
+func (m *customRoleApproversModel) IsApprover(ctx context.Context, roleId, step, approverId int64) (bool, error) {
+	_, err := m.FindOneByRoleIdStepApproverId(ctx, roleId, step, approverId)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// IsRoleApprover 检查用户是否为角色任一步骤的审批人
+func (m *customRoleApproversModel) IsRoleApprover(ctx context.Context, roleId, approverId int64) (bool, error) {
+	query := fmt.Sprintf("select count(1) from %s where role_id = $1 and approver_id = $2 and tenant_id = $3", m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, roleId, approverId, TenantIdFromContext(ctx))
+	return count > 0, err
+}
+
+// CountSteps 统计角色审批链的步骤数，为0表示该角色不接受申请
+func (m *customRoleApproversModel) CountSteps(ctx context.Context, roleId int64) (int64, error) {
+	query := fmt.Sprintf("select coalesce(max(step), 0) from %s where role_id = $1 and tenant_id = $2", m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, roleId, TenantIdFromContext(ctx))
+	return count, err
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ RoleConstraintRolesModel = (*customRoleConstraintRolesModel)(nil)
+
+type (
+	// RoleConstraintRolesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customRoleConstraintRolesModel.
+	RoleConstraintRolesModel interface {
+		roleConstraintRolesModel
+		AddRoles(ctx context.Context, constraintId int64, roleIds []int64) error                    // 为约束添加角色，已存在的跳过
+		FindAll(ctx context.Context) ([]*RoleConstraintRoles, error)                                // 查询当前租户所有约束的角色
+		FindByConstraintId(ctx context.Context, constraintId int64) ([]*RoleConstraintRoles, error) // 查询约束的角色
+	}
+
+	customRoleConstraintRolesModel struct {
+		*defaultRoleConstraintRolesModel
+	}
+)
+
+// NewRoleConstraintRolesModel returns a model for the database table.
+func NewRoleConstraintRolesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) RoleConstraintRolesModel {
+	return &customRoleConstraintRolesModel{
+		defaultRoleConstraintRolesModel: newRoleConstraintRolesModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，约束角色归属当前调用的租户
+func (m *customRoleConstraintRolesModel) Insert(ctx context.Context, data *RoleConstraintRoles) (sql.Result, error) {
+	data.TenantId = TenantIdFromContext(ctx)
+	return m.defaultRoleConstraintRolesModel.Insert(ctx, data)
+}
+
+// AddRoles 为约束添加角色，已存在的跳过
+func (m *customRoleConstraintRolesModel) AddRoles(ctx context.Context, constraintId int64, roleIds []int64) error {
+	existing, err := m.FindByConstraintId(ctx, constraintId)
+	if err != nil {
+		return err
+	}
+	existingRoleMap := make(map[int64]bool, len(existing))
+	for _, cr := range existing {
+		existingRoleMap[cr.RoleId] = true
+	}
+
+	for _, roleId := range roleIds {
+		if existingRoleMap[roleId] {
+			continue
+		}
+		existingRoleMap[roleId] = true
+		if _, err := m.Insert(ctx, &RoleConstraintRoles{ConstraintId: constraintId, RoleId: roleId}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindAll 查询当前租户所有约束的角色，按约束和角色排序
+func (m *customRoleConstraintRolesModel) FindAll(ctx context.Context) ([]*RoleConstraintRoles, error) {
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 order by constraint_id, role_id", roleConstraintRolesRows, m.table)
+	var resp []*RoleConstraintRoles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// FindByConstraintId 查询约束的角色，按角色排序
+func (m *customRoleConstraintRolesModel) FindByConstraintId(ctx context.Context, constraintId int64) ([]*RoleConstraintRoles, error) {
+	query := fmt.Sprintf("select %s from %s where constraint_id = $1 and tenant_id = $2 order by role_id", roleConstraintRolesRows, m.table)
+	var resp []*RoleConstraintRoles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, constraintId, TenantIdFromContext(ctx))
+	return resp, err
+}
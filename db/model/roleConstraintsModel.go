@@ -0,0 +1,103 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 职责分离约束类型
+const (
+	RoleConstraintExclusive       = "exclusive"        // 用户最多持有角色集合中max_count个角色，max_count为1时即互斥
+	RoleConstraintRoleCardinality = "role_cardinality" // 角色集合中每个角色最多max_count个持有人
+	RoleConstraintUserCardinality = "user_cardinality" // 用户最多持有max_count个角色
+)
+
+// 职责分离约束校验方式
+const (
+	RoleConstraintStatic  = "static"  // 所有未过期的授权都计入，无论有效期是否重叠
+	RoleConstraintDynamic = "dynamic" // 仅有效期重叠的授权计入，可在不同时段持有互斥角色
+)
+
+// roleConstraintsLockQuery 获取当前租户的职责分离事务锁，锁在事务结束时释放
+const roleConstraintsLockQuery = "select pg_advisory_xact_lock(hashtext('iam.role_constraints'), $1::int)"
+
+var _ RoleConstraintsModel = (*customRoleConstraintsModel)(nil)
+
+type (
+	// RoleConstraintsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customRoleConstraintsModel.
+	RoleConstraintsModel interface {
+		roleConstraintsModel
+		FindAll(ctx context.Context) ([]*RoleConstraints, error)              // 查询当前租户的所有约束，按编码排序
+		Locked(ctx context.Context, fn func(ctx context.Context) error) error // 在当前租户的职责分离锁内执行fn
+	}
+
+	customRoleConstraintsModel struct {
+		*defaultRoleConstraintsModel
+	}
+)
+
+// NewRoleConstraintsModel returns a model for the database table.
+func NewRoleConstraintsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) RoleConstraintsModel {
+	return &customRoleConstraintsModel{
+		defaultRoleConstraintsModel: newRoleConstraintsModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，约束归属当前调用的租户，并使用RETURNING子句获取插入后的ID
+func (m *customRoleConstraintsModel) Insert(ctx context.Context, data *RoleConstraints) (sql.Result, error) {
+	var insertedID int64
+	data.TenantId = TenantIdFromContext(ctx)
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id", m.table, roleConstraintsRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.TenantId, data.Code, data.Name, data.Description, data.ConstraintType, data.Enforcement, data.MaxCount, data.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+
+	// 清除相关缓存
+	iamRoleConstraintsIdKey := fmt.Sprintf("%s%v", cacheIamRoleConstraintsIdPrefix, insertedID)
+	iamRoleConstraintsTenantIdCodeKey := fmt.Sprintf("%s%v:%v", cacheIamRoleConstraintsTenantIdCodePrefix, data.TenantId, data.Code)
+	_ = m.DelCacheCtx(ctx, iamRoleConstraintsIdKey, iamRoleConstraintsTenantIdCodeKey)
+
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// FindOne 重写FindOne方法，校验约束属于当前租户
+func (m *customRoleConstraintsModel) FindOne(ctx context.Context, id int64) (*RoleConstraints, error) {
+	resp, err := m.defaultRoleConstraintsModel.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
+// FindAll 查询当前租户的所有约束，按编码排序
+func (m *customRoleConstraintsModel) FindAll(ctx context.Context) ([]*RoleConstraints, error) {
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 order by code", roleConstraintsRows, m.table)
+	var resp []*RoleConstraints
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// Locked 在当前租户的职责分离锁内执行fn，同一租户的约束校验与授予串行执行，fn返回后释放锁
+// 锁由单独的事务持有，fn中的写入在锁释放前各自提交；等待锁超时时返回错误，避免连接池耗尽时互相等待
+func (m *customRoleConstraintsModel) Locked(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		if _, err := session.ExecCtx(ctx, "set local lock_timeout = '10s'"); err != nil {
+			return err
+		}
+		if _, err := session.ExecCtx(ctx, roleConstraintsLockQuery, TenantIdFromContext(ctx)); err != nil {
+			return err
+		}
+		return fn(ctx)
+	})
+}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ RoleDepartmentsModel = (*customRoleDepartmentsModel)(nil)
+
+type (
+	// RoleDepartmentsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customRoleDepartmentsModel.
+	RoleDepartmentsModel interface {
+		roleDepartmentsModel
+		ReplaceDepartments(ctx context.Context, roleId int64, departmentIds []int64, createdBy sql.NullInt64) error // 替换角色的自定义数据权限部门
+		RemoveAllRoleDepartments(ctx context.Context, roleId int64) error                                           // 移除角色的所有自定义数据权限部门
+
+		FindByRoleId(ctx context.Context, roleId int64) ([]*RoleDepartments, error)     // 查询角色的自定义数据权限部门
+		FindByRoleIds(ctx context.Context, roleIds []int64) ([]*RoleDepartments, error) // 批量查询多个角色的自定义数据权限部门
+	}
+
+	customRoleDepartmentsModel struct {
+		*defaultRoleDepartmentsModel
+	}
+)
+
+// NewRoleDepartmentsModel returns a model for the database table.
+func NewRoleDepartmentsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) RoleDepartmentsModel {
+	return &customRoleDepartmentsModel{
+		defaultRoleDepartmentsModel: newRoleDepartmentsModel(conn, c, opts...),
+	}
+}
+
+// ReplaceDepartments 替换角色的自定义数据权限部门，不在新列表中的关联会被移除
+func (m *customRoleDepartmentsModel) ReplaceDepartments(ctx context.Context, roleId int64, departmentIds []int64, createdBy sql.NullInt64) error {
+	existing, err := m.FindByRoleId(ctx, roleId)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[int64]bool, len(departmentIds))
+	for _, departmentId := range departmentIds {
+		wanted[departmentId] = true
+	}
+
+	// 移除不再需要的关联
+	existingMap := make(map[int64]bool, len(existing))
+	for _, rd := range existing {
+		existingMap[rd.DepartmentId] = true
+		if wanted[rd.DepartmentId] {
+			continue
+		}
+		if err := m.Delete(ctx, rd.Id); err != nil {
+			return err
+		}
+	}
+
+	// 只插入不存在的关联
+	for _, departmentId := range departmentIds {
+		if existingMap[departmentId] {
+			continue
+		}
+		existingMap[departmentId] = true
+		roleDepartment := &RoleDepartments{
+			RoleId:       roleId,
+			DepartmentId: departmentId,
+			CreatedBy:    createdBy,
+		}
+		if _, err := m.Insert(ctx, roleDepartment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveAllRoleDepartments 移除角色的所有自定义数据权限部门
+func (m *customRoleDepartmentsModel) RemoveAllRoleDepartments(ctx context.Context, roleId int64) error {
+	toDelete, err := m.FindByRoleId(ctx, roleId)
+	if err != nil {
+		return err
+	}
+	if len(toDelete) == 0 {
+		return nil
+	}
+
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		deleteQuery := fmt.Sprintf("delete from %s where role_id = $1", m.table)
+		return conn.ExecCtx(ctx, deleteQuery, roleId)
+	}, m.cacheKeys(toDelete)...)
+	return err
+}
+
+// FindByRoleId 查询角色的自定义数据权限部门
+func (m *customRoleDepartmentsModel) FindByRoleId(ctx context.Context, roleId int64) ([]*RoleDepartments, error) {
+	query := fmt.Sprintf("select %s from %s where role_id = $1 order by department_id", roleDepartmentsRows, m.table)
+	var resp []*RoleDepartments
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, roleId)
+	return resp, err
+}
+
+// FindByRoleIds 批量查询多个角色的自定义数据权限部门
+func (m *customRoleDepartmentsModel) FindByRoleIds(ctx context.Context, roleIds []int64) ([]*RoleDepartments, error) {
+	if len(roleIds) == 0 {
+		return []*RoleDepartments{}, nil
+	}
+
+	// 构建占位符
+	placeholders := make([]string, len(roleIds))
+	args := make([]interface{}, len(roleIds))
+	for i, roleId := range roleIds {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = roleId
+	}
+
+	query := fmt.Sprintf("select %s from %s where role_id IN (%s) order by role_id, department_id", roleDepartmentsRows, m.table, strings.Join(placeholders, ","))
+	var resp []*RoleDepartments
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// cacheKeys 构建角色部门关联记录的缓存键
+func (m *customRoleDepartmentsModel) cacheKeys(roleDepartments []*RoleDepartments) []string {
+	keys := make([]string, 0, len(roleDepartments)*2)
+	for _, rd := range roleDepartments {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheIamRoleDepartmentsIdPrefix, rd.Id))
+		keys = append(keys, fmt.Sprintf("%s%v:%v", cacheIamRoleDepartmentsRoleIdDepartmentIdPrefix, rd.RoleId, rd.DepartmentId))
+	}
+	return keys
+}
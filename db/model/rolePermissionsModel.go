@@ -6,11 +6,18 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
+// 角色权限授权效果，同一权限同时存在允许和拒绝授权时拒绝优先
+const (
+	EffectAllow = "allow"
+	EffectDeny  = "deny"
+)
+
 var _ RolePermissionsModel = (*customRolePermissionsModel)(nil)
 
 type (
@@ -31,8 +38,12 @@ type (
 		FindByRoleIds(ctx context.Context, roleIds []int64) ([]*RolePermissions, error)
 		FindRolesByPermissionIds(ctx context.Context, permissionIds []int64) ([]*RolePermissions, error)
 		CountRolesByPermissionId(ctx context.Context, permissionId int64) (int64, error)
+		CountRolesByPermissionIdAcrossTenants(ctx context.Context, permissionId int64) (int64, error) // 统计所有租户中拥有指定权限的角色数量，用于全局权限
 		CountPermissionsByRoleId(ctx context.Context, roleId int64) (int64, error)
 		ReplaceRolePermissions(ctx context.Context, roleId int64, permissionIds []int64, createdBy sql.NullInt64) error
+		FindExpired(ctx context.Context, limit int) ([]*RolePermissions, error)            // 查询所有租户中已过期的角色权限，供后台清理
+		DeleteExpired(ctx context.Context, data *RolePermissions) (bool, error)            // 删除已过期的角色权限，期间被重新授权时不删除
+		FindAllByRoleIds(ctx context.Context, roleIds []int64) ([]*RolePermissions, error) // 批量查询多个角色的全部授权，包括已过期和尚未生效的授权
 	}
 
 	customRolePermissionsModel struct {
@@ -47,6 +58,27 @@ func NewRolePermissionsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache
 	}
 }
 
+// Insert 重写Insert方法，关联归属当前调用的租户，未指定授权效果时为允许
+func (m *customRolePermissionsModel) Insert(ctx context.Context, data *RolePermissions) (sql.Result, error) {
+	data.TenantId = TenantIdFromContext(ctx)
+	if data.Effect == "" {
+		data.Effect = EffectAllow
+	}
+	return m.defaultRolePermissionsModel.Insert(ctx, data)
+}
+
+// FindOneByRoleIdPermissionId 重写FindOneByRoleIdPermissionId方法，校验关联属于当前租户
+func (m *customRolePermissionsModel) FindOneByRoleIdPermissionId(ctx context.Context, roleId int64, permissionId int64) (*RolePermissions, error) {
+	resp, err := m.defaultRolePermissionsModel.FindOneByRoleIdPermissionId(ctx, roleId, permissionId)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
 // AssignPermission 为角色分配权限
 func (m *customRolePermissionsModel) AssignPermission(ctx context.Context, roleId, permissionId int64, createdBy sql.NullInt64) error {
 	// 检查是否已经存在
@@ -114,40 +146,40 @@ func (m *customRolePermissionsModel) RemovePermissions(ctx context.Context, role
 
 // RemoveAllRolePermissions 移除角色的所有权限
 func (m *customRolePermissionsModel) RemoveAllRolePermissions(ctx context.Context, roleId int64) error {
-	query := fmt.Sprintf("delete from %s where role_id = $1", m.table)
-	_, err := m.ExecNoCacheCtx(ctx, query, roleId)
+	query := fmt.Sprintf("delete from %s where role_id = $1 and tenant_id = $2", m.table)
+	_, err := m.ExecNoCacheCtx(ctx, query, roleId, TenantIdFromContext(ctx))
 	return err
 }
 
-// FindByRoleId 查询角色拥有的所有权限
+// FindByRoleId 查询角色当前生效的所有权限
 func (m *customRolePermissionsModel) FindByRoleId(ctx context.Context, roleId int64) ([]*RolePermissions, error) {
-	query := fmt.Sprintf("select %s from %s where role_id = $1 order by created_at", rolePermissionsRows, m.table)
+	query := fmt.Sprintf("select %s from %s where role_id = $1 and tenant_id = $2 and %s order by created_at", rolePermissionsRows, m.table, GrantEffectiveClause)
 	var resp []*RolePermissions
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, roleId)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, roleId, TenantIdFromContext(ctx))
 	return resp, err
 }
 
-// FindRolesByPermissionId 查询拥有指定权限的所有角色
+// FindRolesByPermissionId 查询当前拥有指定权限的所有角色
 func (m *customRolePermissionsModel) FindRolesByPermissionId(ctx context.Context, permissionId int64) ([]*RolePermissions, error) {
-	query := fmt.Sprintf("select %s from %s where permission_id = $1 order by created_at", rolePermissionsRows, m.table)
+	query := fmt.Sprintf("select %s from %s where permission_id = $1 and tenant_id = $2 and %s order by created_at", rolePermissionsRows, m.table, GrantEffectiveClause)
 	var resp []*RolePermissions
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, permissionId)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, permissionId, TenantIdFromContext(ctx))
 	return resp, err
 }
 
-// HasPermission 检查角色是否拥有指定权限
+// HasPermission 检查角色当前是否拥有指定权限
 func (m *customRolePermissionsModel) HasPermission(ctx context.Context, roleId, permissionId int64) (bool, error) {
-	_, err := m.FindOneByRoleIdPermissionId(ctx, roleId, permissionId)
+	rolePermission, err := m.FindOneByRoleIdPermissionId(ctx, roleId, permissionId)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			return false, nil
 		}
 		return false, err
 	}
-	return true, nil
+	return rolePermission.Validity().Effective(time.Now()), nil
 }
 
-// HasAnyPermission 检查角色是否拥有任意一个指定权限
+// HasAnyPermission 检查角色当前是否拥有任意一个指定权限
 func (m *customRolePermissionsModel) HasAnyPermission(ctx context.Context, roleId int64, permissionIds []int64) (bool, error) {
 	if len(permissionIds) == 0 {
 		return false, nil
@@ -155,14 +187,15 @@ func (m *customRolePermissionsModel) HasAnyPermission(ctx context.Context, roleI
 
 	// 构建占位符
 	placeholders := make([]string, len(permissionIds))
-	args := make([]interface{}, len(permissionIds)+1)
+	args := make([]interface{}, len(permissionIds)+2)
 	args[0] = roleId
+	args[1] = TenantIdFromContext(ctx)
 	for i, permissionId := range permissionIds {
-		placeholders[i] = fmt.Sprintf("$%d", i+2)
-		args[i+1] = permissionId
+		placeholders[i] = fmt.Sprintf("$%d", i+3)
+		args[i+2] = permissionId
 	}
 
-	query := fmt.Sprintf("select count(1) from %s where role_id = $1 and permission_id IN (%s) limit 1", m.table, strings.Join(placeholders, ","))
+	query := fmt.Sprintf("select count(1) from %s where role_id = $1 and tenant_id = $2 and permission_id IN (%s) and %s limit 1", m.table, strings.Join(placeholders, ","), GrantEffectiveClause)
 	var count int64
 	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
 	if err != nil {
@@ -171,7 +204,7 @@ func (m *customRolePermissionsModel) HasAnyPermission(ctx context.Context, roleI
 	return count > 0, nil
 }
 
-// HasAllPermissions 检查角色是否拥有所有指定权限
+// HasAllPermissions 检查角色当前是否拥有所有指定权限
 func (m *customRolePermissionsModel) HasAllPermissions(ctx context.Context, roleId int64, permissionIds []int64) (bool, error) {
 	if len(permissionIds) == 0 {
 		return true, nil
@@ -179,14 +212,15 @@ func (m *customRolePermissionsModel) HasAllPermissions(ctx context.Context, role
 
 	// 构建占位符
 	placeholders := make([]string, len(permissionIds))
-	args := make([]interface{}, len(permissionIds)+1)
+	args := make([]interface{}, len(permissionIds)+2)
 	args[0] = roleId
+	args[1] = TenantIdFromContext(ctx)
 	for i, permissionId := range permissionIds {
-		placeholders[i] = fmt.Sprintf("$%d", i+2)
-		args[i+1] = permissionId
+		placeholders[i] = fmt.Sprintf("$%d", i+3)
+		args[i+2] = permissionId
 	}
 
-	query := fmt.Sprintf("select count(1) from %s where role_id = $1 and permission_id IN (%s)", m.table, strings.Join(placeholders, ","))
+	query := fmt.Sprintf("select count(1) from %s where role_id = $1 and tenant_id = $2 and permission_id IN (%s) and %s", m.table, strings.Join(placeholders, ","), GrantEffectiveClause)
 	var count int64
 	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
 	if err != nil {
@@ -195,7 +229,7 @@ func (m *customRolePermissionsModel) HasAllPermissions(ctx context.Context, role
 	return count == int64(len(permissionIds)), nil
 }
 
-// FindByRoleIds 批量查询多个角色的权限
+// FindByRoleIds 批量查询多个角色当前生效的权限，已禁用或已删除角色的授权不生效，不包含在结果中
 func (m *customRolePermissionsModel) FindByRoleIds(ctx context.Context, roleIds []int64) ([]*RolePermissions, error) {
 	if len(roleIds) == 0 {
 		return []*RolePermissions{}, nil
@@ -203,19 +237,22 @@ func (m *customRolePermissionsModel) FindByRoleIds(ctx context.Context, roleIds
 
 	// 构建占位符
 	placeholders := make([]string, len(roleIds))
-	args := make([]interface{}, len(roleIds))
+	args := make([]interface{}, len(roleIds)+1)
+	args[0] = TenantIdFromContext(ctx)
 	for i, roleId := range roleIds {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = roleId
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = roleId
 	}
 
-	query := fmt.Sprintf("select %s from %s where role_id IN (%s) order by role_id, created_at", rolePermissionsRows, m.table, strings.Join(placeholders, ","))
+	query := fmt.Sprintf(`select %s from %s where tenant_id = $1 and role_id IN (%s) and %s
+		and role_id IN (select id from "iam"."roles" where tenant_id = $1 and deleted_at IS NULL and disabled_at IS NULL)
+		order by role_id, created_at`, rolePermissionsRows, m.table, strings.Join(placeholders, ","), GrantEffectiveClause)
 	var resp []*RolePermissions
 	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
 	return resp, err
 }
 
-// FindRolesByPermissionIds 批量查询拥有指定权限的角色
+// FindRolesByPermissionIds 批量查询当前拥有指定权限的角色
 func (m *customRolePermissionsModel) FindRolesByPermissionIds(ctx context.Context, permissionIds []int64) ([]*RolePermissions, error) {
 	if len(permissionIds) == 0 {
 		return []*RolePermissions{}, nil
@@ -223,13 +260,14 @@ func (m *customRolePermissionsModel) FindRolesByPermissionIds(ctx context.Contex
 
 	// 构建占位符
 	placeholders := make([]string, len(permissionIds))
-	args := make([]interface{}, len(permissionIds))
+	args := make([]interface{}, len(permissionIds)+1)
+	args[0] = TenantIdFromContext(ctx)
 	for i, permissionId := range permissionIds {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = permissionId
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = permissionId
 	}
 
-	query := fmt.Sprintf("select %s from %s where permission_id IN (%s) order by permission_id, created_at", rolePermissionsRows, m.table, strings.Join(placeholders, ","))
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and permission_id IN (%s) and %s order by permission_id, created_at", rolePermissionsRows, m.table, strings.Join(placeholders, ","), GrantEffectiveClause)
 	var resp []*RolePermissions
 	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
 	return resp, err
@@ -237,6 +275,15 @@ func (m *customRolePermissionsModel) FindRolesByPermissionIds(ctx context.Contex
 
 // CountRolesByPermissionId 统计拥有指定权限的角色数量
 func (m *customRolePermissionsModel) CountRolesByPermissionId(ctx context.Context, permissionId int64) (int64, error) {
+	query := fmt.Sprintf("select count(1) from %s where permission_id = $1 and tenant_id = $2", m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, permissionId, TenantIdFromContext(ctx))
+	return count, err
+}
+
+// CountRolesByPermissionIdAcrossTenants 统计所有租户中拥有指定权限的角色数量
+// 全局系统权限被所有租户共享，删除前需要检查所有租户的使用情况
+func (m *customRolePermissionsModel) CountRolesByPermissionIdAcrossTenants(ctx context.Context, permissionId int64) (int64, error) {
 	query := fmt.Sprintf("select count(1) from %s where permission_id = $1", m.table)
 	var count int64
 	err := m.QueryRowNoCacheCtx(ctx, &count, query, permissionId)
@@ -245,9 +292,9 @@ func (m *customRolePermissionsModel) CountRolesByPermissionId(ctx context.Contex
 
 // CountPermissionsByRoleId 统计角色拥有的权限数量
 func (m *customRolePermissionsModel) CountPermissionsByRoleId(ctx context.Context, roleId int64) (int64, error) {
-	query := fmt.Sprintf("select count(1) from %s where role_id = $1", m.table)
+	query := fmt.Sprintf("select count(1) from %s where role_id = $1 and tenant_id = $2", m.table)
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, roleId)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, roleId, TenantIdFromContext(ctx))
 	return count, err
 }
 
@@ -264,3 +311,47 @@ func (m *customRolePermissionsModel) ReplaceRolePermissions(ctx context.Context,
 	}
 	return nil
 }
+
+// FindExpired 查询所有租户中已过期的角色权限，按失效时间排序
+func (m *customRolePermissionsModel) FindExpired(ctx context.Context, limit int) ([]*RolePermissions, error) {
+	query := fmt.Sprintf("select %s from %s where valid_until IS NOT NULL and valid_until <= NOW() order by valid_until limit $1", rolePermissionsRows, m.table)
+	var resp []*RolePermissions
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, limit)
+	return resp, err
+}
+
+// DeleteExpired 删除已过期的角色权限，查询后被重新授权的关联不会删除，返回是否实际删除
+func (m *customRolePermissionsModel) DeleteExpired(ctx context.Context, data *RolePermissions) (bool, error) {
+	iamRolePermissionsIdKey := fmt.Sprintf("%s%v", cacheIamRolePermissionsIdPrefix, data.Id)
+	iamRolePermissionsRoleIdPermissionIdKey := fmt.Sprintf("%s%v:%v", cacheIamRolePermissionsRoleIdPermissionIdPrefix, data.RoleId, data.PermissionId)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("delete from %s where id = $1 and valid_until IS NOT NULL and valid_until <= NOW()", m.table)
+		return conn.ExecCtx(ctx, query, data.Id)
+	}, iamRolePermissionsIdKey, iamRolePermissionsRoleIdPermissionIdKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// FindAllByRoleIds 批量查询多个角色的全部授权，包括已过期但尚未清理和尚未生效的授权，用于解释权限决策
+func (m *customRolePermissionsModel) FindAllByRoleIds(ctx context.Context, roleIds []int64) ([]*RolePermissions, error) {
+	if len(roleIds) == 0 {
+		return []*RolePermissions{}, nil
+	}
+
+	// 构建占位符
+	placeholders := make([]string, len(roleIds))
+	args := make([]interface{}, len(roleIds)+1)
+	args[0] = TenantIdFromContext(ctx)
+	for i, roleId := range roleIds {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = roleId
+	}
+
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and role_id IN (%s) order by role_id, created_at", rolePermissionsRows, m.table, strings.Join(placeholders, ","))
+	var resp []*RolePermissions
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
@@ -44,8 +44,13 @@ type (
 
 	RolePermissions struct {
 		Id           int64         `db:"id"`            // 主键ID
+		TenantId     int64         `db:"tenant_id"`     // 所属租户ID，与角色所属租户一致
 		RoleId       int64         `db:"role_id"`       // 角色ID，外键关联roles表
 		PermissionId int64         `db:"permission_id"` // 权限ID，外键关联permissions表
+		Effect       string        `db:"effect"`        // 授权效果：allow-允许，deny-拒绝，拒绝优先于允许
+		Condition    string        `db:"condition"`     // 授权条件表达式（CEL），为空表示无条件授予
+		ValidFrom    sql.NullTime  `db:"valid_from"`    // 生效时间，为空表示立即生效
+		ValidUntil   sql.NullTime  `db:"valid_until"`   // 失效时间，为空表示永久有效，过期后由后台任务清理
 		CreatedAt    time.Time     `db:"created_at"`    // 关联创建时间
 		CreatedBy    sql.NullInt64 `db:"created_by"`    // 创建人ID
 	}
@@ -114,8 +119,8 @@ func (m *defaultRolePermissionsModel) Insert(ctx context.Context, data *RolePerm
 	iamRolePermissionsIdKey := fmt.Sprintf("%s%v", cacheIamRolePermissionsIdPrefix, data.Id)
 	iamRolePermissionsRoleIdPermissionIdKey := fmt.Sprintf("%s%v:%v", cacheIamRolePermissionsRoleIdPermissionIdPrefix, data.RoleId, data.PermissionId)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3)", m.table, rolePermissionsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.RoleId, data.PermissionId, data.CreatedBy)
+		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8)", m.table, rolePermissionsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.TenantId, data.RoleId, data.PermissionId, data.Effect, data.Condition, data.ValidFrom, data.ValidUntil, data.CreatedBy)
 	}, iamRolePermissionsIdKey, iamRolePermissionsRoleIdPermissionIdKey)
 	return ret, err
 }
@@ -130,7 +135,7 @@ func (m *defaultRolePermissionsModel) Update(ctx context.Context, newData *RoleP
 	iamRolePermissionsRoleIdPermissionIdKey := fmt.Sprintf("%s%v:%v", cacheIamRolePermissionsRoleIdPermissionIdPrefix, data.RoleId, data.PermissionId)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where id = $1", m.table, rolePermissionsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.Id, newData.RoleId, newData.PermissionId, newData.CreatedBy)
+		return conn.ExecCtx(ctx, query, newData.Id, newData.TenantId, newData.RoleId, newData.PermissionId, newData.Effect, newData.Condition, newData.ValidFrom, newData.ValidUntil, newData.CreatedBy)
 	}, iamRolePermissionsIdKey, iamRolePermissionsRoleIdPermissionIdKey)
 	return err
 }
@@ -13,6 +13,24 @@ import (
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
+// 角色数据权限范围
+const (
+	DataScopeAll          = "all"            // 全部数据
+	DataScopeDeptAndChild = "dept_and_child" // 本部门及以下
+	DataScopeDept         = "dept"           // 仅本部门
+	DataScopeSelf         = "self"           // 仅本人
+	DataScopeCustom       = "custom"         // 自定义部门
+)
+
+// IsValidDataScope 检查数据权限范围是否合法
+func IsValidDataScope(scope string) bool {
+	switch scope {
+	case DataScopeAll, DataScopeDeptAndChild, DataScopeDept, DataScopeSelf, DataScopeCustom:
+		return true
+	}
+	return false
+}
+
 var _ RolesModel = (*customRolesModel)(nil)
 
 type (
@@ -45,7 +63,11 @@ type (
 		ExistsByName(ctx context.Context, name string, excludeId int64) (bool, error)
 
 		FindActiveRoles(ctx context.Context) ([]*Roles, error)
+		FindAll(ctx context.Context) ([]*Roles, error) // 查询当前租户的全部角色，包括已禁用和已删除的角色
 		FindRolesBySortOrder(ctx context.Context, limit int) ([]*Roles, error)
+		FindActiveWithConditions(ctx context.Context, conditions []string, args []any, limit, offset int32) ([]*Roles, error) // 按条件分页查询活跃角色
+		CountActiveWithConditions(ctx context.Context, conditions []string, args []any) (int64, error)
+		Touch(ctx context.Context, id int64) error // 刷新updated_at，用于关联数据变化时更新版本
 
 		// TODO: 低优先级方法
 		// TODO: FindRolesWithPagination(ctx context.Context, page, pageSize int) ([]*Roles, int64, error)
@@ -79,6 +101,9 @@ func (m *customRolesModel) FindActiveById(ctx context.Context, id int64) (*Roles
 	})
 	switch {
 	case err == nil:
+		if resp.TenantId != TenantIdFromContext(ctx) {
+			return nil, ErrNotFound
+		}
 		return &resp, nil
 	case errors.Is(err, sqlc.ErrNotFound):
 		return nil, ErrNotFound
@@ -89,9 +114,9 @@ func (m *customRolesModel) FindActiveById(ctx context.Context, id int64) (*Roles
 
 // FindById 按ID查询角色（包含已删除）
 func (m *customRolesModel) FindById(ctx context.Context, id int64) (*Roles, error) {
-	query := fmt.Sprintf("select %s from %s where id = $1 limit 1", rolesRows, m.table)
+	query := fmt.Sprintf("select %s from %s where id = $1 and tenant_id = $2 limit 1", rolesRows, m.table)
 	var resp Roles
-	err := m.QueryRowNoCacheCtx(ctx, &resp, query, id)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, id, TenantIdFromContext(ctx))
 	switch {
 	case err == nil:
 		return &resp, nil
@@ -104,11 +129,12 @@ func (m *customRolesModel) FindById(ctx context.Context, id int64) (*Roles, erro
 
 // FindActiveByCode 按代码查询活跃角色
 func (m *customRolesModel) FindActiveByCode(ctx context.Context, code string) (*Roles, error) {
-	iamRolesCodeKey := fmt.Sprintf("%s%v", cacheIamRolesCodePrefix, code)
+	tenantId := TenantIdFromContext(ctx)
+	iamRolesTenantIdCodeKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdCodePrefix, tenantId, code)
 	var resp Roles
-	err := m.QueryRowCtx(ctx, &resp, iamRolesCodeKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
-		query := fmt.Sprintf("select %s from %s where code = $1 and deleted_at IS NULL and disabled_at IS NULL limit 1", rolesRows, m.table)
-		return conn.QueryRowCtx(ctx, v, query, code)
+	err := m.QueryRowCtx(ctx, &resp, iamRolesTenantIdCodeKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where tenant_id = $1 and code = $2 and deleted_at IS NULL and disabled_at IS NULL limit 1", rolesRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, tenantId, code)
 	})
 	switch {
 	case err == nil:
@@ -122,11 +148,12 @@ func (m *customRolesModel) FindActiveByCode(ctx context.Context, code string) (*
 
 // FindActiveByName 按名称查询活跃角色
 func (m *customRolesModel) FindActiveByName(ctx context.Context, name string) (*Roles, error) {
-	iamRolesNameKey := fmt.Sprintf("%s%v", cacheIamRolesNamePrefix, name)
+	tenantId := TenantIdFromContext(ctx)
+	iamRolesTenantIdNameKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdNamePrefix, tenantId, name)
 	var resp Roles
-	err := m.QueryRowCtx(ctx, &resp, iamRolesNameKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
-		query := fmt.Sprintf("select %s from %s where name = $1 and deleted_at IS NULL and disabled_at IS NULL limit 1", rolesRows, m.table)
-		return conn.QueryRowCtx(ctx, v, query, name)
+	err := m.QueryRowCtx(ctx, &resp, iamRolesTenantIdNameKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where tenant_id = $1 and name = $2 and deleted_at IS NULL and disabled_at IS NULL limit 1", rolesRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, tenantId, name)
 	})
 	switch {
 	case err == nil:
@@ -140,9 +167,9 @@ func (m *customRolesModel) FindActiveByName(ctx context.Context, name string) (*
 
 // FindByCode 按代码查询角色（包含已删除）
 func (m *customRolesModel) FindByCode(ctx context.Context, code string) (*Roles, error) {
-	query := fmt.Sprintf("select %s from %s where code = $1 limit 1", rolesRows, m.table)
+	query := fmt.Sprintf("select %s from %s where code = $1 and tenant_id = $2 limit 1", rolesRows, m.table)
 	var resp Roles
-	err := m.QueryRowNoCacheCtx(ctx, &resp, query, code)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, code, TenantIdFromContext(ctx))
 	switch {
 	case err == nil:
 		return &resp, nil
@@ -155,9 +182,9 @@ func (m *customRolesModel) FindByCode(ctx context.Context, code string) (*Roles,
 
 // FindByName 按名称查询角色（包含已删除）
 func (m *customRolesModel) FindByName(ctx context.Context, name string) (*Roles, error) {
-	query := fmt.Sprintf("select %s from %s where name = $1 limit 1", rolesRows, m.table)
+	query := fmt.Sprintf("select %s from %s where name = $1 and tenant_id = $2 limit 1", rolesRows, m.table)
 	var resp Roles
-	err := m.QueryRowNoCacheCtx(ctx, &resp, query, name)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, name, TenantIdFromContext(ctx))
 	switch {
 	case err == nil:
 		return &resp, nil
@@ -184,8 +211,8 @@ func (m *customRolesModel) SoftDelete(ctx context.Context, id int64) error {
 func (m *customRolesModel) Restore(ctx context.Context, id int64) error {
 	iamRolesIdKey := fmt.Sprintf("%s%v", cacheIamRolesIdPrefix, id)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set deleted_at = NULL where id = $1", m.table)
-		return conn.ExecCtx(ctx, query, id)
+		query := fmt.Sprintf("update %s set deleted_at = NULL where id = $1 and tenant_id = $2", m.table)
+		return conn.ExecCtx(ctx, query, id, TenantIdFromContext(ctx))
 	}, iamRolesIdKey)
 	return err
 }
@@ -194,8 +221,8 @@ func (m *customRolesModel) Restore(ctx context.Context, id int64) error {
 func (m *customRolesModel) Disable(ctx context.Context, id int64) error {
 	iamRolesIdKey := fmt.Sprintf("%s%v", cacheIamRolesIdPrefix, id)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set disabled_at = NOW() where id = $1 and deleted_at IS NULL and disabled_at IS NULL", m.table)
-		return conn.ExecCtx(ctx, query, id)
+		query := fmt.Sprintf("update %s set disabled_at = NOW() where id = $1 and tenant_id = $2 and deleted_at IS NULL and disabled_at IS NULL", m.table)
+		return conn.ExecCtx(ctx, query, id, TenantIdFromContext(ctx))
 	}, iamRolesIdKey)
 	return err
 }
@@ -204,8 +231,8 @@ func (m *customRolesModel) Disable(ctx context.Context, id int64) error {
 func (m *customRolesModel) Enable(ctx context.Context, id int64) error {
 	iamRolesIdKey := fmt.Sprintf("%s%v", cacheIamRolesIdPrefix, id)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set disabled_at = NULL where id = $1 and deleted_at IS NULL", m.table)
-		return conn.ExecCtx(ctx, query, id)
+		query := fmt.Sprintf("update %s set disabled_at = NULL where id = $1 and tenant_id = $2 and deleted_at IS NULL", m.table)
+		return conn.ExecCtx(ctx, query, id, TenantIdFromContext(ctx))
 	}, iamRolesIdKey)
 	return err
 }
@@ -216,12 +243,13 @@ func (m *customRolesModel) BatchSoftDelete(ctx context.Context, ids []int64) err
 		return nil
 	}
 
-	// 构建占位符
+	// 构建占位符，$1为租户ID
 	placeholders := make([]string, len(ids))
-	args := make([]interface{}, len(ids))
+	args := make([]interface{}, len(ids)+1)
+	args[0] = TenantIdFromContext(ctx)
 	for i, id := range ids {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = id
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = id
 	}
 
 	// 清除相关缓存
@@ -231,7 +259,7 @@ func (m *customRolesModel) BatchSoftDelete(ctx context.Context, ids []int64) err
 	}
 
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set deleted_at = NOW() where id IN (%s) and deleted_at IS NULL",
+		query := fmt.Sprintf("update %s set deleted_at = NOW() where tenant_id = $1 and id IN (%s) and deleted_at IS NULL",
 			m.table, strings.Join(placeholders, ","))
 		return conn.ExecCtx(ctx, query, args...)
 	}, keys...)
@@ -244,12 +272,13 @@ func (m *customRolesModel) BatchDisable(ctx context.Context, ids []int64) error
 		return nil
 	}
 
-	// 构建占位符
+	// 构建占位符，$1为租户ID
 	placeholders := make([]string, len(ids))
-	args := make([]interface{}, len(ids))
+	args := make([]interface{}, len(ids)+1)
+	args[0] = TenantIdFromContext(ctx)
 	for i, id := range ids {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = id
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = id
 	}
 
 	// 清除相关缓存
@@ -259,7 +288,7 @@ func (m *customRolesModel) BatchDisable(ctx context.Context, ids []int64) error
 	}
 
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set disabled_at = NOW() where id IN (%s) and deleted_at IS NULL and disabled_at IS NULL",
+		query := fmt.Sprintf("update %s set disabled_at = NOW() where tenant_id = $1 and id IN (%s) and deleted_at IS NULL and disabled_at IS NULL",
 			m.table, strings.Join(placeholders, ","))
 		return conn.ExecCtx(ctx, query, args...)
 	}, keys...)
@@ -268,9 +297,9 @@ func (m *customRolesModel) BatchDisable(ctx context.Context, ids []int64) error
 
 // ExistsByCode 检查角色代码是否存在（排除指定ID）
 func (m *customRolesModel) ExistsByCode(ctx context.Context, code string, excludeId int64) (bool, error) {
-	query := fmt.Sprintf("select count(1) from %s where code = $1 and id != $2 and deleted_at IS NULL", m.table)
+	query := fmt.Sprintf("select count(1) from %s where code = $1 and id != $2 and tenant_id = $3 and deleted_at IS NULL", m.table)
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, code, excludeId)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, code, excludeId, TenantIdFromContext(ctx))
 	if err != nil {
 		return false, err
 	}
@@ -279,9 +308,9 @@ func (m *customRolesModel) ExistsByCode(ctx context.Context, code string, exclud
 
 // ExistsByName 检查角色名称是否存在（排除指定ID）
 func (m *customRolesModel) ExistsByName(ctx context.Context, name string, excludeId int64) (bool, error) {
-	query := fmt.Sprintf("select count(1) from %s where name = $1 and id != $2 and deleted_at IS NULL", m.table)
+	query := fmt.Sprintf("select count(1) from %s where name = $1 and id != $2 and tenant_id = $3 and deleted_at IS NULL", m.table)
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, name, excludeId)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, name, excludeId, TenantIdFromContext(ctx))
 	if err != nil {
 		return false, err
 	}
@@ -290,55 +319,64 @@ func (m *customRolesModel) ExistsByName(ctx context.Context, name string, exclud
 
 // FindActiveRoles 查询所有活跃角色
 func (m *customRolesModel) FindActiveRoles(ctx context.Context) ([]*Roles, error) {
-	query := fmt.Sprintf("select %s from %s where deleted_at IS NULL and disabled_at IS NULL order by sort_order, created_at", rolesRows, m.table)
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and deleted_at IS NULL and disabled_at IS NULL order by sort_order, created_at", rolesRows, m.table)
+	var resp []*Roles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// FindAll 查询当前租户的全部角色，包括已禁用和已删除的角色
+func (m *customRolesModel) FindAll(ctx context.Context) ([]*Roles, error) {
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 order by sort_order, created_at", rolesRows, m.table)
 	var resp []*Roles
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx))
 	return resp, err
 }
 
 // FindActiveWithPagination 分页查询活跃角色
 func (m *customRolesModel) FindActiveWithPagination(ctx context.Context, limit, offset int32) ([]*Roles, error) {
-	query := fmt.Sprintf("select %s from %s where deleted_at IS NULL and disabled_at IS NULL order by sort_order, created_at limit $1 offset $2", rolesRows, m.table)
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and deleted_at IS NULL and disabled_at IS NULL order by sort_order, created_at limit $2 offset $3", rolesRows, m.table)
 	var resp []*Roles
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, limit, offset)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx), limit, offset)
 	return resp, err
 }
 
 // CountActive 统计活跃角色数量
 func (m *customRolesModel) CountActive(ctx context.Context) (int64, error) {
-	query := fmt.Sprintf("select count(1) from %s where deleted_at IS NULL and disabled_at IS NULL", m.table)
+	query := fmt.Sprintf("select count(1) from %s where tenant_id = $1 and deleted_at IS NULL and disabled_at IS NULL", m.table)
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, TenantIdFromContext(ctx))
 	return count, err
 }
 
 // SearchActiveByKeyword 按关键词搜索活跃角色
 func (m *customRolesModel) SearchActiveByKeyword(ctx context.Context, keyword string, limit, offset int32) ([]*Roles, error) {
-	query := fmt.Sprintf("select %s from %s where deleted_at IS NULL and disabled_at IS NULL AND (name LIKE $1 OR code LIKE $1 OR description LIKE $1) order by sort_order, created_at limit $2 offset $3", rolesRows, m.table)
+	query := fmt.Sprintf("select %s from %s where tenant_id = $2 and deleted_at IS NULL and disabled_at IS NULL AND (name LIKE $1 OR code LIKE $1 OR description LIKE $1) order by sort_order, created_at limit $3 offset $4", rolesRows, m.table)
 	var resp []*Roles
 	keywordPattern := "%" + keyword + "%"
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, keywordPattern, limit, offset)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, keywordPattern, TenantIdFromContext(ctx), limit, offset)
 	return resp, err
 }
 
 // CountActiveByKeyword 按关键词统计活跃角色数量
 func (m *customRolesModel) CountActiveByKeyword(ctx context.Context, keyword string) (int64, error) {
-	query := fmt.Sprintf("select count(1) from %s where deleted_at IS NULL and disabled_at IS NULL AND (name LIKE $1 OR code LIKE $1 OR description LIKE $1)", m.table)
+	query := fmt.Sprintf("select count(1) from %s where tenant_id = $2 and deleted_at IS NULL and disabled_at IS NULL AND (name LIKE $1 OR code LIKE $1 OR description LIKE $1)", m.table)
 	var count int64
 	keywordPattern := "%" + keyword + "%"
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, keywordPattern)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, keywordPattern, TenantIdFromContext(ctx))
 	return count, err
 }
 
 // FindRolesBySortOrder 按排序顺序查询角色
 func (m *customRolesModel) FindRolesBySortOrder(ctx context.Context, limit int) ([]*Roles, error) {
-	query := fmt.Sprintf("select %s from %s where deleted_at IS NULL order by sort_order, created_at limit $1", rolesRows, m.table)
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and deleted_at IS NULL order by sort_order, created_at limit $2", rolesRows, m.table)
 	var resp []*Roles
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, limit)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx), limit)
 	return resp, err
 }
 
 // FindOne 重写FindOne方法，排除已删除的记录
+// ID全局唯一，缓存按ID存储，读取后校验记录属于当前租户
 func (m *customRolesModel) FindOne(ctx context.Context, id int64) (*Roles, error) {
 	iamRolesIdKey := fmt.Sprintf("%s%v", cacheIamRolesIdPrefix, id)
 	var resp Roles
@@ -349,6 +387,9 @@ func (m *customRolesModel) FindOne(ctx context.Context, id int64) (*Roles, error
 	})
 	switch {
 	case err == nil:
+		if resp.TenantId != TenantIdFromContext(ctx) {
+			return nil, ErrNotFound
+		}
 		return &resp, nil
 	case errors.Is(err, sqlc.ErrNotFound):
 		return nil, ErrNotFound
@@ -357,14 +398,14 @@ func (m *customRolesModel) FindOne(ctx context.Context, id int64) (*Roles, error
 	}
 }
 
-// FindOneByCode 重写FindOneByCode方法，排除已删除的记录
-func (m *customRolesModel) FindOneByCode(ctx context.Context, code string) (*Roles, error) {
-	iamRolesCodeKey := fmt.Sprintf("%s%v", cacheIamRolesCodePrefix, code)
+// FindOneByTenantIdCode 重写FindOneByTenantIdCode方法，排除已删除的记录
+func (m *customRolesModel) FindOneByTenantIdCode(ctx context.Context, tenantId int64, code string) (*Roles, error) {
+	iamRolesTenantIdCodeKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdCodePrefix, tenantId, code)
 	var resp Roles
-	err := m.QueryRowCtx(ctx, &resp, iamRolesCodeKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+	err := m.QueryRowCtx(ctx, &resp, iamRolesTenantIdCodeKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
 		// 排除软删除的
-		query := fmt.Sprintf("select %s from %s where code = $1 and deleted_at IS NULL limit 1", rolesRows, m.table)
-		return conn.QueryRowCtx(ctx, v, query, code)
+		query := fmt.Sprintf("select %s from %s where tenant_id = $1 and code = $2 and deleted_at IS NULL limit 1", rolesRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, tenantId, code)
 	})
 	switch {
 	case err == nil:
@@ -376,14 +417,14 @@ func (m *customRolesModel) FindOneByCode(ctx context.Context, code string) (*Rol
 	}
 }
 
-// FindOneByName 重写FindOneByName方法，排除已删除的记录
-func (m *customRolesModel) FindOneByName(ctx context.Context, name string) (*Roles, error) {
-	iamRolesNameKey := fmt.Sprintf("%s%v", cacheIamRolesNamePrefix, name)
+// FindOneByTenantIdName 重写FindOneByTenantIdName方法，排除已删除的记录
+func (m *customRolesModel) FindOneByTenantIdName(ctx context.Context, tenantId int64, name string) (*Roles, error) {
+	iamRolesTenantIdNameKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdNamePrefix, tenantId, name)
 	var resp Roles
-	err := m.QueryRowCtx(ctx, &resp, iamRolesNameKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+	err := m.QueryRowCtx(ctx, &resp, iamRolesTenantIdNameKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
 		// 排除软删除的
-		query := fmt.Sprintf("select %s from %s where name = $1 and deleted_at IS NULL limit 1", rolesRows, m.table)
-		return conn.QueryRowCtx(ctx, v, query, name)
+		query := fmt.Sprintf("select %s from %s where tenant_id = $1 and name = $2 and deleted_at IS NULL limit 1", rolesRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, tenantId, name)
 	})
 	switch {
 	case err == nil:
@@ -398,10 +439,15 @@ func (m *customRolesModel) FindOneByName(ctx context.Context, name string) (*Rol
 // Insert 插入角色并返回ID
 func (m *customRolesModel) Insert(ctx context.Context, data *Roles) (sql.Result, error) {
 	var insertedID int64
-	iamRolesCodeKey := fmt.Sprintf("%s%v", cacheIamRolesCodePrefix, data.Code)
-	iamRolesNameKey := fmt.Sprintf("%s%v", cacheIamRolesNamePrefix, data.Name)
+	// 角色归属当前调用的租户
+	data.TenantId = TenantIdFromContext(ctx)
+	if data.DataScope == "" {
+		data.DataScope = DataScopeSelf
+	}
+	iamRolesTenantIdCodeKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdCodePrefix, data.TenantId, data.Code)
+	iamRolesTenantIdNameKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdNamePrefix, data.TenantId, data.Name)
 
-	err := m.QueryRowNoCacheCtx(ctx, &insertedID, fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6) RETURNING id", m.table, rolesRowsExpectAutoSet), data.Name, data.Code, data.Description, data.SortOrder, data.DisabledAt, data.DeletedAt)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id", m.table, rolesRowsExpectAutoSet), data.TenantId, data.Name, data.Code, data.Description, data.SortOrder, data.DataScope, data.DisabledAt, data.DeletedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -411,7 +457,37 @@ func (m *customRolesModel) Insert(ctx context.Context, data *Roles) (sql.Result,
 
 	// 清除相关缓存
 	iamRolesIdKey := fmt.Sprintf("%s%v", cacheIamRolesIdPrefix, insertedID)
-	_ = m.DelCacheCtx(ctx, iamRolesCodeKey, iamRolesIdKey, iamRolesNameKey)
+	_ = m.DelCacheCtx(ctx, iamRolesIdKey, iamRolesTenantIdCodeKey, iamRolesTenantIdNameKey)
 
 	return &customResult{insertedID: insertedID}, nil
 }
+
+// FindActiveWithConditions 按条件分页查询活跃角色
+// conditions中的占位符从$1开始编号，与args一一对应
+func (m *customRolesModel) FindActiveWithConditions(ctx context.Context, conditions []string, args []any, limit, offset int32) ([]*Roles, error) {
+	whereClause := strings.Join(append([]string{"deleted_at IS NULL and disabled_at IS NULL", fmt.Sprintf("tenant_id = $%d", len(args)+1)}, conditions...), " and ")
+	query := fmt.Sprintf("select %s from %s where %s order by sort_order, id limit $%d offset $%d",
+		rolesRows, m.table, whereClause, len(args)+2, len(args)+3)
+	var resp []*Roles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, append(args, TenantIdFromContext(ctx), limit, offset)...)
+	return resp, err
+}
+
+// CountActiveWithConditions 统计条件查询活跃角色数量
+func (m *customRolesModel) CountActiveWithConditions(ctx context.Context, conditions []string, args []any) (int64, error) {
+	whereClause := strings.Join(append([]string{"deleted_at IS NULL and disabled_at IS NULL", fmt.Sprintf("tenant_id = $%d", len(args)+1)}, conditions...), " and ")
+	query := fmt.Sprintf("select count(1) from %s where %s", m.table, whereClause)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, append(args, TenantIdFromContext(ctx))...)
+	return count, err
+}
+
+// Touch 刷新角色的updated_at
+func (m *customRolesModel) Touch(ctx context.Context, id int64) error {
+	iamRolesIdKey := fmt.Sprintf("%s%v", cacheIamRolesIdPrefix, id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set updated_at = NOW() where id = $1 and tenant_id = $2", m.table)
+		return conn.ExecCtx(ctx, query, id, TenantIdFromContext(ctx))
+	}, iamRolesIdKey)
+	return err
+}
@@ -24,17 +24,17 @@ var (
 	rolesRowsExpectAutoSet   = strings.Join(stringx.Remove(rolesFieldNames, "id", "create_at", "create_time", "created_at", "update_at", "update_time", "updated_at"), ",")
 	rolesRowsWithPlaceHolder = builder.PostgreSqlJoin(stringx.Remove(rolesFieldNames, "id", "create_at", "create_time", "created_at", "update_at", "update_time", "updated_at"))
 
-	cacheIamRolesIdPrefix   = "cache:iam:roles:id:"
-	cacheIamRolesCodePrefix = "cache:iam:roles:code:"
-	cacheIamRolesNamePrefix = "cache:iam:roles:name:"
+	cacheIamRolesIdPrefix           = "cache:iam:roles:id:"
+	cacheIamRolesTenantIdCodePrefix = "cache:iam:roles:tenantId:code:"
+	cacheIamRolesTenantIdNamePrefix = "cache:iam:roles:tenantId:name:"
 )
 
 type (
 	rolesModel interface {
 		Insert(ctx context.Context, data *Roles) (sql.Result, error)
 		FindOne(ctx context.Context, id int64) (*Roles, error)
-		FindOneByCode(ctx context.Context, code string) (*Roles, error)
-		FindOneByName(ctx context.Context, name string) (*Roles, error)
+		FindOneByTenantIdCode(ctx context.Context, tenantId int64, code string) (*Roles, error)
+		FindOneByTenantIdName(ctx context.Context, tenantId int64, name string) (*Roles, error)
 		Update(ctx context.Context, data *Roles) error
 		Delete(ctx context.Context, id int64) error
 	}
@@ -46,10 +46,12 @@ type (
 
 	Roles struct {
 		Id          int64          `db:"id"`          // 主键ID
-		Name        string         `db:"name"`        // 角色名称
-		Code        string         `db:"code"`        // 角色编码，唯一标识
+		TenantId    int64          `db:"tenant_id"`   // 所属租户ID，外键关联tenants表
+		Name        string         `db:"name"`        // 角色名称，租户内唯一
+		Code        string         `db:"code"`        // 角色编码，租户内唯一
 		Description sql.NullString `db:"description"` // 角色描述
 		SortOrder   int64          `db:"sort_order"`  // 排序顺序
+		DataScope   string         `db:"data_scope"`  // 数据权限范围：all-全部数据，dept_and_child-本部门及以下，dept-仅本部门，self-仅本人，custom-自定义部门
 		CreatedAt   time.Time      `db:"created_at"`  // 创建时间
 		UpdatedAt   time.Time      `db:"updated_at"`  // 更新时间，通过触发器自动维护
 		DisabledAt  sql.NullTime   `db:"disabled_at"` // 禁用时间，NULL表示未禁用
@@ -70,13 +72,13 @@ func (m *defaultRolesModel) Delete(ctx context.Context, id int64) error {
 		return err
 	}
 
-	iamRolesCodeKey := fmt.Sprintf("%s%v", cacheIamRolesCodePrefix, data.Code)
 	iamRolesIdKey := fmt.Sprintf("%s%v", cacheIamRolesIdPrefix, id)
-	iamRolesNameKey := fmt.Sprintf("%s%v", cacheIamRolesNamePrefix, data.Name)
+	iamRolesTenantIdCodeKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdCodePrefix, data.TenantId, data.Code)
+	iamRolesTenantIdNameKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdNamePrefix, data.TenantId, data.Name)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("delete from %s where id = $1", m.table)
 		return conn.ExecCtx(ctx, query, id)
-	}, iamRolesCodeKey, iamRolesIdKey, iamRolesNameKey)
+	}, iamRolesIdKey, iamRolesTenantIdCodeKey, iamRolesTenantIdNameKey)
 	return err
 }
 
@@ -97,12 +99,12 @@ func (m *defaultRolesModel) FindOne(ctx context.Context, id int64) (*Roles, erro
 	}
 }
 
-func (m *defaultRolesModel) FindOneByCode(ctx context.Context, code string) (*Roles, error) {
-	iamRolesCodeKey := fmt.Sprintf("%s%v", cacheIamRolesCodePrefix, code)
+func (m *defaultRolesModel) FindOneByTenantIdCode(ctx context.Context, tenantId int64, code string) (*Roles, error) {
+	iamRolesTenantIdCodeKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdCodePrefix, tenantId, code)
 	var resp Roles
-	err := m.QueryRowIndexCtx(ctx, &resp, iamRolesCodeKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
-		query := fmt.Sprintf("select %s from %s where code = $1 limit 1", rolesRows, m.table)
-		if err := conn.QueryRowCtx(ctx, &resp, query, code); err != nil {
+	err := m.QueryRowIndexCtx(ctx, &resp, iamRolesTenantIdCodeKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where tenant_id = $1 and code = $2 limit 1", rolesRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, tenantId, code); err != nil {
 			return nil, err
 		}
 		return resp.Id, nil
@@ -117,12 +119,12 @@ func (m *defaultRolesModel) FindOneByCode(ctx context.Context, code string) (*Ro
 	}
 }
 
-func (m *defaultRolesModel) FindOneByName(ctx context.Context, name string) (*Roles, error) {
-	iamRolesNameKey := fmt.Sprintf("%s%v", cacheIamRolesNamePrefix, name)
+func (m *defaultRolesModel) FindOneByTenantIdName(ctx context.Context, tenantId int64, name string) (*Roles, error) {
+	iamRolesTenantIdNameKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdNamePrefix, tenantId, name)
 	var resp Roles
-	err := m.QueryRowIndexCtx(ctx, &resp, iamRolesNameKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
-		query := fmt.Sprintf("select %s from %s where name = $1 limit 1", rolesRows, m.table)
-		if err := conn.QueryRowCtx(ctx, &resp, query, name); err != nil {
+	err := m.QueryRowIndexCtx(ctx, &resp, iamRolesTenantIdNameKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where tenant_id = $1 and name = $2 limit 1", rolesRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, tenantId, name); err != nil {
 			return nil, err
 		}
 		return resp.Id, nil
@@ -138,13 +140,13 @@ func (m *defaultRolesModel) FindOneByName(ctx context.Context, name string) (*Ro
 }
 
 func (m *defaultRolesModel) Insert(ctx context.Context, data *Roles) (sql.Result, error) {
-	iamRolesCodeKey := fmt.Sprintf("%s%v", cacheIamRolesCodePrefix, data.Code)
 	iamRolesIdKey := fmt.Sprintf("%s%v", cacheIamRolesIdPrefix, data.Id)
-	iamRolesNameKey := fmt.Sprintf("%s%v", cacheIamRolesNamePrefix, data.Name)
+	iamRolesTenantIdCodeKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdCodePrefix, data.TenantId, data.Code)
+	iamRolesTenantIdNameKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdNamePrefix, data.TenantId, data.Name)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6)", m.table, rolesRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.Name, data.Code, data.Description, data.SortOrder, data.DisabledAt, data.DeletedAt)
-	}, iamRolesCodeKey, iamRolesIdKey, iamRolesNameKey)
+		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8)", m.table, rolesRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.TenantId, data.Name, data.Code, data.Description, data.SortOrder, data.DataScope, data.DisabledAt, data.DeletedAt)
+	}, iamRolesIdKey, iamRolesTenantIdCodeKey, iamRolesTenantIdNameKey)
 	return ret, err
 }
 
@@ -154,13 +156,13 @@ func (m *defaultRolesModel) Update(ctx context.Context, newData *Roles) error {
 		return err
 	}
 
-	iamRolesCodeKey := fmt.Sprintf("%s%v", cacheIamRolesCodePrefix, data.Code)
 	iamRolesIdKey := fmt.Sprintf("%s%v", cacheIamRolesIdPrefix, data.Id)
-	iamRolesNameKey := fmt.Sprintf("%s%v", cacheIamRolesNamePrefix, data.Name)
+	iamRolesTenantIdCodeKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdCodePrefix, data.TenantId, data.Code)
+	iamRolesTenantIdNameKey := fmt.Sprintf("%s%v:%v", cacheIamRolesTenantIdNamePrefix, data.TenantId, data.Name)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where id = $1", m.table, rolesRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.Id, newData.Name, newData.Code, newData.Description, newData.SortOrder, newData.DisabledAt, newData.DeletedAt)
-	}, iamRolesCodeKey, iamRolesIdKey, iamRolesNameKey)
+		return conn.ExecCtx(ctx, query, newData.Id, newData.TenantId, newData.Name, newData.Code, newData.Description, newData.SortOrder, newData.DataScope, newData.DisabledAt, newData.DeletedAt)
+	}, iamRolesIdKey, iamRolesTenantIdCodeKey, iamRolesTenantIdNameKey)
 	return err
 }
 
@@ -0,0 +1,153 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 会话撤销原因
+const (
+	SessionRevokeManual          = "manual"           // 手动撤销
+	SessionRevokeUserDisabled    = "user_disabled"    // 用户被禁用
+	SessionRevokeUserDeleted     = "user_deleted"     // 用户被删除
+	SessionRevokePasswordChanged = "password_changed" // 用户修改密码
+)
+
+var _ SessionsModel = (*customSessionsModel)(nil)
+
+type (
+	// SessionsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customSessionsModel.
+	SessionsModel interface {
+		sessionsModel
+		FindActiveById(ctx context.Context, id int64) (*Sessions, error)                                               // 查询未撤销的会话
+		FindByUserId(ctx context.Context, userId int64, includeRevoked bool, limit, offset int32) ([]*Sessions, error) // 分页查询用户会话
+		CountByUserId(ctx context.Context, userId int64, includeRevoked bool) (int64, error)                           // 统计用户会话数量
+		Touch(ctx context.Context, id int64) (bool, error)                                                             // 刷新最后活跃时间，会话已撤销时返回false
+		Revoke(ctx context.Context, id int64, reason string) (bool, error)                                             // 撤销会话，会话已撤销时返回false
+		RevokeAllByUserId(ctx context.Context, userId int64, reason string) (int64, error)                             // 撤销用户所有活跃会话，返回撤销数量
+	}
+
+	customSessionsModel struct {
+		*defaultSessionsModel
+	}
+)
+
+// NewSessionsModel returns a model for the database table.
+func NewSessionsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) SessionsModel {
+	return &customSessionsModel{
+		defaultSessionsModel: newSessionsModel(conn, c, opts...),
+	}
+}
+
+// Insert 插入会话并返回ID
+func (m *customSessionsModel) Insert(ctx context.Context, data *Sessions) (sql.Result, error) {
+	var insertedID int64
+	// 未指定最后活跃时间时使用数据库当前时间
+	lastSeenAt := sql.NullTime{Time: data.LastSeenAt, Valid: !data.LastSeenAt.IsZero()}
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, COALESCE($5, NOW()), $6, $7) RETURNING id", m.table, sessionsRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.UserId, data.Client, data.Ip, data.UserAgent, lastSeenAt, data.RevokedAt, data.RevokeReason)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// tenantUsersClause 会话所属用户必须属于当前租户，argIndex为租户ID参数的占位符序号
+func tenantUsersClause(argIndex int) string {
+	return fmt.Sprintf(`user_id IN (select id from "iam"."users" where tenant_id = $%d)`, argIndex)
+}
+
+// FindActiveById 查询未撤销的会话（仅当前租户用户的会话）
+func (m *customSessionsModel) FindActiveById(ctx context.Context, id int64) (*Sessions, error) {
+	query := fmt.Sprintf("select %s from %s where id = $1 and revoked_at IS NULL and %s limit 1", sessionsRows, m.table, tenantUsersClause(2))
+	var resp Sessions
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, id, TenantIdFromContext(ctx))
+	switch {
+	case err == nil:
+		return &resp, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindByUserId 分页查询用户会话，按创建时间倒序
+func (m *customSessionsModel) FindByUserId(ctx context.Context, userId int64, includeRevoked bool, limit, offset int32) ([]*Sessions, error) {
+	query := fmt.Sprintf("select %s from %s where user_id = $1 and ($2 or revoked_at IS NULL) order by created_at desc, id desc limit $3 offset $4", sessionsRows, m.table)
+	var resp []*Sessions
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, includeRevoked, limit, offset)
+	return resp, err
+}
+
+// CountByUserId 统计用户会话数量
+func (m *customSessionsModel) CountByUserId(ctx context.Context, userId int64, includeRevoked bool) (int64, error) {
+	query := fmt.Sprintf("select count(1) from %s where user_id = $1 and ($2 or revoked_at IS NULL)", m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, userId, includeRevoked)
+	return count, err
+}
+
+// Touch 刷新会话最后活跃时间
+func (m *customSessionsModel) Touch(ctx context.Context, id int64) (bool, error) {
+	iamSessionsIdKey := fmt.Sprintf("%s%v", cacheIamSessionsIdPrefix, id)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set last_seen_at = NOW() where id = $1 and revoked_at IS NULL and %s", m.table, tenantUsersClause(2))
+		return conn.ExecCtx(ctx, query, id, TenantIdFromContext(ctx))
+	}, iamSessionsIdKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// Revoke 撤销会话
+func (m *customSessionsModel) Revoke(ctx context.Context, id int64, reason string) (bool, error) {
+	iamSessionsIdKey := fmt.Sprintf("%s%v", cacheIamSessionsIdPrefix, id)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set revoked_at = NOW(), revoke_reason = $2 where id = $1 and revoked_at IS NULL and %s", m.table, tenantUsersClause(3))
+		return conn.ExecCtx(ctx, query, id, reason, TenantIdFromContext(ctx))
+	}, iamSessionsIdKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// RevokeAllByUserId 撤销用户所有活跃会话
+func (m *customSessionsModel) RevokeAllByUserId(ctx context.Context, userId int64, reason string) (int64, error) {
+	// 先查询活跃会话以清除缓存
+	var ids []int64
+	query := fmt.Sprintf("select id from %s where user_id = $1 and revoked_at IS NULL", m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &ids, query, userId); err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheIamSessionsIdPrefix, id))
+	}
+
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set revoked_at = NOW(), revoke_reason = $2 where user_id = $1 and revoked_at IS NULL", m.table)
+		return conn.ExecCtx(ctx, query, userId, reason)
+	}, keys...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,21 @@
+package model
+
+import "context"
+
+// DefaultTenantId 默认租户ID，未携带租户信息的调用归属该租户，全局系统权限也由该租户维护
+const DefaultTenantId int64 = 1
+
+type tenantIdKey struct{}
+
+// WithTenantId 将租户ID写入上下文，模型的查询和写入都按上下文中的租户隔离
+func WithTenantId(ctx context.Context, tenantId int64) context.Context {
+	return context.WithValue(ctx, tenantIdKey{}, tenantId)
+}
+
+// TenantIdFromContext 读取上下文中的租户ID，未设置时返回默认租户
+func TenantIdFromContext(ctx context.Context) int64 {
+	if tenantId, ok := ctx.Value(tenantIdKey{}).(int64); ok && tenantId > 0 {
+		return tenantId
+	}
+	return DefaultTenantId
+}
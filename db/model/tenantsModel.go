@@ -0,0 +1,63 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ TenantsModel = (*customTenantsModel)(nil)
+
+type (
+	// TenantsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customTenantsModel.
+	TenantsModel interface {
+		tenantsModel
+		FindActiveById(ctx context.Context, id int64) (*Tenants, error) // 查询活跃租户（未删除且未禁用）
+	}
+
+	customTenantsModel struct {
+		*defaultTenantsModel
+	}
+)
+
+// NewTenantsModel returns a model for the database table.
+func NewTenantsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) TenantsModel {
+	return &customTenantsModel{
+		defaultTenantsModel: newTenantsModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，使用PostgreSQL的RETURNING子句获取插入后的ID
+func (m *customTenantsModel) Insert(ctx context.Context, data *Tenants) (sql.Result, error) {
+	var insertedID int64
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4) RETURNING id", m.table, tenantsRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.Code, data.Name, data.DisabledAt, data.DeletedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+
+	// 清除相关缓存
+	iamTenantsCodeKey := fmt.Sprintf("%s%v", cacheIamTenantsCodePrefix, data.Code)
+	iamTenantsIdKey := fmt.Sprintf("%s%v", cacheIamTenantsIdPrefix, insertedID)
+	_ = m.DelCacheCtx(ctx, iamTenantsCodeKey, iamTenantsIdKey)
+
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// FindActiveById 查询活跃租户（未删除且未禁用），每次调用都会经过，使用ID缓存
+func (m *customTenantsModel) FindActiveById(ctx context.Context, id int64) (*Tenants, error) {
+	resp, err := m.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.DeletedAt.Valid || resp.DisabledAt.Valid {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -18,11 +19,12 @@ type (
 	// and implement the added methods in customUserRolesModel.
 	UserRolesModel interface {
 		userRolesModel
-		AssignRole(ctx context.Context, userId, roleId int64, createdBy sql.NullInt64) error
-		AssignRoles(ctx context.Context, userId int64, roleIds []int64, createdBy sql.NullInt64) error
+		AssignRole(ctx context.Context, userId, roleId int64, createdBy sql.NullInt64, validity Validity) error
+		AssignRoles(ctx context.Context, userId int64, roleIds []int64, createdBy sql.NullInt64, validity Validity) error
 		RemoveRole(ctx context.Context, userId, roleId int64) error
 		RemoveRoles(ctx context.Context, userId int64, roleIds []int64) error
 		RemoveAllUserRoles(ctx context.Context, userId int64) error
+		RemoveAllRoleUsers(ctx context.Context, roleId int64) error
 		FindRolesByUserId(ctx context.Context, userId int64) ([]*UserRoles, error)
 		FindUsersByRoleId(ctx context.Context, roleId int64) ([]*UserRoles, error)
 		HasRole(ctx context.Context, userId, roleId int64) (bool, error)
@@ -33,6 +35,13 @@ type (
 		CountUsersByRoleId(ctx context.Context, roleId int64) (int64, error)
 		CountRolesByUserId(ctx context.Context, userId int64) (int64, error)
 		ReplaceUserRoles(ctx context.Context, userId int64, roleIds []int64, createdBy sql.NullInt64) error
+		UpdateValidity(ctx context.Context, data *UserRoles, validity Validity) error      // 更新用户角色的有效期
+		FindExpired(ctx context.Context, limit int) ([]*UserRoles, error)                  // 查询所有租户中已过期的用户角色，供后台清理
+		DeleteExpired(ctx context.Context, data *UserRoles) (bool, error)                  // 删除已过期的用户角色，期间被延期时不删除
+		FindUnexpiredByUserIds(ctx context.Context, userIds []int64) ([]*UserRoles, error) // 批量查询多个用户未过期的角色，包括尚未生效的角色
+		FindUnexpiredByRoleIds(ctx context.Context, roleIds []int64) ([]*UserRoles, error) // 批量查询多个角色未过期的用户，包括尚未生效的关联
+		FindAllUnexpired(ctx context.Context) ([]*UserRoles, error)                        // 查询当前租户所有未过期的用户角色
+		FindAllByUserId(ctx context.Context, userId int64) ([]*UserRoles, error)           // 查询用户的全部角色关联，包括已过期和尚未生效的关联
 	}
 
 	customUserRolesModel struct {
@@ -47,60 +56,96 @@ func NewUserRolesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Optio
 	}
 }
 
-// AssignRole 为用户分配角色
-func (m *customUserRolesModel) AssignRole(ctx context.Context, userId, roleId int64, createdBy sql.NullInt64) error {
+// Insert 重写Insert方法，关联归属当前调用的租户
+func (m *customUserRolesModel) Insert(ctx context.Context, data *UserRoles) (sql.Result, error) {
+	data.TenantId = TenantIdFromContext(ctx)
+	return m.defaultUserRolesModel.Insert(ctx, data)
+}
+
+// FindOneByUserIdRoleId 重写FindOneByUserIdRoleId方法，校验关联属于当前租户
+func (m *customUserRolesModel) FindOneByUserIdRoleId(ctx context.Context, userId int64, roleId int64) (*UserRoles, error) {
+	resp, err := m.defaultUserRolesModel.FindOneByUserIdRoleId(ctx, userId, roleId)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TenantId != TenantIdFromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return resp, nil
+}
+
+// AssignRole 为用户分配角色，已存在的关联不会被缩短，有效期合并为覆盖两者的有效期，已过期的关联改为新的有效期
+func (m *customUserRolesModel) AssignRole(ctx context.Context, userId, roleId int64, createdBy sql.NullInt64, validity Validity) error {
 	// 检查是否已存在
 	existing, err := m.FindOneByUserIdRoleId(ctx, userId, roleId)
 	if err != nil && !errors.Is(err, ErrNotFound) {
 		return err
 	}
 	if existing != nil {
-		return nil // 已存在，不重复分配
+		return m.UpdateValidity(ctx, existing, existing.Validity().Widen(validity, time.Now()))
 	}
 
 	userRole := &UserRoles{
-		UserId:    userId,
-		RoleId:    roleId,
-		CreatedBy: createdBy,
+		UserId:     userId,
+		RoleId:     roleId,
+		ValidFrom:  validity.From,
+		ValidUntil: validity.Until,
+		CreatedBy:  createdBy,
 	}
 	_, err = m.Insert(ctx, userRole)
 	return err
 }
 
-// AssignRoles 为用户批量分配角色
-func (m *customUserRolesModel) AssignRoles(ctx context.Context, userId int64, roleIds []int64, createdBy sql.NullInt64) error {
+// AssignRoles 为用户批量分配角色，已存在的关联按AssignRole的规则合并有效期
+func (m *customUserRolesModel) AssignRoles(ctx context.Context, userId int64, roleIds []int64, createdBy sql.NullInt64, validity Validity) error {
 	if len(roleIds) == 0 {
 		return nil
 	}
 
-	// 查询已存在的角色关联
-	existingRoles, err := m.FindRolesByUserId(ctx, userId)
+	// 查询已存在的角色关联，包括未生效和已过期的关联
+	existingRoles, err := m.FindAllByUserId(ctx, userId)
 	if err != nil {
 		return err
 	}
 
 	// 构建已存在角色ID的映射
-	existingRoleMap := make(map[int64]bool)
+	existingRoleMap := make(map[int64]*UserRoles)
 	for _, ur := range existingRoles {
-		existingRoleMap[ur.RoleId] = true
+		existingRoleMap[ur.RoleId] = ur
 	}
 
-	// 只插入不存在的角色关联
+	// 插入不存在的角色关联
 	for _, roleId := range roleIds {
-		if !existingRoleMap[roleId] {
-			userRole := &UserRoles{
-				UserId:    userId,
-				RoleId:    roleId,
-				CreatedBy: createdBy,
-			}
-			if _, err := m.Insert(ctx, userRole); err != nil {
+		if existing, ok := existingRoleMap[roleId]; ok {
+			if err := m.UpdateValidity(ctx, existing, existing.Validity().Widen(validity, time.Now())); err != nil {
 				return err
 			}
+			continue
+		}
+		userRole := &UserRoles{
+			UserId:     userId,
+			RoleId:     roleId,
+			ValidFrom:  validity.From,
+			ValidUntil: validity.Until,
+			CreatedBy:  createdBy,
+		}
+		if _, err := m.Insert(ctx, userRole); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// UpdateValidity 更新用户角色的有效期，有效期未变化时不写库
+func (m *customUserRolesModel) UpdateValidity(ctx context.Context, data *UserRoles, validity Validity) error {
+	if data.Validity().Equal(validity) {
+		return nil
+	}
+	data.ValidFrom = validity.From
+	data.ValidUntil = validity.Until
+	return m.Update(ctx, data)
+}
+
 // RemoveRole 移除用户角色
 func (m *customUserRolesModel) RemoveRole(ctx context.Context, userId, roleId int64) error {
 	userRole, err := m.FindOneByUserIdRoleId(ctx, userId, roleId)
@@ -121,15 +166,16 @@ func (m *customUserRolesModel) RemoveRoles(ctx context.Context, userId int64, ro
 
 	// 构建占位符
 	placeholders := make([]string, len(roleIds))
-	args := make([]interface{}, len(roleIds)+1)
+	args := make([]interface{}, len(roleIds)+2)
 	args[0] = userId
+	args[1] = TenantIdFromContext(ctx)
 	for i, roleId := range roleIds {
-		placeholders[i] = fmt.Sprintf("$%d", i+2)
-		args[i+1] = roleId
+		placeholders[i] = fmt.Sprintf("$%d", i+3)
+		args[i+2] = roleId
 	}
 
 	// 先查询要删除的记录以清除缓存
-	query := fmt.Sprintf("select %s from %s where user_id = $1 and role_id IN (%s)", userRolesRows, m.table, strings.Join(placeholders, ","))
+	query := fmt.Sprintf("select %s from %s where user_id = $1 and tenant_id = $2 and role_id IN (%s)", userRolesRows, m.table, strings.Join(placeholders, ","))
 	var toDelete []*UserRoles
 	err := m.QueryRowsNoCacheCtx(ctx, &toDelete, query, args...)
 	if err != nil {
@@ -145,7 +191,7 @@ func (m *customUserRolesModel) RemoveRoles(ctx context.Context, userId int64, ro
 
 	// 执行删除
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		deleteQuery := fmt.Sprintf("delete from %s where user_id = $1 and role_id IN (%s)", m.table, strings.Join(placeholders, ","))
+		deleteQuery := fmt.Sprintf("delete from %s where user_id = $1 and tenant_id = $2 and role_id IN (%s)", m.table, strings.Join(placeholders, ","))
 		return conn.ExecCtx(ctx, deleteQuery, args...)
 	}, keys...)
 	return err
@@ -154,7 +200,7 @@ func (m *customUserRolesModel) RemoveRoles(ctx context.Context, userId int64, ro
 // RemoveAllUserRoles 移除用户的所有角色
 func (m *customUserRolesModel) RemoveAllUserRoles(ctx context.Context, userId int64) error {
 	// 先查询用户的所有角色以清除缓存
-	userRoles, err := m.FindRolesByUserId(ctx, userId)
+	userRoles, err := m.FindAllByUserId(ctx, userId)
 	if err != nil {
 		return err
 	}
@@ -172,41 +218,84 @@ func (m *customUserRolesModel) RemoveAllUserRoles(ctx context.Context, userId in
 
 	// 执行删除
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("delete from %s where user_id = $1", m.table)
-		return conn.ExecCtx(ctx, query, userId)
+		query := fmt.Sprintf("delete from %s where user_id = $1 and tenant_id = $2", m.table)
+		return conn.ExecCtx(ctx, query, userId, TenantIdFromContext(ctx))
 	}, keys...)
 	return err
 }
 
-// FindRolesByUserId 查询用户的所有角色
+// RemoveAllRoleUsers 移除拥有指定角色的所有用户关联
+func (m *customUserRolesModel) RemoveAllRoleUsers(ctx context.Context, roleId int64) error {
+	// 先查询角色的所有用户以清除缓存
+	userRoles, err := m.findAllByRoleId(ctx, roleId)
+	if err != nil {
+		return err
+	}
+
+	if len(userRoles) == 0 {
+		return nil
+	}
+
+	// 构建缓存键
+	keys := make([]string, 0, len(userRoles)*2)
+	for _, ur := range userRoles {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheIamUserRolesIdPrefix, ur.Id))
+		keys = append(keys, fmt.Sprintf("%s%v:%v", cacheIamUserRolesUserIdRoleIdPrefix, ur.UserId, ur.RoleId))
+	}
+
+	// 执行删除
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where role_id = $1 and tenant_id = $2", m.table)
+		return conn.ExecCtx(ctx, query, roleId, TenantIdFromContext(ctx))
+	}, keys...)
+	return err
+}
+
+// FindRolesByUserId 查询用户当前生效的所有角色
 func (m *customUserRolesModel) FindRolesByUserId(ctx context.Context, userId int64) ([]*UserRoles, error) {
-	query := fmt.Sprintf("select %s from %s where user_id = $1 order by created_at", userRolesRows, m.table)
+	query := fmt.Sprintf("select %s from %s where user_id = $1 and tenant_id = $2 and %s order by created_at", userRolesRows, m.table, GrantEffectiveClause)
 	var resp []*UserRoles
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, TenantIdFromContext(ctx))
 	return resp, err
 }
 
-// FindUsersByRoleId 查询拥有指定角色的所有用户
+// FindUsersByRoleId 查询当前拥有指定角色的所有用户
 func (m *customUserRolesModel) FindUsersByRoleId(ctx context.Context, roleId int64) ([]*UserRoles, error) {
-	query := fmt.Sprintf("select %s from %s where role_id = $1 order by created_at", userRolesRows, m.table)
+	query := fmt.Sprintf("select %s from %s where role_id = $1 and tenant_id = $2 and %s order by created_at", userRolesRows, m.table, GrantEffectiveClause)
+	var resp []*UserRoles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, roleId, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// FindAllByUserId 查询用户的所有角色关联，包括未生效和已过期的关联
+func (m *customUserRolesModel) FindAllByUserId(ctx context.Context, userId int64) ([]*UserRoles, error) {
+	query := fmt.Sprintf("select %s from %s where user_id = $1 and tenant_id = $2 order by created_at", userRolesRows, m.table)
+	var resp []*UserRoles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// findAllByRoleId 查询角色的所有用户关联，包括未生效和已过期的关联
+func (m *customUserRolesModel) findAllByRoleId(ctx context.Context, roleId int64) ([]*UserRoles, error) {
+	query := fmt.Sprintf("select %s from %s where role_id = $1 and tenant_id = $2 order by created_at", userRolesRows, m.table)
 	var resp []*UserRoles
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, roleId)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, roleId, TenantIdFromContext(ctx))
 	return resp, err
 }
 
-// HasRole 检查用户是否拥有指定角色
+// HasRole 检查用户当前是否拥有指定角色
 func (m *customUserRolesModel) HasRole(ctx context.Context, userId, roleId int64) (bool, error) {
-	_, err := m.FindOneByUserIdRoleId(ctx, userId, roleId)
+	userRole, err := m.FindOneByUserIdRoleId(ctx, userId, roleId)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			return false, nil
 		}
 		return false, err
 	}
-	return true, nil
+	return userRole.Validity().Effective(time.Now()), nil
 }
 
-// HasAnyRole 检查用户是否拥有任意一个指定角色
+// HasAnyRole 检查用户当前是否拥有任意一个指定角色
 func (m *customUserRolesModel) HasAnyRole(ctx context.Context, userId int64, roleIds []int64) (bool, error) {
 	if len(roleIds) == 0 {
 		return false, nil
@@ -214,14 +303,15 @@ func (m *customUserRolesModel) HasAnyRole(ctx context.Context, userId int64, rol
 
 	// 构建占位符
 	placeholders := make([]string, len(roleIds))
-	args := make([]interface{}, len(roleIds)+1)
+	args := make([]interface{}, len(roleIds)+2)
 	args[0] = userId
+	args[1] = TenantIdFromContext(ctx)
 	for i, roleId := range roleIds {
-		placeholders[i] = fmt.Sprintf("$%d", i+2)
-		args[i+1] = roleId
+		placeholders[i] = fmt.Sprintf("$%d", i+3)
+		args[i+2] = roleId
 	}
 
-	query := fmt.Sprintf("select count(1) from %s where user_id = $1 and role_id IN (%s)", m.table, strings.Join(placeholders, ","))
+	query := fmt.Sprintf("select count(1) from %s where user_id = $1 and tenant_id = $2 and role_id IN (%s) and %s", m.table, strings.Join(placeholders, ","), GrantEffectiveClause)
 	var count int64
 	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
 	if err != nil {
@@ -230,7 +320,7 @@ func (m *customUserRolesModel) HasAnyRole(ctx context.Context, userId int64, rol
 	return count > 0, nil
 }
 
-// HasAllRoles 检查用户是否拥有所有指定角色
+// HasAllRoles 检查用户当前是否拥有所有指定角色
 func (m *customUserRolesModel) HasAllRoles(ctx context.Context, userId int64, roleIds []int64) (bool, error) {
 	if len(roleIds) == 0 {
 		return true, nil
@@ -238,14 +328,15 @@ func (m *customUserRolesModel) HasAllRoles(ctx context.Context, userId int64, ro
 
 	// 构建占位符
 	placeholders := make([]string, len(roleIds))
-	args := make([]interface{}, len(roleIds)+1)
+	args := make([]interface{}, len(roleIds)+2)
 	args[0] = userId
+	args[1] = TenantIdFromContext(ctx)
 	for i, roleId := range roleIds {
-		placeholders[i] = fmt.Sprintf("$%d", i+2)
-		args[i+1] = roleId
+		placeholders[i] = fmt.Sprintf("$%d", i+3)
+		args[i+2] = roleId
 	}
 
-	query := fmt.Sprintf("select count(1) from %s where user_id = $1 and role_id IN (%s)", m.table, strings.Join(placeholders, ","))
+	query := fmt.Sprintf("select count(1) from %s where user_id = $1 and tenant_id = $2 and role_id IN (%s) and %s", m.table, strings.Join(placeholders, ","), GrantEffectiveClause)
 	var count int64
 	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
 	if err != nil {
@@ -254,7 +345,7 @@ func (m *customUserRolesModel) HasAllRoles(ctx context.Context, userId int64, ro
 	return count == int64(len(roleIds)), nil
 }
 
-// FindRolesByUserIds 批量查询多个用户的角色
+// FindRolesByUserIds 批量查询多个用户当前生效的角色
 func (m *customUserRolesModel) FindRolesByUserIds(ctx context.Context, userIds []int64) ([]*UserRoles, error) {
 	if len(userIds) == 0 {
 		return []*UserRoles{}, nil
@@ -262,19 +353,20 @@ func (m *customUserRolesModel) FindRolesByUserIds(ctx context.Context, userIds [
 
 	// 构建占位符
 	placeholders := make([]string, len(userIds))
-	args := make([]interface{}, len(userIds))
+	args := make([]interface{}, len(userIds)+1)
+	args[0] = TenantIdFromContext(ctx)
 	for i, userId := range userIds {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = userId
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = userId
 	}
 
-	query := fmt.Sprintf("select %s from %s where user_id IN (%s) order by user_id, created_at", userRolesRows, m.table, strings.Join(placeholders, ","))
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and user_id IN (%s) and %s order by user_id, created_at", userRolesRows, m.table, strings.Join(placeholders, ","), GrantEffectiveClause)
 	var resp []*UserRoles
 	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
 	return resp, err
 }
 
-// FindUsersByRoleIds 批量查询拥有指定角色的用户
+// FindUsersByRoleIds 批量查询当前拥有指定角色的用户
 func (m *customUserRolesModel) FindUsersByRoleIds(ctx context.Context, roleIds []int64) ([]*UserRoles, error) {
 	if len(roleIds) == 0 {
 		return []*UserRoles{}, nil
@@ -282,13 +374,14 @@ func (m *customUserRolesModel) FindUsersByRoleIds(ctx context.Context, roleIds [
 
 	// 构建占位符
 	placeholders := make([]string, len(roleIds))
-	args := make([]interface{}, len(roleIds))
+	args := make([]interface{}, len(roleIds)+1)
+	args[0] = TenantIdFromContext(ctx)
 	for i, roleId := range roleIds {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = roleId
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = roleId
 	}
 
-	query := fmt.Sprintf("select %s from %s where role_id IN (%s) order by role_id, created_at", userRolesRows, m.table, strings.Join(placeholders, ","))
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and role_id IN (%s) and %s order by role_id, created_at", userRolesRows, m.table, strings.Join(placeholders, ","), GrantEffectiveClause)
 	var resp []*UserRoles
 	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
 	return resp, err
@@ -296,17 +389,17 @@ func (m *customUserRolesModel) FindUsersByRoleIds(ctx context.Context, roleIds [
 
 // CountUsersByRoleId 统计拥有指定角色的用户数量
 func (m *customUserRolesModel) CountUsersByRoleId(ctx context.Context, roleId int64) (int64, error) {
-	query := fmt.Sprintf("select count(1) from %s where role_id = $1", m.table)
+	query := fmt.Sprintf("select count(1) from %s where role_id = $1 and tenant_id = $2", m.table)
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, roleId)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, roleId, TenantIdFromContext(ctx))
 	return count, err
 }
 
 // CountRolesByUserId 统计用户拥有的角色数量
 func (m *customUserRolesModel) CountRolesByUserId(ctx context.Context, userId int64) (int64, error) {
-	query := fmt.Sprintf("select count(1) from %s where user_id = $1", m.table)
+	query := fmt.Sprintf("select count(1) from %s where user_id = $1 and tenant_id = $2", m.table)
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, userId)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, userId, TenantIdFromContext(ctx))
 	return count, err
 }
 
@@ -319,7 +412,69 @@ func (m *customUserRolesModel) ReplaceUserRoles(ctx context.Context, userId int6
 
 	// 再分配新的角色
 	if len(roleIds) > 0 {
-		return m.AssignRoles(ctx, userId, roleIds, createdBy)
+		return m.AssignRoles(ctx, userId, roleIds, createdBy, Validity{})
 	}
 	return nil
 }
+
+// FindExpired 查询所有租户中已过期的用户角色，按失效时间排序
+func (m *customUserRolesModel) FindExpired(ctx context.Context, limit int) ([]*UserRoles, error) {
+	query := fmt.Sprintf("select %s from %s where valid_until IS NOT NULL and valid_until <= NOW() order by valid_until limit $1", userRolesRows, m.table)
+	var resp []*UserRoles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, limit)
+	return resp, err
+}
+
+// DeleteExpired 删除已过期的用户角色，查询后被延期的关联不会删除，返回是否实际删除
+func (m *customUserRolesModel) DeleteExpired(ctx context.Context, data *UserRoles) (bool, error) {
+	iamUserRolesIdKey := fmt.Sprintf("%s%v", cacheIamUserRolesIdPrefix, data.Id)
+	iamUserRolesUserIdRoleIdKey := fmt.Sprintf("%s%v:%v", cacheIamUserRolesUserIdRoleIdPrefix, data.UserId, data.RoleId)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("delete from %s where id = $1 and valid_until IS NOT NULL and valid_until <= NOW()", m.table)
+		return conn.ExecCtx(ctx, query, data.Id)
+	}, iamUserRolesIdKey, iamUserRolesUserIdRoleIdKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// FindUnexpiredByUserIds 批量查询多个用户未过期的角色，包括尚未生效的角色
+func (m *customUserRolesModel) FindUnexpiredByUserIds(ctx context.Context, userIds []int64) ([]*UserRoles, error) {
+	return m.findUnexpiredIn(ctx, "user_id", userIds)
+}
+
+// FindUnexpiredByRoleIds 批量查询多个角色未过期的用户，包括尚未生效的关联
+func (m *customUserRolesModel) FindUnexpiredByRoleIds(ctx context.Context, roleIds []int64) ([]*UserRoles, error) {
+	return m.findUnexpiredIn(ctx, "role_id", roleIds)
+}
+
+// findUnexpiredIn 按指定列批量查询未过期的用户角色
+func (m *customUserRolesModel) findUnexpiredIn(ctx context.Context, column string, ids []int64) ([]*UserRoles, error) {
+	if len(ids) == 0 {
+		return []*UserRoles{}, nil
+	}
+
+	// 构建占位符
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids)+1)
+	args[0] = TenantIdFromContext(ctx)
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = id
+	}
+
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and %s IN (%s) and %s order by user_id, role_id", userRolesRows, m.table, column, strings.Join(placeholders, ","), GrantUnexpiredClause)
+	var resp []*UserRoles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// FindAllUnexpired 查询当前租户所有未过期的用户角色
+func (m *customUserRolesModel) FindAllUnexpired(ctx context.Context) ([]*UserRoles, error) {
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and %s order by user_id, role_id", userRolesRows, m.table, GrantUnexpiredClause)
+	var resp []*UserRoles
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx))
+	return resp, err
+}
@@ -43,11 +43,14 @@ type (
 	}
 
 	UserRoles struct {
-		Id        int64         `db:"id"`         // 主键ID
-		UserId    int64         `db:"user_id"`    // 用户ID，外键关联users表
-		RoleId    int64         `db:"role_id"`    // 角色ID，外键关联roles表
-		CreatedAt time.Time     `db:"created_at"` // 关联创建时间
-		CreatedBy sql.NullInt64 `db:"created_by"` // 创建人ID
+		Id         int64         `db:"id"`          // 主键ID
+		TenantId   int64         `db:"tenant_id"`   // 所属租户ID，与用户和角色所属租户一致
+		UserId     int64         `db:"user_id"`     // 用户ID，外键关联users表
+		RoleId     int64         `db:"role_id"`     // 角色ID，外键关联roles表
+		ValidFrom  sql.NullTime  `db:"valid_from"`  // 生效时间，为空表示立即生效
+		ValidUntil sql.NullTime  `db:"valid_until"` // 失效时间，为空表示永久有效，过期后由后台任务清理
+		CreatedAt  time.Time     `db:"created_at"`  // 关联创建时间
+		CreatedBy  sql.NullInt64 `db:"created_by"`  // 创建人ID
 	}
 )
 
@@ -114,8 +117,8 @@ func (m *defaultUserRolesModel) Insert(ctx context.Context, data *UserRoles) (sq
 	iamUserRolesIdKey := fmt.Sprintf("%s%v", cacheIamUserRolesIdPrefix, data.Id)
 	iamUserRolesUserIdRoleIdKey := fmt.Sprintf("%s%v:%v", cacheIamUserRolesUserIdRoleIdPrefix, data.UserId, data.RoleId)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3)", m.table, userRolesRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.UserId, data.RoleId, data.CreatedBy)
+		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6)", m.table, userRolesRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.TenantId, data.UserId, data.RoleId, data.ValidFrom, data.ValidUntil, data.CreatedBy)
 	}, iamUserRolesIdKey, iamUserRolesUserIdRoleIdKey)
 	return ret, err
 }
@@ -130,7 +133,7 @@ func (m *defaultUserRolesModel) Update(ctx context.Context, newData *UserRoles)
 	iamUserRolesUserIdRoleIdKey := fmt.Sprintf("%s%v:%v", cacheIamUserRolesUserIdRoleIdPrefix, data.UserId, data.RoleId)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where id = $1", m.table, userRolesRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.Id, newData.UserId, newData.RoleId, newData.CreatedBy)
+		return conn.ExecCtx(ctx, query, newData.Id, newData.TenantId, newData.UserId, newData.RoleId, newData.ValidFrom, newData.ValidUntil, newData.CreatedBy)
 	}, iamUserRolesIdKey, iamUserRolesUserIdRoleIdKey)
 	return err
 }
@@ -28,6 +28,18 @@ func (r *customResult) RowsAffected() (int64, error) {
 	return 1, nil
 }
 
+// 用户来源
+const (
+	UserSourceLocal = "local" // 本地用户
+	UserSourceLdap  = "ldap"  // LDAP目录同步用户
+	UserSourceScim  = "scim"  // SCIM接口开通用户
+)
+
+// 用户禁用原因，手动禁用时为NULL
+const (
+	UserDisabledLdapSync = "ldap_sync" // LDAP同步时因不在目录中而禁用
+)
+
 var _ UsersModel = (*customUsersModel)(nil)
 
 type (
@@ -35,31 +47,49 @@ type (
 	// and implement the added methods in customUsersModel.
 	UsersModel interface {
 		usersModel
-		FindActiveById(ctx context.Context, id int64) (*Users, error)                // 查询活跃用户（未删除且未禁用）
-		FindById(ctx context.Context, id int64) (*Users, error)                      // 查询用户（未删除）
-		FindActiveByUsername(ctx context.Context, username string) (*Users, error)   // 按用户名查询活跃用户
-		FindActiveByEmail(ctx context.Context, email string) (*Users, error)         // 按邮箱查询活跃用户
-		FindByUsername(ctx context.Context, username string) (*Users, error)         // 按用户名查询用户（未删除）
-		FindByEmail(ctx context.Context, email string) (*Users, error)               // 按邮箱查询用户（未删除）
-		FindByNickname(ctx context.Context, nickname string) ([]*Users, error)       // 按昵称查询用户
-		FindActiveByNickname(ctx context.Context, nickname string) ([]*Users, error) // 按昵称查询活跃用户
-
-		SoftDelete(ctx context.Context, id int64) error         // 软删除用户
-		Restore(ctx context.Context, id int64) error            // 恢复已删除用户
-		Disable(ctx context.Context, id int64) error            // 禁用用户
-		Enable(ctx context.Context, id int64) error             // 启用用户
-		BatchSoftDelete(ctx context.Context, ids []int64) error // 批量软删除
-		BatchDisable(ctx context.Context, ids []int64) error    // 批量禁用
+		FindActiveById(ctx context.Context, id int64) (*Users, error)                    // 查询活跃用户（未删除且未禁用）
+		FindById(ctx context.Context, id int64) (*Users, error)                          // 查询用户（未删除）
+		FindActiveByUsername(ctx context.Context, username string) (*Users, error)       // 按用户名查询活跃用户
+		FindActiveByEmail(ctx context.Context, email string) (*Users, error)             // 按邮箱查询活跃用户
+		FindByUsername(ctx context.Context, username string) (*Users, error)             // 按用户名查询用户（未删除）
+		FindByEmail(ctx context.Context, email string) (*Users, error)                   // 按邮箱查询用户（未删除）
+		FindByNickname(ctx context.Context, nickname string) ([]*Users, error)           // 按昵称查询用户
+		FindActiveByNickname(ctx context.Context, nickname string) ([]*Users, error)     // 按昵称查询活跃用户
+		FindBySource(ctx context.Context, source string) ([]*Users, error)               // 按来源查询用户（未删除，包含已禁用）
+		FindByExternalId(ctx context.Context, source, externalId string) (*Users, error) // 按外部标识查询用户（未删除，包含已禁用）
+		FindByPhone(ctx context.Context, phone string) (*Users, error)                   // 按手机号查询用户（未删除，包含已禁用）
+		FindByEmailFold(ctx context.Context, email string) (*Users, error)               // 按邮箱查询用户，忽略大小写（未删除，包含已禁用）
+
+		SoftDelete(ctx context.Context, id int64) error                       // 软删除用户
+		Restore(ctx context.Context, id int64) error                          // 恢复已删除用户
+		Disable(ctx context.Context, id int64) error                          // 禁用用户
+		DisableWithReason(ctx context.Context, id int64, reason string) error // 禁用用户并记录原因，已禁用的用户不变
+		Enable(ctx context.Context, id int64) error                           // 启用用户
+		BatchSoftDelete(ctx context.Context, ids []int64) error               // 批量软删除
+		BatchDisable(ctx context.Context, ids []int64) error                  // 批量禁用
 
 		ExistsByUsername(ctx context.Context, username string, excludeId int64) (bool, error) // 检查用户名是否存在（排除指定ID）
 		ExistsByEmail(ctx context.Context, email string, excludeId int64) (bool, error)       // 检查邮箱是否存在（排除指定ID）
 		ExistsByPhone(ctx context.Context, phone string, excludeId int64) (bool, error)       // 检查手机号是否存在（排除指定ID）
 
 		// 分页和统计方法
-		FindActiveWithPagination(ctx context.Context, limit, offset int32) ([]*Users, error)              // 分页查询活跃用户
-		CountActive(ctx context.Context) (int64, error)                                                   // 统计活跃用户数量
-		SearchActiveByKeyword(ctx context.Context, keyword string, limit, offset int32) ([]*Users, error) // 按关键词搜索活跃用户
-		CountActiveByKeyword(ctx context.Context, keyword string) (int64, error)                          // 统计搜索结果数量
+		FindActiveWithPagination(ctx context.Context, limit, offset int32) ([]*Users, error)                            // 分页查询活跃用户
+		CountActive(ctx context.Context) (int64, error)                                                                 // 统计活跃用户数量
+		SearchActiveByKeyword(ctx context.Context, keyword string, limit, offset int32) ([]*Users, error)               // 按关键词搜索活跃用户
+		CountActiveByKeyword(ctx context.Context, keyword string) (int64, error)                                        // 统计搜索结果数量
+		FindWithConditions(ctx context.Context, conditions []string, args []any, limit, offset int32) ([]*Users, error) // 按条件分页查询用户（未删除，包含已禁用）
+		CountWithConditions(ctx context.Context, conditions []string, args []any) (int64, error)                        // 统计条件查询结果数量
+
+		// 验证方法
+		MarkEmailVerified(ctx context.Context, id int64, email string) (bool, error) // 标记邮箱已验证，邮箱已变更时返回false
+		MarkPhoneVerified(ctx context.Context, id int64, phone string) (bool, error) // 标记手机号已验证，手机号已变更时返回false
+
+		// 登录安全方法
+		RecordLoginFailure(ctx context.Context, id int64, maxAttempts, lockSeconds int64) (*Users, error) // 记录一次登录失败，达到上限时锁定账号并清零计数，返回更新后的用户
+		ResetLoginFailures(ctx context.Context, id int64) error                                           // 清零登录失败次数并解除锁定
+
+		// 角色成员方法
+		FindActiveIdsByRoleId(ctx context.Context, roleId int64) ([]int64, error) // 查询直接拥有或通过用户组继承指定角色的活跃用户ID
 
 		/*
 			TODO: 根据业务需求和性能优化，添加以下低优先级方法
@@ -72,7 +102,6 @@ type (
 			// 其他搜索方法
 			SearchByKeyword(ctx context.Context, keyword string, limit int64) ([]*Users, error)         // 按关键词搜索（用户名、昵称、邮箱）
 			FindByTimeRange(ctx context.Context, startTime, endTime string) ([]*Users, error)           // 按时间范围查询
-			FindByPhone(ctx context.Context, phone string) (*Users, error)                              // 按手机号查询用户
 
 			// 状态管理方法
 			FindDisabled(ctx context.Context) ([]*Users, error)                                         // 查询所有禁用用户
@@ -101,9 +130,9 @@ func NewUsersModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) U
 
 // FindActiveById 查询活跃用户（未删除且未禁用）
 func (m *customUsersModel) FindActiveById(ctx context.Context, id int64) (*Users, error) {
-	query := fmt.Sprintf("select %s from %s where id = $1 and deleted_at IS NULL and disabled_at IS NULL limit 1", usersRows, m.table)
+	query := fmt.Sprintf("select %s from %s where id = $1 and tenant_id = $2 and deleted_at IS NULL and disabled_at IS NULL limit 1", usersRows, m.table)
 	var resp Users
-	err := m.QueryRowNoCacheCtx(ctx, &resp, query, id)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, id, TenantIdFromContext(ctx))
 	switch {
 	case err == nil:
 		return &resp, nil
@@ -116,9 +145,9 @@ func (m *customUsersModel) FindActiveById(ctx context.Context, id int64) (*Users
 
 // FindById 查询用户（未删除）
 func (m *customUsersModel) FindById(ctx context.Context, id int64) (*Users, error) {
-	query := fmt.Sprintf("select %s from %s where id = $1 and deleted_at IS NULL limit 1", usersRows, m.table)
+	query := fmt.Sprintf("select %s from %s where id = $1 and tenant_id = $2 and deleted_at IS NULL limit 1", usersRows, m.table)
 	var resp Users
-	err := m.QueryRowNoCacheCtx(ctx, &resp, query, id)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, id, TenantIdFromContext(ctx))
 	switch {
 	case err == nil:
 		return &resp, nil
@@ -131,9 +160,9 @@ func (m *customUsersModel) FindById(ctx context.Context, id int64) (*Users, erro
 
 // FindActiveByUsername 按用户名查询活跃用户
 func (m *customUsersModel) FindActiveByUsername(ctx context.Context, username string) (*Users, error) {
-	query := fmt.Sprintf("select %s from %s where username = $1 and deleted_at IS NULL and disabled_at IS NULL limit 1", usersRows, m.table)
+	query := fmt.Sprintf("select %s from %s where username = $1 and tenant_id = $2 and deleted_at IS NULL and disabled_at IS NULL limit 1", usersRows, m.table)
 	var resp Users
-	err := m.QueryRowNoCacheCtx(ctx, &resp, query, username)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, username, TenantIdFromContext(ctx))
 	switch {
 	case err == nil:
 		return &resp, nil
@@ -146,9 +175,9 @@ func (m *customUsersModel) FindActiveByUsername(ctx context.Context, username st
 
 // FindActiveByEmail 按邮箱查询活跃用户
 func (m *customUsersModel) FindActiveByEmail(ctx context.Context, email string) (*Users, error) {
-	query := fmt.Sprintf("select %s from %s where email = $1 and deleted_at IS NULL and disabled_at IS NULL limit 1", usersRows, m.table)
+	query := fmt.Sprintf("select %s from %s where email = $1 and tenant_id = $2 and deleted_at IS NULL and disabled_at IS NULL limit 1", usersRows, m.table)
 	var resp Users
-	err := m.QueryRowNoCacheCtx(ctx, &resp, query, email)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, email, TenantIdFromContext(ctx))
 	switch {
 	case err == nil:
 		return &resp, nil
@@ -161,9 +190,9 @@ func (m *customUsersModel) FindActiveByEmail(ctx context.Context, email string)
 
 // FindByUsername 按用户名查询用户（未删除）
 func (m *customUsersModel) FindByUsername(ctx context.Context, username string) (*Users, error) {
-	query := fmt.Sprintf("select %s from %s where username = $1 and deleted_at IS NULL limit 1", usersRows, m.table)
+	query := fmt.Sprintf("select %s from %s where username = $1 and tenant_id = $2 and deleted_at IS NULL limit 1", usersRows, m.table)
 	var resp Users
-	err := m.QueryRowNoCacheCtx(ctx, &resp, query, username)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, username, TenantIdFromContext(ctx))
 	switch {
 	case err == nil:
 		return &resp, nil
@@ -176,9 +205,42 @@ func (m *customUsersModel) FindByUsername(ctx context.Context, username string)
 
 // FindByEmail 按邮箱查询用户（未删除）
 func (m *customUsersModel) FindByEmail(ctx context.Context, email string) (*Users, error) {
-	query := fmt.Sprintf("select %s from %s where email = $1 and deleted_at IS NULL limit 1", usersRows, m.table)
+	query := fmt.Sprintf("select %s from %s where email = $1 and tenant_id = $2 and deleted_at IS NULL limit 1", usersRows, m.table)
+	var resp Users
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, email, TenantIdFromContext(ctx))
+	switch {
+	case err == nil:
+		return &resp, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindByPhone 按手机号查询用户（未删除，包含已禁用）
+func (m *customUsersModel) FindByPhone(ctx context.Context, phone string) (*Users, error) {
+	query := fmt.Sprintf("select %s from %s where phone = $1 and tenant_id = $2 and deleted_at IS NULL limit 1", usersRows, m.table)
 	var resp Users
-	err := m.QueryRowNoCacheCtx(ctx, &resp, query, email)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, phone, TenantIdFromContext(ctx))
+	switch {
+	case err == nil:
+		return &resp, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindByEmailFold 按邮箱查询用户，忽略大小写（未删除，包含已禁用）
+// 历史数据中可能存在仅大小写不同的邮箱，此时优先返回完全匹配的记录，其次返回最早创建的记录
+func (m *customUsersModel) FindByEmailFold(ctx context.Context, email string) (*Users, error) {
+	query := fmt.Sprintf(`select %s from %s 
+		where lower(email) = lower($1) and tenant_id = $2 and deleted_at IS NULL 
+		order by (email = $1) desc, id asc limit 1`, usersRows, m.table)
+	var resp Users
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, email, TenantIdFromContext(ctx))
 	switch {
 	case err == nil:
 		return &resp, nil
@@ -191,20 +253,43 @@ func (m *customUsersModel) FindByEmail(ctx context.Context, email string) (*User
 
 // FindByNickname 按昵称查询用户
 func (m *customUsersModel) FindByNickname(ctx context.Context, nickname string) ([]*Users, error) {
-	query := fmt.Sprintf("select %s from %s where nickname = $1 and deleted_at IS NULL order by created_at", usersRows, m.table)
+	query := fmt.Sprintf("select %s from %s where nickname = $1 and tenant_id = $2 and deleted_at IS NULL order by created_at", usersRows, m.table)
 	var resp []*Users
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, nickname)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, nickname, TenantIdFromContext(ctx))
 	return resp, err
 }
 
 // FindActiveByNickname 按昵称查询活跃用户
 func (m *customUsersModel) FindActiveByNickname(ctx context.Context, nickname string) ([]*Users, error) {
-	query := fmt.Sprintf("select %s from %s where nickname = $1 and deleted_at IS NULL and disabled_at IS NULL order by created_at", usersRows, m.table)
+	query := fmt.Sprintf("select %s from %s where nickname = $1 and tenant_id = $2 and deleted_at IS NULL and disabled_at IS NULL order by created_at", usersRows, m.table)
 	var resp []*Users
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, nickname)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, nickname, TenantIdFromContext(ctx))
 	return resp, err
 }
 
+// FindBySource 按来源查询用户（未删除，包含已禁用）
+func (m *customUsersModel) FindBySource(ctx context.Context, source string) ([]*Users, error) {
+	query := fmt.Sprintf("select %s from %s where source = $1 and tenant_id = $2 and deleted_at IS NULL order by id", usersRows, m.table)
+	var resp []*Users
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, source, TenantIdFromContext(ctx))
+	return resp, err
+}
+
+// FindByExternalId 按来源和外部标识查询用户（未删除，包含已禁用）
+func (m *customUsersModel) FindByExternalId(ctx context.Context, source, externalId string) (*Users, error) {
+	query := fmt.Sprintf("select %s from %s where source = $1 and external_id = $2 and tenant_id = $3 and deleted_at IS NULL limit 1", usersRows, m.table)
+	var resp Users
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, source, externalId, TenantIdFromContext(ctx))
+	switch {
+	case err == nil:
+		return &resp, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
 // SoftDelete 软删除用户
 func (m *customUsersModel) SoftDelete(ctx context.Context, id int64) error {
 	one, err := m.FindOne(ctx, id)
@@ -221,18 +306,28 @@ func (m *customUsersModel) SoftDelete(ctx context.Context, id int64) error {
 func (m *customUsersModel) Restore(ctx context.Context, id int64) error {
 	iamUsersIdKey := fmt.Sprintf("%s%v", cacheIamUsersIdPrefix, id)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set deleted_at = NULL where id = $1", m.table)
-		return conn.ExecCtx(ctx, query, id)
+		query := fmt.Sprintf("update %s set deleted_at = NULL where id = $1 and tenant_id = $2", m.table)
+		return conn.ExecCtx(ctx, query, id, TenantIdFromContext(ctx))
 	}, iamUsersIdKey)
 	return err
 }
 
-// Disable 禁用用户
+// Disable 禁用用户，已被同步等原因禁用的用户转为手动禁用
 func (m *customUsersModel) Disable(ctx context.Context, id int64) error {
 	iamUsersIdKey := fmt.Sprintf("%s%v", cacheIamUsersIdPrefix, id)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set disabled_at = NOW() where id = $1 and deleted_at IS NULL and disabled_at IS NULL", m.table)
-		return conn.ExecCtx(ctx, query, id)
+		query := fmt.Sprintf("update %s set disabled_at = COALESCE(disabled_at, NOW()), disabled_reason = NULL where id = $1 and tenant_id = $2 and deleted_at IS NULL", m.table)
+		return conn.ExecCtx(ctx, query, id, TenantIdFromContext(ctx))
+	}, iamUsersIdKey)
+	return err
+}
+
+// DisableWithReason 禁用用户并记录原因，已禁用的用户保持原有的禁用时间和原因
+func (m *customUsersModel) DisableWithReason(ctx context.Context, id int64, reason string) error {
+	iamUsersIdKey := fmt.Sprintf("%s%v", cacheIamUsersIdPrefix, id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set disabled_at = NOW(), disabled_reason = $3 where id = $1 and tenant_id = $2 and deleted_at IS NULL and disabled_at IS NULL", m.table)
+		return conn.ExecCtx(ctx, query, id, TenantIdFromContext(ctx), reason)
 	}, iamUsersIdKey)
 	return err
 }
@@ -241,8 +336,8 @@ func (m *customUsersModel) Disable(ctx context.Context, id int64) error {
 func (m *customUsersModel) Enable(ctx context.Context, id int64) error {
 	iamUsersIdKey := fmt.Sprintf("%s%v", cacheIamUsersIdPrefix, id)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set disabled_at = NULL where id = $1 and deleted_at IS NULL", m.table)
-		return conn.ExecCtx(ctx, query, id)
+		query := fmt.Sprintf("update %s set disabled_at = NULL, disabled_reason = NULL where id = $1 and tenant_id = $2 and deleted_at IS NULL", m.table)
+		return conn.ExecCtx(ctx, query, id, TenantIdFromContext(ctx))
 	}, iamUsersIdKey)
 	return err
 }
@@ -253,12 +348,13 @@ func (m *customUsersModel) BatchSoftDelete(ctx context.Context, ids []int64) err
 		return nil
 	}
 
-	// 构建占位符
+	// 构建占位符，$1为租户ID
 	placeholders := make([]string, len(ids))
-	args := make([]interface{}, len(ids))
+	args := make([]interface{}, len(ids)+1)
+	args[0] = TenantIdFromContext(ctx)
 	for i, id := range ids {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = id
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = id
 	}
 
 	// 清除相关缓存
@@ -268,7 +364,7 @@ func (m *customUsersModel) BatchSoftDelete(ctx context.Context, ids []int64) err
 	}
 
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set deleted_at = NOW() where id IN (%s) and deleted_at IS NULL",
+		query := fmt.Sprintf("update %s set deleted_at = NOW() where tenant_id = $1 and id IN (%s) and deleted_at IS NULL",
 			m.table, strings.Join(placeholders, ","))
 		return conn.ExecCtx(ctx, query, args...)
 	}, keys...)
@@ -281,12 +377,13 @@ func (m *customUsersModel) BatchDisable(ctx context.Context, ids []int64) error
 		return nil
 	}
 
-	// 构建占位符
+	// 构建占位符，$1为租户ID
 	placeholders := make([]string, len(ids))
-	args := make([]interface{}, len(ids))
+	args := make([]interface{}, len(ids)+1)
+	args[0] = TenantIdFromContext(ctx)
 	for i, id := range ids {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = id
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args[i+1] = id
 	}
 
 	// 清除相关缓存
@@ -296,7 +393,7 @@ func (m *customUsersModel) BatchDisable(ctx context.Context, ids []int64) error
 	}
 
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set disabled_at = NOW() where id IN (%s) and deleted_at IS NULL and disabled_at IS NULL",
+		query := fmt.Sprintf("update %s set disabled_at = COALESCE(disabled_at, NOW()), disabled_reason = NULL where tenant_id = $1 and id IN (%s) and deleted_at IS NULL",
 			m.table, strings.Join(placeholders, ","))
 		return conn.ExecCtx(ctx, query, args...)
 	}, keys...)
@@ -305,9 +402,9 @@ func (m *customUsersModel) BatchDisable(ctx context.Context, ids []int64) error
 
 // ExistsByUsername 检查用户名是否存在（排除指定ID）
 func (m *customUsersModel) ExistsByUsername(ctx context.Context, username string, excludeId int64) (bool, error) {
-	query := fmt.Sprintf("select count(1) from %s where username = $1 and id != $2 and deleted_at IS NULL", m.table)
+	query := fmt.Sprintf("select count(1) from %s where username = $1 and id != $2 and tenant_id = $3 and deleted_at IS NULL", m.table)
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, username, excludeId)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, username, excludeId, TenantIdFromContext(ctx))
 	if err != nil {
 		return false, err
 	}
@@ -316,18 +413,20 @@ func (m *customUsersModel) ExistsByUsername(ctx context.Context, username string
 
 // ExistsByEmail 检查邮箱是否存在（排除指定ID）
 func (m *customUsersModel) ExistsByEmail(ctx context.Context, email string, excludeId int64) (bool, error) {
-	query := fmt.Sprintf("select count(1) from %s where email = $1 and id != $2 and deleted_at IS NULL", m.table)
+	query := fmt.Sprintf("select count(1) from %s where email = $1 and id != $2 and tenant_id = $3 and deleted_at IS NULL", m.table)
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, email, excludeId)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, email, excludeId, TenantIdFromContext(ctx))
 	if err != nil {
 		return false, err
 	}
 	return count > 0, nil
 }
+
+// ExistsByPhone 检查手机号是否存在（排除指定ID）
 func (m *customUsersModel) ExistsByPhone(ctx context.Context, phone string, excludeId int64) (bool, error) {
-	query := fmt.Sprintf("select count(1) from %s where phone = $1 and id != $2 and deleted_at IS NULL", m.table)
+	query := fmt.Sprintf("select count(1) from %s where phone = $1 and id != $2 and tenant_id = $3 and deleted_at IS NULL", m.table)
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, phone, excludeId)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, phone, excludeId, TenantIdFromContext(ctx))
 	if err != nil {
 		return false, err
 	}
@@ -335,6 +434,7 @@ func (m *customUsersModel) ExistsByPhone(ctx context.Context, phone string, excl
 }
 
 // FindOne 重写FindOne方法，排除已删除的记录
+// ID全局唯一，缓存按ID存储，读取后校验记录属于当前租户
 func (m *customUsersModel) FindOne(ctx context.Context, id int64) (*Users, error) {
 	iamUsersIdKey := fmt.Sprintf("%s%v", cacheIamUsersIdPrefix, id)
 	var resp Users
@@ -345,6 +445,9 @@ func (m *customUsersModel) FindOne(ctx context.Context, id int64) (*Users, error
 	})
 	switch {
 	case err == nil:
+		if resp.TenantId != TenantIdFromContext(ctx) {
+			return nil, ErrNotFound
+		}
 		return &resp, nil
 	case errors.Is(err, sqlc.ErrNotFound):
 		return nil, ErrNotFound
@@ -353,14 +456,14 @@ func (m *customUsersModel) FindOne(ctx context.Context, id int64) (*Users, error
 	}
 }
 
-// FindOneByEmail 重写FindOneByEmail方法，排除已删除的记录
-func (m *customUsersModel) FindOneByEmail(ctx context.Context, email sql.NullString) (*Users, error) {
-	iamUsersEmailKey := fmt.Sprintf("%s%v", cacheIamUsersEmailPrefix, email.String)
+// FindOneByTenantIdEmail 重写FindOneByTenantIdEmail方法，排除已删除的记录
+func (m *customUsersModel) FindOneByTenantIdEmail(ctx context.Context, tenantId int64, email sql.NullString) (*Users, error) {
+	iamUsersTenantIdEmailKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdEmailPrefix, tenantId, email.String)
 	var resp Users
-	err := m.QueryRowCtx(ctx, &resp, iamUsersEmailKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+	err := m.QueryRowCtx(ctx, &resp, iamUsersTenantIdEmailKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
 		// 排除软删除的
-		query := fmt.Sprintf("select %s from %s where email = $1 and deleted_at IS NULL limit 1", usersRows, m.table)
-		return conn.QueryRowCtx(ctx, v, query, email.String)
+		query := fmt.Sprintf("select %s from %s where tenant_id = $1 and email = $2 and deleted_at IS NULL limit 1", usersRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, tenantId, email.String)
 	})
 	switch {
 	case err == nil:
@@ -372,14 +475,14 @@ func (m *customUsersModel) FindOneByEmail(ctx context.Context, email sql.NullStr
 	}
 }
 
-// FindOneByUsername 重写FindOneByUsername方法，排除已删除的记录
-func (m *customUsersModel) FindOneByUsername(ctx context.Context, username string) (*Users, error) {
-	iamUsersUsernameKey := fmt.Sprintf("%s%v", cacheIamUsersUsernamePrefix, username)
+// FindOneByTenantIdUsername 重写FindOneByTenantIdUsername方法，排除已删除的记录
+func (m *customUsersModel) FindOneByTenantIdUsername(ctx context.Context, tenantId int64, username string) (*Users, error) {
+	iamUsersTenantIdUsernameKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdUsernamePrefix, tenantId, username)
 	var resp Users
-	err := m.QueryRowCtx(ctx, &resp, iamUsersUsernameKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+	err := m.QueryRowCtx(ctx, &resp, iamUsersTenantIdUsernameKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
 		// 排除软删除的
-		query := fmt.Sprintf("select %s from %s where username = $1 and deleted_at IS NULL limit 1", usersRows, m.table)
-		return conn.QueryRowCtx(ctx, v, query, username)
+		query := fmt.Sprintf("select %s from %s where tenant_id = $1 and username = $2 and deleted_at IS NULL limit 1", usersRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, tenantId, username)
 	})
 	switch {
 	case err == nil:
@@ -393,17 +496,17 @@ func (m *customUsersModel) FindOneByUsername(ctx context.Context, username strin
 
 // FindActiveWithPagination 分页查询活跃用户
 func (m *customUsersModel) FindActiveWithPagination(ctx context.Context, limit, offset int32) ([]*Users, error) {
-	query := fmt.Sprintf("select %s from %s where deleted_at IS NULL and disabled_at IS NULL order by created_at desc limit $1 offset $2", usersRows, m.table)
+	query := fmt.Sprintf("select %s from %s where tenant_id = $1 and deleted_at IS NULL and disabled_at IS NULL order by created_at desc limit $2 offset $3", usersRows, m.table)
 	var resp []*Users
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, limit, offset)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, TenantIdFromContext(ctx), limit, offset)
 	return resp, err
 }
 
 // CountActive 统计活跃用户数量
 func (m *customUsersModel) CountActive(ctx context.Context) (int64, error) {
-	query := fmt.Sprintf("select count(1) from %s where deleted_at IS NULL and disabled_at IS NULL", m.table)
+	query := fmt.Sprintf("select count(1) from %s where tenant_id = $1 and deleted_at IS NULL and disabled_at IS NULL", m.table)
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, TenantIdFromContext(ctx))
 	return count, err
 }
 
@@ -415,11 +518,11 @@ func (m *customUsersModel) SearchActiveByKeyword(ctx context.Context, keyword st
 
 	keywordPattern := "%" + keyword + "%"
 	query := fmt.Sprintf(`select %s from %s 
-		where deleted_at IS NULL and disabled_at IS NULL 
+		where tenant_id = $2 and deleted_at IS NULL and disabled_at IS NULL 
 		and (username ILIKE $1 or email ILIKE $1 or nickname ILIKE $1) 
-		order by created_at desc limit $2 offset $3`, usersRows, m.table)
+		order by created_at desc limit $3 offset $4`, usersRows, m.table)
 	var resp []*Users
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, keywordPattern, limit, offset)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, keywordPattern, TenantIdFromContext(ctx), limit, offset)
 	return resp, err
 }
 
@@ -431,10 +534,10 @@ func (m *customUsersModel) CountActiveByKeyword(ctx context.Context, keyword str
 
 	keywordPattern := "%" + keyword + "%"
 	query := fmt.Sprintf(`select count(1) from %s 
-		where deleted_at IS NULL and disabled_at IS NULL 
+		where tenant_id = $2 and deleted_at IS NULL and disabled_at IS NULL 
 		and (username ILIKE $1 or email ILIKE $1 or nickname ILIKE $1)`, m.table)
 	var count int64
-	err := m.QueryRowNoCacheCtx(ctx, &count, query, keywordPattern)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, keywordPattern, TenantIdFromContext(ctx))
 	return count, err
 }
 
@@ -442,8 +545,16 @@ func (m *customUsersModel) CountActiveByKeyword(ctx context.Context, keyword str
 func (m *customUsersModel) Insert(ctx context.Context, data *Users) (sql.Result, error) {
 	// 使用QueryRowCtx来处理RETURNING子句，获取插入后的ID
 	var insertedID int64
-	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id", m.table, usersRowsExpectAutoSet)
-	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.Username, data.Email, data.PasswordHash, data.Salt, data.Nickname, data.Phone, data.DisabledAt, data.DeletedAt)
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18) RETURNING id", m.table, usersRowsExpectAutoSet)
+	// 用户归属当前调用的租户
+	data.TenantId = TenantIdFromContext(ctx)
+	if data.Source == "" {
+		data.Source = UserSourceLocal
+	}
+	if data.PasswordChangedAt.IsZero() {
+		data.PasswordChangedAt = time.Now()
+	}
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.TenantId, data.DepartmentId, data.Username, data.Email, data.PasswordHash, data.Salt, data.Nickname, data.Phone, data.Source, data.ExternalId, data.EmailVerifiedAt, data.PhoneVerifiedAt, data.PasswordChangedAt, data.FailedLoginAttempts, data.LockedUntil, data.DisabledAt, data.DisabledReason, data.DeletedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -453,13 +564,127 @@ func (m *customUsersModel) Insert(ctx context.Context, data *Users) (sql.Result,
 
 	// 清除相关缓存
 	iamUsersIdKey := fmt.Sprintf("%s%v", cacheIamUsersIdPrefix, insertedID)
-	iamUsersEmailKey := fmt.Sprintf("%s%v", cacheIamUsersEmailPrefix, data.Email)
-	iamUsersPhoneKey := fmt.Sprintf("%s%v", cacheIamUsersPhonePrefix, data.Phone)
-	iamUsersUsernameKey := fmt.Sprintf("%s%v", cacheIamUsersUsernamePrefix, data.Username)
+	iamUsersTenantIdEmailKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdEmailPrefix, data.TenantId, data.Email)
+	iamUsersTenantIdPhoneKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdPhonePrefix, data.TenantId, data.Phone)
+	iamUsersTenantIdUsernameKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdUsernamePrefix, data.TenantId, data.Username)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		// 这里返回一个模拟的Result，包含正确的LastInsertId
 		return &customResult{insertedID: insertedID}, nil
-	}, iamUsersIdKey, iamUsersEmailKey, iamUsersPhoneKey, iamUsersUsernameKey)
+	}, iamUsersIdKey, iamUsersTenantIdEmailKey, iamUsersTenantIdPhoneKey, iamUsersTenantIdUsernameKey)
 
 	return &customResult{insertedID: insertedID}, err
 }
+
+// FindWithConditions 按条件分页查询用户（未删除，包含已禁用）
+// conditions中的占位符从$1开始编号，与args一一对应
+func (m *customUsersModel) FindWithConditions(ctx context.Context, conditions []string, args []any, limit, offset int32) ([]*Users, error) {
+	whereClause := strings.Join(append([]string{"deleted_at IS NULL", fmt.Sprintf("tenant_id = $%d", len(args)+1)}, conditions...), " and ")
+	query := fmt.Sprintf("select %s from %s where %s order by id limit $%d offset $%d",
+		usersRows, m.table, whereClause, len(args)+2, len(args)+3)
+	var resp []*Users
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, append(args, TenantIdFromContext(ctx), limit, offset)...)
+	return resp, err
+}
+
+// CountWithConditions 统计条件查询结果数量
+func (m *customUsersModel) CountWithConditions(ctx context.Context, conditions []string, args []any) (int64, error) {
+	whereClause := strings.Join(append([]string{"deleted_at IS NULL", fmt.Sprintf("tenant_id = $%d", len(args)+1)}, conditions...), " and ")
+	query := fmt.Sprintf("select count(1) from %s where %s", m.table, whereClause)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, append(args, TenantIdFromContext(ctx))...)
+	return count, err
+}
+
+// SetEmail 设置邮箱，邮箱变更时清空验证状态，返回是否变更
+func (u *Users) SetEmail(email string) bool {
+	if u.Email.Valid == (email != "") && u.Email.String == email {
+		return false
+	}
+	u.Email = sql.NullString{String: email, Valid: email != ""}
+	u.EmailVerifiedAt = sql.NullTime{}
+	return true
+}
+
+// SetPhone 设置手机号，手机号变更时清空验证状态，返回是否变更
+func (u *Users) SetPhone(phone string) bool {
+	if u.Phone.Valid == (phone != "") && u.Phone.String == phone {
+		return false
+	}
+	u.Phone = sql.NullString{String: phone, Valid: phone != ""}
+	u.PhoneVerifiedAt = sql.NullTime{}
+	return true
+}
+
+// MarkEmailVerified 标记邮箱已验证
+func (m *customUsersModel) MarkEmailVerified(ctx context.Context, id int64, email string) (bool, error) {
+	return m.markVerified(ctx, id, "email", email)
+}
+
+// MarkPhoneVerified 标记手机号已验证
+func (m *customUsersModel) MarkPhoneVerified(ctx context.Context, id int64, phone string) (bool, error) {
+	return m.markVerified(ctx, id, "phone", phone)
+}
+
+// markVerified 仅当字段值仍为验证时的值才更新验证时间
+func (m *customUsersModel) markVerified(ctx context.Context, id int64, column, value string) (bool, error) {
+	iamUsersIdKey := fmt.Sprintf("%s%v", cacheIamUsersIdPrefix, id)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s_verified_at = NOW() where id = $1 and %s = $2 and tenant_id = $3 and deleted_at IS NULL", m.table, column, column)
+		return conn.ExecCtx(ctx, query, id, value, TenantIdFromContext(ctx))
+	}, iamUsersIdKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// RecordLoginFailure 记录一次登录失败
+// maxAttempts 大于0且失败次数达到上限时，设置锁定截止时间并清零计数；maxAttempts 为0时只累加计数
+func (m *customUsersModel) RecordLoginFailure(ctx context.Context, id int64, maxAttempts, lockSeconds int64) (*Users, error) {
+	iamUsersIdKey := fmt.Sprintf("%s%v", cacheIamUsersIdPrefix, id)
+	var resp Users
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf(`update %s set 
+			failed_login_attempts = CASE WHEN $2 > 0 AND failed_login_attempts + 1 >= $2 THEN 0 ELSE failed_login_attempts + 1 END, 
+			locked_until = CASE WHEN $2 > 0 AND failed_login_attempts + 1 >= $2 THEN NOW() + make_interval(secs => $3) ELSE locked_until END 
+			where id = $1 and tenant_id = $4 and deleted_at IS NULL 
+			returning %s`, m.table, usersRows)
+		return nil, conn.QueryRowCtx(ctx, &resp, query, id, maxAttempts, lockSeconds, TenantIdFromContext(ctx))
+	}, iamUsersIdKey)
+	switch {
+	case err == nil:
+		return &resp, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// ResetLoginFailures 清零登录失败次数并解除锁定
+func (m *customUsersModel) ResetLoginFailures(ctx context.Context, id int64) error {
+	iamUsersIdKey := fmt.Sprintf("%s%v", cacheIamUsersIdPrefix, id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set failed_login_attempts = 0, locked_until = NULL where id = $1 and tenant_id = $2 and (failed_login_attempts > 0 or locked_until IS NOT NULL)", m.table)
+		return conn.ExecCtx(ctx, query, id, TenantIdFromContext(ctx))
+	}, iamUsersIdKey)
+	return err
+}
+
+// FindActiveIdsByRoleId 查询直接拥有或通过用户组继承指定角色的活跃用户ID
+func (m *customUsersModel) FindActiveIdsByRoleId(ctx context.Context, roleId int64) ([]int64, error) {
+	query := fmt.Sprintf(`select id from %s
+		where tenant_id = $2 and deleted_at IS NULL and disabled_at IS NULL and (
+			id IN (select user_id from "iam"."user_roles" where role_id = $1 and %s)
+			or id IN (
+				select gm.user_id from "iam"."group_members" gm
+				join "iam"."group_roles" gr on gr.group_id = gm.group_id
+				join "iam"."groups" g on g.id = gm.group_id
+				where gr.role_id = $1 and g.deleted_at IS NULL
+			)
+		) order by id`, m.table, GrantEffectiveClause)
+	var resp []int64
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, roleId, TenantIdFromContext(ctx))
+	return resp, err
+}
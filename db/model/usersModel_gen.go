@@ -24,19 +24,19 @@ var (
 	usersRowsExpectAutoSet   = strings.Join(stringx.Remove(usersFieldNames, "id", "create_at", "create_time", "created_at", "update_at", "update_time", "updated_at"), ",")
 	usersRowsWithPlaceHolder = builder.PostgreSqlJoin(stringx.Remove(usersFieldNames, "id", "create_at", "create_time", "created_at", "update_at", "update_time", "updated_at"))
 
-	cacheIamUsersIdPrefix       = "cache:iam:users:id:"
-	cacheIamUsersEmailPrefix    = "cache:iam:users:email:"
-	cacheIamUsersPhonePrefix    = "cache:iam:users:phone:"
-	cacheIamUsersUsernamePrefix = "cache:iam:users:username:"
+	cacheIamUsersIdPrefix               = "cache:iam:users:id:"
+	cacheIamUsersTenantIdEmailPrefix    = "cache:iam:users:tenantId:email:"
+	cacheIamUsersTenantIdPhonePrefix    = "cache:iam:users:tenantId:phone:"
+	cacheIamUsersTenantIdUsernamePrefix = "cache:iam:users:tenantId:username:"
 )
 
 type (
 	usersModel interface {
 		Insert(ctx context.Context, data *Users) (sql.Result, error)
 		FindOne(ctx context.Context, id int64) (*Users, error)
-		FindOneByEmail(ctx context.Context, email sql.NullString) (*Users, error)
-		FindOneByPhone(ctx context.Context, phone sql.NullString) (*Users, error)
-		FindOneByUsername(ctx context.Context, username string) (*Users, error)
+		FindOneByTenantIdEmail(ctx context.Context, tenantId int64, email sql.NullString) (*Users, error)
+		FindOneByTenantIdPhone(ctx context.Context, tenantId int64, phone sql.NullString) (*Users, error)
+		FindOneByTenantIdUsername(ctx context.Context, tenantId int64, username string) (*Users, error)
 		Update(ctx context.Context, data *Users) error
 		Delete(ctx context.Context, id int64) error
 	}
@@ -47,17 +47,27 @@ type (
 	}
 
 	Users struct {
-		Id           int64          `db:"id"`            // 主键ID
-		Username     string         `db:"username"`      // 用户名，唯一标识
-		Email        sql.NullString `db:"email"`         // 邮箱地址，唯一标识
-		PasswordHash string         `db:"password_hash"` // 密码哈希值，使用加盐哈希算法存储
-		Salt         string         `db:"salt"`          // 密码加盐值，用于增强密码安全性
-		Nickname     sql.NullString `db:"nickname"`      // 用户昵称
-		Phone        sql.NullString `db:"phone"`         // 手机号码
-		CreatedAt    time.Time      `db:"created_at"`    // 创建时间
-		UpdatedAt    time.Time      `db:"updated_at"`    // 更新时间，通过触发器自动维护
-		DisabledAt   sql.NullTime   `db:"disabled_at"`   // 禁用时间，NULL表示未禁用
-		DeletedAt    sql.NullTime   `db:"deleted_at"`    // 软删除时间，NULL表示未删除
+		Id                  int64          `db:"id"`                    // 主键ID
+		TenantId            int64          `db:"tenant_id"`             // 所属租户ID，外键关联tenants表
+		DepartmentId        sql.NullInt64  `db:"department_id"`         // 所属部门ID，外键关联departments表，NULL表示未分配部门
+		Username            string         `db:"username"`              // 用户名，租户内唯一
+		Email               sql.NullString `db:"email"`                 // 邮箱地址，租户内唯一
+		PasswordHash        string         `db:"password_hash"`         // 密码哈希值，使用加盐哈希算法存储
+		Salt                string         `db:"salt"`                  // 密码加盐值，用于增强密码安全性
+		Nickname            sql.NullString `db:"nickname"`              // 用户昵称
+		Phone               sql.NullString `db:"phone"`                 // 手机号码，租户内唯一
+		Source              string         `db:"source"`                // 用户来源：local-本地用户，ldap-LDAP目录同步用户，scim-SCIM接口开通用户
+		ExternalId          sql.NullString `db:"external_id"`           // 外部系统中的唯一标识（如LDAP DN、SCIM externalId），本地用户为NULL
+		EmailVerifiedAt     sql.NullTime   `db:"email_verified_at"`     // 邮箱验证时间，NULL表示未验证，邮箱变更时清空
+		PhoneVerifiedAt     sql.NullTime   `db:"phone_verified_at"`     // 手机号验证时间，NULL表示未验证，手机号变更时清空
+		PasswordChangedAt   time.Time      `db:"password_changed_at"`   // 密码最后修改时间，用于判断密码是否过期
+		FailedLoginAttempts int64          `db:"failed_login_attempts"` // 连续登录失败次数，登录成功或锁定后清零
+		LockedUntil         sql.NullTime   `db:"locked_until"`          // 账号锁定截止时间，NULL或早于当前时间表示未锁定
+		CreatedAt           time.Time      `db:"created_at"`            // 创建时间
+		UpdatedAt           time.Time      `db:"updated_at"`            // 更新时间，通过触发器自动维护
+		DisabledAt          sql.NullTime   `db:"disabled_at"`           // 禁用时间，NULL表示未禁用
+		DisabledReason      sql.NullString `db:"disabled_reason"`       // 禁用原因：NULL-手动禁用，ldap_sync-LDAP同步时因不在目录中而禁用
+		DeletedAt           sql.NullTime   `db:"deleted_at"`            // 软删除时间，NULL表示未删除
 	}
 )
 
@@ -74,14 +84,14 @@ func (m *defaultUsersModel) Delete(ctx context.Context, id int64) error {
 		return err
 	}
 
-	iamUsersEmailKey := fmt.Sprintf("%s%v", cacheIamUsersEmailPrefix, data.Email)
 	iamUsersIdKey := fmt.Sprintf("%s%v", cacheIamUsersIdPrefix, id)
-	iamUsersPhoneKey := fmt.Sprintf("%s%v", cacheIamUsersPhonePrefix, data.Phone)
-	iamUsersUsernameKey := fmt.Sprintf("%s%v", cacheIamUsersUsernamePrefix, data.Username)
+	iamUsersTenantIdEmailKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdEmailPrefix, data.TenantId, data.Email)
+	iamUsersTenantIdPhoneKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdPhonePrefix, data.TenantId, data.Phone)
+	iamUsersTenantIdUsernameKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdUsernamePrefix, data.TenantId, data.Username)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("delete from %s where id = $1", m.table)
 		return conn.ExecCtx(ctx, query, id)
-	}, iamUsersEmailKey, iamUsersIdKey, iamUsersPhoneKey, iamUsersUsernameKey)
+	}, iamUsersIdKey, iamUsersTenantIdEmailKey, iamUsersTenantIdPhoneKey, iamUsersTenantIdUsernameKey)
 	return err
 }
 
@@ -102,12 +112,12 @@ func (m *defaultUsersModel) FindOne(ctx context.Context, id int64) (*Users, erro
 	}
 }
 
-func (m *defaultUsersModel) FindOneByEmail(ctx context.Context, email sql.NullString) (*Users, error) {
-	iamUsersEmailKey := fmt.Sprintf("%s%v", cacheIamUsersEmailPrefix, email)
+func (m *defaultUsersModel) FindOneByTenantIdEmail(ctx context.Context, tenantId int64, email sql.NullString) (*Users, error) {
+	iamUsersTenantIdEmailKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdEmailPrefix, tenantId, email)
 	var resp Users
-	err := m.QueryRowIndexCtx(ctx, &resp, iamUsersEmailKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
-		query := fmt.Sprintf("select %s from %s where email = $1 limit 1", usersRows, m.table)
-		if err := conn.QueryRowCtx(ctx, &resp, query, email); err != nil {
+	err := m.QueryRowIndexCtx(ctx, &resp, iamUsersTenantIdEmailKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where tenant_id = $1 and email = $2 limit 1", usersRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, tenantId, email); err != nil {
 			return nil, err
 		}
 		return resp.Id, nil
@@ -122,12 +132,12 @@ func (m *defaultUsersModel) FindOneByEmail(ctx context.Context, email sql.NullSt
 	}
 }
 
-func (m *defaultUsersModel) FindOneByPhone(ctx context.Context, phone sql.NullString) (*Users, error) {
-	iamUsersPhoneKey := fmt.Sprintf("%s%v", cacheIamUsersPhonePrefix, phone)
+func (m *defaultUsersModel) FindOneByTenantIdPhone(ctx context.Context, tenantId int64, phone sql.NullString) (*Users, error) {
+	iamUsersTenantIdPhoneKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdPhonePrefix, tenantId, phone)
 	var resp Users
-	err := m.QueryRowIndexCtx(ctx, &resp, iamUsersPhoneKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
-		query := fmt.Sprintf("select %s from %s where phone = $1 limit 1", usersRows, m.table)
-		if err := conn.QueryRowCtx(ctx, &resp, query, phone); err != nil {
+	err := m.QueryRowIndexCtx(ctx, &resp, iamUsersTenantIdPhoneKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where tenant_id = $1 and phone = $2 limit 1", usersRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, tenantId, phone); err != nil {
 			return nil, err
 		}
 		return resp.Id, nil
@@ -142,12 +152,12 @@ func (m *defaultUsersModel) FindOneByPhone(ctx context.Context, phone sql.NullSt
 	}
 }
 
-func (m *defaultUsersModel) FindOneByUsername(ctx context.Context, username string) (*Users, error) {
-	iamUsersUsernameKey := fmt.Sprintf("%s%v", cacheIamUsersUsernamePrefix, username)
+func (m *defaultUsersModel) FindOneByTenantIdUsername(ctx context.Context, tenantId int64, username string) (*Users, error) {
+	iamUsersTenantIdUsernameKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdUsernamePrefix, tenantId, username)
 	var resp Users
-	err := m.QueryRowIndexCtx(ctx, &resp, iamUsersUsernameKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
-		query := fmt.Sprintf("select %s from %s where username = $1 limit 1", usersRows, m.table)
-		if err := conn.QueryRowCtx(ctx, &resp, query, username); err != nil {
+	err := m.QueryRowIndexCtx(ctx, &resp, iamUsersTenantIdUsernameKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where tenant_id = $1 and username = $2 limit 1", usersRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, tenantId, username); err != nil {
 			return nil, err
 		}
 		return resp.Id, nil
@@ -163,14 +173,14 @@ func (m *defaultUsersModel) FindOneByUsername(ctx context.Context, username stri
 }
 
 func (m *defaultUsersModel) Insert(ctx context.Context, data *Users) (sql.Result, error) {
-	iamUsersEmailKey := fmt.Sprintf("%s%v", cacheIamUsersEmailPrefix, data.Email)
 	iamUsersIdKey := fmt.Sprintf("%s%v", cacheIamUsersIdPrefix, data.Id)
-	iamUsersPhoneKey := fmt.Sprintf("%s%v", cacheIamUsersPhonePrefix, data.Phone)
-	iamUsersUsernameKey := fmt.Sprintf("%s%v", cacheIamUsersUsernamePrefix, data.Username)
+	iamUsersTenantIdEmailKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdEmailPrefix, data.TenantId, data.Email)
+	iamUsersTenantIdPhoneKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdPhonePrefix, data.TenantId, data.Phone)
+	iamUsersTenantIdUsernameKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdUsernamePrefix, data.TenantId, data.Username)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8)", m.table, usersRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.Username, data.Email, data.PasswordHash, data.Salt, data.Nickname, data.Phone, data.DisabledAt, data.DeletedAt)
-	}, iamUsersEmailKey, iamUsersIdKey, iamUsersPhoneKey, iamUsersUsernameKey)
+		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)", m.table, usersRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.TenantId, data.DepartmentId, data.Username, data.Email, data.PasswordHash, data.Salt, data.Nickname, data.Phone, data.Source, data.ExternalId, data.EmailVerifiedAt, data.PhoneVerifiedAt, data.PasswordChangedAt, data.FailedLoginAttempts, data.LockedUntil, data.DisabledAt, data.DisabledReason, data.DeletedAt)
+	}, iamUsersIdKey, iamUsersTenantIdEmailKey, iamUsersTenantIdPhoneKey, iamUsersTenantIdUsernameKey)
 	return ret, err
 }
 
@@ -180,14 +190,14 @@ func (m *defaultUsersModel) Update(ctx context.Context, newData *Users) error {
 		return err
 	}
 
-	iamUsersEmailKey := fmt.Sprintf("%s%v", cacheIamUsersEmailPrefix, data.Email)
 	iamUsersIdKey := fmt.Sprintf("%s%v", cacheIamUsersIdPrefix, data.Id)
-	iamUsersPhoneKey := fmt.Sprintf("%s%v", cacheIamUsersPhonePrefix, data.Phone)
-	iamUsersUsernameKey := fmt.Sprintf("%s%v", cacheIamUsersUsernamePrefix, data.Username)
+	iamUsersTenantIdEmailKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdEmailPrefix, data.TenantId, data.Email)
+	iamUsersTenantIdPhoneKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdPhonePrefix, data.TenantId, data.Phone)
+	iamUsersTenantIdUsernameKey := fmt.Sprintf("%s%v:%v", cacheIamUsersTenantIdUsernamePrefix, data.TenantId, data.Username)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where id = $1", m.table, usersRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.Id, newData.Username, newData.Email, newData.PasswordHash, newData.Salt, newData.Nickname, newData.Phone, newData.DisabledAt, newData.DeletedAt)
-	}, iamUsersEmailKey, iamUsersIdKey, iamUsersPhoneKey, iamUsersUsernameKey)
+		return conn.ExecCtx(ctx, query, newData.Id, newData.TenantId, newData.DepartmentId, newData.Username, newData.Email, newData.PasswordHash, newData.Salt, newData.Nickname, newData.Phone, newData.Source, newData.ExternalId, newData.EmailVerifiedAt, newData.PhoneVerifiedAt, newData.PasswordChangedAt, newData.FailedLoginAttempts, newData.LockedUntil, newData.DisabledAt, newData.DisabledReason, newData.DeletedAt)
+	}, iamUsersIdKey, iamUsersTenantIdEmailKey, iamUsersTenantIdPhoneKey, iamUsersTenantIdUsernameKey)
 	return err
 }
 
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// GrantEffectiveClause 用户角色和角色权限当前生效的查询条件：已到生效时间且未到失效时间
+const GrantEffectiveClause = "(valid_from IS NULL or valid_from <= NOW()) and (valid_until IS NULL or valid_until > NOW())"
+
+// GrantUnexpiredClause 用户角色和角色权限未过期的查询条件，包括尚未生效的授权
+const GrantUnexpiredClause = "(valid_until IS NULL or valid_until > NOW())"
+
+// Validity 用户角色和角色权限的有效期，From为空表示立即生效，Until为空表示永久有效
+type Validity struct {
+	From  sql.NullTime
+	Until sql.NullTime
+}
+
+// Effective 在指定时间是否生效
+func (v Validity) Effective(now time.Time) bool {
+	return (!v.From.Valid || !v.From.Time.After(now)) && !v.Expired(now)
+}
+
+// Expired 在指定时间是否已过期
+func (v Validity) Expired(now time.Time) bool {
+	return v.Until.Valid && !v.Until.Time.After(now)
+}
+
+// Widen 合并已有有效期与新的有效期，返回同时覆盖两者的有效期：生效时间取较早者，失效时间取较晚者，为空表示不限
+// 已有有效期在now时已过期时不再保留，直接返回新的有效期
+func (v Validity) Widen(o Validity, now time.Time) Validity {
+	if v.Expired(now) {
+		return o
+	}
+	merged := v
+	if !o.From.Valid || (merged.From.Valid && o.From.Time.Before(merged.From.Time)) {
+		merged.From = o.From
+	}
+	if !o.Until.Valid || (merged.Until.Valid && o.Until.Time.After(merged.Until.Time)) {
+		merged.Until = o.Until
+	}
+	return merged
+}
+
+// Equal 两个有效期是否相同
+func (v Validity) Equal(o Validity) bool {
+	return nullTimeEqual(v.From, o.From) && nullTimeEqual(v.Until, o.Until)
+}
+
+func nullTimeEqual(a, b sql.NullTime) bool {
+	return a.Valid == b.Valid && (!a.Valid || a.Time.Equal(b.Time))
+}
+
+// Validity 用户角色关联的有效期
+func (r *UserRoles) Validity() Validity {
+	return Validity{From: r.ValidFrom, Until: r.ValidUntil}
+}
+
+// Validity 角色权限关联的有效期
+func (r *RolePermissions) Validity() Validity {
+	return Validity{From: r.ValidFrom, Until: r.ValidUntil}
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 验证渠道
+const (
+	VerificationChannelEmail = "email" // 邮箱
+	VerificationChannelPhone = "phone" // 手机号
+)
+
+var _ VerificationCodesModel = (*customVerificationCodesModel)(nil)
+
+type (
+	// VerificationCodesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customVerificationCodesModel.
+	VerificationCodesModel interface {
+		verificationCodesModel
+		FindLatestPending(ctx context.Context, userId int64, channel string) (*VerificationCodes, error) // 查询最新的待使用验证码（包含已过期）
+		IncrementAttempts(ctx context.Context, id int64) error                                           // 增加校验次数
+		Consume(ctx context.Context, id int64) (bool, error)                                             // 使用验证码，已被使用时返回false
+		InvalidatePending(ctx context.Context, userId int64, channel string) error                       // 作废用户在该渠道的所有待使用验证码
+	}
+
+	customVerificationCodesModel struct {
+		*defaultVerificationCodesModel
+	}
+)
+
+// NewVerificationCodesModel returns a model for the database table.
+func NewVerificationCodesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) VerificationCodesModel {
+	return &customVerificationCodesModel{
+		defaultVerificationCodesModel: newVerificationCodesModel(conn, c, opts...),
+	}
+}
+
+// Insert 插入验证码并返回ID
+func (m *customVerificationCodesModel) Insert(ctx context.Context, data *VerificationCodes) (sql.Result, error) {
+	var insertedID int64
+	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7) RETURNING id", m.table, verificationCodesRowsExpectAutoSet)
+	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.UserId, data.Channel, data.Target, data.CodeHash, data.Attempts, data.ExpiresAt, data.ConsumedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Id = insertedID
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// FindLatestPending 查询最新的待使用验证码
+func (m *customVerificationCodesModel) FindLatestPending(ctx context.Context, userId int64, channel string) (*VerificationCodes, error) {
+	query := fmt.Sprintf("select %s from %s where user_id = $1 and channel = $2 and consumed_at IS NULL order by created_at desc, id desc limit 1", verificationCodesRows, m.table)
+	var resp VerificationCodes
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, userId, channel)
+	switch {
+	case err == nil:
+		return &resp, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// IncrementAttempts 增加校验次数
+func (m *customVerificationCodesModel) IncrementAttempts(ctx context.Context, id int64) error {
+	iamVerificationCodesIdKey := fmt.Sprintf("%s%v", cacheIamVerificationCodesIdPrefix, id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set attempts = attempts + 1 where id = $1", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, iamVerificationCodesIdKey)
+	return err
+}
+
+// Consume 使用验证码
+func (m *customVerificationCodesModel) Consume(ctx context.Context, id int64) (bool, error) {
+	iamVerificationCodesIdKey := fmt.Sprintf("%s%v", cacheIamVerificationCodesIdPrefix, id)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set consumed_at = NOW() where id = $1 and consumed_at IS NULL", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, iamVerificationCodesIdKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// InvalidatePending 作废用户在该渠道的所有待使用验证码
+func (m *customVerificationCodesModel) InvalidatePending(ctx context.Context, userId int64, channel string) error {
+	// 验证码只按ID缓存，且查询均不走缓存，这里直接更新
+	query := fmt.Sprintf("update %s set consumed_at = NOW() where user_id = $1 and channel = $2 and consumed_at IS NULL", m.table)
+	_, err := m.ExecNoCacheCtx(ctx, query, userId, channel)
+	return err
+}
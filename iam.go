@@ -1,18 +1,36 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
+	"os"
+
 	"github.com/ziptako/iam/iam"
 	"github.com/ziptako/iam/internal/config"
+	"github.com/ziptako/iam/internal/delegation"
+	"github.com/ziptako/iam/internal/impersonation"
+	"github.com/ziptako/iam/internal/policy"
+	"github.com/ziptako/iam/internal/rpcauth"
+	"github.com/ziptako/iam/internal/scim"
 	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/tenant"
 
+	accessrequestserviceServer "github.com/ziptako/iam/internal/server/accessrequestservice"
+	accessreviewserviceServer "github.com/ziptako/iam/internal/server/accessreviewservice"
+	bindingserviceServer "github.com/ziptako/iam/internal/server/bindingservice"
+	departmentserviceServer "github.com/ziptako/iam/internal/server/departmentservice"
+	groupserviceServer "github.com/ziptako/iam/internal/server/groupservice"
 	permissionserviceServer "github.com/ziptako/iam/internal/server/permissionservice"
+	policyserviceServer "github.com/ziptako/iam/internal/server/policyservice"
+	relationserviceServer "github.com/ziptako/iam/internal/server/relationservice"
 	roleserviceServer "github.com/ziptako/iam/internal/server/roleservice"
 	userserviceServer "github.com/ziptako/iam/internal/server/userservice"
 
 	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/core/threading"
 	"github.com/zeromicro/go-zero/zrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -21,6 +39,12 @@ import (
 var configFile = flag.String("f", "etc/iam.yaml", "the config file")
 
 func main() {
+	// 策略清单子命令：iam policy <export|plan|apply>，执行后退出，不启动服务
+	if len(os.Args) > 1 && os.Args[1] == "policy" {
+		cmd := &policy.Command{Stdout: os.Stdout, Stderr: os.Stderr, Load: loadPolicyService}
+		os.Exit(cmd.Run(os.Args[2:]))
+	}
+
 	flag.Parse()
 
 	var c config.Config
@@ -34,13 +58,78 @@ func main() {
 		iam.RegisterRoleServiceServer(grpcServer, roleserviceServer.NewRoleServiceServer(ctx))
 		// 注册权限服务
 		iam.RegisterPermissionServiceServer(grpcServer, permissionserviceServer.NewPermissionServiceServer(ctx))
+		// 注册用户组服务
+		iam.RegisterGroupServiceServer(grpcServer, groupserviceServer.NewGroupServiceServer(ctx))
+		// 注册资源绑定服务
+		iam.RegisterBindingServiceServer(grpcServer, bindingserviceServer.NewBindingServiceServer(ctx))
+		// 注册部门服务
+		iam.RegisterDepartmentServiceServer(grpcServer, departmentserviceServer.NewDepartmentServiceServer(ctx))
+		// 注册关系服务
+		iam.RegisterRelationServiceServer(grpcServer, relationserviceServer.NewRelationServiceServer(ctx))
+		// 注册访问申请服务
+		iam.RegisterAccessRequestServiceServer(grpcServer, accessrequestserviceServer.NewAccessRequestServiceServer(ctx))
+		// 注册访问复核服务
+		iam.RegisterAccessReviewServiceServer(grpcServer, accessreviewserviceServer.NewAccessReviewServiceServer(ctx))
+		// 注册策略服务
+		iam.RegisterPolicyServiceServer(grpcServer, policyserviceServer.NewPolicyServiceServer(ctx))
 
 		if c.Mode == service.DevMode || c.Mode == service.TestMode {
 			reflection.Register(grpcServer)
 		}
 	})
+	// 解析调用所属租户，之后的查询都按租户隔离
+	s.AddUnaryInterceptors(tenant.UnaryServerInterceptor(c.Tenant, ctx.TenantsModel))
+	// 认证调用方并按接口对应的内置权限编码鉴权，IAM自身也受RBAC管控
+	if c.RpcAuth.Enabled {
+		if rpcauth.MtlsEnabled(c.RpcAuth) {
+			creds, err := rpcauth.ServerCredentials(c.RpcAuth)
+			logx.Must(err)
+			s.AddOptions(grpc.Creds(creds))
+		}
+		s.AddUnaryInterceptors(rpcauth.UnaryServerInterceptor(ctx.RpcAuthn, ctx.RpcAuthz))
+	}
+	// 识别模拟登录调用，打标日志并写入审计记录
+	s.AddUnaryInterceptors(impersonation.UnaryServerInterceptor(ctx.Impersonation, ctx.ImpersonationsModel, ctx.AuditLogsModel))
+	// 按调用人的委派管理范围校验变更接口，调用人身份只信任rpcauth的认证结果
+	if ctx.Delegation.Enabled() {
+		if !c.RpcAuth.Enabled {
+			logx.Must(errors.New("Delegation requires RpcAuth to be enabled"))
+		}
+		s.AddUnaryInterceptors(delegation.UnaryServerInterceptor(ctx.Delegation))
+	}
 	defer s.Stop()
 
+	// 启动限时授权到期清理
+	ctx.ExpirySweeper.Start()
+	defer ctx.ExpirySweeper.Stop()
+
+	// 启动权限使用记录的定时写入
+	ctx.Usage.Start()
+	defer ctx.Usage.Stop()
+
+	// 启动LDAP定时同步
+	if ctx.LdapSyncer != nil {
+		ctx.LdapSyncer.Start()
+		defer ctx.LdapSyncer.Stop()
+	}
+
+	// 启动SCIM开通接口
+	if c.Scim.Enabled {
+		scimServer := scim.NewServer(c.Scim, ctx.UsersModel, ctx.RolesModel, ctx.UserRolesModel, ctx.RolePermissionsModel,
+			ctx.SessionsModel, ctx.Constraints)
+		threading.GoSafe(scimServer.Start)
+		defer scimServer.Stop()
+	}
+
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
 	s.Start()
 }
+
+// loadPolicyService 按配置文件创建策略即代码服务，供policy子命令使用
+func loadPolicyService(configFile string) (*policy.Service, error) {
+	var c config.Config
+	if err := conf.Load(configFile, &c); err != nil {
+		return nil, err
+	}
+	return svc.NewServiceContext(c).Policy, nil
+}
@@ -19,21 +19,40 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_CreateUser_FullMethodName          = "/iam.userService/CreateUser"
-	UserService_GetUser_FullMethodName             = "/iam.userService/GetUser"
-	UserService_UpdateUser_FullMethodName          = "/iam.userService/UpdateUser"
-	UserService_DeleteUser_FullMethodName          = "/iam.userService/DeleteUser"
-	UserService_ListUsers_FullMethodName           = "/iam.userService/ListUsers"
-	UserService_AssignUserRole_FullMethodName      = "/iam.userService/AssignUserRole"
-	UserService_AssignUserRoles_FullMethodName     = "/iam.userService/AssignUserRoles"
-	UserService_RemoveUserRole_FullMethodName      = "/iam.userService/RemoveUserRole"
-	UserService_RemoveUserRoles_FullMethodName     = "/iam.userService/RemoveUserRoles"
-	UserService_GetUserRoles_FullMethodName        = "/iam.userService/GetUserRoles"
-	UserService_CheckUserRole_FullMethodName       = "/iam.userService/CheckUserRole"
-	UserService_CheckUserPermission_FullMethodName = "/iam.userService/CheckUserPermission"
-	UserService_GetUserByUsername_FullMethodName   = "/iam.userService/GetUserByUsername"
-	UserService_VerifyPassword_FullMethodName      = "/iam.userService/VerifyPassword"
-	UserService_ChangePassword_FullMethodName      = "/iam.userService/ChangePassword"
+	UserService_CreateUser_FullMethodName                  = "/iam.userService/CreateUser"
+	UserService_GetUser_FullMethodName                     = "/iam.userService/GetUser"
+	UserService_UpdateUser_FullMethodName                  = "/iam.userService/UpdateUser"
+	UserService_DeleteUser_FullMethodName                  = "/iam.userService/DeleteUser"
+	UserService_ListUsers_FullMethodName                   = "/iam.userService/ListUsers"
+	UserService_AssignUserRole_FullMethodName              = "/iam.userService/AssignUserRole"
+	UserService_AssignUserRoles_FullMethodName             = "/iam.userService/AssignUserRoles"
+	UserService_ExtendUserRole_FullMethodName              = "/iam.userService/ExtendUserRole"
+	UserService_RemoveUserRole_FullMethodName              = "/iam.userService/RemoveUserRole"
+	UserService_RemoveUserRoles_FullMethodName             = "/iam.userService/RemoveUserRoles"
+	UserService_GetUserRoles_FullMethodName                = "/iam.userService/GetUserRoles"
+	UserService_CheckUserRole_FullMethodName               = "/iam.userService/CheckUserRole"
+	UserService_CheckUserPermission_FullMethodName         = "/iam.userService/CheckUserPermission"
+	UserService_CheckUserResourcePermission_FullMethodName = "/iam.userService/CheckUserResourcePermission"
+	UserService_GetUserByUsername_FullMethodName           = "/iam.userService/GetUserByUsername"
+	UserService_VerifyPassword_FullMethodName              = "/iam.userService/VerifyPassword"
+	UserService_ChangePassword_FullMethodName              = "/iam.userService/ChangePassword"
+	UserService_SyncLdapUsers_FullMethodName               = "/iam.userService/SyncLdapUsers"
+	UserService_CreateSession_FullMethodName               = "/iam.userService/CreateSession"
+	UserService_TouchSession_FullMethodName                = "/iam.userService/TouchSession"
+	UserService_ListUserSessions_FullMethodName            = "/iam.userService/ListUserSessions"
+	UserService_RevokeSession_FullMethodName               = "/iam.userService/RevokeSession"
+	UserService_RevokeAllUserSessions_FullMethodName       = "/iam.userService/RevokeAllUserSessions"
+	UserService_Impersonate_FullMethodName                 = "/iam.userService/Impersonate"
+	UserService_SendVerification_FullMethodName            = "/iam.userService/SendVerification"
+	UserService_ConfirmVerification_FullMethodName         = "/iam.userService/ConfirmVerification"
+	UserService_Authenticate_FullMethodName                = "/iam.userService/Authenticate"
+	UserService_GetUserDataScope_FullMethodName            = "/iam.userService/GetUserDataScope"
+	UserService_GetUserPermissions_FullMethodName          = "/iam.userService/GetUserPermissions"
+	UserService_SetAdminScope_FullMethodName               = "/iam.userService/SetAdminScope"
+	UserService_GetAdminScope_FullMethodName               = "/iam.userService/GetAdminScope"
+	UserService_DeleteAdminScope_FullMethodName            = "/iam.userService/DeleteAdminScope"
+	UserService_ExplainUserPermission_FullMethodName       = "/iam.userService/ExplainUserPermission"
+	UserService_SimulateChange_FullMethodName              = "/iam.userService/SimulateChange"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -59,6 +78,8 @@ type UserServiceClient interface {
 	AssignUserRole(ctx context.Context, in *AssignUserRoleRequest, opts ...grpc.CallOption) (*AssignUserRoleResponse, error)
 	// AssignUserRoles 为用户批量分配角色
 	AssignUserRoles(ctx context.Context, in *AssignUserRolesRequest, opts ...grpc.CallOption) (*AssignUserRolesResponse, error)
+	// ExtendUserRole 调整用户直接分配角色的失效时间
+	ExtendUserRole(ctx context.Context, in *ExtendUserRoleRequest, opts ...grpc.CallOption) (*ExtendUserRoleResponse, error)
 	// RemoveUserRole 移除用户的单个角色
 	RemoveUserRole(ctx context.Context, in *RemoveUserRoleRequest, opts ...grpc.CallOption) (*RemoveUserRoleResponse, error)
 	// RemoveUserRoles 批量移除用户的角色
@@ -67,14 +88,50 @@ type UserServiceClient interface {
 	GetUserRoles(ctx context.Context, in *GetUserRolesRequest, opts ...grpc.CallOption) (*GetUserRolesResponse, error)
 	// CheckUserRole 检查用户是否拥有指定角色
 	CheckUserRole(ctx context.Context, in *CheckUserRoleRequest, opts ...grpc.CallOption) (*CheckUserRoleResponse, error)
-	// CheckUserPermission 检查用户是否具备指定权限
+	// CheckUserPermission 检查用户是否具备指定权限，带条件的授权根据请求上下文求值，任一角色拒绝即不具备
 	CheckUserPermission(ctx context.Context, in *CheckUserPermissionRequest, opts ...grpc.CallOption) (*CheckUserPermissionResponse, error)
+	// CheckUserResourcePermission 检查用户在指定资源上是否具备权限，合并全局角色与该资源上的绑定角色
+	CheckUserResourcePermission(ctx context.Context, in *CheckUserResourcePermissionRequest, opts ...grpc.CallOption) (*CheckUserPermissionResponse, error)
 	// GetUserByUsername 根据用户名获取用户详情
 	GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, opts ...grpc.CallOption) (*User, error)
-	// VerifyPassword 验证用户密码
+	// VerifyPassword 验证用户密码，与Authenticate共用失败次数和账号锁定
 	VerifyPassword(ctx context.Context, in *VerifyPasswordRequest, opts ...grpc.CallOption) (*VerifyPasswordResponse, error)
 	// ChangePassword 修改用户密码
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	// SyncLdapUsers 从LDAP目录同步用户及组角色映射
+	SyncLdapUsers(ctx context.Context, in *SyncLdapUsersRequest, opts ...grpc.CallOption) (*SyncLdapUsersResponse, error)
+	// CreateSession 登录成功后记录用户会话
+	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*Session, error)
+	// TouchSession 刷新会话最后活跃时间，并返回会话是否仍有效
+	TouchSession(ctx context.Context, in *TouchSessionRequest, opts ...grpc.CallOption) (*TouchSessionResponse, error)
+	// ListUserSessions 分页查询用户的会话
+	ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*ListUserSessionsResponse, error)
+	// RevokeSession 撤销单个会话
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	// RevokeAllUserSessions 撤销用户的所有活跃会话
+	RevokeAllUserSessions(ctx context.Context, in *RevokeAllUserSessionsRequest, opts ...grpc.CallOption) (*RevokeAllUserSessionsResponse, error)
+	// Impersonate 管理员以指定用户身份访问，签发限时模拟凭证
+	Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*ImpersonateResponse, error)
+	// SendVerification 向用户邮箱或手机号发送验证码
+	SendVerification(ctx context.Context, in *SendVerificationRequest, opts ...grpc.CallOption) (*SendVerificationResponse, error)
+	// ConfirmVerification 校验验证码并标记邮箱或手机号已验证
+	ConfirmVerification(ctx context.Context, in *ConfirmVerificationRequest, opts ...grpc.CallOption) (*ConfirmVerificationResponse, error)
+	// Authenticate 使用用户名、邮箱或手机号加密码登录认证
+	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	// GetUserDataScope 获取用户的数据权限范围，合并用户所有角色的数据权限并解析为部门ID列表
+	GetUserDataScope(ctx context.Context, in *GetUserDataScopeRequest, opts ...grpc.CallOption) (*GetUserDataScopeResponse, error)
+	// GetUserPermissions 获取用户拥有的全部具体权限，通配授权展开为其覆盖的权限
+	GetUserPermissions(ctx context.Context, in *GetUserPermissionsRequest, opts ...grpc.CallOption) (*GetUserPermissionsResponse, error)
+	// SetAdminScope 设置委派管理员的管理范围，覆盖原有配置
+	SetAdminScope(ctx context.Context, in *SetAdminScopeRequest, opts ...grpc.CallOption) (*AdminScope, error)
+	// GetAdminScope 获取委派管理员的管理范围
+	GetAdminScope(ctx context.Context, in *GetAdminScopeRequest, opts ...grpc.CallOption) (*AdminScope, error)
+	// DeleteAdminScope 删除委派管理员的管理范围，删除后该用户不能再调用管理接口
+	DeleteAdminScope(ctx context.Context, in *DeleteAdminScopeRequest, opts ...grpc.CallOption) (*DeleteAdminScopeResponse, error)
+	// ExplainUserPermission 解释用户权限检查的决策过程，返回结构化的决策轨迹和可读文本
+	ExplainUserPermission(ctx context.Context, in *ExplainUserPermissionRequest, opts ...grpc.CallOption) (*ExplainUserPermissionResponse, error)
+	// SimulateChange 模拟一组策略变更，返回受影响用户有效权限的差异；只在内存中求值，不修改任何数据
+	SimulateChange(ctx context.Context, in *SimulateChangeRequest, opts ...grpc.CallOption) (*SimulateChangeResponse, error)
 }
 
 type userServiceClient struct {
@@ -155,6 +212,16 @@ func (c *userServiceClient) AssignUserRoles(ctx context.Context, in *AssignUserR
 	return out, nil
 }
 
+func (c *userServiceClient) ExtendUserRole(ctx context.Context, in *ExtendUserRoleRequest, opts ...grpc.CallOption) (*ExtendUserRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendUserRoleResponse)
+	err := c.cc.Invoke(ctx, UserService_ExtendUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) RemoveUserRole(ctx context.Context, in *RemoveUserRoleRequest, opts ...grpc.CallOption) (*RemoveUserRoleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RemoveUserRoleResponse)
@@ -205,6 +272,16 @@ func (c *userServiceClient) CheckUserPermission(ctx context.Context, in *CheckUs
 	return out, nil
 }
 
+func (c *userServiceClient) CheckUserResourcePermission(ctx context.Context, in *CheckUserResourcePermissionRequest, opts ...grpc.CallOption) (*CheckUserPermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckUserPermissionResponse)
+	err := c.cc.Invoke(ctx, UserService_CheckUserResourcePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, opts ...grpc.CallOption) (*User, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(User)
@@ -235,6 +312,176 @@ func (c *userServiceClient) ChangePassword(ctx context.Context, in *ChangePasswo
 	return out, nil
 }
 
+func (c *userServiceClient) SyncLdapUsers(ctx context.Context, in *SyncLdapUsersRequest, opts ...grpc.CallOption) (*SyncLdapUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncLdapUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_SyncLdapUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*Session, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Session)
+	err := c.cc.Invoke(ctx, UserService_CreateSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) TouchSession(ctx context.Context, in *TouchSessionRequest, opts ...grpc.CallOption) (*TouchSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TouchSessionResponse)
+	err := c.cc.Invoke(ctx, UserService_TouchSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*ListUserSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeAllUserSessions(ctx context.Context, in *RevokeAllUserSessionsRequest, opts ...grpc.CallOption) (*RevokeAllUserSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllUserSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeAllUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*ImpersonateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonateResponse)
+	err := c.cc.Invoke(ctx, UserService_Impersonate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SendVerification(ctx context.Context, in *SendVerificationRequest, opts ...grpc.CallOption) (*SendVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendVerificationResponse)
+	err := c.cc.Invoke(ctx, UserService_SendVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmVerification(ctx context.Context, in *ConfirmVerificationRequest, opts ...grpc.CallOption) (*ConfirmVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmVerificationResponse)
+	err := c.cc.Invoke(ctx, UserService_ConfirmVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthenticateResponse)
+	err := c.cc.Invoke(ctx, UserService_Authenticate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserDataScope(ctx context.Context, in *GetUserDataScopeRequest, opts ...grpc.CallOption) (*GetUserDataScopeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserDataScopeResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserDataScope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserPermissions(ctx context.Context, in *GetUserPermissionsRequest, opts ...grpc.CallOption) (*GetUserPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserPermissionsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetAdminScope(ctx context.Context, in *SetAdminScopeRequest, opts ...grpc.CallOption) (*AdminScope, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminScope)
+	err := c.cc.Invoke(ctx, UserService_SetAdminScope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetAdminScope(ctx context.Context, in *GetAdminScopeRequest, opts ...grpc.CallOption) (*AdminScope, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminScope)
+	err := c.cc.Invoke(ctx, UserService_GetAdminScope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteAdminScope(ctx context.Context, in *DeleteAdminScopeRequest, opts ...grpc.CallOption) (*DeleteAdminScopeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAdminScopeResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteAdminScope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ExplainUserPermission(ctx context.Context, in *ExplainUserPermissionRequest, opts ...grpc.CallOption) (*ExplainUserPermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainUserPermissionResponse)
+	err := c.cc.Invoke(ctx, UserService_ExplainUserPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SimulateChange(ctx context.Context, in *SimulateChangeRequest, opts ...grpc.CallOption) (*SimulateChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateChangeResponse)
+	err := c.cc.Invoke(ctx, UserService_SimulateChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -258,6 +505,8 @@ type UserServiceServer interface {
 	AssignUserRole(context.Context, *AssignUserRoleRequest) (*AssignUserRoleResponse, error)
 	// AssignUserRoles 为用户批量分配角色
 	AssignUserRoles(context.Context, *AssignUserRolesRequest) (*AssignUserRolesResponse, error)
+	// ExtendUserRole 调整用户直接分配角色的失效时间
+	ExtendUserRole(context.Context, *ExtendUserRoleRequest) (*ExtendUserRoleResponse, error)
 	// RemoveUserRole 移除用户的单个角色
 	RemoveUserRole(context.Context, *RemoveUserRoleRequest) (*RemoveUserRoleResponse, error)
 	// RemoveUserRoles 批量移除用户的角色
@@ -266,14 +515,50 @@ type UserServiceServer interface {
 	GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error)
 	// CheckUserRole 检查用户是否拥有指定角色
 	CheckUserRole(context.Context, *CheckUserRoleRequest) (*CheckUserRoleResponse, error)
-	// CheckUserPermission 检查用户是否具备指定权限
+	// CheckUserPermission 检查用户是否具备指定权限，带条件的授权根据请求上下文求值，任一角色拒绝即不具备
 	CheckUserPermission(context.Context, *CheckUserPermissionRequest) (*CheckUserPermissionResponse, error)
+	// CheckUserResourcePermission 检查用户在指定资源上是否具备权限，合并全局角色与该资源上的绑定角色
+	CheckUserResourcePermission(context.Context, *CheckUserResourcePermissionRequest) (*CheckUserPermissionResponse, error)
 	// GetUserByUsername 根据用户名获取用户详情
 	GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*User, error)
-	// VerifyPassword 验证用户密码
+	// VerifyPassword 验证用户密码，与Authenticate共用失败次数和账号锁定
 	VerifyPassword(context.Context, *VerifyPasswordRequest) (*VerifyPasswordResponse, error)
 	// ChangePassword 修改用户密码
 	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// SyncLdapUsers 从LDAP目录同步用户及组角色映射
+	SyncLdapUsers(context.Context, *SyncLdapUsersRequest) (*SyncLdapUsersResponse, error)
+	// CreateSession 登录成功后记录用户会话
+	CreateSession(context.Context, *CreateSessionRequest) (*Session, error)
+	// TouchSession 刷新会话最后活跃时间，并返回会话是否仍有效
+	TouchSession(context.Context, *TouchSessionRequest) (*TouchSessionResponse, error)
+	// ListUserSessions 分页查询用户的会话
+	ListUserSessions(context.Context, *ListUserSessionsRequest) (*ListUserSessionsResponse, error)
+	// RevokeSession 撤销单个会话
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	// RevokeAllUserSessions 撤销用户的所有活跃会话
+	RevokeAllUserSessions(context.Context, *RevokeAllUserSessionsRequest) (*RevokeAllUserSessionsResponse, error)
+	// Impersonate 管理员以指定用户身份访问，签发限时模拟凭证
+	Impersonate(context.Context, *ImpersonateRequest) (*ImpersonateResponse, error)
+	// SendVerification 向用户邮箱或手机号发送验证码
+	SendVerification(context.Context, *SendVerificationRequest) (*SendVerificationResponse, error)
+	// ConfirmVerification 校验验证码并标记邮箱或手机号已验证
+	ConfirmVerification(context.Context, *ConfirmVerificationRequest) (*ConfirmVerificationResponse, error)
+	// Authenticate 使用用户名、邮箱或手机号加密码登录认证
+	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	// GetUserDataScope 获取用户的数据权限范围，合并用户所有角色的数据权限并解析为部门ID列表
+	GetUserDataScope(context.Context, *GetUserDataScopeRequest) (*GetUserDataScopeResponse, error)
+	// GetUserPermissions 获取用户拥有的全部具体权限，通配授权展开为其覆盖的权限
+	GetUserPermissions(context.Context, *GetUserPermissionsRequest) (*GetUserPermissionsResponse, error)
+	// SetAdminScope 设置委派管理员的管理范围，覆盖原有配置
+	SetAdminScope(context.Context, *SetAdminScopeRequest) (*AdminScope, error)
+	// GetAdminScope 获取委派管理员的管理范围
+	GetAdminScope(context.Context, *GetAdminScopeRequest) (*AdminScope, error)
+	// DeleteAdminScope 删除委派管理员的管理范围，删除后该用户不能再调用管理接口
+	DeleteAdminScope(context.Context, *DeleteAdminScopeRequest) (*DeleteAdminScopeResponse, error)
+	// ExplainUserPermission 解释用户权限检查的决策过程，返回结构化的决策轨迹和可读文本
+	ExplainUserPermission(context.Context, *ExplainUserPermissionRequest) (*ExplainUserPermissionResponse, error)
+	// SimulateChange 模拟一组策略变更，返回受影响用户有效权限的差异；只在内存中求值，不修改任何数据
+	SimulateChange(context.Context, *SimulateChangeRequest) (*SimulateChangeResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -305,6 +590,9 @@ func (UnimplementedUserServiceServer) AssignUserRole(context.Context, *AssignUse
 func (UnimplementedUserServiceServer) AssignUserRoles(context.Context, *AssignUserRolesRequest) (*AssignUserRolesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssignUserRoles not implemented")
 }
+func (UnimplementedUserServiceServer) ExtendUserRole(context.Context, *ExtendUserRoleRequest) (*ExtendUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendUserRole not implemented")
+}
 func (UnimplementedUserServiceServer) RemoveUserRole(context.Context, *RemoveUserRoleRequest) (*RemoveUserRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserRole not implemented")
 }
@@ -320,6 +608,9 @@ func (UnimplementedUserServiceServer) CheckUserRole(context.Context, *CheckUserR
 func (UnimplementedUserServiceServer) CheckUserPermission(context.Context, *CheckUserPermissionRequest) (*CheckUserPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckUserPermission not implemented")
 }
+func (UnimplementedUserServiceServer) CheckUserResourcePermission(context.Context, *CheckUserResourcePermissionRequest) (*CheckUserPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUserResourcePermission not implemented")
+}
 func (UnimplementedUserServiceServer) GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUsername not implemented")
 }
@@ -329,6 +620,57 @@ func (UnimplementedUserServiceServer) VerifyPassword(context.Context, *VerifyPas
 func (UnimplementedUserServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
+func (UnimplementedUserServiceServer) SyncLdapUsers(context.Context, *SyncLdapUsersRequest) (*SyncLdapUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncLdapUsers not implemented")
+}
+func (UnimplementedUserServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*Session, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+}
+func (UnimplementedUserServiceServer) TouchSession(context.Context, *TouchSessionRequest) (*TouchSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TouchSession not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserSessions(context.Context, *ListUserSessionsRequest) (*ListUserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserSessions not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeAllUserSessions(context.Context, *RevokeAllUserSessionsRequest) (*RevokeAllUserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllUserSessions not implemented")
+}
+func (UnimplementedUserServiceServer) Impersonate(context.Context, *ImpersonateRequest) (*ImpersonateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Impersonate not implemented")
+}
+func (UnimplementedUserServiceServer) SendVerification(context.Context, *SendVerificationRequest) (*SendVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendVerification not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmVerification(context.Context, *ConfirmVerificationRequest) (*ConfirmVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmVerification not implemented")
+}
+func (UnimplementedUserServiceServer) Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserDataScope(context.Context, *GetUserDataScopeRequest) (*GetUserDataScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDataScope not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserPermissions(context.Context, *GetUserPermissionsRequest) (*GetUserPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPermissions not implemented")
+}
+func (UnimplementedUserServiceServer) SetAdminScope(context.Context, *SetAdminScopeRequest) (*AdminScope, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdminScope not implemented")
+}
+func (UnimplementedUserServiceServer) GetAdminScope(context.Context, *GetAdminScopeRequest) (*AdminScope, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdminScope not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteAdminScope(context.Context, *DeleteAdminScopeRequest) (*DeleteAdminScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAdminScope not implemented")
+}
+func (UnimplementedUserServiceServer) ExplainUserPermission(context.Context, *ExplainUserPermissionRequest) (*ExplainUserPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainUserPermission not implemented")
+}
+func (UnimplementedUserServiceServer) SimulateChange(context.Context, *SimulateChangeRequest) (*SimulateChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateChange not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -476,6 +818,24 @@ func _UserService_AssignUserRoles_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ExtendUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExtendUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExtendUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExtendUserRole(ctx, req.(*ExtendUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_RemoveUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveUserRoleRequest)
 	if err := dec(in); err != nil {
@@ -566,6 +926,24 @@ func _UserService_CheckUserPermission_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_CheckUserResourcePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUserResourcePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CheckUserResourcePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CheckUserResourcePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CheckUserResourcePermission(ctx, req.(*CheckUserResourcePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetUserByUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserByUsernameRequest)
 	if err := dec(in); err != nil {
@@ -620,83 +998,465 @@ func _UserService_ChangePassword_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var UserService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "iam.userService",
-	HandlerType: (*UserServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateUser",
-			Handler:    _UserService_CreateUser_Handler,
-		},
-		{
-			MethodName: "GetUser",
-			Handler:    _UserService_GetUser_Handler,
-		},
-		{
-			MethodName: "UpdateUser",
-			Handler:    _UserService_UpdateUser_Handler,
-		},
-		{
-			MethodName: "DeleteUser",
-			Handler:    _UserService_DeleteUser_Handler,
-		},
-		{
-			MethodName: "ListUsers",
-			Handler:    _UserService_ListUsers_Handler,
-		},
-		{
-			MethodName: "AssignUserRole",
-			Handler:    _UserService_AssignUserRole_Handler,
-		},
-		{
-			MethodName: "AssignUserRoles",
-			Handler:    _UserService_AssignUserRoles_Handler,
-		},
-		{
-			MethodName: "RemoveUserRole",
-			Handler:    _UserService_RemoveUserRole_Handler,
-		},
-		{
-			MethodName: "RemoveUserRoles",
-			Handler:    _UserService_RemoveUserRoles_Handler,
-		},
-		{
-			MethodName: "GetUserRoles",
-			Handler:    _UserService_GetUserRoles_Handler,
-		},
-		{
-			MethodName: "CheckUserRole",
-			Handler:    _UserService_CheckUserRole_Handler,
-		},
-		{
-			MethodName: "CheckUserPermission",
-			Handler:    _UserService_CheckUserPermission_Handler,
-		},
-		{
-			MethodName: "GetUserByUsername",
-			Handler:    _UserService_GetUserByUsername_Handler,
-		},
-		{
-			MethodName: "VerifyPassword",
-			Handler:    _UserService_VerifyPassword_Handler,
-		},
-		{
-			MethodName: "ChangePassword",
-			Handler:    _UserService_ChangePassword_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "iam.proto",
-}
-
-const (
-	RoleService_CreateRole_FullMethodName            = "/iam.roleService/CreateRole"
-	RoleService_GetRole_FullMethodName               = "/iam.roleService/GetRole"
-	RoleService_UpdateRole_FullMethodName            = "/iam.roleService/UpdateRole"
-	RoleService_DeleteRole_FullMethodName            = "/iam.roleService/DeleteRole"
+func _UserService_SyncLdapUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncLdapUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SyncLdapUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SyncLdapUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SyncLdapUsers(ctx, req.(*SyncLdapUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_TouchSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).TouchSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_TouchSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).TouchSession(ctx, req.(*TouchSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserSessions(ctx, req.(*ListUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeAllUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeAllUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeAllUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeAllUserSessions(ctx, req.(*RevokeAllUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Impersonate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Impersonate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Impersonate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Impersonate(ctx, req.(*ImpersonateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SendVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SendVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SendVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SendVerification(ctx, req.(*SendVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmVerification(ctx, req.(*ConfirmVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Authenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Authenticate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Authenticate(ctx, req.(*AuthenticateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserDataScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDataScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserDataScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserDataScope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserDataScope(ctx, req.(*GetUserDataScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserPermissions(ctx, req.(*GetUserPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetAdminScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAdminScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetAdminScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetAdminScope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetAdminScope(ctx, req.(*SetAdminScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetAdminScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdminScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAdminScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetAdminScope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAdminScope(ctx, req.(*GetAdminScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteAdminScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAdminScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteAdminScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteAdminScope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteAdminScope(ctx, req.(*DeleteAdminScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ExplainUserPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainUserPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExplainUserPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExplainUserPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExplainUserPermission(ctx, req.(*ExplainUserPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SimulateChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SimulateChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SimulateChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SimulateChange(ctx, req.(*SimulateChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iam.userService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _UserService_CreateUser_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _UserService_GetUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UserService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UserService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UserService_ListUsers_Handler,
+		},
+		{
+			MethodName: "AssignUserRole",
+			Handler:    _UserService_AssignUserRole_Handler,
+		},
+		{
+			MethodName: "AssignUserRoles",
+			Handler:    _UserService_AssignUserRoles_Handler,
+		},
+		{
+			MethodName: "ExtendUserRole",
+			Handler:    _UserService_ExtendUserRole_Handler,
+		},
+		{
+			MethodName: "RemoveUserRole",
+			Handler:    _UserService_RemoveUserRole_Handler,
+		},
+		{
+			MethodName: "RemoveUserRoles",
+			Handler:    _UserService_RemoveUserRoles_Handler,
+		},
+		{
+			MethodName: "GetUserRoles",
+			Handler:    _UserService_GetUserRoles_Handler,
+		},
+		{
+			MethodName: "CheckUserRole",
+			Handler:    _UserService_CheckUserRole_Handler,
+		},
+		{
+			MethodName: "CheckUserPermission",
+			Handler:    _UserService_CheckUserPermission_Handler,
+		},
+		{
+			MethodName: "CheckUserResourcePermission",
+			Handler:    _UserService_CheckUserResourcePermission_Handler,
+		},
+		{
+			MethodName: "GetUserByUsername",
+			Handler:    _UserService_GetUserByUsername_Handler,
+		},
+		{
+			MethodName: "VerifyPassword",
+			Handler:    _UserService_VerifyPassword_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _UserService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "SyncLdapUsers",
+			Handler:    _UserService_SyncLdapUsers_Handler,
+		},
+		{
+			MethodName: "CreateSession",
+			Handler:    _UserService_CreateSession_Handler,
+		},
+		{
+			MethodName: "TouchSession",
+			Handler:    _UserService_TouchSession_Handler,
+		},
+		{
+			MethodName: "ListUserSessions",
+			Handler:    _UserService_ListUserSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UserService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllUserSessions",
+			Handler:    _UserService_RevokeAllUserSessions_Handler,
+		},
+		{
+			MethodName: "Impersonate",
+			Handler:    _UserService_Impersonate_Handler,
+		},
+		{
+			MethodName: "SendVerification",
+			Handler:    _UserService_SendVerification_Handler,
+		},
+		{
+			MethodName: "ConfirmVerification",
+			Handler:    _UserService_ConfirmVerification_Handler,
+		},
+		{
+			MethodName: "Authenticate",
+			Handler:    _UserService_Authenticate_Handler,
+		},
+		{
+			MethodName: "GetUserDataScope",
+			Handler:    _UserService_GetUserDataScope_Handler,
+		},
+		{
+			MethodName: "GetUserPermissions",
+			Handler:    _UserService_GetUserPermissions_Handler,
+		},
+		{
+			MethodName: "SetAdminScope",
+			Handler:    _UserService_SetAdminScope_Handler,
+		},
+		{
+			MethodName: "GetAdminScope",
+			Handler:    _UserService_GetAdminScope_Handler,
+		},
+		{
+			MethodName: "DeleteAdminScope",
+			Handler:    _UserService_DeleteAdminScope_Handler,
+		},
+		{
+			MethodName: "ExplainUserPermission",
+			Handler:    _UserService_ExplainUserPermission_Handler,
+		},
+		{
+			MethodName: "SimulateChange",
+			Handler:    _UserService_SimulateChange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iam.proto",
+}
+
+const (
+	RoleService_CreateRole_FullMethodName            = "/iam.roleService/CreateRole"
+	RoleService_GetRole_FullMethodName               = "/iam.roleService/GetRole"
+	RoleService_UpdateRole_FullMethodName            = "/iam.roleService/UpdateRole"
+	RoleService_DeleteRole_FullMethodName            = "/iam.roleService/DeleteRole"
 	RoleService_ListRoles_FullMethodName             = "/iam.roleService/ListRoles"
 	RoleService_AssignRolePermission_FullMethodName  = "/iam.roleService/AssignRolePermission"
 	RoleService_AssignRolePermissions_FullMethodName = "/iam.roleService/AssignRolePermissions"
@@ -704,502 +1464,3340 @@ const (
 	RoleService_RemoveRolePermissions_FullMethodName = "/iam.roleService/RemoveRolePermissions"
 	RoleService_GetRolePermissions_FullMethodName    = "/iam.roleService/GetRolePermissions"
 	RoleService_CheckRolePermission_FullMethodName   = "/iam.roleService/CheckRolePermission"
+	RoleService_SetRoleDataScope_FullMethodName      = "/iam.roleService/SetRoleDataScope"
+	RoleService_CreateRoleConstraint_FullMethodName  = "/iam.roleService/CreateRoleConstraint"
+	RoleService_DeleteRoleConstraint_FullMethodName  = "/iam.roleService/DeleteRoleConstraint"
+	RoleService_ListRoleConstraints_FullMethodName   = "/iam.roleService/ListRoleConstraints"
+	RoleService_ValidateConstraints_FullMethodName   = "/iam.roleService/ValidateConstraints"
+	RoleService_ListUnusedGrants_FullMethodName      = "/iam.roleService/ListUnusedGrants"
+	RoleService_ListUnusedRoles_FullMethodName       = "/iam.roleService/ListUnusedRoles"
+	RoleService_RecommendRoleTrim_FullMethodName     = "/iam.roleService/RecommendRoleTrim"
+)
+
+// RoleServiceClient is the client API for RoleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============================================================
+// roleService
+// 角色管理服务，提供角色的增删改查及权限分配功能
+// ============================================================
+type RoleServiceClient interface {
+	// CreateRole 创建新角色
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
+	// GetRole 根据ID获取角色详情
+	GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*Role, error)
+	// UpdateRole 更新角色信息
+	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*Role, error)
+	// DeleteRole 删除角色（软删除）
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
+	// ListRoles 分页查询角色列表
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	// AssignRolePermission 为角色分配单个权限，可附带授权条件；拒绝授权优先于其他角色的允许授权
+	AssignRolePermission(ctx context.Context, in *AssignRolePermissionRequest, opts ...grpc.CallOption) (*AssignRolePermissionResponse, error)
+	// AssignRolePermissions 为角色批量分配权限
+	AssignRolePermissions(ctx context.Context, in *AssignRolePermissionsRequest, opts ...grpc.CallOption) (*AssignRolePermissionsResponse, error)
+	// RemoveRolePermission 移除角色的单个权限
+	RemoveRolePermission(ctx context.Context, in *RemoveRolePermissionRequest, opts ...grpc.CallOption) (*RemoveRolePermissionResponse, error)
+	// RemoveRolePermissions 批量移除角色的权限
+	RemoveRolePermissions(ctx context.Context, in *RemoveRolePermissionsRequest, opts ...grpc.CallOption) (*RemoveRolePermissionsResponse, error)
+	// GetRolePermissions 获取角色拥有的所有权限
+	GetRolePermissions(ctx context.Context, in *GetRolePermissionsRequest, opts ...grpc.CallOption) (*GetRolePermissionsResponse, error)
+	// CheckRolePermission 检查角色是否拥有指定权限
+	CheckRolePermission(ctx context.Context, in *CheckRolePermissionRequest, opts ...grpc.CallOption) (*CheckRolePermissionResponse, error)
+	// SetRoleDataScope 设置角色的数据权限范围，自定义范围时同时设置部门列表
+	SetRoleDataScope(ctx context.Context, in *SetRoleDataScopeRequest, opts ...grpc.CallOption) (*SetRoleDataScopeResponse, error)
+	// CreateRoleConstraint 创建职责分离约束，角色分配、用户组继承和访问申请授权时校验
+	CreateRoleConstraint(ctx context.Context, in *CreateRoleConstraintRequest, opts ...grpc.CallOption) (*RoleConstraint, error)
+	// DeleteRoleConstraint 删除职责分离约束
+	DeleteRoleConstraint(ctx context.Context, in *DeleteRoleConstraintRequest, opts ...grpc.CallOption) (*DeleteRoleConstraintResponse, error)
+	// ListRoleConstraints 查询所有职责分离约束
+	ListRoleConstraints(ctx context.Context, in *ListRoleConstraintsRequest, opts ...grpc.CallOption) (*ListRoleConstraintsResponse, error)
+	// ValidateConstraints 检查已有数据中违反职责分离约束的情况
+	ValidateConstraints(ctx context.Context, in *ValidateConstraintsRequest, opts ...grpc.CallOption) (*ValidateConstraintsResponse, error)
+	// ListUnusedGrants 查询最近N天内未被权限检查使用过的允许授权，可按角色或用户过滤
+	ListUnusedGrants(ctx context.Context, in *ListUnusedGrantsRequest, opts ...grpc.CallOption) (*ListUnusedGrantsResponse, error)
+	// ListUnusedRoles 查询最近N天内没有任何授权被权限检查使用过的角色
+	ListUnusedRoles(ctx context.Context, in *ListUnusedRolesRequest, opts ...grpc.CallOption) (*ListUnusedRolesResponse, error)
+	// RecommendRoleTrim 根据使用记录和授权覆盖关系，建议从角色中移除的权限
+	RecommendRoleTrim(ctx context.Context, in *RecommendRoleTrimRequest, opts ...grpc.CallOption) (*RecommendRoleTrimResponse, error)
+}
+
+type roleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoleServiceClient(cc grpc.ClientConnInterface) RoleServiceClient {
+	return &roleServiceClient{cc}
+}
+
+func (c *roleServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoleResponse)
+	err := c.cc.Invoke(ctx, RoleService_CreateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*Role, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Role)
+	err := c.cc.Invoke(ctx, RoleService_GetRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*Role, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Role)
+	err := c.cc.Invoke(ctx, RoleService_UpdateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoleResponse)
+	err := c.cc.Invoke(ctx, RoleService_DeleteRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, RoleService_ListRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) AssignRolePermission(ctx context.Context, in *AssignRolePermissionRequest, opts ...grpc.CallOption) (*AssignRolePermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignRolePermissionResponse)
+	err := c.cc.Invoke(ctx, RoleService_AssignRolePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) AssignRolePermissions(ctx context.Context, in *AssignRolePermissionsRequest, opts ...grpc.CallOption) (*AssignRolePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignRolePermissionsResponse)
+	err := c.cc.Invoke(ctx, RoleService_AssignRolePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) RemoveRolePermission(ctx context.Context, in *RemoveRolePermissionRequest, opts ...grpc.CallOption) (*RemoveRolePermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveRolePermissionResponse)
+	err := c.cc.Invoke(ctx, RoleService_RemoveRolePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) RemoveRolePermissions(ctx context.Context, in *RemoveRolePermissionsRequest, opts ...grpc.CallOption) (*RemoveRolePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveRolePermissionsResponse)
+	err := c.cc.Invoke(ctx, RoleService_RemoveRolePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) GetRolePermissions(ctx context.Context, in *GetRolePermissionsRequest, opts ...grpc.CallOption) (*GetRolePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRolePermissionsResponse)
+	err := c.cc.Invoke(ctx, RoleService_GetRolePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) CheckRolePermission(ctx context.Context, in *CheckRolePermissionRequest, opts ...grpc.CallOption) (*CheckRolePermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckRolePermissionResponse)
+	err := c.cc.Invoke(ctx, RoleService_CheckRolePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) SetRoleDataScope(ctx context.Context, in *SetRoleDataScopeRequest, opts ...grpc.CallOption) (*SetRoleDataScopeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRoleDataScopeResponse)
+	err := c.cc.Invoke(ctx, RoleService_SetRoleDataScope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) CreateRoleConstraint(ctx context.Context, in *CreateRoleConstraintRequest, opts ...grpc.CallOption) (*RoleConstraint, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleConstraint)
+	err := c.cc.Invoke(ctx, RoleService_CreateRoleConstraint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) DeleteRoleConstraint(ctx context.Context, in *DeleteRoleConstraintRequest, opts ...grpc.CallOption) (*DeleteRoleConstraintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoleConstraintResponse)
+	err := c.cc.Invoke(ctx, RoleService_DeleteRoleConstraint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) ListRoleConstraints(ctx context.Context, in *ListRoleConstraintsRequest, opts ...grpc.CallOption) (*ListRoleConstraintsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoleConstraintsResponse)
+	err := c.cc.Invoke(ctx, RoleService_ListRoleConstraints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) ValidateConstraints(ctx context.Context, in *ValidateConstraintsRequest, opts ...grpc.CallOption) (*ValidateConstraintsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateConstraintsResponse)
+	err := c.cc.Invoke(ctx, RoleService_ValidateConstraints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) ListUnusedGrants(ctx context.Context, in *ListUnusedGrantsRequest, opts ...grpc.CallOption) (*ListUnusedGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUnusedGrantsResponse)
+	err := c.cc.Invoke(ctx, RoleService_ListUnusedGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) ListUnusedRoles(ctx context.Context, in *ListUnusedRolesRequest, opts ...grpc.CallOption) (*ListUnusedRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUnusedRolesResponse)
+	err := c.cc.Invoke(ctx, RoleService_ListUnusedRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) RecommendRoleTrim(ctx context.Context, in *RecommendRoleTrimRequest, opts ...grpc.CallOption) (*RecommendRoleTrimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendRoleTrimResponse)
+	err := c.cc.Invoke(ctx, RoleService_RecommendRoleTrim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoleServiceServer is the server API for RoleService service.
+// All implementations must embed UnimplementedRoleServiceServer
+// for forward compatibility.
+//
+// ============================================================
+// roleService
+// 角色管理服务，提供角色的增删改查及权限分配功能
+// ============================================================
+type RoleServiceServer interface {
+	// CreateRole 创建新角色
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
+	// GetRole 根据ID获取角色详情
+	GetRole(context.Context, *GetRoleRequest) (*Role, error)
+	// UpdateRole 更新角色信息
+	UpdateRole(context.Context, *UpdateRoleRequest) (*Role, error)
+	// DeleteRole 删除角色（软删除）
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	// ListRoles 分页查询角色列表
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	// AssignRolePermission 为角色分配单个权限，可附带授权条件；拒绝授权优先于其他角色的允许授权
+	AssignRolePermission(context.Context, *AssignRolePermissionRequest) (*AssignRolePermissionResponse, error)
+	// AssignRolePermissions 为角色批量分配权限
+	AssignRolePermissions(context.Context, *AssignRolePermissionsRequest) (*AssignRolePermissionsResponse, error)
+	// RemoveRolePermission 移除角色的单个权限
+	RemoveRolePermission(context.Context, *RemoveRolePermissionRequest) (*RemoveRolePermissionResponse, error)
+	// RemoveRolePermissions 批量移除角色的权限
+	RemoveRolePermissions(context.Context, *RemoveRolePermissionsRequest) (*RemoveRolePermissionsResponse, error)
+	// GetRolePermissions 获取角色拥有的所有权限
+	GetRolePermissions(context.Context, *GetRolePermissionsRequest) (*GetRolePermissionsResponse, error)
+	// CheckRolePermission 检查角色是否拥有指定权限
+	CheckRolePermission(context.Context, *CheckRolePermissionRequest) (*CheckRolePermissionResponse, error)
+	// SetRoleDataScope 设置角色的数据权限范围，自定义范围时同时设置部门列表
+	SetRoleDataScope(context.Context, *SetRoleDataScopeRequest) (*SetRoleDataScopeResponse, error)
+	// CreateRoleConstraint 创建职责分离约束，角色分配、用户组继承和访问申请授权时校验
+	CreateRoleConstraint(context.Context, *CreateRoleConstraintRequest) (*RoleConstraint, error)
+	// DeleteRoleConstraint 删除职责分离约束
+	DeleteRoleConstraint(context.Context, *DeleteRoleConstraintRequest) (*DeleteRoleConstraintResponse, error)
+	// ListRoleConstraints 查询所有职责分离约束
+	ListRoleConstraints(context.Context, *ListRoleConstraintsRequest) (*ListRoleConstraintsResponse, error)
+	// ValidateConstraints 检查已有数据中违反职责分离约束的情况
+	ValidateConstraints(context.Context, *ValidateConstraintsRequest) (*ValidateConstraintsResponse, error)
+	// ListUnusedGrants 查询最近N天内未被权限检查使用过的允许授权，可按角色或用户过滤
+	ListUnusedGrants(context.Context, *ListUnusedGrantsRequest) (*ListUnusedGrantsResponse, error)
+	// ListUnusedRoles 查询最近N天内没有任何授权被权限检查使用过的角色
+	ListUnusedRoles(context.Context, *ListUnusedRolesRequest) (*ListUnusedRolesResponse, error)
+	// RecommendRoleTrim 根据使用记录和授权覆盖关系，建议从角色中移除的权限
+	RecommendRoleTrim(context.Context, *RecommendRoleTrimRequest) (*RecommendRoleTrimResponse, error)
+	mustEmbedUnimplementedRoleServiceServer()
+}
+
+// UnimplementedRoleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRoleServiceServer struct{}
+
+func (UnimplementedRoleServiceServer) CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedRoleServiceServer) GetRole(context.Context, *GetRoleRequest) (*Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
+}
+func (UnimplementedRoleServiceServer) UpdateRole(context.Context, *UpdateRoleRequest) (*Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedRoleServiceServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedRoleServiceServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedRoleServiceServer) AssignRolePermission(context.Context, *AssignRolePermissionRequest) (*AssignRolePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRolePermission not implemented")
+}
+func (UnimplementedRoleServiceServer) AssignRolePermissions(context.Context, *AssignRolePermissionsRequest) (*AssignRolePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRolePermissions not implemented")
+}
+func (UnimplementedRoleServiceServer) RemoveRolePermission(context.Context, *RemoveRolePermissionRequest) (*RemoveRolePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRolePermission not implemented")
+}
+func (UnimplementedRoleServiceServer) RemoveRolePermissions(context.Context, *RemoveRolePermissionsRequest) (*RemoveRolePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRolePermissions not implemented")
+}
+func (UnimplementedRoleServiceServer) GetRolePermissions(context.Context, *GetRolePermissionsRequest) (*GetRolePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRolePermissions not implemented")
+}
+func (UnimplementedRoleServiceServer) CheckRolePermission(context.Context, *CheckRolePermissionRequest) (*CheckRolePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckRolePermission not implemented")
+}
+func (UnimplementedRoleServiceServer) SetRoleDataScope(context.Context, *SetRoleDataScopeRequest) (*SetRoleDataScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoleDataScope not implemented")
+}
+func (UnimplementedRoleServiceServer) CreateRoleConstraint(context.Context, *CreateRoleConstraintRequest) (*RoleConstraint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleConstraint not implemented")
+}
+func (UnimplementedRoleServiceServer) DeleteRoleConstraint(context.Context, *DeleteRoleConstraintRequest) (*DeleteRoleConstraintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleConstraint not implemented")
+}
+func (UnimplementedRoleServiceServer) ListRoleConstraints(context.Context, *ListRoleConstraintsRequest) (*ListRoleConstraintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleConstraints not implemented")
+}
+func (UnimplementedRoleServiceServer) ValidateConstraints(context.Context, *ValidateConstraintsRequest) (*ValidateConstraintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConstraints not implemented")
+}
+func (UnimplementedRoleServiceServer) ListUnusedGrants(context.Context, *ListUnusedGrantsRequest) (*ListUnusedGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnusedGrants not implemented")
+}
+func (UnimplementedRoleServiceServer) ListUnusedRoles(context.Context, *ListUnusedRolesRequest) (*ListUnusedRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnusedRoles not implemented")
+}
+func (UnimplementedRoleServiceServer) RecommendRoleTrim(context.Context, *RecommendRoleTrimRequest) (*RecommendRoleTrimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendRoleTrim not implemented")
+}
+func (UnimplementedRoleServiceServer) mustEmbedUnimplementedRoleServiceServer() {}
+func (UnimplementedRoleServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeRoleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoleServiceServer will
+// result in compilation errors.
+type UnsafeRoleServiceServer interface {
+	mustEmbedUnimplementedRoleServiceServer()
+}
+
+func RegisterRoleServiceServer(s grpc.ServiceRegistrar, srv RoleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRoleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RoleService_ServiceDesc, srv)
+}
+
+func _RoleService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_CreateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).GetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_GetRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).GetRole(ctx, req.(*GetRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_UpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_DeleteRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ListRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_AssignRolePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRolePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).AssignRolePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_AssignRolePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).AssignRolePermission(ctx, req.(*AssignRolePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_AssignRolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRolePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).AssignRolePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_AssignRolePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).AssignRolePermissions(ctx, req.(*AssignRolePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_RemoveRolePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRolePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).RemoveRolePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_RemoveRolePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).RemoveRolePermission(ctx, req.(*RemoveRolePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_RemoveRolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRolePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).RemoveRolePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_RemoveRolePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).RemoveRolePermissions(ctx, req.(*RemoveRolePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_GetRolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRolePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).GetRolePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_GetRolePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).GetRolePermissions(ctx, req.(*GetRolePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_CheckRolePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRolePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).CheckRolePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_CheckRolePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).CheckRolePermission(ctx, req.(*CheckRolePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_SetRoleDataScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRoleDataScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).SetRoleDataScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_SetRoleDataScope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).SetRoleDataScope(ctx, req.(*SetRoleDataScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_CreateRoleConstraint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleConstraintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).CreateRoleConstraint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_CreateRoleConstraint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).CreateRoleConstraint(ctx, req.(*CreateRoleConstraintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_DeleteRoleConstraint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleConstraintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).DeleteRoleConstraint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_DeleteRoleConstraint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).DeleteRoleConstraint(ctx, req.(*DeleteRoleConstraintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_ListRoleConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleConstraintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ListRoleConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ListRoleConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ListRoleConstraints(ctx, req.(*ListRoleConstraintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_ValidateConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConstraintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ValidateConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ValidateConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ValidateConstraints(ctx, req.(*ValidateConstraintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_ListUnusedGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnusedGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ListUnusedGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ListUnusedGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ListUnusedGrants(ctx, req.(*ListUnusedGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_ListUnusedRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnusedRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ListUnusedRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ListUnusedRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ListUnusedRoles(ctx, req.(*ListUnusedRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_RecommendRoleTrim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendRoleTrimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).RecommendRoleTrim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_RecommendRoleTrim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).RecommendRoleTrim(ctx, req.(*RecommendRoleTrimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoleService_ServiceDesc is the grpc.ServiceDesc for RoleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iam.roleService",
+	HandlerType: (*RoleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRole",
+			Handler:    _RoleService_CreateRole_Handler,
+		},
+		{
+			MethodName: "GetRole",
+			Handler:    _RoleService_GetRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _RoleService_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _RoleService_DeleteRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _RoleService_ListRoles_Handler,
+		},
+		{
+			MethodName: "AssignRolePermission",
+			Handler:    _RoleService_AssignRolePermission_Handler,
+		},
+		{
+			MethodName: "AssignRolePermissions",
+			Handler:    _RoleService_AssignRolePermissions_Handler,
+		},
+		{
+			MethodName: "RemoveRolePermission",
+			Handler:    _RoleService_RemoveRolePermission_Handler,
+		},
+		{
+			MethodName: "RemoveRolePermissions",
+			Handler:    _RoleService_RemoveRolePermissions_Handler,
+		},
+		{
+			MethodName: "GetRolePermissions",
+			Handler:    _RoleService_GetRolePermissions_Handler,
+		},
+		{
+			MethodName: "CheckRolePermission",
+			Handler:    _RoleService_CheckRolePermission_Handler,
+		},
+		{
+			MethodName: "SetRoleDataScope",
+			Handler:    _RoleService_SetRoleDataScope_Handler,
+		},
+		{
+			MethodName: "CreateRoleConstraint",
+			Handler:    _RoleService_CreateRoleConstraint_Handler,
+		},
+		{
+			MethodName: "DeleteRoleConstraint",
+			Handler:    _RoleService_DeleteRoleConstraint_Handler,
+		},
+		{
+			MethodName: "ListRoleConstraints",
+			Handler:    _RoleService_ListRoleConstraints_Handler,
+		},
+		{
+			MethodName: "ValidateConstraints",
+			Handler:    _RoleService_ValidateConstraints_Handler,
+		},
+		{
+			MethodName: "ListUnusedGrants",
+			Handler:    _RoleService_ListUnusedGrants_Handler,
+		},
+		{
+			MethodName: "ListUnusedRoles",
+			Handler:    _RoleService_ListUnusedRoles_Handler,
+		},
+		{
+			MethodName: "RecommendRoleTrim",
+			Handler:    _RoleService_RecommendRoleTrim_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iam.proto",
+}
+
+const (
+	PermissionService_CreatePermission_FullMethodName    = "/iam.permissionService/CreatePermission"
+	PermissionService_GetPermission_FullMethodName       = "/iam.permissionService/GetPermission"
+	PermissionService_UpdatePermission_FullMethodName    = "/iam.permissionService/UpdatePermission"
+	PermissionService_DeletePermission_FullMethodName    = "/iam.permissionService/DeletePermission"
+	PermissionService_ListPermissions_FullMethodName     = "/iam.permissionService/ListPermissions"
+	PermissionService_RegisterPermissions_FullMethodName = "/iam.permissionService/RegisterPermissions"
+)
+
+// PermissionServiceClient is the client API for PermissionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============================================================
+// permissionService
+// 权限管理服务，提供权限的增删改查功能
+// ============================================================
+type PermissionServiceClient interface {
+	// CreatePermission 创建新权限
+	CreatePermission(ctx context.Context, in *CreatePermissionRequest, opts ...grpc.CallOption) (*CreatePermissionResponse, error)
+	// GetPermission 根据ID获取权限详情
+	GetPermission(ctx context.Context, in *GetPermissionRequest, opts ...grpc.CallOption) (*Permission, error)
+	// UpdatePermission 更新权限信息
+	UpdatePermission(ctx context.Context, in *UpdatePermissionRequest, opts ...grpc.CallOption) (*Permission, error)
+	// DeletePermission 删除权限（软删除）
+	DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeletePermissionResponse, error)
+	// ListPermissions 分页查询权限列表
+	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
+	// RegisterPermissions 服务按命名空间注册完整的权限目录，幂等地新建和更新权限，目录中不再包含的权限标记为弃用
+	RegisterPermissions(ctx context.Context, in *RegisterPermissionsRequest, opts ...grpc.CallOption) (*RegisterPermissionsResponse, error)
+}
+
+type permissionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPermissionServiceClient(cc grpc.ClientConnInterface) PermissionServiceClient {
+	return &permissionServiceClient{cc}
+}
+
+func (c *permissionServiceClient) CreatePermission(ctx context.Context, in *CreatePermissionRequest, opts ...grpc.CallOption) (*CreatePermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePermissionResponse)
+	err := c.cc.Invoke(ctx, PermissionService_CreatePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionServiceClient) GetPermission(ctx context.Context, in *GetPermissionRequest, opts ...grpc.CallOption) (*Permission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Permission)
+	err := c.cc.Invoke(ctx, PermissionService_GetPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionServiceClient) UpdatePermission(ctx context.Context, in *UpdatePermissionRequest, opts ...grpc.CallOption) (*Permission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Permission)
+	err := c.cc.Invoke(ctx, PermissionService_UpdatePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionServiceClient) DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeletePermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePermissionResponse)
+	err := c.cc.Invoke(ctx, PermissionService_DeletePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionServiceClient) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPermissionsResponse)
+	err := c.cc.Invoke(ctx, PermissionService_ListPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionServiceClient) RegisterPermissions(ctx context.Context, in *RegisterPermissionsRequest, opts ...grpc.CallOption) (*RegisterPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterPermissionsResponse)
+	err := c.cc.Invoke(ctx, PermissionService_RegisterPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PermissionServiceServer is the server API for PermissionService service.
+// All implementations must embed UnimplementedPermissionServiceServer
+// for forward compatibility.
+//
+// ============================================================
+// permissionService
+// 权限管理服务，提供权限的增删改查功能
+// ============================================================
+type PermissionServiceServer interface {
+	// CreatePermission 创建新权限
+	CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error)
+	// GetPermission 根据ID获取权限详情
+	GetPermission(context.Context, *GetPermissionRequest) (*Permission, error)
+	// UpdatePermission 更新权限信息
+	UpdatePermission(context.Context, *UpdatePermissionRequest) (*Permission, error)
+	// DeletePermission 删除权限（软删除）
+	DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error)
+	// ListPermissions 分页查询权限列表
+	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	// RegisterPermissions 服务按命名空间注册完整的权限目录，幂等地新建和更新权限，目录中不再包含的权限标记为弃用
+	RegisterPermissions(context.Context, *RegisterPermissionsRequest) (*RegisterPermissionsResponse, error)
+	mustEmbedUnimplementedPermissionServiceServer()
+}
+
+// UnimplementedPermissionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPermissionServiceServer struct{}
+
+func (UnimplementedPermissionServiceServer) CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePermission not implemented")
+}
+func (UnimplementedPermissionServiceServer) GetPermission(context.Context, *GetPermissionRequest) (*Permission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermission not implemented")
+}
+func (UnimplementedPermissionServiceServer) UpdatePermission(context.Context, *UpdatePermissionRequest) (*Permission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePermission not implemented")
+}
+func (UnimplementedPermissionServiceServer) DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePermission not implemented")
+}
+func (UnimplementedPermissionServiceServer) ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
+}
+func (UnimplementedPermissionServiceServer) RegisterPermissions(context.Context, *RegisterPermissionsRequest) (*RegisterPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPermissions not implemented")
+}
+func (UnimplementedPermissionServiceServer) mustEmbedUnimplementedPermissionServiceServer() {}
+func (UnimplementedPermissionServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafePermissionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PermissionServiceServer will
+// result in compilation errors.
+type UnsafePermissionServiceServer interface {
+	mustEmbedUnimplementedPermissionServiceServer()
+}
+
+func RegisterPermissionServiceServer(s grpc.ServiceRegistrar, srv PermissionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPermissionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PermissionService_ServiceDesc, srv)
+}
+
+func _PermissionService_CreatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).CreatePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionService_CreatePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).CreatePermission(ctx, req.(*CreatePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionService_GetPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).GetPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionService_GetPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).GetPermission(ctx, req.(*GetPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionService_UpdatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).UpdatePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionService_UpdatePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).UpdatePermission(ctx, req.(*UpdatePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionService_DeletePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).DeletePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionService_DeletePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).DeletePermission(ctx, req.(*DeletePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionService_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).ListPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionService_ListPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionService_RegisterPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).RegisterPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionService_RegisterPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).RegisterPermissions(ctx, req.(*RegisterPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PermissionService_ServiceDesc is the grpc.ServiceDesc for PermissionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PermissionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iam.permissionService",
+	HandlerType: (*PermissionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePermission",
+			Handler:    _PermissionService_CreatePermission_Handler,
+		},
+		{
+			MethodName: "GetPermission",
+			Handler:    _PermissionService_GetPermission_Handler,
+		},
+		{
+			MethodName: "UpdatePermission",
+			Handler:    _PermissionService_UpdatePermission_Handler,
+		},
+		{
+			MethodName: "DeletePermission",
+			Handler:    _PermissionService_DeletePermission_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _PermissionService_ListPermissions_Handler,
+		},
+		{
+			MethodName: "RegisterPermissions",
+			Handler:    _PermissionService_RegisterPermissions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iam.proto",
+}
+
+const (
+	GroupService_CreateGroup_FullMethodName        = "/iam.groupService/CreateGroup"
+	GroupService_GetGroup_FullMethodName           = "/iam.groupService/GetGroup"
+	GroupService_UpdateGroup_FullMethodName        = "/iam.groupService/UpdateGroup"
+	GroupService_DeleteGroup_FullMethodName        = "/iam.groupService/DeleteGroup"
+	GroupService_ListGroups_FullMethodName         = "/iam.groupService/ListGroups"
+	GroupService_AddGroupMembers_FullMethodName    = "/iam.groupService/AddGroupMembers"
+	GroupService_RemoveGroupMembers_FullMethodName = "/iam.groupService/RemoveGroupMembers"
+	GroupService_ListGroupMembers_FullMethodName   = "/iam.groupService/ListGroupMembers"
+	GroupService_ListUserGroups_FullMethodName     = "/iam.groupService/ListUserGroups"
+	GroupService_AssignGroupRoles_FullMethodName   = "/iam.groupService/AssignGroupRoles"
+	GroupService_RemoveGroupRoles_FullMethodName   = "/iam.groupService/RemoveGroupRoles"
+	GroupService_GetGroupRoles_FullMethodName      = "/iam.groupService/GetGroupRoles"
+)
+
+// GroupServiceClient is the client API for GroupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============================================================
+// groupService
+// 用户组管理服务，提供用户组的增删改查、成员管理及角色分配功能
+// ============================================================
+type GroupServiceClient interface {
+	// CreateGroup 创建用户组
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+	// GetGroup 根据ID获取用户组详情
+	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	// UpdateGroup 更新用户组信息
+	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	// DeleteGroup 删除用户组（软删除），同时解除成员和角色关联
+	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	// ListGroups 分页查询用户组列表
+	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	// AddGroupMembers 向用户组批量添加成员
+	AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error)
+	// RemoveGroupMembers 从用户组批量移除成员
+	RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error)
+	// ListGroupMembers 分页查询用户组成员
+	ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersResponse, error)
+	// ListUserGroups 分页查询用户所属的用户组
+	ListUserGroups(ctx context.Context, in *ListUserGroupsRequest, opts ...grpc.CallOption) (*ListUserGroupsResponse, error)
+	// AssignGroupRoles 为用户组批量分配角色，组内成员继承这些角色
+	AssignGroupRoles(ctx context.Context, in *AssignGroupRolesRequest, opts ...grpc.CallOption) (*AssignGroupRolesResponse, error)
+	// RemoveGroupRoles 批量移除用户组的角色
+	RemoveGroupRoles(ctx context.Context, in *RemoveGroupRolesRequest, opts ...grpc.CallOption) (*RemoveGroupRolesResponse, error)
+	// GetGroupRoles 获取用户组拥有的所有角色
+	GetGroupRoles(ctx context.Context, in *GetGroupRolesRequest, opts ...grpc.CallOption) (*GetGroupRolesResponse, error)
+}
+
+type groupServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupServiceClient(cc grpc.ClientConnInterface) GroupServiceClient {
+	return &groupServiceClient{cc}
+}
+
+func (c *groupServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupResponse)
+	err := c.cc.Invoke(ctx, GroupService_CreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Group)
+	err := c.cc.Invoke(ctx, GroupService_GetGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Group)
+	err := c.cc.Invoke(ctx, GroupService_UpdateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupResponse)
+	err := c.cc.Invoke(ctx, GroupService_DeleteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupsResponse)
+	err := c.cc.Invoke(ctx, GroupService_ListGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGroupMembersResponse)
+	err := c.cc.Invoke(ctx, GroupService_AddGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGroupMembersResponse)
+	err := c.cc.Invoke(ctx, GroupService_RemoveGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupMembersResponse)
+	err := c.cc.Invoke(ctx, GroupService_ListGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ListUserGroups(ctx context.Context, in *ListUserGroupsRequest, opts ...grpc.CallOption) (*ListUserGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserGroupsResponse)
+	err := c.cc.Invoke(ctx, GroupService_ListUserGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) AssignGroupRoles(ctx context.Context, in *AssignGroupRolesRequest, opts ...grpc.CallOption) (*AssignGroupRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignGroupRolesResponse)
+	err := c.cc.Invoke(ctx, GroupService_AssignGroupRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) RemoveGroupRoles(ctx context.Context, in *RemoveGroupRolesRequest, opts ...grpc.CallOption) (*RemoveGroupRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGroupRolesResponse)
+	err := c.cc.Invoke(ctx, GroupService_RemoveGroupRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetGroupRoles(ctx context.Context, in *GetGroupRolesRequest, opts ...grpc.CallOption) (*GetGroupRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupRolesResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetGroupRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupServiceServer is the server API for GroupService service.
+// All implementations must embed UnimplementedGroupServiceServer
+// for forward compatibility.
+//
+// ============================================================
+// groupService
+// 用户组管理服务，提供用户组的增删改查、成员管理及角色分配功能
+// ============================================================
+type GroupServiceServer interface {
+	// CreateGroup 创建用户组
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	// GetGroup 根据ID获取用户组详情
+	GetGroup(context.Context, *GetGroupRequest) (*Group, error)
+	// UpdateGroup 更新用户组信息
+	UpdateGroup(context.Context, *UpdateGroupRequest) (*Group, error)
+	// DeleteGroup 删除用户组（软删除），同时解除成员和角色关联
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	// ListGroups 分页查询用户组列表
+	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	// AddGroupMembers 向用户组批量添加成员
+	AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersResponse, error)
+	// RemoveGroupMembers 从用户组批量移除成员
+	RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersResponse, error)
+	// ListGroupMembers 分页查询用户组成员
+	ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersResponse, error)
+	// ListUserGroups 分页查询用户所属的用户组
+	ListUserGroups(context.Context, *ListUserGroupsRequest) (*ListUserGroupsResponse, error)
+	// AssignGroupRoles 为用户组批量分配角色，组内成员继承这些角色
+	AssignGroupRoles(context.Context, *AssignGroupRolesRequest) (*AssignGroupRolesResponse, error)
+	// RemoveGroupRoles 批量移除用户组的角色
+	RemoveGroupRoles(context.Context, *RemoveGroupRolesRequest) (*RemoveGroupRolesResponse, error)
+	// GetGroupRoles 获取用户组拥有的所有角色
+	GetGroupRoles(context.Context, *GetGroupRolesRequest) (*GetGroupRolesResponse, error)
+	mustEmbedUnimplementedGroupServiceServer()
+}
+
+// UnimplementedGroupServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGroupServiceServer struct{}
+
+func (UnimplementedGroupServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) GetGroup(context.Context, *GetGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (UnimplementedGroupServiceServer) AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupMembers not implemented")
+}
+func (UnimplementedGroupServiceServer) RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMembers not implemented")
+}
+func (UnimplementedGroupServiceServer) ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupMembers not implemented")
+}
+func (UnimplementedGroupServiceServer) ListUserGroups(context.Context, *ListUserGroupsRequest) (*ListUserGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserGroups not implemented")
+}
+func (UnimplementedGroupServiceServer) AssignGroupRoles(context.Context, *AssignGroupRolesRequest) (*AssignGroupRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignGroupRoles not implemented")
+}
+func (UnimplementedGroupServiceServer) RemoveGroupRoles(context.Context, *RemoveGroupRolesRequest) (*RemoveGroupRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupRoles not implemented")
+}
+func (UnimplementedGroupServiceServer) GetGroupRoles(context.Context, *GetGroupRolesRequest) (*GetGroupRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupRoles not implemented")
+}
+func (UnimplementedGroupServiceServer) mustEmbedUnimplementedGroupServiceServer() {}
+func (UnimplementedGroupServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeGroupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupServiceServer will
+// result in compilation errors.
+type UnsafeGroupServiceServer interface {
+	mustEmbedUnimplementedGroupServiceServer()
+}
+
+func RegisterGroupServiceServer(s grpc.ServiceRegistrar, srv GroupServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGroupServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GroupService_ServiceDesc, srv)
+}
+
+func _GroupService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UpdateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateGroup(ctx, req.(*UpdateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ListGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ListGroups(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_AddGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).AddGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_AddGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).AddGroupMembers(ctx, req.(*AddGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_RemoveGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).RemoveGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_RemoveGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).RemoveGroupMembers(ctx, req.(*RemoveGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ListGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ListGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ListGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ListGroupMembers(ctx, req.(*ListGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ListUserGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ListUserGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ListUserGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ListUserGroups(ctx, req.(*ListUserGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_AssignGroupRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignGroupRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).AssignGroupRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_AssignGroupRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).AssignGroupRoles(ctx, req.(*AssignGroupRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_RemoveGroupRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).RemoveGroupRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_RemoveGroupRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).RemoveGroupRoles(ctx, req.(*RemoveGroupRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetGroupRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroupRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroupRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroupRoles(ctx, req.(*GetGroupRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroupService_ServiceDesc is the grpc.ServiceDesc for GroupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iam.groupService",
+	HandlerType: (*GroupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _GroupService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "GetGroup",
+			Handler:    _GroupService_GetGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroup",
+			Handler:    _GroupService_UpdateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _GroupService_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _GroupService_ListGroups_Handler,
+		},
+		{
+			MethodName: "AddGroupMembers",
+			Handler:    _GroupService_AddGroupMembers_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMembers",
+			Handler:    _GroupService_RemoveGroupMembers_Handler,
+		},
+		{
+			MethodName: "ListGroupMembers",
+			Handler:    _GroupService_ListGroupMembers_Handler,
+		},
+		{
+			MethodName: "ListUserGroups",
+			Handler:    _GroupService_ListUserGroups_Handler,
+		},
+		{
+			MethodName: "AssignGroupRoles",
+			Handler:    _GroupService_AssignGroupRoles_Handler,
+		},
+		{
+			MethodName: "RemoveGroupRoles",
+			Handler:    _GroupService_RemoveGroupRoles_Handler,
+		},
+		{
+			MethodName: "GetGroupRoles",
+			Handler:    _GroupService_GetGroupRoles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iam.proto",
+}
+
+const (
+	BindingService_CreateResourceBinding_FullMethodName = "/iam.bindingService/CreateResourceBinding"
+	BindingService_DeleteResourceBinding_FullMethodName = "/iam.bindingService/DeleteResourceBinding"
+	BindingService_ListResourceAccess_FullMethodName    = "/iam.bindingService/ListResourceAccess"
+	BindingService_ListUserResources_FullMethodName     = "/iam.bindingService/ListUserResources"
+)
+
+// BindingServiceClient is the client API for BindingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============================================================
+// bindingService
+// 资源绑定服务，为用户在指定资源上授予角色，并查询资源的访问者及用户可访问的资源
+// ============================================================
+type BindingServiceClient interface {
+	// CreateResourceBinding 为用户在指定资源上绑定角色，resource_id为*时绑定该类型的所有资源
+	CreateResourceBinding(ctx context.Context, in *CreateResourceBindingRequest, opts ...grpc.CallOption) (*CreateResourceBindingResponse, error)
+	// DeleteResourceBinding 删除资源绑定
+	DeleteResourceBinding(ctx context.Context, in *DeleteResourceBindingRequest, opts ...grpc.CallOption) (*DeleteResourceBindingResponse, error)
+	// ListResourceAccess 分页查询能访问指定资源的用户绑定，包含通配绑定，不包含全局角色
+	ListResourceAccess(ctx context.Context, in *ListResourceAccessRequest, opts ...grpc.CallOption) (*ListResourceBindingsResponse, error)
+	// ListUserResources 分页查询用户通过资源绑定可操作的资源
+	ListUserResources(ctx context.Context, in *ListUserResourcesRequest, opts ...grpc.CallOption) (*ListResourceBindingsResponse, error)
+}
+
+type bindingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBindingServiceClient(cc grpc.ClientConnInterface) BindingServiceClient {
+	return &bindingServiceClient{cc}
+}
+
+func (c *bindingServiceClient) CreateResourceBinding(ctx context.Context, in *CreateResourceBindingRequest, opts ...grpc.CallOption) (*CreateResourceBindingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateResourceBindingResponse)
+	err := c.cc.Invoke(ctx, BindingService_CreateResourceBinding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bindingServiceClient) DeleteResourceBinding(ctx context.Context, in *DeleteResourceBindingRequest, opts ...grpc.CallOption) (*DeleteResourceBindingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResourceBindingResponse)
+	err := c.cc.Invoke(ctx, BindingService_DeleteResourceBinding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bindingServiceClient) ListResourceAccess(ctx context.Context, in *ListResourceAccessRequest, opts ...grpc.CallOption) (*ListResourceBindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResourceBindingsResponse)
+	err := c.cc.Invoke(ctx, BindingService_ListResourceAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bindingServiceClient) ListUserResources(ctx context.Context, in *ListUserResourcesRequest, opts ...grpc.CallOption) (*ListResourceBindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResourceBindingsResponse)
+	err := c.cc.Invoke(ctx, BindingService_ListUserResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BindingServiceServer is the server API for BindingService service.
+// All implementations must embed UnimplementedBindingServiceServer
+// for forward compatibility.
+//
+// ============================================================
+// bindingService
+// 资源绑定服务，为用户在指定资源上授予角色，并查询资源的访问者及用户可访问的资源
+// ============================================================
+type BindingServiceServer interface {
+	// CreateResourceBinding 为用户在指定资源上绑定角色，resource_id为*时绑定该类型的所有资源
+	CreateResourceBinding(context.Context, *CreateResourceBindingRequest) (*CreateResourceBindingResponse, error)
+	// DeleteResourceBinding 删除资源绑定
+	DeleteResourceBinding(context.Context, *DeleteResourceBindingRequest) (*DeleteResourceBindingResponse, error)
+	// ListResourceAccess 分页查询能访问指定资源的用户绑定，包含通配绑定，不包含全局角色
+	ListResourceAccess(context.Context, *ListResourceAccessRequest) (*ListResourceBindingsResponse, error)
+	// ListUserResources 分页查询用户通过资源绑定可操作的资源
+	ListUserResources(context.Context, *ListUserResourcesRequest) (*ListResourceBindingsResponse, error)
+	mustEmbedUnimplementedBindingServiceServer()
+}
+
+// UnimplementedBindingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBindingServiceServer struct{}
+
+func (UnimplementedBindingServiceServer) CreateResourceBinding(context.Context, *CreateResourceBindingRequest) (*CreateResourceBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateResourceBinding not implemented")
+}
+func (UnimplementedBindingServiceServer) DeleteResourceBinding(context.Context, *DeleteResourceBindingRequest) (*DeleteResourceBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResourceBinding not implemented")
+}
+func (UnimplementedBindingServiceServer) ListResourceAccess(context.Context, *ListResourceAccessRequest) (*ListResourceBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceAccess not implemented")
+}
+func (UnimplementedBindingServiceServer) ListUserResources(context.Context, *ListUserResourcesRequest) (*ListResourceBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserResources not implemented")
+}
+func (UnimplementedBindingServiceServer) mustEmbedUnimplementedBindingServiceServer() {}
+func (UnimplementedBindingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeBindingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BindingServiceServer will
+// result in compilation errors.
+type UnsafeBindingServiceServer interface {
+	mustEmbedUnimplementedBindingServiceServer()
+}
+
+func RegisterBindingServiceServer(s grpc.ServiceRegistrar, srv BindingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBindingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BindingService_ServiceDesc, srv)
+}
+
+func _BindingService_CreateResourceBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateResourceBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BindingServiceServer).CreateResourceBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BindingService_CreateResourceBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BindingServiceServer).CreateResourceBinding(ctx, req.(*CreateResourceBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BindingService_DeleteResourceBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResourceBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BindingServiceServer).DeleteResourceBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BindingService_DeleteResourceBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BindingServiceServer).DeleteResourceBinding(ctx, req.(*DeleteResourceBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BindingService_ListResourceAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourceAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BindingServiceServer).ListResourceAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BindingService_ListResourceAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BindingServiceServer).ListResourceAccess(ctx, req.(*ListResourceAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BindingService_ListUserResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BindingServiceServer).ListUserResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BindingService_ListUserResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BindingServiceServer).ListUserResources(ctx, req.(*ListUserResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BindingService_ServiceDesc is the grpc.ServiceDesc for BindingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BindingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iam.bindingService",
+	HandlerType: (*BindingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateResourceBinding",
+			Handler:    _BindingService_CreateResourceBinding_Handler,
+		},
+		{
+			MethodName: "DeleteResourceBinding",
+			Handler:    _BindingService_DeleteResourceBinding_Handler,
+		},
+		{
+			MethodName: "ListResourceAccess",
+			Handler:    _BindingService_ListResourceAccess_Handler,
+		},
+		{
+			MethodName: "ListUserResources",
+			Handler:    _BindingService_ListUserResources_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iam.proto",
+}
+
+const (
+	DepartmentService_CreateDepartment_FullMethodName  = "/iam.departmentService/CreateDepartment"
+	DepartmentService_GetDepartment_FullMethodName     = "/iam.departmentService/GetDepartment"
+	DepartmentService_UpdateDepartment_FullMethodName  = "/iam.departmentService/UpdateDepartment"
+	DepartmentService_DeleteDepartment_FullMethodName  = "/iam.departmentService/DeleteDepartment"
+	DepartmentService_ListDepartments_FullMethodName   = "/iam.departmentService/ListDepartments"
+	DepartmentService_SetUserDepartment_FullMethodName = "/iam.departmentService/SetUserDepartment"
+)
+
+// DepartmentServiceClient is the client API for DepartmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============================================================
+// departmentService
+// 部门管理服务，提供组织树的维护及用户部门归属管理
+// ============================================================
+type DepartmentServiceClient interface {
+	// CreateDepartment 创建部门
+	CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*CreateDepartmentResponse, error)
+	// GetDepartment 根据ID获取部门详情
+	GetDepartment(ctx context.Context, in *GetDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
+	// UpdateDepartment 更新部门信息，调整上级部门时同步更新所有下级部门
+	UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
+	// DeleteDepartment 删除部门（软删除），存在下级部门或成员时不允许删除
+	DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*DeleteDepartmentResponse, error)
+	// ListDepartments 查询组织树，按层级顺序返回指定部门及其所有下级部门
+	ListDepartments(ctx context.Context, in *ListDepartmentsRequest, opts ...grpc.CallOption) (*ListDepartmentsResponse, error)
+	// SetUserDepartment 设置用户所属部门
+	SetUserDepartment(ctx context.Context, in *SetUserDepartmentRequest, opts ...grpc.CallOption) (*SetUserDepartmentResponse, error)
+}
+
+type departmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDepartmentServiceClient(cc grpc.ClientConnInterface) DepartmentServiceClient {
+	return &departmentServiceClient{cc}
+}
+
+func (c *departmentServiceClient) CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*CreateDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDepartmentResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_CreateDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) GetDepartment(ctx context.Context, in *GetDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Department)
+	err := c.cc.Invoke(ctx, DepartmentService_GetDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Department)
+	err := c.cc.Invoke(ctx, DepartmentService_UpdateDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*DeleteDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDepartmentResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_DeleteDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) ListDepartments(ctx context.Context, in *ListDepartmentsRequest, opts ...grpc.CallOption) (*ListDepartmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDepartmentsResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_ListDepartments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) SetUserDepartment(ctx context.Context, in *SetUserDepartmentRequest, opts ...grpc.CallOption) (*SetUserDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserDepartmentResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_SetUserDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DepartmentServiceServer is the server API for DepartmentService service.
+// All implementations must embed UnimplementedDepartmentServiceServer
+// for forward compatibility.
+//
+// ============================================================
+// departmentService
+// 部门管理服务，提供组织树的维护及用户部门归属管理
+// ============================================================
+type DepartmentServiceServer interface {
+	// CreateDepartment 创建部门
+	CreateDepartment(context.Context, *CreateDepartmentRequest) (*CreateDepartmentResponse, error)
+	// GetDepartment 根据ID获取部门详情
+	GetDepartment(context.Context, *GetDepartmentRequest) (*Department, error)
+	// UpdateDepartment 更新部门信息，调整上级部门时同步更新所有下级部门
+	UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*Department, error)
+	// DeleteDepartment 删除部门（软删除），存在下级部门或成员时不允许删除
+	DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*DeleteDepartmentResponse, error)
+	// ListDepartments 查询组织树，按层级顺序返回指定部门及其所有下级部门
+	ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error)
+	// SetUserDepartment 设置用户所属部门
+	SetUserDepartment(context.Context, *SetUserDepartmentRequest) (*SetUserDepartmentResponse, error)
+	mustEmbedUnimplementedDepartmentServiceServer()
+}
+
+// UnimplementedDepartmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDepartmentServiceServer struct{}
+
+func (UnimplementedDepartmentServiceServer) CreateDepartment(context.Context, *CreateDepartmentRequest) (*CreateDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) GetDepartment(context.Context, *GetDepartmentRequest) (*Department, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*Department, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*DeleteDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDepartments not implemented")
+}
+func (UnimplementedDepartmentServiceServer) SetUserDepartment(context.Context, *SetUserDepartmentRequest) (*SetUserDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) mustEmbedUnimplementedDepartmentServiceServer() {}
+func (UnimplementedDepartmentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeDepartmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DepartmentServiceServer will
+// result in compilation errors.
+type UnsafeDepartmentServiceServer interface {
+	mustEmbedUnimplementedDepartmentServiceServer()
+}
+
+func RegisterDepartmentServiceServer(s grpc.ServiceRegistrar, srv DepartmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDepartmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DepartmentService_ServiceDesc, srv)
+}
+
+func _DepartmentService_CreateDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).CreateDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_CreateDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).CreateDepartment(ctx, req.(*CreateDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_GetDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).GetDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_GetDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).GetDepartment(ctx, req.(*GetDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_UpdateDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).UpdateDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_UpdateDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).UpdateDepartment(ctx, req.(*UpdateDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_DeleteDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).DeleteDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_DeleteDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).DeleteDepartment(ctx, req.(*DeleteDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_ListDepartments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDepartmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).ListDepartments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_ListDepartments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).ListDepartments(ctx, req.(*ListDepartmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_SetUserDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).SetUserDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_SetUserDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).SetUserDepartment(ctx, req.(*SetUserDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DepartmentService_ServiceDesc is the grpc.ServiceDesc for DepartmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DepartmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iam.departmentService",
+	HandlerType: (*DepartmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDepartment",
+			Handler:    _DepartmentService_CreateDepartment_Handler,
+		},
+		{
+			MethodName: "GetDepartment",
+			Handler:    _DepartmentService_GetDepartment_Handler,
+		},
+		{
+			MethodName: "UpdateDepartment",
+			Handler:    _DepartmentService_UpdateDepartment_Handler,
+		},
+		{
+			MethodName: "DeleteDepartment",
+			Handler:    _DepartmentService_DeleteDepartment_Handler,
+		},
+		{
+			MethodName: "ListDepartments",
+			Handler:    _DepartmentService_ListDepartments_Handler,
+		},
+		{
+			MethodName: "SetUserDepartment",
+			Handler:    _DepartmentService_SetUserDepartment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iam.proto",
+}
+
+const (
+	RelationService_WriteRelationTuples_FullMethodName  = "/iam.relationService/WriteRelationTuples"
+	RelationService_DeleteRelationTuples_FullMethodName = "/iam.relationService/DeleteRelationTuples"
+	RelationService_Check_FullMethodName                = "/iam.relationService/Check"
+	RelationService_Expand_FullMethodName               = "/iam.relationService/Expand"
+	RelationService_ListObjects_FullMethodName          = "/iam.relationService/ListObjects"
+	RelationService_ListSubjects_FullMethodName         = "/iam.relationService/ListSubjects"
 )
 
-// RoleServiceClient is the client API for RoleService service.
+// RelationServiceClient is the client API for RelationService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
 // ============================================================
-// roleService
-// 角色管理服务，提供角色的增删改查及权限分配功能
+// relationService
+// 关系服务，基于关系元组和命名空间配置进行访问控制（ReBAC），可通过 role:<code>#member 引用角色
 // ============================================================
-type RoleServiceClient interface {
-	// CreateRole 创建新角色
-	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
-	// GetRole 根据ID获取角色详情
-	GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*Role, error)
-	// UpdateRole 更新角色信息
-	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*Role, error)
-	// DeleteRole 删除角色（软删除）
-	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
-	// ListRoles 分页查询角色列表
-	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
-	// AssignRolePermission 为角色分配单个权限
-	AssignRolePermission(ctx context.Context, in *AssignRolePermissionRequest, opts ...grpc.CallOption) (*AssignRolePermissionResponse, error)
-	// AssignRolePermissions 为角色批量分配权限
-	AssignRolePermissions(ctx context.Context, in *AssignRolePermissionsRequest, opts ...grpc.CallOption) (*AssignRolePermissionsResponse, error)
-	// RemoveRolePermission 移除角色的单个权限
-	RemoveRolePermission(ctx context.Context, in *RemoveRolePermissionRequest, opts ...grpc.CallOption) (*RemoveRolePermissionResponse, error)
-	// RemoveRolePermissions 批量移除角色的权限
-	RemoveRolePermissions(ctx context.Context, in *RemoveRolePermissionsRequest, opts ...grpc.CallOption) (*RemoveRolePermissionsResponse, error)
-	// GetRolePermissions 获取角色拥有的所有权限
-	GetRolePermissions(ctx context.Context, in *GetRolePermissionsRequest, opts ...grpc.CallOption) (*GetRolePermissionsResponse, error)
-	// CheckRolePermission 检查角色是否拥有指定权限
-	CheckRolePermission(ctx context.Context, in *CheckRolePermissionRequest, opts ...grpc.CallOption) (*CheckRolePermissionResponse, error)
+type RelationServiceClient interface {
+	// WriteRelationTuples 批量写入关系元组，已存在的元组跳过
+	WriteRelationTuples(ctx context.Context, in *WriteRelationTuplesRequest, opts ...grpc.CallOption) (*WriteRelationTuplesResponse, error)
+	// DeleteRelationTuples 批量删除关系元组，不存在的元组跳过
+	DeleteRelationTuples(ctx context.Context, in *DeleteRelationTuplesRequest, opts ...grpc.CallOption) (*DeleteRelationTuplesResponse, error)
+	// Check 检查主体是否与对象存在指定关系
+	Check(ctx context.Context, in *CheckRelationRequest, opts ...grpc.CallOption) (*CheckRelationResponse, error)
+	// Expand 展开对象上指定关系的改写规则，返回关系展开树
+	Expand(ctx context.Context, in *ExpandRelationRequest, opts ...grpc.CallOption) (*ExpandRelationResponse, error)
+	// ListObjects 查询主体与之存在指定关系的对象
+	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
+	// ListSubjects 查询与对象存在指定关系的所有用户，主体集合和角色会被完全展开
+	ListSubjects(ctx context.Context, in *ListSubjectsRequest, opts ...grpc.CallOption) (*ListSubjectsResponse, error)
 }
 
-type roleServiceClient struct {
+type relationServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewRoleServiceClient(cc grpc.ClientConnInterface) RoleServiceClient {
-	return &roleServiceClient{cc}
+func NewRelationServiceClient(cc grpc.ClientConnInterface) RelationServiceClient {
+	return &relationServiceClient{cc}
 }
 
-func (c *roleServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
+func (c *relationServiceClient) WriteRelationTuples(ctx context.Context, in *WriteRelationTuplesRequest, opts ...grpc.CallOption) (*WriteRelationTuplesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateRoleResponse)
-	err := c.cc.Invoke(ctx, RoleService_CreateRole_FullMethodName, in, out, cOpts...)
+	out := new(WriteRelationTuplesResponse)
+	err := c.cc.Invoke(ctx, RelationService_WriteRelationTuples_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roleServiceClient) GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*Role, error) {
+func (c *relationServiceClient) DeleteRelationTuples(ctx context.Context, in *DeleteRelationTuplesRequest, opts ...grpc.CallOption) (*DeleteRelationTuplesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Role)
-	err := c.cc.Invoke(ctx, RoleService_GetRole_FullMethodName, in, out, cOpts...)
+	out := new(DeleteRelationTuplesResponse)
+	err := c.cc.Invoke(ctx, RelationService_DeleteRelationTuples_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roleServiceClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*Role, error) {
+func (c *relationServiceClient) Check(ctx context.Context, in *CheckRelationRequest, opts ...grpc.CallOption) (*CheckRelationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Role)
-	err := c.cc.Invoke(ctx, RoleService_UpdateRole_FullMethodName, in, out, cOpts...)
+	out := new(CheckRelationResponse)
+	err := c.cc.Invoke(ctx, RelationService_Check_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roleServiceClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error) {
+func (c *relationServiceClient) Expand(ctx context.Context, in *ExpandRelationRequest, opts ...grpc.CallOption) (*ExpandRelationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteRoleResponse)
-	err := c.cc.Invoke(ctx, RoleService_DeleteRole_FullMethodName, in, out, cOpts...)
+	out := new(ExpandRelationResponse)
+	err := c.cc.Invoke(ctx, RelationService_Expand_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roleServiceClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+func (c *relationServiceClient) ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListRolesResponse)
-	err := c.cc.Invoke(ctx, RoleService_ListRoles_FullMethodName, in, out, cOpts...)
+	out := new(ListObjectsResponse)
+	err := c.cc.Invoke(ctx, RelationService_ListObjects_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roleServiceClient) AssignRolePermission(ctx context.Context, in *AssignRolePermissionRequest, opts ...grpc.CallOption) (*AssignRolePermissionResponse, error) {
+func (c *relationServiceClient) ListSubjects(ctx context.Context, in *ListSubjectsRequest, opts ...grpc.CallOption) (*ListSubjectsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AssignRolePermissionResponse)
-	err := c.cc.Invoke(ctx, RoleService_AssignRolePermission_FullMethodName, in, out, cOpts...)
+	out := new(ListSubjectsResponse)
+	err := c.cc.Invoke(ctx, RelationService_ListSubjects_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roleServiceClient) AssignRolePermissions(ctx context.Context, in *AssignRolePermissionsRequest, opts ...grpc.CallOption) (*AssignRolePermissionsResponse, error) {
+// RelationServiceServer is the server API for RelationService service.
+// All implementations must embed UnimplementedRelationServiceServer
+// for forward compatibility.
+//
+// ============================================================
+// relationService
+// 关系服务，基于关系元组和命名空间配置进行访问控制（ReBAC），可通过 role:<code>#member 引用角色
+// ============================================================
+type RelationServiceServer interface {
+	// WriteRelationTuples 批量写入关系元组，已存在的元组跳过
+	WriteRelationTuples(context.Context, *WriteRelationTuplesRequest) (*WriteRelationTuplesResponse, error)
+	// DeleteRelationTuples 批量删除关系元组，不存在的元组跳过
+	DeleteRelationTuples(context.Context, *DeleteRelationTuplesRequest) (*DeleteRelationTuplesResponse, error)
+	// Check 检查主体是否与对象存在指定关系
+	Check(context.Context, *CheckRelationRequest) (*CheckRelationResponse, error)
+	// Expand 展开对象上指定关系的改写规则，返回关系展开树
+	Expand(context.Context, *ExpandRelationRequest) (*ExpandRelationResponse, error)
+	// ListObjects 查询主体与之存在指定关系的对象
+	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
+	// ListSubjects 查询与对象存在指定关系的所有用户，主体集合和角色会被完全展开
+	ListSubjects(context.Context, *ListSubjectsRequest) (*ListSubjectsResponse, error)
+	mustEmbedUnimplementedRelationServiceServer()
+}
+
+// UnimplementedRelationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRelationServiceServer struct{}
+
+func (UnimplementedRelationServiceServer) WriteRelationTuples(context.Context, *WriteRelationTuplesRequest) (*WriteRelationTuplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRelationTuples not implemented")
+}
+func (UnimplementedRelationServiceServer) DeleteRelationTuples(context.Context, *DeleteRelationTuplesRequest) (*DeleteRelationTuplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelationTuples not implemented")
+}
+func (UnimplementedRelationServiceServer) Check(context.Context, *CheckRelationRequest) (*CheckRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
+}
+func (UnimplementedRelationServiceServer) Expand(context.Context, *ExpandRelationRequest) (*ExpandRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expand not implemented")
+}
+func (UnimplementedRelationServiceServer) ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
+}
+func (UnimplementedRelationServiceServer) ListSubjects(context.Context, *ListSubjectsRequest) (*ListSubjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubjects not implemented")
+}
+func (UnimplementedRelationServiceServer) mustEmbedUnimplementedRelationServiceServer() {}
+func (UnimplementedRelationServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeRelationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RelationServiceServer will
+// result in compilation errors.
+type UnsafeRelationServiceServer interface {
+	mustEmbedUnimplementedRelationServiceServer()
+}
+
+func RegisterRelationServiceServer(s grpc.ServiceRegistrar, srv RelationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRelationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RelationService_ServiceDesc, srv)
+}
+
+func _RelationService_WriteRelationTuples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteRelationTuplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).WriteRelationTuples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_WriteRelationTuples_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).WriteRelationTuples(ctx, req.(*WriteRelationTuplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_DeleteRelationTuples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRelationTuplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).DeleteRelationTuples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_DeleteRelationTuples_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).DeleteRelationTuples(ctx, req.(*DeleteRelationTuplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).Check(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_Check_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).Check(ctx, req.(*CheckRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_Expand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpandRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).Expand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_Expand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).Expand(ctx, req.(*ExpandRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).ListObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_ListObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).ListObjects(ctx, req.(*ListObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_ListSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).ListSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_ListSubjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).ListSubjects(ctx, req.(*ListSubjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RelationService_ServiceDesc is the grpc.ServiceDesc for RelationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RelationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iam.relationService",
+	HandlerType: (*RelationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WriteRelationTuples",
+			Handler:    _RelationService_WriteRelationTuples_Handler,
+		},
+		{
+			MethodName: "DeleteRelationTuples",
+			Handler:    _RelationService_DeleteRelationTuples_Handler,
+		},
+		{
+			MethodName: "Check",
+			Handler:    _RelationService_Check_Handler,
+		},
+		{
+			MethodName: "Expand",
+			Handler:    _RelationService_Expand_Handler,
+		},
+		{
+			MethodName: "ListObjects",
+			Handler:    _RelationService_ListObjects_Handler,
+		},
+		{
+			MethodName: "ListSubjects",
+			Handler:    _RelationService_ListSubjects_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iam.proto",
+}
+
+const (
+	AccessRequestService_SetRoleApprovers_FullMethodName     = "/iam.accessRequestService/SetRoleApprovers"
+	AccessRequestService_GetRoleApprovers_FullMethodName     = "/iam.accessRequestService/GetRoleApprovers"
+	AccessRequestService_CreateAccessRequest_FullMethodName  = "/iam.accessRequestService/CreateAccessRequest"
+	AccessRequestService_GetAccessRequest_FullMethodName     = "/iam.accessRequestService/GetAccessRequest"
+	AccessRequestService_ListAccessRequests_FullMethodName   = "/iam.accessRequestService/ListAccessRequests"
+	AccessRequestService_ApproveAccessRequest_FullMethodName = "/iam.accessRequestService/ApproveAccessRequest"
+	AccessRequestService_RejectAccessRequest_FullMethodName  = "/iam.accessRequestService/RejectAccessRequest"
+	AccessRequestService_CancelAccessRequest_FullMethodName  = "/iam.accessRequestService/CancelAccessRequest"
+	AccessRequestService_CommentAccessRequest_FullMethodName = "/iam.accessRequestService/CommentAccessRequest"
+)
+
+// AccessRequestServiceClient is the client API for AccessRequestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============================================================
+// accessRequestService
+// 访问申请服务，用户申请角色并经审批链逐级审批，全部通过后自动授予限时角色
+// ============================================================
+type AccessRequestServiceClient interface {
+	// SetRoleApprovers 设置角色的审批链，覆盖原有配置，步骤为空表示该角色不接受申请
+	SetRoleApprovers(ctx context.Context, in *SetRoleApproversRequest, opts ...grpc.CallOption) (*SetRoleApproversResponse, error)
+	// GetRoleApprovers 获取角色的审批链
+	GetRoleApprovers(ctx context.Context, in *GetRoleApproversRequest, opts ...grpc.CallOption) (*GetRoleApproversResponse, error)
+	// CreateAccessRequest 创建角色访问申请，同一用户对同一角色只能存在一个审批中的申请
+	CreateAccessRequest(ctx context.Context, in *CreateAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequest, error)
+	// GetAccessRequest 获取访问申请详情，包含事件历史
+	GetAccessRequest(ctx context.Context, in *GetAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequest, error)
+	// ListAccessRequests 分页查询访问申请，指定审批人时返回当前步骤等待其处理的申请
+	ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error)
+	// ApproveAccessRequest 审批通过当前步骤，最后一步通过后自动授予角色
+	ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequest, error)
+	// RejectAccessRequest 拒绝访问申请
+	RejectAccessRequest(ctx context.Context, in *RejectAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequest, error)
+	// CancelAccessRequest 申请人撤回审批中的申请
+	CancelAccessRequest(ctx context.Context, in *CancelAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequest, error)
+	// CommentAccessRequest 申请人或审批人对申请发表评论
+	CommentAccessRequest(ctx context.Context, in *CommentAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequest, error)
+}
+
+type accessRequestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccessRequestServiceClient(cc grpc.ClientConnInterface) AccessRequestServiceClient {
+	return &accessRequestServiceClient{cc}
+}
+
+func (c *accessRequestServiceClient) SetRoleApprovers(ctx context.Context, in *SetRoleApproversRequest, opts ...grpc.CallOption) (*SetRoleApproversResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AssignRolePermissionsResponse)
-	err := c.cc.Invoke(ctx, RoleService_AssignRolePermissions_FullMethodName, in, out, cOpts...)
+	out := new(SetRoleApproversResponse)
+	err := c.cc.Invoke(ctx, AccessRequestService_SetRoleApprovers_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roleServiceClient) RemoveRolePermission(ctx context.Context, in *RemoveRolePermissionRequest, opts ...grpc.CallOption) (*RemoveRolePermissionResponse, error) {
+func (c *accessRequestServiceClient) GetRoleApprovers(ctx context.Context, in *GetRoleApproversRequest, opts ...grpc.CallOption) (*GetRoleApproversResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RemoveRolePermissionResponse)
-	err := c.cc.Invoke(ctx, RoleService_RemoveRolePermission_FullMethodName, in, out, cOpts...)
+	out := new(GetRoleApproversResponse)
+	err := c.cc.Invoke(ctx, AccessRequestService_GetRoleApprovers_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roleServiceClient) RemoveRolePermissions(ctx context.Context, in *RemoveRolePermissionsRequest, opts ...grpc.CallOption) (*RemoveRolePermissionsResponse, error) {
+func (c *accessRequestServiceClient) CreateAccessRequest(ctx context.Context, in *CreateAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequest, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RemoveRolePermissionsResponse)
-	err := c.cc.Invoke(ctx, RoleService_RemoveRolePermissions_FullMethodName, in, out, cOpts...)
+	out := new(AccessRequest)
+	err := c.cc.Invoke(ctx, AccessRequestService_CreateAccessRequest_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roleServiceClient) GetRolePermissions(ctx context.Context, in *GetRolePermissionsRequest, opts ...grpc.CallOption) (*GetRolePermissionsResponse, error) {
+func (c *accessRequestServiceClient) GetAccessRequest(ctx context.Context, in *GetAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequest, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetRolePermissionsResponse)
-	err := c.cc.Invoke(ctx, RoleService_GetRolePermissions_FullMethodName, in, out, cOpts...)
+	out := new(AccessRequest)
+	err := c.cc.Invoke(ctx, AccessRequestService_GetAccessRequest_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roleServiceClient) CheckRolePermission(ctx context.Context, in *CheckRolePermissionRequest, opts ...grpc.CallOption) (*CheckRolePermissionResponse, error) {
+func (c *accessRequestServiceClient) ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, AccessRequestService_ListAccessRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessRequestServiceClient) ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessRequest)
+	err := c.cc.Invoke(ctx, AccessRequestService_ApproveAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessRequestServiceClient) RejectAccessRequest(ctx context.Context, in *RejectAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessRequest)
+	err := c.cc.Invoke(ctx, AccessRequestService_RejectAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessRequestServiceClient) CancelAccessRequest(ctx context.Context, in *CancelAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessRequest)
+	err := c.cc.Invoke(ctx, AccessRequestService_CancelAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessRequestServiceClient) CommentAccessRequest(ctx context.Context, in *CommentAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequest, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CheckRolePermissionResponse)
-	err := c.cc.Invoke(ctx, RoleService_CheckRolePermission_FullMethodName, in, out, cOpts...)
+	out := new(AccessRequest)
+	err := c.cc.Invoke(ctx, AccessRequestService_CommentAccessRequest_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// RoleServiceServer is the server API for RoleService service.
-// All implementations must embed UnimplementedRoleServiceServer
+// AccessRequestServiceServer is the server API for AccessRequestService service.
+// All implementations must embed UnimplementedAccessRequestServiceServer
 // for forward compatibility.
 //
 // ============================================================
-// roleService
-// 角色管理服务，提供角色的增删改查及权限分配功能
+// accessRequestService
+// 访问申请服务，用户申请角色并经审批链逐级审批，全部通过后自动授予限时角色
 // ============================================================
-type RoleServiceServer interface {
-	// CreateRole 创建新角色
-	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
-	// GetRole 根据ID获取角色详情
-	GetRole(context.Context, *GetRoleRequest) (*Role, error)
-	// UpdateRole 更新角色信息
-	UpdateRole(context.Context, *UpdateRoleRequest) (*Role, error)
-	// DeleteRole 删除角色（软删除）
-	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
-	// ListRoles 分页查询角色列表
-	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
-	// AssignRolePermission 为角色分配单个权限
-	AssignRolePermission(context.Context, *AssignRolePermissionRequest) (*AssignRolePermissionResponse, error)
-	// AssignRolePermissions 为角色批量分配权限
-	AssignRolePermissions(context.Context, *AssignRolePermissionsRequest) (*AssignRolePermissionsResponse, error)
-	// RemoveRolePermission 移除角色的单个权限
-	RemoveRolePermission(context.Context, *RemoveRolePermissionRequest) (*RemoveRolePermissionResponse, error)
-	// RemoveRolePermissions 批量移除角色的权限
-	RemoveRolePermissions(context.Context, *RemoveRolePermissionsRequest) (*RemoveRolePermissionsResponse, error)
-	// GetRolePermissions 获取角色拥有的所有权限
-	GetRolePermissions(context.Context, *GetRolePermissionsRequest) (*GetRolePermissionsResponse, error)
-	// CheckRolePermission 检查角色是否拥有指定权限
-	CheckRolePermission(context.Context, *CheckRolePermissionRequest) (*CheckRolePermissionResponse, error)
-	mustEmbedUnimplementedRoleServiceServer()
+type AccessRequestServiceServer interface {
+	// SetRoleApprovers 设置角色的审批链，覆盖原有配置，步骤为空表示该角色不接受申请
+	SetRoleApprovers(context.Context, *SetRoleApproversRequest) (*SetRoleApproversResponse, error)
+	// GetRoleApprovers 获取角色的审批链
+	GetRoleApprovers(context.Context, *GetRoleApproversRequest) (*GetRoleApproversResponse, error)
+	// CreateAccessRequest 创建角色访问申请，同一用户对同一角色只能存在一个审批中的申请
+	CreateAccessRequest(context.Context, *CreateAccessRequestRequest) (*AccessRequest, error)
+	// GetAccessRequest 获取访问申请详情，包含事件历史
+	GetAccessRequest(context.Context, *GetAccessRequestRequest) (*AccessRequest, error)
+	// ListAccessRequests 分页查询访问申请，指定审批人时返回当前步骤等待其处理的申请
+	ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error)
+	// ApproveAccessRequest 审批通过当前步骤，最后一步通过后自动授予角色
+	ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*AccessRequest, error)
+	// RejectAccessRequest 拒绝访问申请
+	RejectAccessRequest(context.Context, *RejectAccessRequestRequest) (*AccessRequest, error)
+	// CancelAccessRequest 申请人撤回审批中的申请
+	CancelAccessRequest(context.Context, *CancelAccessRequestRequest) (*AccessRequest, error)
+	// CommentAccessRequest 申请人或审批人对申请发表评论
+	CommentAccessRequest(context.Context, *CommentAccessRequestRequest) (*AccessRequest, error)
+	mustEmbedUnimplementedAccessRequestServiceServer()
 }
 
-// UnimplementedRoleServiceServer must be embedded to have
+// UnimplementedAccessRequestServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedRoleServiceServer struct{}
+type UnimplementedAccessRequestServiceServer struct{}
 
-func (UnimplementedRoleServiceServer) CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
-}
-func (UnimplementedRoleServiceServer) GetRole(context.Context, *GetRoleRequest) (*Role, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
+func (UnimplementedAccessRequestServiceServer) SetRoleApprovers(context.Context, *SetRoleApproversRequest) (*SetRoleApproversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoleApprovers not implemented")
 }
-func (UnimplementedRoleServiceServer) UpdateRole(context.Context, *UpdateRoleRequest) (*Role, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
-}
-func (UnimplementedRoleServiceServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+func (UnimplementedAccessRequestServiceServer) GetRoleApprovers(context.Context, *GetRoleApproversRequest) (*GetRoleApproversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleApprovers not implemented")
 }
-func (UnimplementedRoleServiceServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+func (UnimplementedAccessRequestServiceServer) CreateAccessRequest(context.Context, *CreateAccessRequestRequest) (*AccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessRequest not implemented")
 }
-func (UnimplementedRoleServiceServer) AssignRolePermission(context.Context, *AssignRolePermissionRequest) (*AssignRolePermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignRolePermission not implemented")
+func (UnimplementedAccessRequestServiceServer) GetAccessRequest(context.Context, *GetAccessRequestRequest) (*AccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessRequest not implemented")
 }
-func (UnimplementedRoleServiceServer) AssignRolePermissions(context.Context, *AssignRolePermissionsRequest) (*AssignRolePermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignRolePermissions not implemented")
+func (UnimplementedAccessRequestServiceServer) ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessRequests not implemented")
 }
-func (UnimplementedRoleServiceServer) RemoveRolePermission(context.Context, *RemoveRolePermissionRequest) (*RemoveRolePermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRolePermission not implemented")
+func (UnimplementedAccessRequestServiceServer) ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*AccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest not implemented")
 }
-func (UnimplementedRoleServiceServer) RemoveRolePermissions(context.Context, *RemoveRolePermissionsRequest) (*RemoveRolePermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRolePermissions not implemented")
+func (UnimplementedAccessRequestServiceServer) RejectAccessRequest(context.Context, *RejectAccessRequestRequest) (*AccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAccessRequest not implemented")
 }
-func (UnimplementedRoleServiceServer) GetRolePermissions(context.Context, *GetRolePermissionsRequest) (*GetRolePermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRolePermissions not implemented")
+func (UnimplementedAccessRequestServiceServer) CancelAccessRequest(context.Context, *CancelAccessRequestRequest) (*AccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccessRequest not implemented")
 }
-func (UnimplementedRoleServiceServer) CheckRolePermission(context.Context, *CheckRolePermissionRequest) (*CheckRolePermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckRolePermission not implemented")
+func (UnimplementedAccessRequestServiceServer) CommentAccessRequest(context.Context, *CommentAccessRequestRequest) (*AccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentAccessRequest not implemented")
 }
-func (UnimplementedRoleServiceServer) mustEmbedUnimplementedRoleServiceServer() {}
-func (UnimplementedRoleServiceServer) testEmbeddedByValue()                     {}
+func (UnimplementedAccessRequestServiceServer) mustEmbedUnimplementedAccessRequestServiceServer() {}
+func (UnimplementedAccessRequestServiceServer) testEmbeddedByValue()                              {}
 
-// UnsafeRoleServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RoleServiceServer will
+// UnsafeAccessRequestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccessRequestServiceServer will
 // result in compilation errors.
-type UnsafeRoleServiceServer interface {
-	mustEmbedUnimplementedRoleServiceServer()
+type UnsafeAccessRequestServiceServer interface {
+	mustEmbedUnimplementedAccessRequestServiceServer()
 }
 
-func RegisterRoleServiceServer(s grpc.ServiceRegistrar, srv RoleServiceServer) {
-	// If the following call pancis, it indicates UnimplementedRoleServiceServer was
+func RegisterAccessRequestServiceServer(s grpc.ServiceRegistrar, srv AccessRequestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAccessRequestServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&RoleService_ServiceDesc, srv)
+	s.RegisterService(&AccessRequestService_ServiceDesc, srv)
 }
 
-func _RoleService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRoleRequest)
+func _AccessRequestService_SetRoleApprovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRoleApproversRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoleServiceServer).CreateRole(ctx, in)
+		return srv.(AccessRequestServiceServer).SetRoleApprovers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoleService_CreateRole_FullMethodName,
+		FullMethod: AccessRequestService_SetRoleApprovers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoleServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+		return srv.(AccessRequestServiceServer).SetRoleApprovers(ctx, req.(*SetRoleApproversRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoleService_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRoleRequest)
+func _AccessRequestService_GetRoleApprovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleApproversRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoleServiceServer).GetRole(ctx, in)
+		return srv.(AccessRequestServiceServer).GetRoleApprovers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoleService_GetRole_FullMethodName,
+		FullMethod: AccessRequestService_GetRoleApprovers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoleServiceServer).GetRole(ctx, req.(*GetRoleRequest))
+		return srv.(AccessRequestServiceServer).GetRoleApprovers(ctx, req.(*GetRoleApproversRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoleService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRoleRequest)
+func _AccessRequestService_CreateAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoleServiceServer).UpdateRole(ctx, in)
+		return srv.(AccessRequestServiceServer).CreateAccessRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoleService_UpdateRole_FullMethodName,
+		FullMethod: AccessRequestService_CreateAccessRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoleServiceServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+		return srv.(AccessRequestServiceServer).CreateAccessRequest(ctx, req.(*CreateAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessRequestService_GetAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessRequestServiceServer).GetAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessRequestService_GetAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessRequestServiceServer).GetAccessRequest(ctx, req.(*GetAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessRequestService_ListAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessRequestServiceServer).ListAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessRequestService_ListAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessRequestServiceServer).ListAccessRequests(ctx, req.(*ListAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessRequestService_ApproveAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessRequestServiceServer).ApproveAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessRequestService_ApproveAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessRequestServiceServer).ApproveAccessRequest(ctx, req.(*ApproveAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessRequestService_RejectAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessRequestServiceServer).RejectAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessRequestService_RejectAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessRequestServiceServer).RejectAccessRequest(ctx, req.(*RejectAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessRequestService_CancelAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessRequestServiceServer).CancelAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessRequestService_CancelAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessRequestServiceServer).CancelAccessRequest(ctx, req.(*CancelAccessRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoleService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRoleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RoleServiceServer).DeleteRole(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: RoleService_DeleteRole_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoleServiceServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+func _AccessRequestService_CommentAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessRequestServiceServer).CommentAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessRequestService_CommentAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessRequestServiceServer).CommentAccessRequest(ctx, req.(*CommentAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccessRequestService_ServiceDesc is the grpc.ServiceDesc for AccessRequestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccessRequestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iam.accessRequestService",
+	HandlerType: (*AccessRequestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetRoleApprovers",
+			Handler:    _AccessRequestService_SetRoleApprovers_Handler,
+		},
+		{
+			MethodName: "GetRoleApprovers",
+			Handler:    _AccessRequestService_GetRoleApprovers_Handler,
+		},
+		{
+			MethodName: "CreateAccessRequest",
+			Handler:    _AccessRequestService_CreateAccessRequest_Handler,
+		},
+		{
+			MethodName: "GetAccessRequest",
+			Handler:    _AccessRequestService_GetAccessRequest_Handler,
+		},
+		{
+			MethodName: "ListAccessRequests",
+			Handler:    _AccessRequestService_ListAccessRequests_Handler,
+		},
+		{
+			MethodName: "ApproveAccessRequest",
+			Handler:    _AccessRequestService_ApproveAccessRequest_Handler,
+		},
+		{
+			MethodName: "RejectAccessRequest",
+			Handler:    _AccessRequestService_RejectAccessRequest_Handler,
+		},
+		{
+			MethodName: "CancelAccessRequest",
+			Handler:    _AccessRequestService_CancelAccessRequest_Handler,
+		},
+		{
+			MethodName: "CommentAccessRequest",
+			Handler:    _AccessRequestService_CommentAccessRequest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iam.proto",
+}
+
+const (
+	AccessReviewService_CreateReviewCampaign_FullMethodName = "/iam.accessReviewService/CreateReviewCampaign"
+	AccessReviewService_GetReviewCampaign_FullMethodName    = "/iam.accessReviewService/GetReviewCampaign"
+	AccessReviewService_ListReviewCampaigns_FullMethodName  = "/iam.accessReviewService/ListReviewCampaigns"
+	AccessReviewService_ListReviewItems_FullMethodName      = "/iam.accessReviewService/ListReviewItems"
+	AccessReviewService_DecideReviewItem_FullMethodName     = "/iam.accessReviewService/DecideReviewItem"
+	AccessReviewService_CloseReviewCampaign_FullMethodName  = "/iam.accessReviewService/CloseReviewCampaign"
+	AccessReviewService_ExportReviewReport_FullMethodName   = "/iam.accessReviewService/ExportReviewReport"
+)
+
+// AccessReviewServiceClient is the client API for AccessReviewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============================================================
+// accessReviewService
+// 访问复核服务，定期复核选定角色的持有人，活动关闭时自动撤销决定撤销的角色
+// ============================================================
+type AccessReviewServiceClient interface {
+	// CreateReviewCampaign 创建访问复核活动，对选定角色的直接分配做快照并为每个条目指派复核人
+	CreateReviewCampaign(ctx context.Context, in *CreateReviewCampaignRequest, opts ...grpc.CallOption) (*ReviewCampaign, error)
+	// GetReviewCampaign 获取访问复核活动详情及复核进度
+	GetReviewCampaign(ctx context.Context, in *GetReviewCampaignRequest, opts ...grpc.CallOption) (*ReviewCampaign, error)
+	// ListReviewCampaigns 分页查询访问复核活动
+	ListReviewCampaigns(ctx context.Context, in *ListReviewCampaignsRequest, opts ...grpc.CallOption) (*ListReviewCampaignsResponse, error)
+	// ListReviewItems 分页查询活动的复核条目，指定复核人时只返回其负责的条目
+	ListReviewItems(ctx context.Context, in *ListReviewItemsRequest, opts ...grpc.CallOption) (*ListReviewItemsResponse, error)
+	// DecideReviewItem 复核人决定保留或撤销角色，活动关闭前可以修改决定
+	DecideReviewItem(ctx context.Context, in *DecideReviewItemRequest, opts ...grpc.CallOption) (*ReviewItem, error)
+	// CloseReviewCampaign 关闭活动，决定撤销的角色通过RemoveUserRole自动撤销
+	CloseReviewCampaign(ctx context.Context, in *CloseReviewCampaignRequest, opts ...grpc.CallOption) (*ReviewCampaign, error)
+	// ExportReviewReport 导出活动的复核报告，支持CSV和JSON格式
+	ExportReviewReport(ctx context.Context, in *ExportReviewReportRequest, opts ...grpc.CallOption) (*ExportReviewReportResponse, error)
+}
+
+type accessReviewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccessReviewServiceClient(cc grpc.ClientConnInterface) AccessReviewServiceClient {
+	return &accessReviewServiceClient{cc}
+}
+
+func (c *accessReviewServiceClient) CreateReviewCampaign(ctx context.Context, in *CreateReviewCampaignRequest, opts ...grpc.CallOption) (*ReviewCampaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewCampaign)
+	err := c.cc.Invoke(ctx, AccessReviewService_CreateReviewCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessReviewServiceClient) GetReviewCampaign(ctx context.Context, in *GetReviewCampaignRequest, opts ...grpc.CallOption) (*ReviewCampaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewCampaign)
+	err := c.cc.Invoke(ctx, AccessReviewService_GetReviewCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessReviewServiceClient) ListReviewCampaigns(ctx context.Context, in *ListReviewCampaignsRequest, opts ...grpc.CallOption) (*ListReviewCampaignsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewCampaignsResponse)
+	err := c.cc.Invoke(ctx, AccessReviewService_ListReviewCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessReviewServiceClient) ListReviewItems(ctx context.Context, in *ListReviewItemsRequest, opts ...grpc.CallOption) (*ListReviewItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewItemsResponse)
+	err := c.cc.Invoke(ctx, AccessReviewService_ListReviewItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessReviewServiceClient) DecideReviewItem(ctx context.Context, in *DecideReviewItemRequest, opts ...grpc.CallOption) (*ReviewItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewItem)
+	err := c.cc.Invoke(ctx, AccessReviewService_DecideReviewItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessReviewServiceClient) CloseReviewCampaign(ctx context.Context, in *CloseReviewCampaignRequest, opts ...grpc.CallOption) (*ReviewCampaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewCampaign)
+	err := c.cc.Invoke(ctx, AccessReviewService_CloseReviewCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessReviewServiceClient) ExportReviewReport(ctx context.Context, in *ExportReviewReportRequest, opts ...grpc.CallOption) (*ExportReviewReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportReviewReportResponse)
+	err := c.cc.Invoke(ctx, AccessReviewService_ExportReviewReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccessReviewServiceServer is the server API for AccessReviewService service.
+// All implementations must embed UnimplementedAccessReviewServiceServer
+// for forward compatibility.
+//
+// ============================================================
+// accessReviewService
+// 访问复核服务，定期复核选定角色的持有人，活动关闭时自动撤销决定撤销的角色
+// ============================================================
+type AccessReviewServiceServer interface {
+	// CreateReviewCampaign 创建访问复核活动，对选定角色的直接分配做快照并为每个条目指派复核人
+	CreateReviewCampaign(context.Context, *CreateReviewCampaignRequest) (*ReviewCampaign, error)
+	// GetReviewCampaign 获取访问复核活动详情及复核进度
+	GetReviewCampaign(context.Context, *GetReviewCampaignRequest) (*ReviewCampaign, error)
+	// ListReviewCampaigns 分页查询访问复核活动
+	ListReviewCampaigns(context.Context, *ListReviewCampaignsRequest) (*ListReviewCampaignsResponse, error)
+	// ListReviewItems 分页查询活动的复核条目，指定复核人时只返回其负责的条目
+	ListReviewItems(context.Context, *ListReviewItemsRequest) (*ListReviewItemsResponse, error)
+	// DecideReviewItem 复核人决定保留或撤销角色，活动关闭前可以修改决定
+	DecideReviewItem(context.Context, *DecideReviewItemRequest) (*ReviewItem, error)
+	// CloseReviewCampaign 关闭活动，决定撤销的角色通过RemoveUserRole自动撤销
+	CloseReviewCampaign(context.Context, *CloseReviewCampaignRequest) (*ReviewCampaign, error)
+	// ExportReviewReport 导出活动的复核报告，支持CSV和JSON格式
+	ExportReviewReport(context.Context, *ExportReviewReportRequest) (*ExportReviewReportResponse, error)
+	mustEmbedUnimplementedAccessReviewServiceServer()
+}
+
+// UnimplementedAccessReviewServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAccessReviewServiceServer struct{}
+
+func (UnimplementedAccessReviewServiceServer) CreateReviewCampaign(context.Context, *CreateReviewCampaignRequest) (*ReviewCampaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReviewCampaign not implemented")
+}
+func (UnimplementedAccessReviewServiceServer) GetReviewCampaign(context.Context, *GetReviewCampaignRequest) (*ReviewCampaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewCampaign not implemented")
+}
+func (UnimplementedAccessReviewServiceServer) ListReviewCampaigns(context.Context, *ListReviewCampaignsRequest) (*ListReviewCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewCampaigns not implemented")
+}
+func (UnimplementedAccessReviewServiceServer) ListReviewItems(context.Context, *ListReviewItemsRequest) (*ListReviewItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewItems not implemented")
+}
+func (UnimplementedAccessReviewServiceServer) DecideReviewItem(context.Context, *DecideReviewItemRequest) (*ReviewItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideReviewItem not implemented")
+}
+func (UnimplementedAccessReviewServiceServer) CloseReviewCampaign(context.Context, *CloseReviewCampaignRequest) (*ReviewCampaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseReviewCampaign not implemented")
+}
+func (UnimplementedAccessReviewServiceServer) ExportReviewReport(context.Context, *ExportReviewReportRequest) (*ExportReviewReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportReviewReport not implemented")
+}
+func (UnimplementedAccessReviewServiceServer) mustEmbedUnimplementedAccessReviewServiceServer() {}
+func (UnimplementedAccessReviewServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeAccessReviewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccessReviewServiceServer will
+// result in compilation errors.
+type UnsafeAccessReviewServiceServer interface {
+	mustEmbedUnimplementedAccessReviewServiceServer()
+}
+
+func RegisterAccessReviewServiceServer(s grpc.ServiceRegistrar, srv AccessReviewServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAccessReviewServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
 	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&AccessReviewService_ServiceDesc, srv)
 }
 
-func _RoleService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRolesRequest)
+func _AccessReviewService_CreateReviewCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewCampaignRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoleServiceServer).ListRoles(ctx, in)
+		return srv.(AccessReviewServiceServer).CreateReviewCampaign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoleService_ListRoles_FullMethodName,
+		FullMethod: AccessReviewService_CreateReviewCampaign_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoleServiceServer).ListRoles(ctx, req.(*ListRolesRequest))
+		return srv.(AccessReviewServiceServer).CreateReviewCampaign(ctx, req.(*CreateReviewCampaignRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoleService_AssignRolePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignRolePermissionRequest)
+func _AccessReviewService_GetReviewCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewCampaignRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoleServiceServer).AssignRolePermission(ctx, in)
+		return srv.(AccessReviewServiceServer).GetReviewCampaign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoleService_AssignRolePermission_FullMethodName,
+		FullMethod: AccessReviewService_GetReviewCampaign_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoleServiceServer).AssignRolePermission(ctx, req.(*AssignRolePermissionRequest))
+		return srv.(AccessReviewServiceServer).GetReviewCampaign(ctx, req.(*GetReviewCampaignRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoleService_AssignRolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignRolePermissionsRequest)
+func _AccessReviewService_ListReviewCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewCampaignsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoleServiceServer).AssignRolePermissions(ctx, in)
+		return srv.(AccessReviewServiceServer).ListReviewCampaigns(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoleService_AssignRolePermissions_FullMethodName,
+		FullMethod: AccessReviewService_ListReviewCampaigns_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoleServiceServer).AssignRolePermissions(ctx, req.(*AssignRolePermissionsRequest))
+		return srv.(AccessReviewServiceServer).ListReviewCampaigns(ctx, req.(*ListReviewCampaignsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoleService_RemoveRolePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRolePermissionRequest)
+func _AccessReviewService_ListReviewItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoleServiceServer).RemoveRolePermission(ctx, in)
+		return srv.(AccessReviewServiceServer).ListReviewItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoleService_RemoveRolePermission_FullMethodName,
+		FullMethod: AccessReviewService_ListReviewItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoleServiceServer).RemoveRolePermission(ctx, req.(*RemoveRolePermissionRequest))
+		return srv.(AccessReviewServiceServer).ListReviewItems(ctx, req.(*ListReviewItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoleService_RemoveRolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRolePermissionsRequest)
+func _AccessReviewService_DecideReviewItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideReviewItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoleServiceServer).RemoveRolePermissions(ctx, in)
+		return srv.(AccessReviewServiceServer).DecideReviewItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoleService_RemoveRolePermissions_FullMethodName,
+		FullMethod: AccessReviewService_DecideReviewItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoleServiceServer).RemoveRolePermissions(ctx, req.(*RemoveRolePermissionsRequest))
+		return srv.(AccessReviewServiceServer).DecideReviewItem(ctx, req.(*DecideReviewItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoleService_GetRolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRolePermissionsRequest)
+func _AccessReviewService_CloseReviewCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseReviewCampaignRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoleServiceServer).GetRolePermissions(ctx, in)
+		return srv.(AccessReviewServiceServer).CloseReviewCampaign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoleService_GetRolePermissions_FullMethodName,
+		FullMethod: AccessReviewService_CloseReviewCampaign_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoleServiceServer).GetRolePermissions(ctx, req.(*GetRolePermissionsRequest))
+		return srv.(AccessReviewServiceServer).CloseReviewCampaign(ctx, req.(*CloseReviewCampaignRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoleService_CheckRolePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckRolePermissionRequest)
+func _AccessReviewService_ExportReviewReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportReviewReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoleServiceServer).CheckRolePermission(ctx, in)
+		return srv.(AccessReviewServiceServer).ExportReviewReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoleService_CheckRolePermission_FullMethodName,
+		FullMethod: AccessReviewService_ExportReviewReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoleServiceServer).CheckRolePermission(ctx, req.(*CheckRolePermissionRequest))
+		return srv.(AccessReviewServiceServer).ExportReviewReport(ctx, req.(*ExportReviewReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// RoleService_ServiceDesc is the grpc.ServiceDesc for RoleService service.
+// AccessReviewService_ServiceDesc is the grpc.ServiceDesc for AccessReviewService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var RoleService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "iam.roleService",
-	HandlerType: (*RoleServiceServer)(nil),
+var AccessReviewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iam.accessReviewService",
+	HandlerType: (*AccessReviewServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateRole",
-			Handler:    _RoleService_CreateRole_Handler,
-		},
-		{
-			MethodName: "GetRole",
-			Handler:    _RoleService_GetRole_Handler,
-		},
-		{
-			MethodName: "UpdateRole",
-			Handler:    _RoleService_UpdateRole_Handler,
-		},
-		{
-			MethodName: "DeleteRole",
-			Handler:    _RoleService_DeleteRole_Handler,
-		},
-		{
-			MethodName: "ListRoles",
-			Handler:    _RoleService_ListRoles_Handler,
+			MethodName: "CreateReviewCampaign",
+			Handler:    _AccessReviewService_CreateReviewCampaign_Handler,
 		},
 		{
-			MethodName: "AssignRolePermission",
-			Handler:    _RoleService_AssignRolePermission_Handler,
+			MethodName: "GetReviewCampaign",
+			Handler:    _AccessReviewService_GetReviewCampaign_Handler,
 		},
 		{
-			MethodName: "AssignRolePermissions",
-			Handler:    _RoleService_AssignRolePermissions_Handler,
+			MethodName: "ListReviewCampaigns",
+			Handler:    _AccessReviewService_ListReviewCampaigns_Handler,
 		},
 		{
-			MethodName: "RemoveRolePermission",
-			Handler:    _RoleService_RemoveRolePermission_Handler,
+			MethodName: "ListReviewItems",
+			Handler:    _AccessReviewService_ListReviewItems_Handler,
 		},
 		{
-			MethodName: "RemoveRolePermissions",
-			Handler:    _RoleService_RemoveRolePermissions_Handler,
+			MethodName: "DecideReviewItem",
+			Handler:    _AccessReviewService_DecideReviewItem_Handler,
 		},
 		{
-			MethodName: "GetRolePermissions",
-			Handler:    _RoleService_GetRolePermissions_Handler,
+			MethodName: "CloseReviewCampaign",
+			Handler:    _AccessReviewService_CloseReviewCampaign_Handler,
 		},
 		{
-			MethodName: "CheckRolePermission",
-			Handler:    _RoleService_CheckRolePermission_Handler,
+			MethodName: "ExportReviewReport",
+			Handler:    _AccessReviewService_ExportReviewReport_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1207,273 +4805,193 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	PermissionService_CreatePermission_FullMethodName = "/iam.permissionService/CreatePermission"
-	PermissionService_GetPermission_FullMethodName    = "/iam.permissionService/GetPermission"
-	PermissionService_UpdatePermission_FullMethodName = "/iam.permissionService/UpdatePermission"
-	PermissionService_DeletePermission_FullMethodName = "/iam.permissionService/DeletePermission"
-	PermissionService_ListPermissions_FullMethodName  = "/iam.permissionService/ListPermissions"
+	PolicyService_ExportPolicy_FullMethodName = "/iam.policyService/ExportPolicy"
+	PolicyService_PlanPolicy_FullMethodName   = "/iam.policyService/PlanPolicy"
+	PolicyService_ApplyPolicy_FullMethodName  = "/iam.policyService/ApplyPolicy"
 )
 
-// PermissionServiceClient is the client API for PermissionService service.
+// PolicyServiceClient is the client API for PolicyService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
 // ============================================================
-// permissionService
-// 权限管理服务，提供权限的增删改查功能
+// policyService
+// 策略即代码服务，以YAML/JSON清单声明权限、角色、角色授权和用户角色，通过计划和应用使各环境与清单保持一致
 // ============================================================
-type PermissionServiceClient interface {
-	// CreatePermission 创建新权限
-	CreatePermission(ctx context.Context, in *CreatePermissionRequest, opts ...grpc.CallOption) (*CreatePermissionResponse, error)
-	// GetPermission 根据ID获取权限详情
-	GetPermission(ctx context.Context, in *GetPermissionRequest, opts ...grpc.CallOption) (*Permission, error)
-	// UpdatePermission 更新权限信息
-	UpdatePermission(ctx context.Context, in *UpdatePermissionRequest, opts ...grpc.CallOption) (*Permission, error)
-	// DeletePermission 删除权限（软删除）
-	DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeletePermissionResponse, error)
-	// ListPermissions 分页查询权限列表
-	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
+type PolicyServiceClient interface {
+	// ExportPolicy 导出当前租户的策略清单
+	ExportPolicy(ctx context.Context, in *ExportPolicyRequest, opts ...grpc.CallOption) (*ExportPolicyResponse, error)
+	// PlanPolicy 计算清单与数据库现状的差异，不做修改
+	PlanPolicy(ctx context.Context, in *PlanPolicyRequest, opts ...grpc.CallOption) (*PolicyPlan, error)
+	// ApplyPolicy 在一个事务中应用清单，任一变更失败时全部回滚
+	ApplyPolicy(ctx context.Context, in *ApplyPolicyRequest, opts ...grpc.CallOption) (*PolicyPlan, error)
 }
 
-type permissionServiceClient struct {
+type policyServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewPermissionServiceClient(cc grpc.ClientConnInterface) PermissionServiceClient {
-	return &permissionServiceClient{cc}
-}
-
-func (c *permissionServiceClient) CreatePermission(ctx context.Context, in *CreatePermissionRequest, opts ...grpc.CallOption) (*CreatePermissionResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreatePermissionResponse)
-	err := c.cc.Invoke(ctx, PermissionService_CreatePermission_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *permissionServiceClient) GetPermission(ctx context.Context, in *GetPermissionRequest, opts ...grpc.CallOption) (*Permission, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Permission)
-	err := c.cc.Invoke(ctx, PermissionService_GetPermission_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewPolicyServiceClient(cc grpc.ClientConnInterface) PolicyServiceClient {
+	return &policyServiceClient{cc}
 }
 
-func (c *permissionServiceClient) UpdatePermission(ctx context.Context, in *UpdatePermissionRequest, opts ...grpc.CallOption) (*Permission, error) {
+func (c *policyServiceClient) ExportPolicy(ctx context.Context, in *ExportPolicyRequest, opts ...grpc.CallOption) (*ExportPolicyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Permission)
-	err := c.cc.Invoke(ctx, PermissionService_UpdatePermission_FullMethodName, in, out, cOpts...)
+	out := new(ExportPolicyResponse)
+	err := c.cc.Invoke(ctx, PolicyService_ExportPolicy_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *permissionServiceClient) DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeletePermissionResponse, error) {
+func (c *policyServiceClient) PlanPolicy(ctx context.Context, in *PlanPolicyRequest, opts ...grpc.CallOption) (*PolicyPlan, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeletePermissionResponse)
-	err := c.cc.Invoke(ctx, PermissionService_DeletePermission_FullMethodName, in, out, cOpts...)
+	out := new(PolicyPlan)
+	err := c.cc.Invoke(ctx, PolicyService_PlanPolicy_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *permissionServiceClient) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+func (c *policyServiceClient) ApplyPolicy(ctx context.Context, in *ApplyPolicyRequest, opts ...grpc.CallOption) (*PolicyPlan, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListPermissionsResponse)
-	err := c.cc.Invoke(ctx, PermissionService_ListPermissions_FullMethodName, in, out, cOpts...)
+	out := new(PolicyPlan)
+	err := c.cc.Invoke(ctx, PolicyService_ApplyPolicy_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// PermissionServiceServer is the server API for PermissionService service.
-// All implementations must embed UnimplementedPermissionServiceServer
+// PolicyServiceServer is the server API for PolicyService service.
+// All implementations must embed UnimplementedPolicyServiceServer
 // for forward compatibility.
 //
 // ============================================================
-// permissionService
-// 权限管理服务，提供权限的增删改查功能
+// policyService
+// 策略即代码服务，以YAML/JSON清单声明权限、角色、角色授权和用户角色，通过计划和应用使各环境与清单保持一致
 // ============================================================
-type PermissionServiceServer interface {
-	// CreatePermission 创建新权限
-	CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error)
-	// GetPermission 根据ID获取权限详情
-	GetPermission(context.Context, *GetPermissionRequest) (*Permission, error)
-	// UpdatePermission 更新权限信息
-	UpdatePermission(context.Context, *UpdatePermissionRequest) (*Permission, error)
-	// DeletePermission 删除权限（软删除）
-	DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error)
-	// ListPermissions 分页查询权限列表
-	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
-	mustEmbedUnimplementedPermissionServiceServer()
+type PolicyServiceServer interface {
+	// ExportPolicy 导出当前租户的策略清单
+	ExportPolicy(context.Context, *ExportPolicyRequest) (*ExportPolicyResponse, error)
+	// PlanPolicy 计算清单与数据库现状的差异，不做修改
+	PlanPolicy(context.Context, *PlanPolicyRequest) (*PolicyPlan, error)
+	// ApplyPolicy 在一个事务中应用清单，任一变更失败时全部回滚
+	ApplyPolicy(context.Context, *ApplyPolicyRequest) (*PolicyPlan, error)
+	mustEmbedUnimplementedPolicyServiceServer()
 }
 
-// UnimplementedPermissionServiceServer must be embedded to have
+// UnimplementedPolicyServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedPermissionServiceServer struct{}
+type UnimplementedPolicyServiceServer struct{}
 
-func (UnimplementedPermissionServiceServer) CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePermission not implemented")
+func (UnimplementedPolicyServiceServer) ExportPolicy(context.Context, *ExportPolicyRequest) (*ExportPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPolicy not implemented")
 }
-func (UnimplementedPermissionServiceServer) GetPermission(context.Context, *GetPermissionRequest) (*Permission, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPermission not implemented")
-}
-func (UnimplementedPermissionServiceServer) UpdatePermission(context.Context, *UpdatePermissionRequest) (*Permission, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePermission not implemented")
-}
-func (UnimplementedPermissionServiceServer) DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePermission not implemented")
+func (UnimplementedPolicyServiceServer) PlanPolicy(context.Context, *PlanPolicyRequest) (*PolicyPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanPolicy not implemented")
 }
-func (UnimplementedPermissionServiceServer) ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
+func (UnimplementedPolicyServiceServer) ApplyPolicy(context.Context, *ApplyPolicyRequest) (*PolicyPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPolicy not implemented")
 }
-func (UnimplementedPermissionServiceServer) mustEmbedUnimplementedPermissionServiceServer() {}
-func (UnimplementedPermissionServiceServer) testEmbeddedByValue()                           {}
+func (UnimplementedPolicyServiceServer) mustEmbedUnimplementedPolicyServiceServer() {}
+func (UnimplementedPolicyServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafePermissionServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PermissionServiceServer will
+// UnsafePolicyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PolicyServiceServer will
 // result in compilation errors.
-type UnsafePermissionServiceServer interface {
-	mustEmbedUnimplementedPermissionServiceServer()
+type UnsafePolicyServiceServer interface {
+	mustEmbedUnimplementedPolicyServiceServer()
 }
 
-func RegisterPermissionServiceServer(s grpc.ServiceRegistrar, srv PermissionServiceServer) {
-	// If the following call pancis, it indicates UnimplementedPermissionServiceServer was
+func RegisterPolicyServiceServer(s grpc.ServiceRegistrar, srv PolicyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPolicyServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&PermissionService_ServiceDesc, srv)
-}
-
-func _PermissionService_CreatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePermissionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PermissionServiceServer).CreatePermission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: PermissionService_CreatePermission_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PermissionServiceServer).CreatePermission(ctx, req.(*CreatePermissionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _PermissionService_GetPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPermissionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PermissionServiceServer).GetPermission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: PermissionService_GetPermission_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PermissionServiceServer).GetPermission(ctx, req.(*GetPermissionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&PolicyService_ServiceDesc, srv)
 }
 
-func _PermissionService_UpdatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePermissionRequest)
+func _PolicyService_ExportPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PermissionServiceServer).UpdatePermission(ctx, in)
+		return srv.(PolicyServiceServer).ExportPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PermissionService_UpdatePermission_FullMethodName,
+		FullMethod: PolicyService_ExportPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PermissionServiceServer).UpdatePermission(ctx, req.(*UpdatePermissionRequest))
+		return srv.(PolicyServiceServer).ExportPolicy(ctx, req.(*ExportPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PermissionService_DeletePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePermissionRequest)
+func _PolicyService_PlanPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PermissionServiceServer).DeletePermission(ctx, in)
+		return srv.(PolicyServiceServer).PlanPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PermissionService_DeletePermission_FullMethodName,
+		FullMethod: PolicyService_PlanPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PermissionServiceServer).DeletePermission(ctx, req.(*DeletePermissionRequest))
+		return srv.(PolicyServiceServer).PlanPolicy(ctx, req.(*PlanPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PermissionService_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPermissionsRequest)
+func _PolicyService_ApplyPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PermissionServiceServer).ListPermissions(ctx, in)
+		return srv.(PolicyServiceServer).ApplyPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PermissionService_ListPermissions_FullMethodName,
+		FullMethod: PolicyService_ApplyPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PermissionServiceServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
+		return srv.(PolicyServiceServer).ApplyPolicy(ctx, req.(*ApplyPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// PermissionService_ServiceDesc is the grpc.ServiceDesc for PermissionService service.
+// PolicyService_ServiceDesc is the grpc.ServiceDesc for PolicyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var PermissionService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "iam.permissionService",
-	HandlerType: (*PermissionServiceServer)(nil),
+var PolicyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iam.policyService",
+	HandlerType: (*PolicyServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreatePermission",
-			Handler:    _PermissionService_CreatePermission_Handler,
-		},
-		{
-			MethodName: "GetPermission",
-			Handler:    _PermissionService_GetPermission_Handler,
-		},
-		{
-			MethodName: "UpdatePermission",
-			Handler:    _PermissionService_UpdatePermission_Handler,
+			MethodName: "ExportPolicy",
+			Handler:    _PolicyService_ExportPolicy_Handler,
 		},
 		{
-			MethodName: "DeletePermission",
-			Handler:    _PermissionService_DeletePermission_Handler,
+			MethodName: "PlanPolicy",
+			Handler:    _PolicyService_PlanPolicy_Handler,
 		},
 		{
-			MethodName: "ListPermissions",
-			Handler:    _PermissionService_ListPermissions_Handler,
+			MethodName: "ApplyPolicy",
+			Handler:    _PolicyService_ApplyPolicy_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
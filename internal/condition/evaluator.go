@@ -0,0 +1,128 @@
+package condition
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// MaxLength 条件表达式的最大长度
+const MaxLength = 1000
+
+// costLimit 单次求值的代价上限，防止复杂表达式拖慢权限检查
+const costLimit = 10000
+
+// ErrNotBool 条件表达式的结果不是布尔值
+var ErrNotBool = errors.New("condition must evaluate to bool")
+
+// Attributes 条件求值时可用的属性
+//
+//	user     当前用户，包含 id、username、tenant_id、department_id
+//	request  调用方传入的请求上下文，如 request.ip、request.owner_id
+//	now      求值时的时间，如 now.getHours("Asia/Shanghai")
+//
+// 另提供 inCidr(ip, cidr) 函数判断IP是否在网段内。
+type Attributes struct {
+	User    map[string]any
+	Request map[string]string
+	Now     time.Time
+}
+
+// Evaluator 负责条件表达式的校验与求值，编译结果按表达式缓存
+type Evaluator struct {
+	env      *cel.Env
+	programs sync.Map // 表达式 -> cel.Program
+}
+
+// NewEvaluator 创建条件求值器
+func NewEvaluator() (*Evaluator, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("user", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("request", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("now", cel.TimestampType),
+		cel.Function("inCidr",
+			cel.Overload("inCidr_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(inCidr),
+			),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Evaluator{env: env}, nil
+}
+
+// Validate 校验条件表达式的语法和类型，表达式结果必须为布尔值
+func (e *Evaluator) Validate(expr string) error {
+	if len(expr) > MaxLength {
+		return fmt.Errorf("condition exceeds %d characters", MaxLength)
+	}
+	_, err := e.program(expr)
+	return err
+}
+
+// Evaluate 对条件表达式求值，请求上下文缺少表达式用到的键时返回错误
+func (e *Evaluator) Evaluate(expr string, attrs Attributes) (bool, error) {
+	prg, err := e.program(expr)
+	if err != nil {
+		return false, err
+	}
+
+	request := attrs.Request
+	if request == nil {
+		request = map[string]string{}
+	}
+	out, _, err := prg.Eval(map[string]any{
+		"user":    attrs.User,
+		"request": request,
+		"now":     attrs.Now,
+	})
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, ErrNotBool
+	}
+	return result, nil
+}
+
+// program 编译条件表达式，已编译的表达式直接返回缓存
+func (e *Evaluator) program(expr string) (cel.Program, error) {
+	if prg, ok := e.programs.Load(expr); ok {
+		return prg.(cel.Program), nil
+	}
+
+	ast, iss := e.env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, ErrNotBool
+	}
+	prg, err := e.env.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, err
+	}
+	e.programs.Store(expr, prg)
+	return prg, nil
+}
+
+// inCidr 判断IP是否在CIDR网段内
+func inCidr(ip, cidr ref.Val) ref.Val {
+	addr := net.ParseIP(string(ip.(types.String)))
+	if addr == nil {
+		return types.NewErr("invalid ip address: %s", ip)
+	}
+	_, network, err := net.ParseCIDR(string(cidr.(types.String)))
+	if err != nil {
+		return types.NewErr("invalid cidr: %s", cidr)
+	}
+	return types.Bool(network.Contains(addr))
+}
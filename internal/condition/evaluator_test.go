@@ -0,0 +1,92 @@
+package condition
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestEvaluator(t *testing.T) *Evaluator {
+	t.Helper()
+	e, err := NewEvaluator()
+	if err != nil {
+		t.Fatalf("NewEvaluator: %v", err)
+	}
+	return e
+}
+
+func TestValidate(t *testing.T) {
+	e := newTestEvaluator(t)
+	for _, expr := range []string{
+		`request.ip == "10.0.0.1"`,
+		`inCidr(request.ip, "10.0.0.0/8") && user.department_id == 3`,
+		`now.getHours("Asia/Shanghai") >= 9`,
+	} {
+		if err := e.Validate(expr); err != nil {
+			t.Fatalf("Validate(%q): %v", expr, err)
+		}
+	}
+
+	if err := e.Validate(`request.ip`); !errors.Is(err, ErrNotBool) {
+		t.Fatalf("string expression = %v, want ErrNotBool", err)
+	}
+	for _, expr := range []string{
+		`request.ip ==`,
+		`unknown == 1`,
+		`inCidr(request.ip)`,
+		strings.Repeat("a", MaxLength+1),
+	} {
+		if err := e.Validate(expr); err == nil {
+			t.Fatalf("Validate(%q) succeeded", expr)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	e := newTestEvaluator(t)
+	attrs := Attributes{
+		User:    map[string]any{"id": int64(7), "username": "alice", "department_id": int64(3)},
+		Request: map[string]string{"ip": "10.1.2.3", "owner_id": "7"},
+		Now:     time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC),
+	}
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`inCidr(request.ip, "10.0.0.0/8")`, true},
+		{`inCidr(request.ip, "192.168.0.0/16")`, false},
+		{`request.owner_id == string(user.id)`, true},
+		{`user.username == "bob" || user.department_id == 3`, true},
+		{`now.getHours("Asia/Shanghai") == 10`, true},
+		{`"owner_id" in request && request.owner_id == "8"`, false},
+	}
+	for _, tt := range tests {
+		got, err := e.Evaluate(tt.expr, attrs)
+		if err != nil {
+			t.Fatalf("Evaluate(%q): %v", tt.expr, err)
+		}
+		if got != tt.want {
+			t.Fatalf("Evaluate(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	e := newTestEvaluator(t)
+	// 请求上下文缺少表达式用到的键
+	if _, err := e.Evaluate(`request.ip == "10.0.0.1"`, Attributes{}); err == nil {
+		t.Fatalf("missing request key accepted")
+	}
+	attrs := Attributes{Request: map[string]string{"ip": "not-an-ip"}}
+	if _, err := e.Evaluate(`inCidr(request.ip, "10.0.0.0/8")`, attrs); err == nil {
+		t.Fatalf("invalid ip accepted")
+	}
+	attrs.Request["ip"] = "10.0.0.1"
+	if _, err := e.Evaluate(`inCidr(request.ip, "10.0.0.0")`, attrs); err == nil {
+		t.Fatalf("invalid cidr accepted")
+	}
+	if _, err := e.Evaluate(`request.ip +`, attrs); err == nil {
+		t.Fatalf("invalid expression accepted")
+	}
+}
@@ -7,6 +7,130 @@ import (
 
 type Config struct {
 	zrpc.RpcServerConf
-	DataSource string          // 数据库连接字符串
-	Cache      cache.CacheConf // 缓存配置
+	DataSource    string            // 数据库连接字符串
+	Cache         cache.CacheConf   // 缓存配置
+	Ldap          LdapConf          `json:",optional"` // LDAP目录配置
+	Scim          ScimConf          `json:",optional"` // SCIM开通接口配置
+	Impersonation ImpersonationConf `json:",optional"` // 管理员模拟登录配置
+	Verification  VerificationConf  `json:",optional"` // 邮箱和手机号验证配置
+	Auth          AuthConf          `json:",optional"` // 登录认证安全配置
+	Tenant        TenantConf        `json:",optional"` // 多租户配置
+	Rebac         RebacConf         `json:",optional"` // 基于关系的访问控制配置
+	Expiry        ExpiryConf        `json:",optional"` // 限时授权到期清理配置
+	Delegation    DelegationConf    `json:",optional"` // 委派管理配置
+	RpcAuth       RpcAuthConf       `json:",optional"` // IAM自身接口的调用方认证与授权配置
+	Usage         UsageConf         `json:",optional"` // 权限使用记录配置
+}
+
+// LdapConf LDAP目录连接与同步配置
+type LdapConf struct {
+	Enabled      bool   `json:",default=false"` // 是否启用LDAP
+	Url          string `json:",optional"`      // 目录地址，如 ldap://127.0.0.1:389 或 ldaps://...
+	BindDN       string `json:",optional"`      // 服务账号DN，用于查询目录
+	BindPassword string `json:",optional"`      // 服务账号密码
+	BaseDN       string `json:",optional"`      // 用户搜索根节点
+	UserFilter   string `json:",optional"`      // 用户过滤条件，默认 (objectClass=person)
+	// 用户属性映射
+	UsernameAttr string `json:",default=uid"`
+	EmailAttr    string `json:",default=mail"`
+	NicknameAttr string `json:",default=cn"`
+	PhoneAttr    string `json:",default=telephoneNumber"`
+	// 组搜索配置
+	GroupBaseDN     string            `json:",optional"`       // 组搜索根节点，为空时使用BaseDN
+	GroupFilter     string            `json:",optional"`       // 组过滤条件，默认 (objectClass=groupOfNames)
+	GroupMemberAttr string            `json:",default=member"` // 组成员属性，值为成员DN
+	GroupRoles      map[string]string `json:",optional"`       // 组CN到角色编码的映射，同步只增删映射中的角色
+	SyncInterval    int64             `json:",default=0"`      // 同步间隔（秒），0表示不启用定时同步
+	DryRun          bool              `json:",default=false"`  // 定时同步是否只输出报告而不写库
+	Timeout         int64             `json:",default=5"`      // 目录操作超时时间（秒）
+	TenantId        int64             `json:",default=1"`      // 目录用户和组归属的租户ID
+}
+
+// ScimConf SCIM 2.0 HTTP接口配置
+type ScimConf struct {
+	Enabled  bool     `json:",default=false"`        // 是否启用SCIM接口
+	ListenOn string   `json:",default=0.0.0.0:8082"` // HTTP监听地址
+	BasePath string   `json:",default=/scim/v2"`     // 接口路径前缀
+	Tokens   []string `json:",optional"`             // 允许访问的Bearer Token列表
+	TenantId int64    `json:",default=1"`            // 开通的用户和组归属的租户ID
+}
+
+// ImpersonationConf 管理员模拟登录配置
+type ImpersonationConf struct {
+	Secret         string   `json:",optional"`     // 模拟凭证签名密钥，为空时不允许模拟登录
+	DefaultTTL     int64    `json:",default=900"`  // 凭证默认有效期（秒）
+	MaxTTL         int64    `json:",default=3600"` // 凭证最长有效期（秒）
+	ProtectedRoles []string `json:",optional"`     // 受保护的角色编码，拥有这些角色的用户不能被模拟，默认 admin
+}
+
+// VerificationConf 邮箱和手机号验证配置
+type VerificationConf struct {
+	CodeLength     int          `json:",default=6"`   // 验证码位数
+	CodeTTL        int64        `json:",default=600"` // 验证码有效期（秒）
+	MaxAttempts    int64        `json:",default=5"`   // 单个验证码最多校验次数
+	ResendInterval int64        `json:",default=60"`  // 重新发送间隔（秒）
+	Notifier       NotifierConf `json:",optional"`    // 验证码发送渠道
+}
+
+// NotifierConf 通知发送配置
+type NotifierConf struct {
+	Type     string `json:",default=stdout,options=stdout|file"` // 发送方式：stdout-输出到标准输出，file-追加写入文件
+	FilePath string `json:",optional"`                           // Type为file时的文件路径，默认 notifications.log
+}
+
+// AuthConf 登录认证安全配置
+type AuthConf struct {
+	MaxFailedAttempts int64 `json:",default=5"`   // 连续登录失败多少次后锁定账号，0表示不锁定
+	LockDuration      int64 `json:",default=900"` // 账号锁定时长（秒）
+	PasswordMaxAge    int64 `json:",default=0"`   // 密码有效期（天），0表示永不过期
+	MinResponseTime   int64 `json:",default=300"` // 认证接口最短响应时间（毫秒），用于抹平不同失败原因的耗时差异
+}
+
+// TenantConf 多租户配置
+type TenantConf struct {
+	Required bool `json:",default=false"` // 是否要求每次调用都通过 x-tenant-id metadata 携带租户ID，为false时未携带的调用归属默认租户
+}
+
+// RebacConf 基于关系的访问控制（ReBAC）配置
+type RebacConf struct {
+	NamespaceFile string `json:",optional"`   // 命名空间配置文件路径，为空时未定义任何命名空间
+	MaxDepth      int    `json:",default=10"` // 关系推导的最大递归深度
+}
+
+// ExpiryConf 限时授权到期清理配置，过期授权在检查时即被忽略，清理任务负责回收数据并记录事件
+type ExpiryConf struct {
+	SweepInterval int64 `json:",default=60"`  // 清理间隔（秒），0表示不启用定时清理
+	BatchSize     int   `json:",default=500"` // 每次每类授权最多清理的条数
+}
+
+// DelegationConf 委派管理配置，启用后用户、角色、权限、用户组、资源绑定和访问申请等服务的变更接口都要校验调用人的管理范围
+type DelegationConf struct {
+	Enabled         bool     `json:",default=false"` // 是否启用委派管理校验，需同时启用RpcAuth，调用人取自用户令牌或服务调用方转发的 x-user-id metadata
+	SuperAdminRoles []string `json:",optional"`      // 超级管理员角色编码，拥有这些角色的用户不受管理范围限制，默认 admin 和 iam_admin
+}
+
+// RpcAuthConf IAM自身接口的调用方认证与授权配置，启用后每次调用都要认证调用方，并按接口对应的内置权限编码鉴权
+type RpcAuthConf struct {
+	Enabled      bool                   `json:",default=false"` // 是否启用调用方认证与授权
+	CertFile     string                 `json:",optional"`      // 服务端证书文件，与KeyFile、ClientCAFile同时配置时启用mTLS
+	KeyFile      string                 `json:",optional"`      // 服务端私钥文件
+	ClientCAFile string                 `json:",optional"`      // 校验客户端证书的CA文件
+	TokenSecret  string                 `json:",optional"`      // 用户令牌签名密钥，为空时不签发也不接受用户令牌
+	TokenTTL     int64                  `json:",default=3600"`  // 用户令牌有效期（秒）
+	Services     []ServicePrincipalConf `json:",optional"`      // 服务调用方列表
+}
+
+// ServicePrincipalConf 服务调用方配置，通过服务令牌或mTLS客户端证书认证
+type ServicePrincipalConf struct {
+	Name    string   // 服务名称，用于日志和审计
+	Token   string   `json:",optional"` // 服务令牌，通过 authorization: Bearer <token> metadata 携带
+	Subject string   `json:",optional"` // mTLS客户端证书的CN或DNS SAN
+	Roles   []string // 服务拥有的角色编码，在调用所属租户中解析
+}
+
+// UsageConf 权限使用记录配置，CheckUserPermission和CheckUserResourcePermission通过的检查在内存中缓冲后定时批量写入，用于发现未使用的授权
+type UsageConf struct {
+	Enabled       bool  `json:",default=true"`   // 是否记录权限使用情况
+	FlushInterval int64 `json:",default=10"`     // 写入间隔（秒），0表示不记录，进程退出前会写入剩余记录
+	MaxPending    int   `json:",default=100000"` // 缓冲中最多的记录数，达到后立即写入，写入完成前的新记录被丢弃
 }
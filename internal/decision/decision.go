@@ -0,0 +1,69 @@
+package decision
+
+import (
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/condition"
+	"github.com/ziptako/iam/internal/utils"
+)
+
+// Failure 条件不满足的允许授权
+type Failure struct {
+	Grant  *model.RolePermissions
+	Reason string // 条件为false或求值出错的原因
+}
+
+// Decision 权限检查中相关授权的求值结果
+type Decision struct {
+	Allows []*model.RolePermissions // 生效的允许授权
+	Denies []*model.RolePermissions // 生效的拒绝授权
+	Failed []Failure                // 条件不满足的允许授权
+}
+
+// Decide 对角色授权求值，codes为授权的权限ID到权限编码的映射，不在映射中的授权被忽略
+// 授权编码覆盖权限编码即视为相关授权（支持 order、order:* 等层级和通配授权）
+// 无条件的授权直接生效；带条件的授权在attrs上求值，允许授权的条件求值出错视为不满足，拒绝授权的条件求值出错视为生效
+func Decide(conditions *condition.Evaluator, rolePermissions []*model.RolePermissions, codes map[int64]string, code string, attrs condition.Attributes) *Decision {
+	d := &Decision{}
+	for _, rp := range rolePermissions {
+		grantCode, ok := codes[rp.PermissionId]
+		if !ok || !utils.PermissionCodeCovers(grantCode, code) {
+			continue
+		}
+
+		matched, reason := true, ""
+		if rp.Condition != "" {
+			ok, err := conditions.Evaluate(rp.Condition, attrs)
+			switch {
+			case err != nil:
+				matched, reason = rp.Effect == model.EffectDeny, err.Error()
+			case !ok:
+				matched, reason = false, "condition evaluated to false"
+			}
+		}
+
+		switch {
+		case rp.Effect == model.EffectDeny && matched:
+			d.Denies = append(d.Denies, rp)
+		case rp.Effect != model.EffectDeny && matched:
+			d.Allows = append(d.Allows, rp)
+		case rp.Effect != model.EffectDeny:
+			d.Failed = append(d.Failed, Failure{Grant: rp, Reason: reason})
+		}
+	}
+	return d
+}
+
+// Allowed 是否具备权限，拒绝优先：任一生效的拒绝授权都会覆盖允许授权
+func (d *Decision) Allowed() bool {
+	return len(d.Denies) == 0 && len(d.Allows) > 0
+}
+
+// Conditional 授权中是否有带条件的授权，没有时调用方无需构造条件求值的属性
+func Conditional(rolePermissions []*model.RolePermissions) bool {
+	for _, rp := range rolePermissions {
+		if rp.Condition != "" {
+			return true
+		}
+	}
+	return false
+}
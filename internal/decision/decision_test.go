@@ -0,0 +1,127 @@
+package decision
+
+import (
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/condition"
+)
+
+func newEvaluator(t *testing.T) *condition.Evaluator {
+	t.Helper()
+	e, err := condition.NewEvaluator()
+	if err != nil {
+		t.Fatalf("NewEvaluator: %v", err)
+	}
+	return e
+}
+
+func grant(roleId, permissionId int64, effect, cond string) *model.RolePermissions {
+	return &model.RolePermissions{RoleId: roleId, PermissionId: permissionId, Effect: effect, Condition: cond}
+}
+
+var testCodes = map[int64]string{
+	1: "order",
+	2: "order:*",
+	3: "order:read",
+	4: "invoice:read",
+}
+
+func TestDecideCovers(t *testing.T) {
+	e := newEvaluator(t)
+	tests := []struct {
+		name    string
+		grants  []*model.RolePermissions
+		code    string
+		allowed bool
+	}{
+		{"exact", []*model.RolePermissions{grant(1, 3, model.EffectAllow, "")}, "order:read", true},
+		{"parent", []*model.RolePermissions{grant(1, 1, model.EffectAllow, "")}, "order:read", true},
+		{"wildcard", []*model.RolePermissions{grant(1, 2, model.EffectAllow, "")}, "order:read:own", true},
+		{"wildcard excludes parent", []*model.RolePermissions{grant(1, 2, model.EffectAllow, "")}, "order", false},
+		{"unrelated", []*model.RolePermissions{grant(1, 4, model.EffectAllow, "")}, "order:read", false},
+		{"unknown permission", []*model.RolePermissions{grant(1, 99, model.EffectAllow, "")}, "order:read", false},
+		{"no grants", nil, "order:read", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Decide(e, tt.grants, testCodes, tt.code, condition.Attributes{}).Allowed(); got != tt.allowed {
+				t.Fatalf("Allowed = %v, want %v", got, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestDecideDenyOverrides(t *testing.T) {
+	e := newEvaluator(t)
+	d := Decide(e, []*model.RolePermissions{
+		grant(1, 3, model.EffectAllow, ""),
+		grant(2, 2, model.EffectDeny, ""),
+	}, testCodes, "order:read", condition.Attributes{})
+	if d.Allowed() || len(d.Allows) != 1 || len(d.Denies) != 1 {
+		t.Fatalf("deny did not override allow: %+v", d)
+	}
+
+	// 与目标编码无关的拒绝授权不影响结果
+	d = Decide(e, []*model.RolePermissions{
+		grant(1, 3, model.EffectAllow, ""),
+		grant(2, 4, model.EffectDeny, ""),
+	}, testCodes, "order:read", condition.Attributes{})
+	if !d.Allowed() {
+		t.Fatalf("unrelated deny blocked access: %+v", d)
+	}
+}
+
+func TestDecideConditions(t *testing.T) {
+	e := newEvaluator(t)
+	attrs := condition.Attributes{Request: map[string]string{"ip": "10.0.0.1"}}
+
+	d := Decide(e, []*model.RolePermissions{
+		grant(1, 3, model.EffectAllow, `request.ip == "10.0.0.2"`),
+	}, testCodes, "order:read", attrs)
+	if d.Allowed() || len(d.Failed) != 1 || d.Failed[0].Reason != "condition evaluated to false" {
+		t.Fatalf("false condition: %+v", d)
+	}
+
+	d = Decide(e, []*model.RolePermissions{
+		grant(1, 3, model.EffectAllow, `inCidr(request.ip, "10.0.0.0/8")`),
+	}, testCodes, "order:read", attrs)
+	if !d.Allowed() {
+		t.Fatalf("true condition did not allow: %+v", d)
+	}
+
+	// 允许授权求值出错视为不满足
+	d = Decide(e, []*model.RolePermissions{
+		grant(1, 3, model.EffectAllow, `request.owner_id == "1"`),
+	}, testCodes, "order:read", attrs)
+	if d.Allowed() || len(d.Failed) != 1 || d.Failed[0].Reason == "" {
+		t.Fatalf("allow with evaluation error: %+v", d)
+	}
+
+	// 拒绝授权求值出错视为生效
+	d = Decide(e, []*model.RolePermissions{
+		grant(1, 3, model.EffectAllow, ""),
+		grant(2, 3, model.EffectDeny, `request.owner_id == "1"`),
+	}, testCodes, "order:read", attrs)
+	if d.Allowed() || len(d.Denies) != 1 {
+		t.Fatalf("deny with evaluation error was ignored: %+v", d)
+	}
+
+	// 条件不满足的拒绝授权不生效，也不计入失败
+	d = Decide(e, []*model.RolePermissions{
+		grant(1, 3, model.EffectAllow, ""),
+		grant(2, 3, model.EffectDeny, `request.ip == "10.0.0.2"`),
+	}, testCodes, "order:read", attrs)
+	if !d.Allowed() || len(d.Failed) != 0 {
+		t.Fatalf("unmatched deny: %+v", d)
+	}
+}
+
+func TestConditional(t *testing.T) {
+	if Conditional([]*model.RolePermissions{grant(1, 3, model.EffectAllow, "")}) {
+		t.Fatalf("unconditional grants reported as conditional")
+	}
+	if !Conditional([]*model.RolePermissions{grant(1, 3, model.EffectAllow, ""), grant(1, 4, model.EffectDeny, "true")}) {
+		t.Fatalf("conditional grant not detected")
+	}
+}
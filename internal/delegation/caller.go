@@ -0,0 +1,64 @@
+package delegation
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ziptako/iam/internal/impersonation"
+	"github.com/ziptako/iam/internal/rpcauth"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey 已认证的服务调用方转发实际操作用户ID的gRPC metadata键
+const MetadataKey = "x-user-id"
+
+type callerKey struct{}
+
+// WithCallerId 将调用人ID写入上下文
+func WithCallerId(ctx context.Context, callerId int64) context.Context {
+	return context.WithValue(ctx, callerKey{}, callerId)
+}
+
+// CallerIdFromContext 读取当前调用的调用人ID，未经委派管理校验的调用返回false
+func CallerIdFromContext(ctx context.Context) (int64, bool) {
+	callerId, ok := ctx.Value(callerKey{}).(int64)
+	return callerId, ok
+}
+
+// ActorIdFromContext 当前调用的实际操作用户，只取已认证的身份，不信任请求体中的用户ID
+// 经委派管理校验的调用取校验时确定的调用人，其余调用按同样的规则从认证信息中解析
+func ActorIdFromContext(ctx context.Context) (int64, bool) {
+	if callerId, ok := CallerIdFromContext(ctx); ok {
+		return callerId, true
+	}
+	callerId, _, valid := callerFromIncoming(ctx)
+	return callerId, valid
+}
+
+// callerFromIncoming 解析调用人身份，只信任经rpcauth认证的身份，模拟登录调用以被模拟用户的身份执行
+// 通过用户令牌认证的调用以令牌中的用户为调用人，已认证的服务调用方通过metadata转发实际操作的用户
+// 返回值依次为调用人ID、是否携带了身份、身份是否有效
+func callerFromIncoming(ctx context.Context) (int64, bool, bool) {
+	if claims, ok := impersonation.FromContext(ctx); ok {
+		return claims.SubjectId, true, true
+	}
+	principal, ok := rpcauth.FromContext(ctx)
+	if !ok {
+		return 0, false, false
+	}
+	if principal.Kind == rpcauth.PrincipalUser {
+		return principal.UserId, true, true
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(MetadataKey)
+	if len(values) == 0 || strings.TrimSpace(values[0]) == "" {
+		return 0, false, false
+	}
+	callerId, err := strconv.ParseInt(strings.TrimSpace(values[0]), 10, 64)
+	if err != nil || callerId <= 0 {
+		return 0, true, false
+	}
+	return callerId, true, true
+}
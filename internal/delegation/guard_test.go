@@ -0,0 +1,158 @@
+package delegation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/internal/config"
+)
+
+// 测试数据：
+//
+//	用户1 直接拥有admin角色；用户8 通过用户组继承iam_admin角色
+//	用户2 管理部门10及下级部门11，可分配角色5
+//	用户6 不限部门，可分配角色5和6
+//	用户3在部门11，用户4在部门20，用户5未分配部门，用户7不是管理员
+//	用户组100只有用户3、角色5；用户组101有用户3和4、角色6
+//	资源绑定60为用户3角色5，61为用户4角色5，62为用户3角色6
+const (
+	superAdminId   = 1
+	deptAdminId    = 2
+	inDeptUserId   = 3
+	otherUserId    = 4
+	noDeptUserId   = 5
+	allDeptAdminId = 6
+	plainUserId    = 7
+	groupSuperId   = 8
+)
+
+// fakeAdminScopes 管理员的管理范围
+type fakeAdminScopes struct {
+	model.AdminScopesModel
+	scopes map[int64]*model.AdminScopes
+}
+
+func (m *fakeAdminScopes) FindByAdminId(_ context.Context, adminId int64) (*model.AdminScopes, error) {
+	s, ok := m.scopes[adminId]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return s, nil
+}
+
+// fakeAdminScopeRoles 管理范围的可分配角色
+type fakeAdminScopeRoles struct {
+	model.AdminScopeRolesModel
+	roles map[int64][]int64
+}
+
+func (m *fakeAdminScopeRoles) FindByScopeId(_ context.Context, scopeId int64) ([]*model.AdminScopeRoles, error) {
+	var res []*model.AdminScopeRoles
+	for _, roleId := range m.roles[scopeId] {
+		res = append(res, &model.AdminScopeRoles{ScopeId: scopeId, RoleId: roleId})
+	}
+	return res, nil
+}
+
+func newTestGuard() *Guard {
+	users := &modeltest.Users{Rows: []*model.Users{
+		{Id: superAdminId},
+		{Id: deptAdminId, DepartmentId: modeltest.NullInt64(10)},
+		{Id: inDeptUserId, DepartmentId: modeltest.NullInt64(11)},
+		{Id: otherUserId, DepartmentId: modeltest.NullInt64(20)},
+		{Id: noDeptUserId},
+		{Id: allDeptAdminId},
+		{Id: plainUserId, DepartmentId: modeltest.NullInt64(11)},
+		{Id: groupSuperId},
+	}}
+	roles := &modeltest.Roles{Rows: []*model.Roles{
+		{Id: 1, Code: "admin"},
+		{Id: 2, Code: "iam_admin"},
+		{Id: 5, Code: "clerk"},
+		{Id: 6, Code: "auditor"},
+	}}
+	userRoles := &modeltest.UserRoles{Rows: []*model.UserRoles{
+		{UserId: superAdminId, RoleId: 1}, {UserId: deptAdminId, RoleId: 5}, {UserId: inDeptUserId, RoleId: 5},
+	}}
+	members := &modeltest.GroupMembers{Rows: []*model.GroupMembers{
+		{GroupId: 100, UserId: inDeptUserId}, {GroupId: 101, UserId: inDeptUserId}, {GroupId: 101, UserId: otherUserId},
+		{GroupId: 102, UserId: groupSuperId},
+	}}
+	groupRoles := &modeltest.GroupRoles{Rows: []*model.GroupRoles{
+		{GroupId: 100, RoleId: 5}, {GroupId: 101, RoleId: 6}, {GroupId: 102, RoleId: 2},
+	}, Members: members}
+	departments := &modeltest.Departments{Rows: []*model.Departments{
+		{Id: 10, Path: "/"}, {Id: 11, ParentId: modeltest.NullInt64(10), Path: "/10/"}, {Id: 20, Path: "/"},
+	}}
+	bindings := &modeltest.ResourceBindings{Rows: []*model.ResourceBindings{
+		{Id: 60, UserId: inDeptUserId, RoleId: 5, ResourceType: "project", ResourceId: "p1"},
+		{Id: 61, UserId: otherUserId, RoleId: 5, ResourceType: "project", ResourceId: "p1"},
+		{Id: 62, UserId: inDeptUserId, RoleId: 6, ResourceType: "project", ResourceId: "p2"},
+	}}
+	sessions := &modeltest.Sessions{Rows: []*model.Sessions{{Id: 50, UserId: otherUserId}}}
+	scopes := &fakeAdminScopes{scopes: map[int64]*model.AdminScopes{
+		deptAdminId:    {Id: 1, AdminId: deptAdminId, DepartmentId: modeltest.NullInt64(10)},
+		allDeptAdminId: {Id: 2, AdminId: allDeptAdminId},
+	}}
+	scopeRoles := &fakeAdminScopeRoles{roles: map[int64][]int64{1: {5}, 2: {5, 6}}}
+	return NewGuard(config.DelegationConf{Enabled: true}, users, roles, userRoles, groupRoles,
+		members, bindings, departments, sessions, scopes, scopeRoles)
+}
+
+func TestScopeOf(t *testing.T) {
+	g := newTestGuard()
+	ctx := context.Background()
+
+	for _, id := range []int64{superAdminId, groupSuperId} {
+		scope, err := g.ScopeOf(ctx, id)
+		if err != nil || !scope.Super {
+			t.Fatalf("ScopeOf(%d) = %+v, %v, want super", id, scope, err)
+		}
+	}
+
+	scope, err := g.ScopeOf(ctx, deptAdminId)
+	if err != nil {
+		t.Fatalf("ScopeOf: %v", err)
+	}
+	if scope.Super || scope.AllDepartments || !scope.CoversDepartment(11) || scope.CoversDepartment(20) || scope.CoversDepartment(0) {
+		t.Fatalf("unexpected department scope %+v", scope)
+	}
+	if !scope.CanGrant(5) || scope.CanGrant(6) {
+		t.Fatalf("unexpected grantable roles %+v", scope)
+	}
+	if ok, _ := g.CanManageUser(ctx, scope, inDeptUserId); !ok {
+		t.Fatalf("user in sub department not covered")
+	}
+	// 未分配部门的用户只有不限部门的管理员可以管理
+	if ok, _ := g.CanManageUser(ctx, scope, noDeptUserId); ok {
+		t.Fatalf("user without department covered")
+	}
+	if _, err = g.CanManageUser(ctx, scope, 999); !errors.Is(err, model.ErrNotFound) {
+		t.Fatalf("CanManageUser unknown user = %v", err)
+	}
+
+	if scope, _ = g.ScopeOf(ctx, allDeptAdminId); !scope.AllDepartments || !scope.CoversDepartment(0) {
+		t.Fatalf("unrestricted scope = %+v", scope)
+	}
+
+	if _, err = g.ScopeOf(ctx, plainUserId); !errors.Is(err, ErrNoScope) {
+		t.Fatalf("ScopeOf plain user = %v, want ErrNoScope", err)
+	}
+	if _, err = g.ScopeOf(ctx, 999); !errors.Is(err, ErrUnknownCaller) {
+		t.Fatalf("ScopeOf unknown user = %v, want ErrUnknownCaller", err)
+	}
+}
+
+func TestIsSuperAdminRole(t *testing.T) {
+	g := newTestGuard()
+	if !g.IsSuperAdminRole("admin") || !g.IsSuperAdminRole("iam_admin") || g.IsSuperAdminRole("clerk") {
+		t.Fatalf("default super admin roles not applied")
+	}
+	g.c.SuperAdminRoles = []string{"root"}
+	if g.IsSuperAdminRole("admin") || !g.IsSuperAdminRole("root") {
+		t.Fatalf("configured super admin roles not applied")
+	}
+}
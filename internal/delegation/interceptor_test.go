@@ -0,0 +1,174 @@
+package delegation
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/impersonation"
+	"github.com/ziptako/iam/internal/rpcauth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// asUser 以用户令牌认证的调用
+func asUser(userId int64) context.Context {
+	return rpcauth.WithPrincipal(context.Background(), &rpcauth.Principal{Kind: rpcauth.PrincipalUser, UserId: userId})
+}
+
+// asService 已认证的服务调用方转发实际操作用户
+func asService(userId string) context.Context {
+	ctx := rpcauth.WithPrincipal(context.Background(), &rpcauth.Principal{Kind: rpcauth.PrincipalService, Name: "gateway"})
+	if userId == "" {
+		return ctx
+	}
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, userId))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor(newTestGuard())
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		req    any
+		code   codes.Code
+	}{
+		{"open method", context.Background(), iam.UserService_GetUser_FullMethodName, &iam.GetUserRequest{}, codes.OK},
+		{"unguarded service", context.Background(), iam.RelationService_WriteRelationTuples_FullMethodName, nil, codes.OK},
+		{"no caller", context.Background(), iam.UserService_UpdateUser_FullMethodName, &iam.UpdateUserRequest{Id: inDeptUserId}, codes.Unauthenticated},
+		{"unauthenticated metadata", metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, strconv.Itoa(deptAdminId))), iam.UserService_UpdateUser_FullMethodName, &iam.UpdateUserRequest{Id: inDeptUserId}, codes.Unauthenticated},
+		{"service without user", asService(""), iam.UserService_UpdateUser_FullMethodName, &iam.UpdateUserRequest{Id: inDeptUserId}, codes.Unauthenticated},
+		{"service invalid user", asService("abc"), iam.UserService_UpdateUser_FullMethodName, &iam.UpdateUserRequest{Id: inDeptUserId}, codes.Unauthenticated},
+		{"service forwards user", asService(strconv.Itoa(deptAdminId)), iam.UserService_UpdateUser_FullMethodName, &iam.UpdateUserRequest{Id: inDeptUserId}, codes.OK},
+		{"unknown caller", asUser(999), iam.UserService_UpdateUser_FullMethodName, &iam.UpdateUserRequest{Id: inDeptUserId}, codes.Unauthenticated},
+		{"not an admin", asUser(plainUserId), iam.UserService_UpdateUser_FullMethodName, &iam.UpdateUserRequest{Id: inDeptUserId}, codes.PermissionDenied},
+
+		{"super only", asUser(superAdminId), iam.RoleService_DeleteRole_FullMethodName, &iam.DeleteRoleRequest{Id: 5}, codes.OK},
+		{"super via group", asUser(groupSuperId), iam.RoleService_DeleteRole_FullMethodName, &iam.DeleteRoleRequest{Id: 5}, codes.OK},
+		{"super only denied", asUser(deptAdminId), iam.RoleService_DeleteRole_FullMethodName, &iam.DeleteRoleRequest{Id: 5}, codes.PermissionDenied},
+
+		{"manage user", asUser(deptAdminId), iam.UserService_UpdateUser_FullMethodName, &iam.UpdateUserRequest{Id: inDeptUserId}, codes.OK},
+		{"manage user outside", asUser(deptAdminId), iam.UserService_UpdateUser_FullMethodName, &iam.UpdateUserRequest{Id: otherUserId}, codes.PermissionDenied},
+		{"manage user without department", asUser(deptAdminId), iam.UserService_DeleteUser_FullMethodName, &iam.DeleteUserRequest{Id: noDeptUserId}, codes.PermissionDenied},
+		{"manage missing user", asUser(deptAdminId), iam.UserService_UpdateUser_FullMethodName, &iam.UpdateUserRequest{Id: 999}, codes.OK},
+		{"create user", asUser(allDeptAdminId), iam.UserService_CreateUser_FullMethodName, &iam.CreateUserRequest{}, codes.OK},
+		{"create user restricted", asUser(deptAdminId), iam.UserService_CreateUser_FullMethodName, &iam.CreateUserRequest{}, codes.PermissionDenied},
+
+		{"grant roles", asUser(deptAdminId), iam.UserService_AssignUserRoles_FullMethodName, &iam.AssignUserRolesRequest{UserId: inDeptUserId, RoleIds: []int64{5}}, codes.OK},
+		{"grant roles not grantable", asUser(deptAdminId), iam.UserService_AssignUserRoles_FullMethodName, &iam.AssignUserRolesRequest{UserId: inDeptUserId, RoleIds: []int64{5, 6}}, codes.PermissionDenied},
+
+		{"self", asUser(plainUserId), iam.UserService_ChangePassword_FullMethodName, &iam.ChangePasswordRequest{UserId: plainUserId}, codes.OK},
+		{"other user", asUser(plainUserId), iam.UserService_ChangePassword_FullMethodName, &iam.ChangePasswordRequest{UserId: inDeptUserId}, codes.PermissionDenied},
+		{"own session", asUser(otherUserId), iam.UserService_RevokeSession_FullMethodName, &iam.RevokeSessionRequest{Id: 50}, codes.OK},
+		{"session outside", asUser(deptAdminId), iam.UserService_RevokeSession_FullMethodName, &iam.RevokeSessionRequest{Id: 50}, codes.PermissionDenied},
+		{"impersonate outside scope", asUser(deptAdminId), iam.UserService_Impersonate_FullMethodName, &iam.ImpersonateRequest{SubjectUserId: otherUserId}, codes.PermissionDenied},
+		{"impersonate", asUser(deptAdminId), iam.UserService_Impersonate_FullMethodName, &iam.ImpersonateRequest{SubjectUserId: inDeptUserId}, codes.OK},
+
+		{"add group members", asUser(deptAdminId), iam.GroupService_AddGroupMembers_FullMethodName, &iam.AddGroupMembersRequest{GroupId: 100, UserIds: []int64{inDeptUserId}}, codes.OK},
+		{"add group member outside", asUser(deptAdminId), iam.GroupService_AddGroupMembers_FullMethodName, &iam.AddGroupMembersRequest{GroupId: 100, UserIds: []int64{otherUserId}}, codes.PermissionDenied},
+		{"add to group with role not grantable", asUser(deptAdminId), iam.GroupService_AddGroupMembers_FullMethodName, &iam.AddGroupMembersRequest{GroupId: 101, UserIds: []int64{inDeptUserId}}, codes.PermissionDenied},
+		{"remove group members", asUser(allDeptAdminId), iam.GroupService_RemoveGroupMembers_FullMethodName, &iam.RemoveGroupMembersRequest{GroupId: 101, UserIds: []int64{otherUserId}}, codes.OK},
+		{"assign group roles", asUser(deptAdminId), iam.GroupService_AssignGroupRoles_FullMethodName, &iam.AssignGroupRolesRequest{GroupId: 100, RoleIds: []int64{5}}, codes.OK},
+		{"assign group roles with member outside", asUser(deptAdminId), iam.GroupService_AssignGroupRoles_FullMethodName, &iam.AssignGroupRolesRequest{GroupId: 101, RoleIds: []int64{5}}, codes.PermissionDenied},
+		{"remove group roles not grantable", asUser(deptAdminId), iam.GroupService_RemoveGroupRoles_FullMethodName, &iam.RemoveGroupRolesRequest{GroupId: 100, RoleIds: []int64{6}}, codes.PermissionDenied},
+
+		{"create binding", asUser(deptAdminId), iam.BindingService_CreateResourceBinding_FullMethodName, &iam.CreateResourceBindingRequest{UserId: inDeptUserId, RoleId: 5}, codes.OK},
+		{"create binding not grantable", asUser(deptAdminId), iam.BindingService_CreateResourceBinding_FullMethodName, &iam.CreateResourceBindingRequest{UserId: inDeptUserId, RoleId: 6}, codes.PermissionDenied},
+		{"delete binding", asUser(deptAdminId), iam.BindingService_DeleteResourceBinding_FullMethodName, &iam.DeleteResourceBindingRequest{Id: 60}, codes.OK},
+		{"delete binding outside", asUser(deptAdminId), iam.BindingService_DeleteResourceBinding_FullMethodName, &iam.DeleteResourceBindingRequest{Id: 61}, codes.PermissionDenied},
+		{"delete binding not grantable", asUser(deptAdminId), iam.BindingService_DeleteResourceBinding_FullMethodName, &iam.DeleteResourceBindingRequest{Id: 62}, codes.PermissionDenied},
+		{"delete missing binding", asUser(deptAdminId), iam.BindingService_DeleteResourceBinding_FullMethodName, &iam.DeleteResourceBindingRequest{Id: 999}, codes.OK},
+
+		{"request access for self", asUser(plainUserId), iam.AccessRequestService_CreateAccessRequest_FullMethodName, &iam.CreateAccessRequestRequest{RequesterId: plainUserId, RoleId: 5}, codes.OK},
+		{"request access for other", asUser(plainUserId), iam.AccessRequestService_CreateAccessRequest_FullMethodName, &iam.CreateAccessRequestRequest{RequesterId: inDeptUserId, RoleId: 5}, codes.PermissionDenied},
+		{"approve as self", asUser(plainUserId), iam.AccessRequestService_ApproveAccessRequest_FullMethodName, &iam.ApproveAccessRequestRequest{Id: 1, ApproverId: plainUserId}, codes.OK},
+		{"approve as other", asUser(deptAdminId), iam.AccessRequestService_ApproveAccessRequest_FullMethodName, &iam.ApproveAccessRequestRequest{Id: 1, ApproverId: superAdminId}, codes.PermissionDenied},
+		{"comment as other", asUser(plainUserId), iam.AccessRequestService_CommentAccessRequest_FullMethodName, &iam.CommentAccessRequestRequest{Id: 1, UserId: inDeptUserId}, codes.PermissionDenied},
+
+		{"list review items", asUser(plainUserId), iam.AccessReviewService_ListReviewItems_FullMethodName, &iam.ListReviewItemsRequest{}, codes.OK},
+		{"decide review as self", asUser(plainUserId), iam.AccessReviewService_DecideReviewItem_FullMethodName, &iam.DecideReviewItemRequest{Id: 1, ReviewerId: plainUserId}, codes.OK},
+		{"decide review as other", asUser(plainUserId), iam.AccessReviewService_DecideReviewItem_FullMethodName, &iam.DecideReviewItemRequest{Id: 1, ReviewerId: deptAdminId}, codes.PermissionDenied},
+		{"close review campaign", asUser(superAdminId), iam.AccessReviewService_CloseReviewCampaign_FullMethodName, &iam.CloseReviewCampaignRequest{Id: 1}, codes.OK},
+		{"close review campaign not super", asUser(deptAdminId), iam.AccessReviewService_CloseReviewCampaign_FullMethodName, &iam.CloseReviewCampaignRequest{Id: 1}, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := interceptor(tt.ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(context.Context, any) (any, error) {
+				return nil, nil
+			})
+			if status.Code(err) != tt.code {
+				t.Fatalf("error = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
+
+// TestImpersonatedCaller 模拟登录调用以被模拟用户的身份校验
+func TestImpersonatedCaller(t *testing.T) {
+	interceptor := UnaryServerInterceptor(newTestGuard())
+	ctx := impersonation.WithClaims(context.Background(), &impersonation.Claims{ActorId: superAdminId, SubjectId: deptAdminId})
+	info := &grpc.UnaryServerInfo{FullMethod: iam.UserService_UpdateUser_FullMethodName}
+
+	var callerId int64
+	_, err := interceptor(ctx, &iam.UpdateUserRequest{Id: inDeptUserId}, info, func(ctx context.Context, _ any) (any, error) {
+		callerId, _ = CallerIdFromContext(ctx)
+		return nil, nil
+	})
+	if err != nil || callerId != deptAdminId {
+		t.Fatalf("impersonated call = %v, caller %d", err, callerId)
+	}
+	_, err = interceptor(ctx, &iam.UpdateUserRequest{Id: otherUserId}, info, func(context.Context, any) (any, error) {
+		return nil, nil
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("impersonated call outside scope = %v", err)
+	}
+}
+
+// TestActorIdFromContext 操作用户只取已认证的身份
+func TestActorIdFromContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		actorId int64
+		ok      bool
+	}{
+		{"delegation caller", WithCallerId(asUser(plainUserId), deptAdminId), deptAdminId, true},
+		{"user token", asUser(plainUserId), plainUserId, true},
+		{"service forwards user", asService(strconv.Itoa(deptAdminId)), deptAdminId, true},
+		{"service without user", asService(""), 0, false},
+		{"impersonation", impersonation.WithClaims(asUser(superAdminId), &impersonation.Claims{ActorId: superAdminId, SubjectId: deptAdminId}), deptAdminId, true},
+		{"unauthenticated metadata", metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "2")), 0, false},
+		{"anonymous", context.Background(), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actorId, ok := ActorIdFromContext(tt.ctx)
+			if actorId != tt.actorId || ok != tt.ok {
+				t.Fatalf("ActorIdFromContext = %d, %v, want %d, %v", actorId, ok, tt.actorId, tt.ok)
+			}
+		})
+	}
+}
+
+// TestRulesAreGuarded 配置了规则的方法都需要校验管理范围
+func TestRulesAreGuarded(t *testing.T) {
+	for method := range rules {
+		if !guarded(method) {
+			t.Errorf("%s has a rule but is not guarded", method)
+		}
+	}
+	for method := range openMethods {
+		if !strings.HasPrefix(method, "/iam.") {
+			t.Errorf("open method %s is not an IAM method", method)
+		}
+		if _, ok := rules[method]; ok {
+			t.Errorf("open method %s has a rule", method)
+		}
+	}
+}
@@ -0,0 +1,352 @@
+package delegation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// guardedServices 需要校验管理范围的服务，方法全名以这些前缀开头
+var guardedServices = []string{
+	"/iam.userService/",
+	"/iam.roleService/",
+	"/iam.permissionService/",
+	"/iam.policyService/",
+	"/iam.groupService/",
+	"/iam.bindingService/",
+	"/iam.accessRequestService/",
+	"/iam.accessReviewService/",
+}
+
+// openMethods 不修改数据的查询接口、用户自身的认证流程和服务自身的权限注册，不校验管理范围
+var openMethods = map[string]struct{}{
+	iam.UserService_GetUser_FullMethodName:                     {},
+	iam.UserService_ListUsers_FullMethodName:                   {},
+	iam.UserService_GetUserRoles_FullMethodName:                {},
+	iam.UserService_CheckUserRole_FullMethodName:               {},
+	iam.UserService_CheckUserPermission_FullMethodName:         {},
+	iam.UserService_CheckUserResourcePermission_FullMethodName: {},
+	iam.UserService_GetUserByUsername_FullMethodName:           {},
+	iam.UserService_VerifyPassword_FullMethodName:              {},
+	iam.UserService_CreateSession_FullMethodName:               {},
+	iam.UserService_TouchSession_FullMethodName:                {},
+	iam.UserService_ListUserSessions_FullMethodName:            {},
+	iam.UserService_SendVerification_FullMethodName:            {},
+	iam.UserService_ConfirmVerification_FullMethodName:         {},
+	iam.UserService_Authenticate_FullMethodName:                {},
+	iam.UserService_GetUserDataScope_FullMethodName:            {},
+	iam.UserService_GetUserPermissions_FullMethodName:          {},
+	iam.UserService_GetAdminScope_FullMethodName:               {},
+	iam.UserService_ExplainUserPermission_FullMethodName:       {},
+	iam.UserService_SimulateChange_FullMethodName:              {},
+	iam.RoleService_GetRole_FullMethodName:                     {},
+	iam.RoleService_ListRoles_FullMethodName:                   {},
+	iam.RoleService_GetRolePermissions_FullMethodName:          {},
+	iam.RoleService_CheckRolePermission_FullMethodName:         {},
+	iam.RoleService_ListRoleConstraints_FullMethodName:         {},
+	iam.RoleService_ValidateConstraints_FullMethodName:         {},
+	iam.RoleService_ListUnusedGrants_FullMethodName:            {},
+	iam.RoleService_ListUnusedRoles_FullMethodName:             {},
+	iam.RoleService_RecommendRoleTrim_FullMethodName:           {},
+	iam.PolicyService_ExportPolicy_FullMethodName:              {},
+	iam.PolicyService_PlanPolicy_FullMethodName:                {},
+	iam.PermissionService_GetPermission_FullMethodName:         {},
+	iam.PermissionService_ListPermissions_FullMethodName:       {},
+	iam.PermissionService_RegisterPermissions_FullMethodName:   {},
+	iam.GroupService_GetGroup_FullMethodName:                   {},
+	iam.GroupService_ListGroups_FullMethodName:                 {},
+	iam.GroupService_ListGroupMembers_FullMethodName:           {},
+	iam.GroupService_ListUserGroups_FullMethodName:             {},
+	iam.GroupService_GetGroupRoles_FullMethodName:              {},
+	iam.BindingService_ListResourceAccess_FullMethodName:       {},
+	iam.BindingService_ListUserResources_FullMethodName:        {},
+	iam.AccessRequestService_GetRoleApprovers_FullMethodName:   {},
+	iam.AccessRequestService_GetAccessRequest_FullMethodName:   {},
+	iam.AccessRequestService_ListAccessRequests_FullMethodName: {},
+	iam.AccessReviewService_GetReviewCampaign_FullMethodName:   {},
+	iam.AccessReviewService_ListReviewCampaigns_FullMethodName: {},
+	iam.AccessReviewService_ListReviewItems_FullMethodName:     {},
+	iam.AccessReviewService_ExportReviewReport_FullMethodName:  {},
+}
+
+// rule 校验调用人能否执行一次调用，返回gRPC状态错误
+type rule func(ctx context.Context, c *call, req any) error
+
+// rules 委派管理员可以调用的变更接口，未列出的变更接口只允许超级管理员调用
+var rules = map[string]rule{
+	iam.UserService_CreateUser_FullMethodName:            createUser,
+	iam.UserService_UpdateUser_FullMethodName:            manageUser((*iam.UpdateUserRequest).GetId),
+	iam.UserService_DeleteUser_FullMethodName:            manageUser((*iam.DeleteUserRequest).GetId),
+	iam.UserService_AssignUserRole_FullMethodName:        grantRoles((*iam.AssignUserRoleRequest).GetUserId, one((*iam.AssignUserRoleRequest).GetRoleId)),
+	iam.UserService_AssignUserRoles_FullMethodName:       grantRoles((*iam.AssignUserRolesRequest).GetUserId, (*iam.AssignUserRolesRequest).GetRoleIds),
+	iam.UserService_ExtendUserRole_FullMethodName:        grantRoles((*iam.ExtendUserRoleRequest).GetUserId, one((*iam.ExtendUserRoleRequest).GetRoleId)),
+	iam.UserService_RemoveUserRole_FullMethodName:        grantRoles((*iam.RemoveUserRoleRequest).GetUserId, one((*iam.RemoveUserRoleRequest).GetRoleId)),
+	iam.UserService_RemoveUserRoles_FullMethodName:       grantRoles((*iam.RemoveUserRolesRequest).GetUserId, (*iam.RemoveUserRolesRequest).GetRoleIds),
+	iam.UserService_ChangePassword_FullMethodName:        selfOrManageUser((*iam.ChangePasswordRequest).GetUserId),
+	iam.UserService_RevokeAllUserSessions_FullMethodName: selfOrManageUser((*iam.RevokeAllUserSessionsRequest).GetUserId),
+	iam.UserService_RevokeSession_FullMethodName:         revokeSession,
+	iam.UserService_Impersonate_FullMethodName:           impersonate,
+
+	iam.GroupService_AddGroupMembers_FullMethodName:    groupMembers((*iam.AddGroupMembersRequest).GetGroupId, (*iam.AddGroupMembersRequest).GetUserIds),
+	iam.GroupService_RemoveGroupMembers_FullMethodName: groupMembers((*iam.RemoveGroupMembersRequest).GetGroupId, (*iam.RemoveGroupMembersRequest).GetUserIds),
+	iam.GroupService_AssignGroupRoles_FullMethodName:   groupRoles((*iam.AssignGroupRolesRequest).GetGroupId, (*iam.AssignGroupRolesRequest).GetRoleIds),
+	iam.GroupService_RemoveGroupRoles_FullMethodName:   groupRoles((*iam.RemoveGroupRolesRequest).GetGroupId, (*iam.RemoveGroupRolesRequest).GetRoleIds),
+
+	iam.BindingService_CreateResourceBinding_FullMethodName: grantRoles((*iam.CreateResourceBindingRequest).GetUserId, one((*iam.CreateResourceBindingRequest).GetRoleId)),
+	iam.BindingService_DeleteResourceBinding_FullMethodName: deleteResourceBinding,
+
+	iam.AccessRequestService_CreateAccessRequest_FullMethodName:  selfOrManageUser((*iam.CreateAccessRequestRequest).GetRequesterId),
+	iam.AccessRequestService_CancelAccessRequest_FullMethodName:  selfOrManageUser((*iam.CancelAccessRequestRequest).GetRequesterId),
+	iam.AccessRequestService_ApproveAccessRequest_FullMethodName: self((*iam.ApproveAccessRequestRequest).GetApproverId),
+	iam.AccessRequestService_RejectAccessRequest_FullMethodName:  self((*iam.RejectAccessRequestRequest).GetApproverId),
+	iam.AccessRequestService_CommentAccessRequest_FullMethodName: self((*iam.CommentAccessRequestRequest).GetUserId),
+
+	iam.AccessReviewService_DecideReviewItem_FullMethodName: self((*iam.DecideReviewItemRequest).GetReviewerId),
+}
+
+// call 一次调用的校验上下文，管理范围按需计算且只计算一次
+type call struct {
+	g        *Guard
+	callerId int64
+	scope    *Scope
+}
+
+// Scope 获取调用人的管理范围
+func (c *call) Scope(ctx context.Context) (*Scope, error) {
+	if c.scope != nil {
+		return c.scope, nil
+	}
+	scope, err := c.g.ScopeOf(ctx, c.callerId)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrUnknownCaller):
+			return nil, status.Error(codes.Unauthenticated, "[DEL002] Invalid caller identity")
+		case errors.Is(err, ErrNoScope):
+			return nil, status.Error(codes.PermissionDenied, "[DEL004] Caller is not an administrator")
+		}
+		eInfo := "[DEL003] 查询调用人管理范围失败"
+		logx.WithContext(ctx).Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	c.scope = scope
+	return scope, nil
+}
+
+// ManageUser 校验用户在调用人的管理范围内，用户不存在时交由接口本身返回NotFound
+func (c *call) ManageUser(ctx context.Context, userId int64) error {
+	scope, err := c.Scope(ctx)
+	if err != nil {
+		return err
+	}
+	ok, err := c.g.CanManageUser(ctx, scope, userId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil
+		}
+		eInfo := "[DEL005] 查询目标用户失败"
+		logx.WithContext(ctx).Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	if !ok {
+		return status.Error(codes.PermissionDenied, "[DEL006] User is outside the administrator's scope")
+	}
+	return nil
+}
+
+// GrantRoles 校验角色都可由调用人分配和移除
+func (c *call) GrantRoles(ctx context.Context, roleIds []int64) error {
+	scope, err := c.Scope(ctx)
+	if err != nil {
+		return err
+	}
+	for _, roleId := range roleIds {
+		if !scope.CanGrant(roleId) {
+			return status.Error(codes.PermissionDenied, fmt.Sprintf("[DEL007] Role is not grantable by the administrator: %d", roleId))
+		}
+	}
+	return nil
+}
+
+// ManageGroupMembers 校验用户组的所有成员都在调用人的管理范围内
+func (c *call) ManageGroupMembers(ctx context.Context, groupId int64) error {
+	scope, err := c.Scope(ctx)
+	if err != nil {
+		return err
+	}
+	if scope.Super || scope.AllDepartments {
+		return nil
+	}
+	userIds, err := c.g.groupMembersModel.FindUserIdsByGroupId(ctx, groupId)
+	if err != nil {
+		eInfo := "[DEL013] 查询用户组成员失败"
+		logx.WithContext(ctx).Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	for _, userId := range userIds {
+		if err = c.ManageUser(ctx, userId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GrantGroupRoles 校验用户组的所有角色都可由调用人分配和移除
+func (c *call) GrantGroupRoles(ctx context.Context, groupId int64) error {
+	scope, err := c.Scope(ctx)
+	if err != nil {
+		return err
+	}
+	if scope.Super {
+		return nil
+	}
+	groupRoles, err := c.g.groupRolesModel.FindRolesByGroupId(ctx, groupId)
+	if err != nil {
+		eInfo := "[DEL014] 查询用户组角色失败"
+		logx.WithContext(ctx).Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	roleIds := make([]int64, len(groupRoles))
+	for i, gr := range groupRoles {
+		roleIds[i] = gr.RoleId
+	}
+	return c.GrantRoles(ctx, roleIds)
+}
+
+// superOnly 只允许超级管理员调用
+func superOnly(ctx context.Context, c *call, _ any) error {
+	scope, err := c.Scope(ctx)
+	if err != nil {
+		return err
+	}
+	if !scope.Super {
+		return status.Error(codes.PermissionDenied, "[DEL008] Super administrator required")
+	}
+	return nil
+}
+
+// createUser 新用户未分配部门，只有不限部门的管理员可以创建
+func createUser(ctx context.Context, c *call, _ any) error {
+	scope, err := c.Scope(ctx)
+	if err != nil {
+		return err
+	}
+	if !scope.Super && !scope.AllDepartments {
+		return status.Error(codes.PermissionDenied, "[DEL009] Creating users requires an unrestricted department scope")
+	}
+	return nil
+}
+
+// manageUser 目标用户需在管理范围内
+func manageUser[T any](userId func(T) int64) rule {
+	return func(ctx context.Context, c *call, req any) error {
+		return c.ManageUser(ctx, userId(req.(T)))
+	}
+}
+
+// grantRoles 目标用户需在管理范围内，且所有角色都可由调用人分配
+func grantRoles[T any](userId func(T) int64, roleIds func(T) []int64) rule {
+	return func(ctx context.Context, c *call, req any) error {
+		r := req.(T)
+		if err := c.ManageUser(ctx, userId(r)); err != nil {
+			return err
+		}
+		return c.GrantRoles(ctx, roleIds(r))
+	}
+}
+
+// selfOrManageUser 用户可以操作自己，否则目标用户需在管理范围内
+func selfOrManageUser[T any](userId func(T) int64) rule {
+	return func(ctx context.Context, c *call, req any) error {
+		id := userId(req.(T))
+		if id == c.callerId {
+			return nil
+		}
+		return c.ManageUser(ctx, id)
+	}
+}
+
+// revokeSession 用户可以撤销自己的会话，否则会话所属用户需在管理范围内
+func revokeSession(ctx context.Context, c *call, req any) error {
+	session, err := c.g.sessionsModel.FindActiveById(ctx, req.(*iam.RevokeSessionRequest).GetId())
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil
+		}
+		eInfo := "[DEL010] 查询会话失败"
+		logx.WithContext(ctx).Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	if session.UserId == c.callerId {
+		return nil
+	}
+	return c.ManageUser(ctx, session.UserId)
+}
+
+// impersonate 被模拟用户需在管理范围内，发起人由接口取自调用人
+func impersonate(ctx context.Context, c *call, req any) error {
+	return c.ManageUser(ctx, req.(*iam.ImpersonateRequest).GetSubjectUserId())
+}
+
+// self 只能以自己的身份调用，如审批人、评论人
+func self[T any](userId func(T) int64) rule {
+	return func(ctx context.Context, c *call, req any) error {
+		if userId(req.(T)) != c.callerId {
+			return status.Error(codes.PermissionDenied, "[DEL012] The user in the request must be the caller")
+		}
+		return nil
+	}
+}
+
+// groupMembers 成员加入或离开用户组即获得或失去用户组的角色，成员需在管理范围内，且用户组的角色都可由调用人分配
+func groupMembers[T any](groupId func(T) int64, userIds func(T) []int64) rule {
+	return func(ctx context.Context, c *call, req any) error {
+		r := req.(T)
+		for _, userId := range userIds(r) {
+			if err := c.ManageUser(ctx, userId); err != nil {
+				return err
+			}
+		}
+		return c.GrantGroupRoles(ctx, groupId(r))
+	}
+}
+
+// groupRoles 用户组的角色变更作用于所有成员，成员需在管理范围内，且角色都可由调用人分配
+func groupRoles[T any](groupId func(T) int64, roleIds func(T) []int64) rule {
+	return func(ctx context.Context, c *call, req any) error {
+		r := req.(T)
+		if err := c.ManageGroupMembers(ctx, groupId(r)); err != nil {
+			return err
+		}
+		return c.GrantRoles(ctx, roleIds(r))
+	}
+}
+
+// deleteResourceBinding 绑定的用户需在管理范围内，且绑定的角色可由调用人分配，绑定不存在时交由接口本身返回NotFound
+func deleteResourceBinding(ctx context.Context, c *call, req any) error {
+	binding, err := c.g.bindingsModel.FindOne(ctx, req.(*iam.DeleteResourceBindingRequest).GetId())
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil
+		}
+		eInfo := "[DEL015] 查询资源绑定失败"
+		logx.WithContext(ctx).Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	if err = c.ManageUser(ctx, binding.UserId); err != nil {
+		return err
+	}
+	return c.GrantRoles(ctx, []int64{binding.RoleId})
+}
+
+// one 将单个ID的取值方法转换为ID列表的取值方法
+func one[T any](id func(T) int64) func(T) []int64 {
+	return func(r T) []int64 {
+		return []int64{id(r)}
+	}
+}
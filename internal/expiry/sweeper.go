@@ -0,0 +1,165 @@
+package expiry
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/config"
+)
+
+// 过期事件类型，作为审计记录的操作写入
+const (
+	EventUserRoleExpired       = "user_role.expired"       // 用户角色到期回收
+	EventRolePermissionExpired = "role_permission.expired" // 角色权限到期回收
+)
+
+// Event 授权到期回收事件
+type Event struct {
+	Type         string
+	TenantId     int64
+	UserId       int64 // 仅用户角色事件
+	RoleId       int64
+	PermissionId int64 // 仅角色权限事件
+	ValidUntil   time.Time
+}
+
+// String 事件详情
+func (e *Event) String() string {
+	switch e.Type {
+	case EventUserRoleExpired:
+		return fmt.Sprintf("tenant_id=%d user_id=%d role_id=%d valid_until=%s", e.TenantId, e.UserId, e.RoleId, e.ValidUntil.Format(time.RFC3339))
+	default:
+		return fmt.Sprintf("tenant_id=%d role_id=%d permission_id=%d valid_until=%s", e.TenantId, e.RoleId, e.PermissionId, e.ValidUntil.Format(time.RFC3339))
+	}
+}
+
+// Sweeper 定时清理所有租户中已过期的用户角色和角色权限，每条清理记录写入一条审计事件
+// 检查路径本身已忽略过期授权，清理只是回收数据并留下记录
+type Sweeper struct {
+	c                    config.ExpiryConf
+	userRolesModel       model.UserRolesModel
+	rolePermissionsModel model.RolePermissionsModel
+	auditLogsModel       model.AuditLogsModel
+
+	lock sync.Mutex // 防止定时任务与手动触发并发执行
+	done chan struct{}
+}
+
+// NewSweeper 创建过期授权清理器
+func NewSweeper(c config.ExpiryConf, userRolesModel model.UserRolesModel, rolePermissionsModel model.RolePermissionsModel, auditLogsModel model.AuditLogsModel) *Sweeper {
+	return &Sweeper{
+		c:                    c,
+		userRolesModel:       userRolesModel,
+		rolePermissionsModel: rolePermissionsModel,
+		auditLogsModel:       auditLogsModel,
+	}
+}
+
+// Start 按配置的间隔启动定时清理，间隔为0时不启动
+func (s *Sweeper) Start() {
+	if s.c.SweepInterval <= 0 {
+		return
+	}
+
+	s.done = make(chan struct{})
+	ticker := time.NewTicker(time.Duration(s.c.SweepInterval) * time.Second)
+	threading.GoSafe(func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				events, err := s.Sweep(context.Background())
+				if err != nil {
+					logx.Errorf("[EXPIRY] 清理过期授权失败: %v", err)
+				}
+				if len(events) > 0 {
+					logx.Infof("[EXPIRY] 已清理过期授权 %d 条", len(events))
+				}
+			case <-s.done:
+				return
+			}
+		}
+	})
+}
+
+// Stop 停止定时清理
+func (s *Sweeper) Stop() {
+	if s.done != nil {
+		close(s.done)
+		s.done = nil
+	}
+}
+
+// Sweep 执行一次清理，返回本次回收的授权事件；出错时返回出错前已回收的事件
+func (s *Sweeper) Sweep(ctx context.Context) ([]*Event, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var events []*Event
+	userRoles, err := s.userRolesModel.FindExpired(ctx, s.c.BatchSize)
+	if err != nil {
+		return events, fmt.Errorf("find expired user roles: %w", err)
+	}
+	for _, ur := range userRoles {
+		deleted, err := s.userRolesModel.DeleteExpired(ctx, ur)
+		if err != nil {
+			return events, fmt.Errorf("delete expired user role %d: %w", ur.Id, err)
+		}
+		if !deleted {
+			// 查询后已被延期或移除
+			continue
+		}
+		events = append(events, s.emit(ctx, &Event{
+			Type:       EventUserRoleExpired,
+			TenantId:   ur.TenantId,
+			UserId:     ur.UserId,
+			RoleId:     ur.RoleId,
+			ValidUntil: ur.ValidUntil.Time,
+		}))
+	}
+
+	rolePermissions, err := s.rolePermissionsModel.FindExpired(ctx, s.c.BatchSize)
+	if err != nil {
+		return events, fmt.Errorf("find expired role permissions: %w", err)
+	}
+	for _, rp := range rolePermissions {
+		deleted, err := s.rolePermissionsModel.DeleteExpired(ctx, rp)
+		if err != nil {
+			return events, fmt.Errorf("delete expired role permission %d: %w", rp.Id, err)
+		}
+		if !deleted {
+			continue
+		}
+		events = append(events, s.emit(ctx, &Event{
+			Type:         EventRolePermissionExpired,
+			TenantId:     rp.TenantId,
+			RoleId:       rp.RoleId,
+			PermissionId: rp.PermissionId,
+			ValidUntil:   rp.ValidUntil.Time,
+		}))
+	}
+	return events, nil
+}
+
+// emit 记录事件日志并写入审计记录，写入失败只记录日志
+func (s *Sweeper) emit(ctx context.Context, ev *Event) *Event {
+	logx.Infof("[EXPIRY] %s: %s", ev.Type, ev)
+	audit := &model.AuditLogs{
+		Action: ev.Type,
+		Result: "OK",
+		Detail: sql.NullString{String: ev.String(), Valid: true},
+	}
+	if ev.UserId > 0 {
+		audit.SubjectId = sql.NullInt64{Int64: ev.UserId, Valid: true}
+	}
+	if _, err := s.auditLogsModel.Insert(ctx, audit); err != nil {
+		logx.Errorf("[EXPIRY] 写入过期审计记录失败: %v", err)
+	}
+	return ev
+}
@@ -0,0 +1,113 @@
+package expiry
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/internal/config"
+)
+
+// fakeUserRoles 已过期的用户角色，extended中的关联在查询后被延期
+type fakeUserRoles struct {
+	model.UserRolesModel
+	expired  []*model.UserRoles
+	extended map[int64]bool
+	limit    int
+}
+
+func (m *fakeUserRoles) FindExpired(_ context.Context, limit int) ([]*model.UserRoles, error) {
+	m.limit = limit
+	return m.expired, nil
+}
+
+func (m *fakeUserRoles) DeleteExpired(_ context.Context, data *model.UserRoles) (bool, error) {
+	return !m.extended[data.Id], nil
+}
+
+// fakeRolePermissions 已过期的角色权限，deleteErr不为空时删除失败
+type fakeRolePermissions struct {
+	model.RolePermissionsModel
+	expired   []*model.RolePermissions
+	deleteErr error
+}
+
+func (m *fakeRolePermissions) FindExpired(context.Context, int) ([]*model.RolePermissions, error) {
+	return m.expired, nil
+}
+
+func (m *fakeRolePermissions) DeleteExpired(context.Context, *model.RolePermissions) (bool, error) {
+	return m.deleteErr == nil, m.deleteErr
+}
+
+func TestSweep(t *testing.T) {
+	validUntil := sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+	userRoles := &fakeUserRoles{
+		expired: []*model.UserRoles{
+			{Id: 1, TenantId: 2, UserId: 10, RoleId: 20, ValidUntil: validUntil},
+			{Id: 2, TenantId: 2, UserId: 11, RoleId: 20, ValidUntil: validUntil},
+		},
+		extended: map[int64]bool{2: true},
+	}
+	rolePermissions := &fakeRolePermissions{
+		expired: []*model.RolePermissions{{Id: 5, TenantId: 3, RoleId: 21, PermissionId: 30, ValidUntil: validUntil}},
+	}
+	audits := &modeltest.AuditLogs{}
+	s := NewSweeper(config.ExpiryConf{BatchSize: 50}, userRoles, rolePermissions, audits)
+
+	events, err := s.Sweep(context.Background())
+	if err != nil {
+		t.Fatalf("Sweep: %v", err)
+	}
+	if userRoles.limit != 50 {
+		t.Fatalf("batch size = %d, want 50", userRoles.limit)
+	}
+	// 查询后被延期的用户角色不产生事件
+	if len(events) != 2 || events[0].Type != EventUserRoleExpired || events[0].UserId != 10 ||
+		events[1].Type != EventRolePermissionExpired || events[1].PermissionId != 30 {
+		t.Fatalf("unexpected events %+v", events)
+	}
+	if len(audits.Rows) != 2 {
+		t.Fatalf("audit logs = %d, want 2", len(audits.Rows))
+	}
+	if log := audits.Rows[0]; log.Action != EventUserRoleExpired || log.SubjectId.Int64 != 10 ||
+		!strings.Contains(log.Detail.String, "tenant_id=2 user_id=10 role_id=20") {
+		t.Fatalf("unexpected user role audit %+v", log)
+	}
+	if log := audits.Rows[1]; log.SubjectId.Valid || !strings.Contains(log.Detail.String, "permission_id=30") {
+		t.Fatalf("unexpected role permission audit %+v", log)
+	}
+}
+
+func TestSweepError(t *testing.T) {
+	validUntil := sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+	userRoles := &fakeUserRoles{expired: []*model.UserRoles{{Id: 1, UserId: 10, RoleId: 20, ValidUntil: validUntil}}}
+	rolePermissions := &fakeRolePermissions{
+		expired:   []*model.RolePermissions{{Id: 5, RoleId: 21, PermissionId: 30, ValidUntil: validUntil}},
+		deleteErr: errors.New("connection refused"),
+	}
+	s := NewSweeper(config.ExpiryConf{BatchSize: 50}, userRoles, rolePermissions, &modeltest.AuditLogs{})
+
+	// 出错时返回出错前已回收的事件
+	events, err := s.Sweep(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "role permission 5") {
+		t.Fatalf("Sweep error = %v", err)
+	}
+	if len(events) != 1 || events[0].Type != EventUserRoleExpired {
+		t.Fatalf("events before error = %+v", events)
+	}
+}
+
+func TestStartDisabled(t *testing.T) {
+	s := NewSweeper(config.ExpiryConf{}, &fakeUserRoles{}, &fakeRolePermissions{}, &modeltest.AuditLogs{})
+	s.Start()
+	if s.done != nil {
+		t.Fatalf("sweeper started with zero interval")
+	}
+	s.Stop()
+}
@@ -0,0 +1,95 @@
+package impersonation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/rpcauth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type claimsKey struct{}
+
+// WithClaims 将模拟凭证写入上下文
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// FromContext 读取当前调用的模拟凭证，非模拟调用返回false
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
+
+// UnaryServerInterceptor 识别携带模拟凭证的调用，为日志添加模拟标记并写入审计记录
+// 需在rpcauth拦截器之后执行，用户令牌认证的调用人必须是模拟凭证的发起人
+func UnaryServerInterceptor(m *Manager, impersonationsModel model.ImpersonationsModel,
+	auditLogsModel model.AuditLogsModel) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		tokens := md.Get(MetadataKey)
+		if len(tokens) == 0 {
+			return handler(ctx, req)
+		}
+
+		claims, err := m.Parse(tokens[0])
+		if err != nil {
+			logx.WithContext(ctx).Infof("[IMP001] 模拟凭证无效: %v", err)
+			return nil, status.Error(codes.Unauthenticated, "[IMP001] Invalid impersonation token")
+		}
+		record, err := impersonationsModel.FindActiveById(ctx, claims.ImpersonationId)
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
+			eInfo := "[IMP002] 查询模拟登录记录失败"
+			logx.WithContext(ctx).Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		if record == nil || record.ActorId != claims.ActorId || record.SubjectId != claims.SubjectId {
+			return nil, status.Error(codes.Unauthenticated, "[IMP003] Impersonation has expired")
+		}
+		// 以用户令牌认证的调用只能使用本人发起的模拟凭证，防止凭证被其他用户冒用
+		if principal, ok := rpcauth.FromContext(ctx); ok && principal.Kind == rpcauth.PrincipalUser && principal.UserId != claims.ActorId {
+			logx.WithContext(ctx).Infof("[IMP005] 模拟凭证的发起人%d与调用人%d不一致", claims.ActorId, principal.UserId)
+			return nil, status.Error(codes.PermissionDenied, "[IMP005] Impersonation token belongs to another actor")
+		}
+
+		ctx = WithClaims(ctx, claims)
+		ctx = logx.ContextWithFields(ctx,
+			logx.Field("impersonation_id", claims.ImpersonationId),
+			logx.Field("actor_id", claims.ActorId),
+			logx.Field("subject_id", claims.SubjectId),
+		)
+		logger := logx.WithContext(ctx)
+		logger.Infof("impersonated call %s", info.FullMethod)
+
+		resp, err := handler(ctx, req)
+
+		audit := &model.AuditLogs{
+			ActorId:         sql.NullInt64{Int64: claims.ActorId, Valid: true},
+			SubjectId:       sql.NullInt64{Int64: claims.SubjectId, Valid: true},
+			ImpersonationId: sql.NullInt64{Int64: claims.ImpersonationId, Valid: true},
+			Action:          info.FullMethod,
+			Result:          status.Code(err).String(),
+		}
+		if err != nil {
+			audit.Detail = sql.NullString{String: truncate(status.Convert(err).Message(), 500), Valid: true}
+		}
+		if _, auditErr := auditLogsModel.Insert(context.WithoutCancel(ctx), audit); auditErr != nil {
+			logger.Errorf("[IMP004] 写入模拟登录审计记录失败: %v", auditErr)
+		}
+		return resp, err
+	}
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
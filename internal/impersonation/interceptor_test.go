@@ -0,0 +1,141 @@
+package impersonation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/internal/rpcauth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeImpersonations 未过期的模拟登录记录
+type fakeImpersonations struct {
+	model.ImpersonationsModel
+	records map[int64]*model.Impersonations
+}
+
+func (m *fakeImpersonations) FindActiveById(_ context.Context, id int64) (*model.Impersonations, error) {
+	r, ok := m.records[id]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return r, nil
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	m := newTestManager()
+	records := &fakeImpersonations{records: map[int64]*model.Impersonations{5: {Id: 5, ActorId: 1, SubjectId: 2}}}
+	audits := &modeltest.AuditLogs{}
+	interceptor := UnaryServerInterceptor(m, records, audits)
+	info := &grpc.UnaryServerInfo{FullMethod: "/iam.UserService/GetUser"}
+
+	var seen *Claims
+	handler := func(ctx context.Context, _ any) (any, error) {
+		seen, _ = FromContext(ctx)
+		return "ok", nil
+	}
+	call := func(token string, h grpc.UnaryHandler) error {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, token))
+		}
+		_, err := interceptor(ctx, nil, info, h)
+		return err
+	}
+
+	// 未携带凭证的调用不做处理
+	if err := call("", handler); err != nil || seen != nil || len(audits.Rows) != 0 {
+		t.Fatalf("plain call = %v, claims %+v, audits %d", err, seen, len(audits.Rows))
+	}
+
+	now := time.Now()
+	token, _ := m.Issue(5, 1, 2, now, now.Add(time.Minute))
+	if err := call(token, handler); err != nil {
+		t.Fatalf("impersonated call: %v", err)
+	}
+	if seen == nil || seen.SubjectId != 2 {
+		t.Fatalf("claims not in context: %+v", seen)
+	}
+	if len(audits.Rows) != 1 || audits.Rows[0].Action != info.FullMethod || audits.Rows[0].Result != codes.OK.String() {
+		t.Fatalf("unexpected audit logs %+v", audits.Rows)
+	}
+
+	// 失败的调用记录错误信息
+	err := call(token, func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.NotFound, "[GU001] User not found")
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("handler error = %v", err)
+	}
+	if last := audits.Rows[len(audits.Rows)-1]; last.Result != codes.NotFound.String() || last.Detail.String != "[GU001] User not found" {
+		t.Fatalf("unexpected failure audit %+v", last)
+	}
+
+	if err := call("garbage", handler); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("garbage token = %v", err)
+	}
+	// 凭证中的用户与记录不一致，或记录已结束
+	forged, _ := m.Issue(5, 1, 3, now, now.Add(time.Minute))
+	if err := call(forged, handler); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("mismatched token = %v", err)
+	}
+	delete(records.records, 5)
+	if err := call(token, handler); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("ended impersonation = %v", err)
+	}
+}
+
+// failingImpersonations 查询模拟登录记录失败
+type failingImpersonations struct {
+	model.ImpersonationsModel
+}
+
+func (failingImpersonations) FindActiveById(context.Context, int64) (*model.Impersonations, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestUnaryServerInterceptorLookupError(t *testing.T) {
+	m := newTestManager()
+	interceptor := UnaryServerInterceptor(m, failingImpersonations{}, &modeltest.AuditLogs{})
+	token, _ := m.Issue(5, 1, 2, time.Now(), time.Now().Add(time.Minute))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, token))
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+		t.Fatalf("handler called")
+		return nil, nil
+	})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("lookup error = %v, want Internal", err)
+	}
+}
+
+func TestUnaryServerInterceptorActorMismatch(t *testing.T) {
+	m := newTestManager()
+	records := &fakeImpersonations{records: map[int64]*model.Impersonations{5: {Id: 5, ActorId: 1, SubjectId: 2}}}
+	interceptor := UnaryServerInterceptor(m, records, &modeltest.AuditLogs{})
+	token, _ := m.Issue(5, 1, 2, time.Now(), time.Now().Add(time.Minute))
+	call := func(principal *rpcauth.Principal) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, token))
+		ctx = rpcauth.WithPrincipal(ctx, principal)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) { return "ok", nil })
+		return err
+	}
+
+	// 其他用户持有的凭证不能使用
+	if err := call(&rpcauth.Principal{Kind: rpcauth.PrincipalUser, UserId: 3}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("other user = %v, want PermissionDenied", err)
+	}
+	if err := call(&rpcauth.Principal{Kind: rpcauth.PrincipalUser, UserId: 1}); err != nil {
+		t.Fatalf("actor: %v", err)
+	}
+	// 已认证的服务调用方代发起人使用凭证
+	if err := call(&rpcauth.Principal{Kind: rpcauth.PrincipalService, Name: "portal"}); err != nil {
+		t.Fatalf("service: %v", err)
+	}
+}
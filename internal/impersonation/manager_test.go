@@ -0,0 +1,90 @@
+package impersonation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/internal/config"
+)
+
+func newTestManager() *Manager {
+	return NewManager(config.ImpersonationConf{Secret: "secret", DefaultTTL: 900, MaxTTL: 3600})
+}
+
+func TestTokenRoundTrip(t *testing.T) {
+	m := newTestManager()
+	now := time.Now()
+	token, err := m.Issue(5, 1, 2, now, now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	claims, err := m.Parse(token)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if claims.ImpersonationId != 5 || claims.ActorId != 1 || claims.SubjectId != 2 || claims.Subject != "2" {
+		t.Fatalf("unexpected claims %+v", claims)
+	}
+}
+
+func TestTokenRejected(t *testing.T) {
+	m := newTestManager()
+	now := time.Now()
+
+	other, _ := NewManager(config.ImpersonationConf{Secret: "other"}).Issue(5, 1, 2, now, now.Add(time.Minute))
+	if _, err := m.Parse(other); err == nil {
+		t.Fatalf("token signed with another secret accepted")
+	}
+
+	expired, _ := m.Issue(5, 1, 2, now.Add(-time.Hour), now.Add(-time.Minute))
+	if _, err := m.Parse(expired); err == nil {
+		t.Fatalf("expired token accepted")
+	}
+
+	// 缺少模拟记录、操作人或被模拟用户的凭证视为格式错误
+	for _, ids := range [][3]int64{{0, 1, 2}, {5, 0, 2}, {5, 1, 0}} {
+		token, _ := m.Issue(ids[0], ids[1], ids[2], now, now.Add(time.Minute))
+		if _, err := m.Parse(token); err == nil {
+			t.Fatalf("token with ids %v accepted", ids)
+		}
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	m := NewManager(config.ImpersonationConf{})
+	if m.Enabled() {
+		t.Fatalf("manager without secret is enabled")
+	}
+	if _, err := m.Issue(5, 1, 2, time.Now(), time.Now().Add(time.Minute)); !errors.Is(err, ErrDisabled) {
+		t.Fatalf("Issue = %v, want ErrDisabled", err)
+	}
+	if _, err := m.Parse("token"); !errors.Is(err, ErrDisabled) {
+		t.Fatalf("Parse = %v, want ErrDisabled", err)
+	}
+}
+
+func TestTTL(t *testing.T) {
+	m := newTestManager()
+	tests := map[int64]time.Duration{
+		0:     900 * time.Second,
+		-1:    900 * time.Second,
+		60:    time.Minute,
+		86400: time.Hour,
+	}
+	for requested, want := range tests {
+		if got := m.TTL(requested); got != want {
+			t.Fatalf("TTL(%d) = %v, want %v", requested, got, want)
+		}
+	}
+}
+
+func TestIsProtectedRole(t *testing.T) {
+	if m := newTestManager(); !m.IsProtectedRole("admin") || m.IsProtectedRole("clerk") {
+		t.Fatalf("default protected roles not applied")
+	}
+	m := NewManager(config.ImpersonationConf{ProtectedRoles: []string{"auditor"}})
+	if m.IsProtectedRole("admin") || !m.IsProtectedRole("auditor") {
+		t.Fatalf("configured protected roles not applied")
+	}
+}
@@ -0,0 +1,179 @@
+package ldap
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/ziptako/iam/internal/config"
+)
+
+const (
+	defaultUserFilter  = "(objectClass=person)"
+	defaultGroupFilter = "(objectClass=groupOfNames)"
+)
+
+// ErrInvalidCredentials 目录拒绝了提供的凭证
+var ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+
+// Conn 目录连接，*goldap.Conn 实现了该接口；测试时可替换为进程内LDAP服务的连接
+type Conn interface {
+	Bind(username, password string) error
+	Search(searchRequest *goldap.SearchRequest) (*goldap.SearchResult, error)
+	Close() error
+}
+
+// Dialer 建立目录连接
+type Dialer func() (Conn, error)
+
+// Entry 目录中的用户条目
+type Entry struct {
+	DN       string
+	Username string
+	Email    string
+	Nickname string
+	Phone    string
+}
+
+// Group 目录中的组条目
+type Group struct {
+	DN      string
+	CN      string
+	Members []string // 成员DN
+}
+
+// Connector LDAP目录连接器，负责绑定认证和条目查询
+type Connector struct {
+	c    config.LdapConf
+	dial Dialer
+}
+
+// NewConnector 使用配置中的目录地址创建连接器
+func NewConnector(c config.LdapConf) *Connector {
+	timeout := time.Duration(c.Timeout) * time.Second
+	return NewConnectorWithDialer(c, func() (Conn, error) {
+		conn, err := goldap.DialURL(c.Url)
+		if err != nil {
+			return nil, err
+		}
+		if timeout > 0 {
+			conn.SetTimeout(timeout)
+		}
+		return conn, nil
+	})
+}
+
+// NewConnectorWithDialer 使用自定义拨号函数创建连接器，便于接入进程内测试服务
+func NewConnectorWithDialer(c config.LdapConf, dial Dialer) *Connector {
+	if c.UserFilter == "" {
+		c.UserFilter = defaultUserFilter
+	}
+	if c.GroupFilter == "" {
+		c.GroupFilter = defaultGroupFilter
+	}
+	if c.GroupBaseDN == "" {
+		c.GroupBaseDN = c.BaseDN
+	}
+	return &Connector{
+		c:    c,
+		dial: dial,
+	}
+}
+
+// Config 返回连接器使用的配置（已填充默认值）
+func (c *Connector) Config() config.LdapConf {
+	return c.c
+}
+
+// Authenticate 使用用户DN和密码进行目录绑定认证
+// 凭证错误时返回 ErrInvalidCredentials，其余错误表示目录不可用
+func (c *Connector) Authenticate(dn, password string) error {
+	// 空密码会被部分目录视为匿名绑定而成功，必须提前拒绝
+	if strings.TrimSpace(dn) == "" || password == "" {
+		return ErrInvalidCredentials
+	}
+
+	conn, err := c.dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.Bind(dn, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return ErrInvalidCredentials
+		}
+		return err
+	}
+	return nil
+}
+
+// SearchUsers 查询目录中所有匹配过滤条件的用户
+func (c *Connector) SearchUsers() ([]*Entry, error) {
+	attrs := []string{c.c.UsernameAttr, c.c.EmailAttr, c.c.NicknameAttr, c.c.PhoneAttr}
+	result, err := c.search(c.c.BaseDN, c.c.UserFilter, attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*Entry, 0, len(result.Entries))
+	for _, e := range result.Entries {
+		entries = append(entries, &Entry{
+			DN:       e.DN,
+			Username: strings.TrimSpace(e.GetAttributeValue(c.c.UsernameAttr)),
+			Email:    strings.TrimSpace(e.GetAttributeValue(c.c.EmailAttr)),
+			Nickname: strings.TrimSpace(e.GetAttributeValue(c.c.NicknameAttr)),
+			Phone:    strings.TrimSpace(e.GetAttributeValue(c.c.PhoneAttr)),
+		})
+	}
+	return entries, nil
+}
+
+// SearchGroups 查询目录中所有匹配过滤条件的组
+func (c *Connector) SearchGroups() ([]*Group, error) {
+	result, err := c.search(c.c.GroupBaseDN, c.c.GroupFilter, []string{"cn", c.c.GroupMemberAttr})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*Group, 0, len(result.Entries))
+	for _, e := range result.Entries {
+		groups = append(groups, &Group{
+			DN:      e.DN,
+			CN:      e.GetAttributeValue("cn"),
+			Members: e.GetAttributeValues(c.c.GroupMemberAttr),
+		})
+	}
+	return groups, nil
+}
+
+// search 以服务账号绑定后执行子树搜索
+func (c *Connector) search(baseDN, filter string, attrs []string) (*goldap.SearchResult, error) {
+	conn, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if c.c.BindDN != "" {
+		if err := conn.Bind(c.c.BindDN, c.c.BindPassword); err != nil {
+			return nil, fmt.Errorf("ldap: service bind failed: %w", err)
+		}
+	}
+
+	req := goldap.NewSearchRequest(
+		baseDN,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 0, int(c.c.Timeout), false,
+		filter,
+		attrs,
+		nil,
+	)
+	return conn.Search(req)
+}
+
+// NormalizeDN 规范化DN用于比较（DN大小写不敏感）
+func NormalizeDN(dn string) string {
+	return strings.ToLower(strings.TrimSpace(dn))
+}
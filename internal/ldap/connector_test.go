@@ -0,0 +1,144 @@
+package ldap
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ziptako/iam/internal/config"
+)
+
+const (
+	testBaseDN      = "dc=example,dc=com"
+	testPeopleDN    = "ou=people," + testBaseDN
+	testGroupsDN    = "ou=groups," + testBaseDN
+	testBindDN      = "cn=admin," + testBaseDN
+	testBindSecret  = "admin-secret"
+	testAliceDN     = "uid=alice," + testPeopleDN
+	testBobDN       = "uid=bob," + testPeopleDN
+	testCarolDN     = "uid=carol," + testPeopleDN
+	testAdminsDN    = "cn=admins," + testGroupsDN
+	testDevelopers  = "cn=developers," + testGroupsDN
+	testAlicePasswd = "alice-secret"
+)
+
+// testConf 与配置文件默认值一致的目录配置
+func testConf() config.LdapConf {
+	return config.LdapConf{
+		Enabled:         true,
+		BindDN:          testBindDN,
+		BindPassword:    testBindSecret,
+		BaseDN:          testPeopleDN,
+		GroupBaseDN:     testGroupsDN,
+		UsernameAttr:    "uid",
+		EmailAttr:       "mail",
+		NicknameAttr:    "cn",
+		PhoneAttr:       "telephoneNumber",
+		GroupMemberAttr: "member",
+		TenantId:        1,
+	}
+}
+
+// testDirectory 包含alice、bob两个用户和admins组的目录
+func testDirectory() *directory {
+	d := newDirectory()
+	d.setPassword(testBindDN, testBindSecret)
+	d.addUser(testAliceDN, "alice", "alice@example.com", "Alice", testAlicePasswd)
+	d.addUser(testBobDN, "bob", "", "Bob", "")
+	d.addGroup(testAdminsDN, "admins", testAliceDN)
+	return d
+}
+
+func TestAuthenticate(t *testing.T) {
+	d := testDirectory()
+	c := NewConnectorWithDialer(testConf(), d.dial)
+
+	if err := c.Authenticate(testAliceDN, testAlicePasswd); err != nil {
+		t.Fatalf("Authenticate with valid credentials: %v", err)
+	}
+	// DN大小写不敏感
+	if err := c.Authenticate("UID=Alice,OU=People,DC=Example,DC=Com", testAlicePasswd); err != nil {
+		t.Fatalf("Authenticate with differently cased DN: %v", err)
+	}
+	if err := c.Authenticate(testAliceDN, "wrong"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("Authenticate with wrong password = %v, want ErrInvalidCredentials", err)
+	}
+	if err := c.Authenticate(testBobDN, "anything"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("Authenticate user without password = %v, want ErrInvalidCredentials", err)
+	}
+
+	// 空密码和空DN在连接目录前拒绝，避免被当作匿名绑定
+	dials := d.dials
+	if err := c.Authenticate(testAliceDN, ""); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("Authenticate with empty password = %v, want ErrInvalidCredentials", err)
+	}
+	if err := c.Authenticate(" ", testAlicePasswd); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("Authenticate with empty DN = %v, want ErrInvalidCredentials", err)
+	}
+	if d.dials != dials {
+		t.Fatalf("empty credentials dialed the directory")
+	}
+}
+
+func TestAuthenticateDialError(t *testing.T) {
+	dialErr := errors.New("connection refused")
+	c := NewConnectorWithDialer(testConf(), func() (Conn, error) { return nil, dialErr })
+	if err := c.Authenticate(testAliceDN, testAlicePasswd); !errors.Is(err, dialErr) {
+		t.Fatalf("Authenticate = %v, want dial error", err)
+	}
+}
+
+func TestSearchUsers(t *testing.T) {
+	d := testDirectory()
+	d.addUser("uid=outsider,ou=contractors,"+testBaseDN, "outsider", "", "Outsider", "")
+	c := NewConnectorWithDialer(testConf(), d.dial)
+
+	entries, err := c.SearchUsers()
+	if err != nil {
+		t.Fatalf("SearchUsers: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("SearchUsers returned %d entries, want 2 under the base DN", len(entries))
+	}
+	alice := entries[0]
+	if alice.DN != testAliceDN || alice.Username != "alice" || alice.Email != "alice@example.com" || alice.Nickname != "Alice" {
+		t.Fatalf("unexpected entry %+v", alice)
+	}
+	if entries[1].Email != "" {
+		t.Fatalf("missing mail attribute mapped to %q", entries[1].Email)
+	}
+}
+
+func TestSearchRequiresServiceBind(t *testing.T) {
+	conf := testConf()
+	conf.BindPassword = "wrong"
+	c := NewConnectorWithDialer(conf, testDirectory().dial)
+	if _, err := c.SearchUsers(); err == nil {
+		t.Fatalf("SearchUsers with wrong service password succeeded")
+	}
+}
+
+func TestSearchGroups(t *testing.T) {
+	d := testDirectory()
+	d.addGroup(testDevelopers, "developers", testAliceDN, testBobDN)
+	c := NewConnectorWithDialer(testConf(), d.dial)
+
+	groups, err := c.SearchGroups()
+	if err != nil {
+		t.Fatalf("SearchGroups: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("SearchGroups returned %d groups, want 2", len(groups))
+	}
+	if groups[1].CN != "developers" || len(groups[1].Members) != 2 {
+		t.Fatalf("unexpected group %+v", groups[1])
+	}
+}
+
+func TestConnectorDefaults(t *testing.T) {
+	conf := testConf()
+	conf.GroupBaseDN = ""
+	c := NewConnectorWithDialer(conf, nil).Config()
+	if c.UserFilter != defaultUserFilter || c.GroupFilter != defaultGroupFilter || c.GroupBaseDN != conf.BaseDN {
+		t.Fatalf("defaults not applied: %+v", c)
+	}
+}
@@ -0,0 +1,183 @@
+package ldap
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// directory 进程内的LDAP目录，通过Dialer接入连接器，支持简单绑定和等值/与过滤条件的子树搜索
+type directory struct {
+	mu        sync.Mutex
+	entries   []*goldap.Entry
+	passwords map[string]string // 规范化DN -> 密码
+	dials     int
+}
+
+func newDirectory() *directory {
+	return &directory{passwords: make(map[string]string)}
+}
+
+// addUser 添加用户条目，password为空时该用户不能绑定
+func (d *directory) addUser(dn, uid, mail, cn, password string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	attrs := map[string][]string{
+		"objectClass": {"top", "person"},
+		"uid":         {uid},
+		"cn":          {cn},
+	}
+	if mail != "" {
+		attrs["mail"] = []string{mail}
+	}
+	d.entries = append(d.entries, goldap.NewEntry(dn, attrs))
+	if password != "" {
+		d.passwords[NormalizeDN(dn)] = password
+	}
+}
+
+// addGroup 添加groupOfNames组条目
+func (d *directory) addGroup(dn, cn string, members ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries = append(d.entries, goldap.NewEntry(dn, map[string][]string{
+		"objectClass": {"top", "groupOfNames"},
+		"cn":          {cn},
+		"member":      members,
+	}))
+}
+
+// removeEntry 删除条目
+func (d *directory) removeEntry(dn string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, e := range d.entries {
+		if NormalizeDN(e.DN) == NormalizeDN(dn) {
+			d.entries = append(d.entries[:i], d.entries[i+1:]...)
+			return
+		}
+	}
+}
+
+// setPassword 设置服务账号等非用户条目的绑定密码
+func (d *directory) setPassword(dn, password string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.passwords[NormalizeDN(dn)] = password
+}
+
+// dial 实现Dialer
+func (d *directory) dial() (Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dials++
+	return &directoryConn{d: d}, nil
+}
+
+// directoryConn 目录连接，未绑定时拒绝搜索
+type directoryConn struct {
+	d     *directory
+	bound bool
+}
+
+func (c *directoryConn) Bind(username, password string) error {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	expected, ok := c.d.passwords[NormalizeDN(username)]
+	if !ok || password == "" || expected != password {
+		return goldap.NewError(goldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+	}
+	c.bound = true
+	return nil
+}
+
+func (c *directoryConn) Search(req *goldap.SearchRequest) (*goldap.SearchResult, error) {
+	if !c.bound {
+		return nil, goldap.NewError(goldap.LDAPResultInsufficientAccessRights, errors.New("bind required"))
+	}
+	match, err := compileFilter(req.Filter)
+	if err != nil {
+		return nil, goldap.NewError(goldap.LDAPResultFilterError, err)
+	}
+
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	base := NormalizeDN(req.BaseDN)
+	result := &goldap.SearchResult{}
+	for _, e := range c.d.entries {
+		dn := NormalizeDN(e.DN)
+		if dn != base && !strings.HasSuffix(dn, ","+base) {
+			continue
+		}
+		if !match(e) {
+			continue
+		}
+		// 只返回请求的属性
+		attrs := make(map[string][]string, len(req.Attributes))
+		for _, name := range req.Attributes {
+			if values := e.GetAttributeValues(name); len(values) > 0 {
+				attrs[name] = values
+			}
+		}
+		result.Entries = append(result.Entries, goldap.NewEntry(e.DN, attrs))
+	}
+	return result, nil
+}
+
+func (c *directoryConn) Close() error {
+	return nil
+}
+
+// compileFilter 解析 (attr=value) 和 (&(...)(...)) 形式的过滤条件，属性名和值大小写不敏感
+func compileFilter(filter string) (func(*goldap.Entry) bool, error) {
+	filter = strings.TrimSpace(filter)
+	if !strings.HasPrefix(filter, "(") || !strings.HasSuffix(filter, ")") {
+		return nil, fmt.Errorf("invalid filter %q", filter)
+	}
+	inner := filter[1 : len(filter)-1]
+	if strings.HasPrefix(inner, "&") {
+		var parts []func(*goldap.Entry) bool
+		rest := inner[1:]
+		for rest != "" {
+			end := strings.Index(rest, ")")
+			if !strings.HasPrefix(rest, "(") || end < 0 {
+				return nil, fmt.Errorf("invalid filter %q", filter)
+			}
+			part, err := compileFilter(rest[:end+1])
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, part)
+			rest = rest[end+1:]
+		}
+		return func(e *goldap.Entry) bool {
+			for _, part := range parts {
+				if !part(e) {
+					return false
+				}
+			}
+			return true
+		}, nil
+	}
+
+	attr, value, ok := strings.Cut(inner, "=")
+	if !ok || attr == "" {
+		return nil, fmt.Errorf("invalid filter %q", filter)
+	}
+	return func(e *goldap.Entry) bool {
+		for _, a := range e.Attributes {
+			if !strings.EqualFold(a.Name, attr) {
+				continue
+			}
+			for _, v := range a.Values {
+				if value == "*" || strings.EqualFold(v, value) {
+					return true
+				}
+			}
+		}
+		return false
+	}, nil
+}
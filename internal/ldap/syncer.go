@@ -0,0 +1,455 @@
+package ldap
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/sod"
+	"github.com/ziptako/iam/internal/utils"
+)
+
+// 同步动作
+const (
+	ActionCreate  = "create"  // 新建用户
+	ActionUpdate  = "update"  // 更新用户资料
+	ActionDisable = "disable" // 目录中已不存在，禁用用户
+	ActionEnable  = "enable"  // 重新出现在目录中，启用用户
+	ActionRoles   = "roles"   // 按组映射增删用户角色
+	ActionSkip    = "skip"    // 无法处理的条目
+)
+
+// SyncItem 同步明细
+type SyncItem struct {
+	Action     string
+	Username   string
+	ExternalId string
+	Detail     string
+}
+
+// SyncReport 同步报告，DryRun为true时报告中的动作均未实际执行
+type SyncReport struct {
+	DryRun      bool
+	Created     int
+	Updated     int
+	Disabled    int
+	Enabled     int
+	RoleChanged int
+	Skipped     int
+	Items       []*SyncItem
+}
+
+func (r *SyncReport) add(item *SyncItem) {
+	switch item.Action {
+	case ActionCreate:
+		r.Created++
+	case ActionUpdate:
+		r.Updated++
+	case ActionDisable:
+		r.Disabled++
+	case ActionEnable:
+		r.Enabled++
+	case ActionRoles:
+		r.RoleChanged++
+	case ActionSkip:
+		r.Skipped++
+	}
+	r.Items = append(r.Items, item)
+}
+
+// String 报告摘要
+func (r *SyncReport) String() string {
+	return fmt.Sprintf("dryRun=%v created=%d updated=%d disabled=%d enabled=%d roleChanged=%d skipped=%d",
+		r.DryRun, r.Created, r.Updated, r.Disabled, r.Enabled, r.RoleChanged, r.Skipped)
+}
+
+// Syncer 将LDAP目录中的用户和组同步到iam.users及用户角色
+type Syncer struct {
+	connector      *Connector
+	usersModel     model.UsersModel
+	rolesModel     model.RolesModel
+	userRolesModel model.UserRolesModel
+	sessionsModel  model.SessionsModel
+	constraints    *sod.Checker
+
+	lock sync.Mutex // 防止定时任务与手动触发并发执行
+	done chan struct{}
+}
+
+// NewSyncer 创建同步器
+func NewSyncer(connector *Connector, usersModel model.UsersModel, rolesModel model.RolesModel, userRolesModel model.UserRolesModel,
+	sessionsModel model.SessionsModel, constraints *sod.Checker) *Syncer {
+	return &Syncer{
+		connector:      connector,
+		usersModel:     usersModel,
+		rolesModel:     rolesModel,
+		userRolesModel: userRolesModel,
+		sessionsModel:  sessionsModel,
+		constraints:    constraints,
+	}
+}
+
+// Start 按配置的间隔启动定时同步，间隔为0时不启动
+func (s *Syncer) Start() {
+	c := s.connector.Config()
+	if c.SyncInterval <= 0 {
+		return
+	}
+
+	s.done = make(chan struct{})
+	ticker := time.NewTicker(time.Duration(c.SyncInterval) * time.Second)
+	threading.GoSafe(func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				report, err := s.Sync(context.Background(), c.DryRun)
+				if err != nil {
+					logx.Errorf("[LDAP] 定时同步失败: %v", err)
+					continue
+				}
+				logx.Infof("[LDAP] 定时同步完成: %s", report)
+			case <-s.done:
+				return
+			}
+		}
+	})
+}
+
+// Stop 停止定时同步
+func (s *Syncer) Stop() {
+	if s.done != nil {
+		close(s.done)
+		s.done = nil
+	}
+}
+
+// Sync 执行一次同步，dryRun为true时只生成报告
+// 目录中的用户和组同步到配置的租户
+func (s *Syncer) Sync(ctx context.Context, dryRun bool) (*SyncReport, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	c := s.connector.Config()
+	ctx = model.WithTenantId(ctx, c.TenantId)
+	report := &SyncReport{DryRun: dryRun}
+
+	entries, err := s.connector.SearchUsers()
+	if err != nil {
+		return nil, fmt.Errorf("search users: %w", err)
+	}
+
+	// 组成员DN -> 角色ID，managed为组映射涉及的全部角色，同步只增删这些角色
+	var memberRoles map[string][]int64
+	var managed map[int64]bool
+	if len(c.GroupRoles) > 0 {
+		memberRoles, managed, err = s.resolveMemberRoles(ctx, report)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	locals, err := s.usersModel.FindBySource(ctx, model.UserSourceLdap)
+	if err != nil {
+		return nil, fmt.Errorf("find ldap users: %w", err)
+	}
+	localByDN := make(map[string]*model.Users, len(locals))
+	for _, u := range locals {
+		localByDN[NormalizeDN(u.ExternalId.String)] = u
+	}
+
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		key := NormalizeDN(entry.DN)
+		seen[key] = true
+		if entry.Username == "" {
+			report.add(&SyncItem{Action: ActionSkip, ExternalId: entry.DN, Detail: "missing username attribute"})
+			continue
+		}
+
+		user, ok := localByDN[key]
+		if ok {
+			if err := s.syncExisting(ctx, report, user, entry, dryRun); err != nil {
+				return nil, err
+			}
+		} else {
+			user, err = s.syncNew(ctx, report, entry, dryRun)
+			if err != nil {
+				return nil, err
+			}
+			if user == nil {
+				continue
+			}
+		}
+
+		if memberRoles != nil {
+			// 在职责分离锁内校验并同步角色，避免与并发的授予交错
+			err := s.constraints.Locked(ctx, func(ctx context.Context) error {
+				return s.syncRoles(ctx, report, user, entry, managed, memberRoles[key], dryRun)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// 目录未返回任何用户时多半是过滤条件或BaseDN配置错误，不做禁用，避免误禁用全部LDAP用户
+	if len(entries) == 0 {
+		if len(localByDN) > 0 {
+			logx.WithContext(ctx).Errorf("[LDAP] 目录未返回任何用户，跳过禁用 %d 个本地LDAP用户，请检查UserFilter和BaseDN", len(localByDN))
+			report.add(&SyncItem{Action: ActionSkip, Detail: "directory returned no users, disabling skipped"})
+		}
+		return report, nil
+	}
+
+	// 目录中已不存在的用户：禁用并记录原因，重新出现时只启用由同步禁用的用户
+	for key, user := range localByDN {
+		if seen[key] || user.DisabledAt.Valid {
+			continue
+		}
+		report.add(&SyncItem{Action: ActionDisable, Username: user.Username, ExternalId: user.ExternalId.String})
+		if dryRun {
+			continue
+		}
+		if err := s.usersModel.DisableWithReason(ctx, user.Id, model.UserDisabledLdapSync); err != nil {
+			return nil, fmt.Errorf("disable user %d: %w", user.Id, err)
+		}
+		if _, err := s.sessionsModel.RevokeAllByUserId(ctx, user.Id, model.SessionRevokeUserDisabled); err != nil {
+			return nil, fmt.Errorf("revoke sessions of user %d: %w", user.Id, err)
+		}
+	}
+
+	return report, nil
+}
+
+// resolveMemberRoles 查询组并按GroupRoles映射为成员DN对应的角色ID，同时返回映射涉及的全部角色
+func (s *Syncer) resolveMemberRoles(ctx context.Context, report *SyncReport) (map[string][]int64, map[int64]bool, error) {
+	c := s.connector.Config()
+	groups, err := s.connector.SearchGroups()
+	if err != nil {
+		return nil, nil, fmt.Errorf("search groups: %w", err)
+	}
+
+	roleIds := make(map[string]int64, len(c.GroupRoles))
+	managed := make(map[int64]bool, len(c.GroupRoles))
+	for cn, code := range c.GroupRoles {
+		role, err := s.rolesModel.FindActiveByCode(ctx, code)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				report.add(&SyncItem{Action: ActionSkip, ExternalId: cn, Detail: fmt.Sprintf("role %q not found", code)})
+				continue
+			}
+			return nil, nil, fmt.Errorf("find role %q: %w", code, err)
+		}
+		roleIds[cn] = role.Id
+		managed[role.Id] = true
+	}
+
+	memberRoles := make(map[string][]int64)
+	for _, g := range groups {
+		roleId, ok := roleIds[g.CN]
+		if !ok {
+			continue
+		}
+		for _, member := range g.Members {
+			key := NormalizeDN(member)
+			memberRoles[key] = append(memberRoles[key], roleId)
+		}
+	}
+	return memberRoles, managed, nil
+}
+
+// syncExisting 对比并更新已同步过的用户资料，之前因不在目录中而禁用的用户重新出现时启用
+// 手动禁用的用户保持禁用
+func (s *Syncer) syncExisting(ctx context.Context, report *SyncReport, user *model.Users, entry *Entry, dryRun bool) error {
+	if user.DisabledAt.Valid && user.DisabledReason.String == model.UserDisabledLdapSync {
+		report.add(&SyncItem{Action: ActionEnable, Username: user.Username, ExternalId: entry.DN})
+		if !dryRun {
+			if err := s.usersModel.Enable(ctx, user.Id); err != nil {
+				return fmt.Errorf("enable user %d: %w", user.Id, err)
+			}
+		}
+		user.DisabledAt = sql.NullTime{}
+		user.DisabledReason = sql.NullString{}
+	}
+
+	var changes []string
+	if user.Username != entry.Username {
+		exists, err := s.usersModel.ExistsByUsername(ctx, entry.Username, user.Id)
+		if err != nil {
+			return fmt.Errorf("check username %q: %w", entry.Username, err)
+		}
+		if exists {
+			report.add(&SyncItem{Action: ActionSkip, Username: entry.Username, ExternalId: entry.DN, Detail: "username already used by another user"})
+			return nil
+		}
+		changes = append(changes, "username")
+		user.Username = entry.Username
+	}
+	if user.SetEmail(entry.Email) {
+		changes = append(changes, "email")
+	}
+	if user.Nickname.String != entry.Nickname {
+		changes = append(changes, "nickname")
+		user.Nickname = sql.NullString{String: entry.Nickname, Valid: entry.Nickname != ""}
+	}
+	if user.SetPhone(entry.Phone) {
+		changes = append(changes, "phone")
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	report.add(&SyncItem{Action: ActionUpdate, Username: entry.Username, ExternalId: entry.DN, Detail: fmt.Sprintf("changed: %v", changes)})
+	if dryRun {
+		return nil
+	}
+	if err := s.usersModel.Update(ctx, user); err != nil {
+		return fmt.Errorf("update user %d: %w", user.Id, err)
+	}
+	return nil
+}
+
+// syncNew 为目录中的新用户创建本地账号，冲突时跳过并返回nil
+func (s *Syncer) syncNew(ctx context.Context, report *SyncReport, entry *Entry, dryRun bool) (*model.Users, error) {
+	// 用户名被本地用户占用时不接管，避免覆盖本地账号
+	exists, err := s.usersModel.ExistsByUsername(ctx, entry.Username, 0)
+	if err != nil {
+		return nil, fmt.Errorf("check username %q: %w", entry.Username, err)
+	}
+	if exists {
+		report.add(&SyncItem{Action: ActionSkip, Username: entry.Username, ExternalId: entry.DN, Detail: "username already used by another user"})
+		return nil, nil
+	}
+	if entry.Email != "" {
+		exists, err = s.usersModel.ExistsByEmail(ctx, entry.Email, 0)
+		if err != nil {
+			return nil, fmt.Errorf("check email %q: %w", entry.Email, err)
+		}
+		if exists {
+			report.add(&SyncItem{Action: ActionSkip, Username: entry.Username, ExternalId: entry.DN, Detail: "email already used by another user"})
+			return nil, nil
+		}
+	}
+
+	report.add(&SyncItem{Action: ActionCreate, Username: entry.Username, ExternalId: entry.DN})
+
+	user := &model.Users{
+		Username:   entry.Username,
+		Email:      sql.NullString{String: entry.Email, Valid: entry.Email != ""},
+		Nickname:   sql.NullString{String: entry.Nickname, Valid: entry.Nickname != ""},
+		Phone:      sql.NullString{String: entry.Phone, Valid: entry.Phone != ""},
+		Source:     model.UserSourceLdap,
+		ExternalId: sql.NullString{String: entry.DN, Valid: true},
+	}
+	if dryRun {
+		return user, nil
+	}
+
+	// LDAP用户通过目录绑定认证，本地密码为不可用的随机值
+	salt, err := utils.GenerateSalt()
+	if err != nil {
+		return nil, err
+	}
+	random, err := utils.GenerateSalt()
+	if err != nil {
+		return nil, err
+	}
+	user.Salt = salt
+	user.PasswordHash = utils.HashPasswordWithSalt(random, salt)
+
+	if _, err := s.usersModel.Insert(ctx, user); err != nil {
+		return nil, fmt.Errorf("create user %q: %w", entry.Username, err)
+	}
+	return user, nil
+}
+
+// syncRoles 按组映射增删用户角色，只移除组映射涉及的角色，其他途径分配的角色保持不变
+// 新增的角色需满足职责分离约束，违反时不分配本次新增的角色
+func (s *Syncer) syncRoles(ctx context.Context, report *SyncReport, user *model.Users, entry *Entry, managed map[int64]bool, roleIds []int64, dryRun bool) error {
+	desired := uniqueSorted(roleIds)
+
+	current := make(map[int64]bool)
+	if user.Id > 0 {
+		userRoles, err := s.userRolesModel.FindRolesByUserId(ctx, user.Id)
+		if err != nil {
+			return fmt.Errorf("find roles of user %d: %w", user.Id, err)
+		}
+		for _, ur := range userRoles {
+			current[ur.RoleId] = true
+		}
+	}
+
+	var added, removed []int64
+	wanted := make(map[int64]bool, len(desired))
+	for _, roleId := range desired {
+		wanted[roleId] = true
+		if !current[roleId] {
+			added = append(added, roleId)
+		}
+	}
+	for roleId := range current {
+		if managed[roleId] && !wanted[roleId] {
+			removed = append(removed, roleId)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	removed = uniqueSorted(removed)
+
+	// 离开组的角色总是移除，先移除再校验新增角色，避免换组时与即将移除的角色误判冲突
+	if len(removed) > 0 && !dryRun {
+		if err := s.userRolesModel.RemoveRoles(ctx, user.Id, removed); err != nil {
+			return fmt.Errorf("remove roles of user %d: %w", user.Id, err)
+		}
+	}
+	if len(added) > 0 {
+		grants := make([]sod.Grant, len(added))
+		for i, roleId := range added {
+			grants[i] = sod.Grant{UserId: user.Id, RoleId: roleId}
+		}
+		violation, err := s.constraints.Check(ctx, grants)
+		if err != nil {
+			return fmt.Errorf("check constraints of user %d: %w", user.Id, err)
+		}
+		if violation != nil {
+			report.add(&SyncItem{Action: ActionSkip, Username: entry.Username, ExternalId: entry.DN,
+				Detail: fmt.Sprintf("roles %v not assigned, separation of duty constraint violated: %v", added, violation)})
+			added = nil
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	report.add(&SyncItem{Action: ActionRoles, Username: entry.Username, ExternalId: entry.DN, Detail: fmt.Sprintf("+%v -%v", added, removed)})
+	if len(added) == 0 || dryRun {
+		return nil
+	}
+	if err := s.userRolesModel.AssignRoles(ctx, user.Id, added, sql.NullInt64{}, model.Validity{}); err != nil {
+		return fmt.Errorf("assign roles of user %d: %w", user.Id, err)
+	}
+	return nil
+}
+
+func uniqueSorted(ids []int64) []int64 {
+	set := make(map[int64]struct{}, len(ids))
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := set[id]; ok {
+			continue
+		}
+		set[id] = struct{}{}
+		res = append(res, id)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
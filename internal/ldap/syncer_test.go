@@ -0,0 +1,245 @@
+package ldap
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/internal/sod"
+)
+
+type syncFixture struct {
+	dir       *directory
+	users     *modeltest.Users
+	userRoles *modeltest.UserRoles
+	sessions  *modeltest.Sessions
+	syncer    *Syncer
+}
+
+// newSyncFixture 目录中有alice和bob，本地已同步过alice（旧邮箱）和已离开目录的dave，另有本地用户local
+// 角色admin与auditor互斥，auditor不在组映射中
+func newSyncFixture(groupRoles map[string]string) *syncFixture {
+	f := &syncFixture{
+		dir: testDirectory(),
+		users: &modeltest.Users{Rows: []*model.Users{
+			{Id: 1, Username: "alice", Email: modeltest.NullString("old@example.com"), Nickname: modeltest.NullString("Alice"),
+				Source: model.UserSourceLdap, ExternalId: modeltest.NullString(testAliceDN)},
+			{Id: 2, Username: "dave", Source: model.UserSourceLdap, ExternalId: modeltest.NullString("uid=dave," + testPeopleDN)},
+			{Id: 3, Username: "local", Source: model.UserSourceLocal},
+		}},
+		userRoles: &modeltest.UserRoles{},
+		sessions:  &modeltest.Sessions{Rows: []*model.Sessions{{Id: 1, UserId: 1}, {Id: 2, UserId: 2}}},
+	}
+	conf := testConf()
+	conf.GroupRoles = groupRoles
+	roles := &modeltest.Roles{Rows: []*model.Roles{{Id: 10, Code: "admin"}, {Id: 11, Code: "developer"}, {Id: 12, Code: "auditor"}}}
+	constraints := &modeltest.RoleConstraints{Rows: []*model.RoleConstraints{
+		{Id: 1, Code: "admin_auditor", ConstraintType: model.RoleConstraintExclusive, Enforcement: model.RoleConstraintStatic, MaxCount: 1},
+	}}
+	constraintRoles := &modeltest.RoleConstraintRoles{Rows: []*model.RoleConstraintRoles{{ConstraintId: 1, RoleId: 10}, {ConstraintId: 1, RoleId: 12}}}
+	checker := sod.NewChecker(constraints, constraintRoles, f.userRoles, &modeltest.GroupRoles{Members: &modeltest.GroupMembers{}})
+	f.syncer = NewSyncer(NewConnectorWithDialer(conf, f.dir.dial), f.users, roles, f.userRoles, f.sessions, checker)
+	return f
+}
+
+// roleIds 用户直接分配的角色ID，按ID排序
+func (f *syncFixture) roleIds(userId int64) []int64 {
+	var res []int64
+	for _, ur := range f.userRoles.Rows {
+		if ur.UserId == userId {
+			res = append(res, ur.RoleId)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+// revoked 会话已被撤销的用户
+func (f *syncFixture) revoked() []int64 {
+	var res []int64
+	for _, s := range f.sessions.Rows {
+		if s.RevokedAt.Valid {
+			res = append(res, s.UserId)
+		}
+	}
+	return res
+}
+
+func (f *syncFixture) sync(t *testing.T, dryRun bool) *SyncReport {
+	t.Helper()
+	report, err := f.syncer.Sync(context.Background(), dryRun)
+	if err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+	return report
+}
+
+func TestSyncCreateUpdateDisable(t *testing.T) {
+	f := newSyncFixture(nil)
+	report := f.sync(t, false)
+
+	if report.Created != 1 || report.Updated != 1 || report.Disabled != 1 || report.Skipped != 0 {
+		t.Fatalf("unexpected report %s", report)
+	}
+	bob := f.users.RowByUsername("bob")
+	if bob == nil || bob.Source != model.UserSourceLdap || bob.ExternalId.String != testBobDN || bob.Nickname.String != "Bob" {
+		t.Fatalf("bob not created from directory: %+v", bob)
+	}
+	if bob.PasswordHash == "" || bob.Salt == "" {
+		t.Fatalf("created user has no unusable local password")
+	}
+	if alice := f.users.Row(1); alice.Email.String != "alice@example.com" {
+		t.Fatalf("alice email = %q, want updated from directory", alice.Email.String)
+	}
+	if dave := f.users.Row(2); !dave.DisabledAt.Valid || dave.DisabledReason.String != model.UserDisabledLdapSync {
+		t.Fatalf("dave was not disabled by sync after leaving the directory: %+v", dave)
+	}
+	if got := f.revoked(); !slices.Equal(got, []int64{2}) {
+		t.Fatalf("revoked sessions of %v, want [2]", got)
+	}
+	if f.users.Row(3).DisabledAt.Valid {
+		t.Fatalf("local user was disabled by LDAP sync")
+	}
+
+	// 再次同步没有变化
+	if report = f.sync(t, false); len(report.Items) != 0 {
+		t.Fatalf("second sync reported changes: %s", report)
+	}
+}
+
+func TestSyncDryRun(t *testing.T) {
+	f := newSyncFixture(map[string]string{"admins": "admin"})
+	before := len(f.users.Rows)
+	report := f.sync(t, true)
+
+	if !report.DryRun || report.Created != 1 || report.Updated != 1 || report.Disabled != 1 || report.RoleChanged != 1 {
+		t.Fatalf("unexpected dry run report %s", report)
+	}
+	if len(f.users.Rows) != before {
+		t.Fatalf("dry run created users")
+	}
+	if f.users.Row(1).Email.String != "old@example.com" || f.users.Row(2).DisabledAt.Valid {
+		t.Fatalf("dry run modified users")
+	}
+	if len(f.userRoles.Rows) != 0 || len(f.revoked()) != 0 {
+		t.Fatalf("dry run replaced roles or revoked sessions")
+	}
+}
+
+func TestSyncGroupRoles(t *testing.T) {
+	f := newSyncFixture(map[string]string{"admins": "admin", "developers": "developer", "ops": "missing"})
+	f.dir.addGroup(testDevelopers, "developers", testAliceDN, testBobDN)
+	report := f.sync(t, false)
+
+	// 映射到不存在的角色时跳过该映射
+	if report.Skipped != 1 || report.RoleChanged != 2 {
+		t.Fatalf("unexpected report %s", report)
+	}
+	if got := f.roleIds(1); !slices.Equal(got, []int64{10, 11}) {
+		t.Fatalf("alice roles = %v, want [10 11]", got)
+	}
+	bob := f.users.RowByUsername("bob")
+	if got := f.roleIds(bob.Id); !slices.Equal(got, []int64{11}) {
+		t.Fatalf("bob roles = %v, want [11]", got)
+	}
+
+	// 离开组后角色被移除
+	f.dir.removeEntry(testAdminsDN)
+	f.sync(t, false)
+	if got := f.roleIds(1); !slices.Equal(got, []int64{11}) {
+		t.Fatalf("alice roles after leaving admins = %v, want [11]", got)
+	}
+
+	// 角色未变化时不写库
+	before := slices.Clone(f.userRoles.Rows)
+	f.sync(t, false)
+	if !slices.Equal(f.userRoles.Rows, before) {
+		t.Fatalf("unchanged roles were written again")
+	}
+}
+
+func TestSyncGroupRolesKeepUnmanaged(t *testing.T) {
+	f := newSyncFixture(map[string]string{"admins": "admin", "developers": "developer"})
+	// alice直接分配了developer，bob直接分配了组映射未涉及的auditor
+	f.userRoles.AssignRoles(context.Background(), 1, []int64{11}, sql.NullInt64{}, model.Validity{})
+	bob := &model.Users{Username: "bob", Source: model.UserSourceLdap, ExternalId: modeltest.NullString(testBobDN)}
+	f.users.Insert(context.Background(), bob)
+	f.userRoles.AssignRoles(context.Background(), bob.Id, []int64{12}, sql.NullInt64{}, model.Validity{})
+
+	f.sync(t, false)
+	// developer由组映射管理，alice不在developers组中时移除
+	if got := f.roleIds(1); !slices.Equal(got, []int64{10}) {
+		t.Fatalf("alice roles = %v, want [10]", got)
+	}
+	if got := f.roleIds(bob.Id); !slices.Equal(got, []int64{12}) {
+		t.Fatalf("bob roles = %v, want unmanaged [12] kept", got)
+	}
+}
+
+func TestSyncGroupRolesConstraint(t *testing.T) {
+	f := newSyncFixture(map[string]string{"admins": "admin", "developers": "developer"})
+	f.dir.addGroup(testDevelopers, "developers", testAliceDN)
+	f.userRoles.AssignRoles(context.Background(), 1, []int64{12}, sql.NullInt64{}, model.Validity{})
+
+	report := f.sync(t, false)
+	// admin与alice已持有的auditor互斥，不分配
+	if report.Skipped != 1 || report.RoleChanged != 0 {
+		t.Fatalf("unexpected report %s", report)
+	}
+	if got := f.roleIds(1); !slices.Equal(got, []int64{12}) {
+		t.Fatalf("alice roles = %v, want [12]", got)
+	}
+}
+
+func TestSyncEmptyResultSkipsDisable(t *testing.T) {
+	f := newSyncFixture(nil)
+	conf := testConf()
+	conf.UserFilter = "(objectClass=nobody)"
+	f.syncer.connector = NewConnectorWithDialer(conf, f.dir.dial)
+
+	report := f.sync(t, false)
+	if report.Disabled != 0 || report.Skipped != 1 {
+		t.Fatalf("unexpected report %s", report)
+	}
+	if f.users.Row(1).DisabledAt.Valid || f.users.Row(2).DisabledAt.Valid || len(f.revoked()) != 0 {
+		t.Fatalf("users were disabled although the directory returned no entries")
+	}
+}
+
+func TestSyncReenablesReturningUser(t *testing.T) {
+	f := newSyncFixture(nil)
+	f.sync(t, false)
+	f.dir.addUser("uid=dave,"+testPeopleDN, "dave", "", "Dave", "")
+
+	report := f.sync(t, false)
+	if report.Enabled != 1 {
+		t.Fatalf("unexpected report %s", report)
+	}
+	if f.users.Row(2).DisabledAt.Valid {
+		t.Fatalf("dave was not re-enabled after returning to the directory")
+	}
+}
+
+func TestSyncKeepsManuallyDisabledUser(t *testing.T) {
+	f := newSyncFixture(nil)
+	f.users.Disable(context.Background(), 1)
+
+	report := f.sync(t, false)
+	if report.Enabled != 0 {
+		t.Fatalf("unexpected report %s", report)
+	}
+	if alice := f.users.Row(1); !alice.DisabledAt.Valid || alice.Email.String != "alice@example.com" {
+		t.Fatalf("manually disabled alice = %+v, want still disabled with updated profile", alice)
+	}
+
+	// 同步禁用后被管理员再次禁用的用户同样不会重新启用
+	f.sync(t, false)
+	f.users.Disable(context.Background(), 2)
+	f.dir.addUser("uid=dave,"+testPeopleDN, "dave", "", "Dave", "")
+	if report = f.sync(t, false); report.Enabled != 0 || !f.users.Row(2).DisabledAt.Valid {
+		t.Fatalf("manually disabled dave was re-enabled: %s", report)
+	}
+}
@@ -0,0 +1,86 @@
+package accessrequestservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/sod"
+	"github.com/ziptako/iam/internal/svc"
+	"time"
+)
+
+// recordEvent 记录访问申请事件，actorId为0表示系统操作
+func recordEvent(ctx context.Context, svcCtx *svc.ServiceContext, requestId, actorId int64, eventType string, step int64, comment string) error {
+	_, err := svcCtx.AccessRequestEventsModel.Insert(ctx, &model.AccessRequestEvents{
+		RequestId: requestId,
+		ActorId:   sql.NullInt64{Int64: actorId, Valid: actorId > 0},
+		EventType: eventType,
+		Step:      step,
+		Comment:   comment,
+	})
+	return err
+}
+
+// accessRequestDetail 转换访问申请并填充事件历史
+func accessRequestDetail(ctx context.Context, svcCtx *svc.ServiceContext, request *model.AccessRequests) (*iam.AccessRequest, error) {
+	events, err := svcCtx.AccessRequestEventsModel.FindByRequestId(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	resp := logic.ModelToProtoAccessRequest(request)
+	resp.Events = logic.ModelAccessRequestEventsToProtoEvents(events)
+	return resp, nil
+}
+
+// requestedValidity 计算审批通过后申请人对角色的有效期，授权时长从当前时间起算
+// 用户已有的直接分配不会被缩短：永久或更晚失效的分配保持不变，尚未生效的分配改为立即生效
+// 返回false表示已有分配无需变更
+func requestedValidity(ctx context.Context, svcCtx *svc.ServiceContext, request *model.AccessRequests, now time.Time) (model.Validity, bool, error) {
+	var validity model.Validity
+	if request.DurationSeconds > 0 {
+		validity.Until = sql.NullTime{Time: now.Add(time.Duration(request.DurationSeconds) * time.Second), Valid: true}
+	}
+
+	existing, err := svcCtx.UserRolesModel.FindOneByUserIdRoleId(ctx, request.RequesterId, request.RoleId)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return model.Validity{}, false, err
+	}
+	if existing != nil {
+		current := existing.Validity()
+		validity = current.Widen(validity, now)
+		if current.Equal(validity) {
+			return validity, false, nil
+		}
+	}
+	return validity, true, nil
+}
+
+// checkRequestedRole 校验授予申请的角色是否违反职责分离约束
+func checkRequestedRole(ctx context.Context, svcCtx *svc.ServiceContext, request *model.AccessRequests, now time.Time) (*sod.Violation, error) {
+	validity, _, err := requestedValidity(ctx, svcCtx, request, now)
+	if err != nil {
+		return nil, err
+	}
+	return svcCtx.Constraints.Check(ctx, []sod.Grant{{UserId: request.RequesterId, RoleId: request.RoleId, Validity: validity}})
+}
+
+// grantRequestedRole 为申请人授予申请的角色
+func grantRequestedRole(ctx context.Context, svcCtx *svc.ServiceContext, request *model.AccessRequests, grantedBy int64, now time.Time) error {
+	validity, changed, err := requestedValidity(ctx, svcCtx, request, now)
+	if err != nil || !changed {
+		return err
+	}
+	return svcCtx.UserRolesModel.AssignRole(ctx, request.RequesterId, request.RoleId, sql.NullInt64{Int64: grantedBy, Valid: true}, validity)
+}
+
+// reloadAccessRequest 重新查询访问申请并填充事件历史，用于返回状态变更后的申请
+func reloadAccessRequest(ctx context.Context, svcCtx *svc.ServiceContext, id int64) (*iam.AccessRequest, error) {
+	request, err := svcCtx.AccessRequestsModel.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return accessRequestDetail(ctx, svcCtx, request)
+}
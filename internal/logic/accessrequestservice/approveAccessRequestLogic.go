@@ -0,0 +1,174 @@
+package accessrequestservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/delegation"
+	"github.com/ziptako/iam/internal/svc"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ApproveAccessRequestLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewApproveAccessRequestLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ApproveAccessRequestLogic {
+	return &ApproveAccessRequestLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ApproveAccessRequest 审批通过当前步骤，最后一步通过后自动授予角色
+func (l *ApproveAccessRequestLogic) ApproveAccessRequest(in *iam.ApproveAccessRequestRequest) (*iam.AccessRequest, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[AAR001] Access request ID is required")
+	}
+	if in.ApproverId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[AAR002] Approver ID is required")
+	}
+	if utf8.RuneCountInString(in.Comment) > 1000 {
+		return nil, status.Error(codes.InvalidArgument, "[AAR003] Comment must not exceed 1000 characters")
+	}
+
+	// 审批人取自已认证的调用人，请求中的审批人必须与调用人一致
+	actorId, ok := delegation.ActorIdFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "[AAR018] Authenticated caller identity is required")
+	}
+	if in.ApproverId != actorId {
+		return nil, status.Error(codes.PermissionDenied, "[AAR019] Approver must be the caller")
+	}
+
+	// 查询申请
+	request, err := l.svcCtx.AccessRequestsModel.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[AAR004] Access request not found")
+		}
+		eInfo := "[AAR005] 查询访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if request.Status != model.AccessRequestPending {
+		return nil, status.Error(codes.FailedPrecondition, "[AAR006] Access request is not pending")
+	}
+
+	// 申请人不能审批自己的申请
+	if request.RequesterId == in.ApproverId {
+		return nil, status.Error(codes.PermissionDenied, "[AAR007] Requester cannot decide their own access request")
+	}
+
+	// 检查是否为当前步骤的审批人
+	isApprover, err := l.svcCtx.RoleApproversModel.IsApprover(l.ctx, request.RoleId, request.CurrentStep, in.ApproverId)
+	if err != nil {
+		eInfo := "[AAR008] 查询审批人失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if !isApprover {
+		return nil, status.Error(codes.PermissionDenied, "[AAR009] User is not an approver for the current step")
+	}
+
+	// 非最后一步进入下一步，最后一步通过后申请结束
+	now := time.Now()
+	fromStep := request.CurrentStep
+	if request.CurrentStep < request.TotalSteps {
+		request.CurrentStep++
+		if err := l.advance(l.ctx, request, fromStep, in, now); err != nil {
+			return nil, err
+		}
+	} else {
+		// 最后一步在职责分离锁内校验约束并授予角色，避免与并发的授予交错
+		request.Status = model.AccessRequestApproved
+		request.DecidedAt = sql.NullTime{Time: now, Valid: true}
+		var failed error
+		err = l.svcCtx.Constraints.Locked(l.ctx, func(ctx context.Context) error {
+			failed = l.advance(ctx, request, fromStep, in, now)
+			return failed
+		})
+		if failed != nil {
+			return nil, failed
+		}
+		if err != nil {
+			eInfo := "[AAR020] 获取职责分离锁失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	resp, err := reloadAccessRequest(l.ctx, l.svcCtx, request.Id)
+	if err != nil {
+		eInfo := "[AAR015] 查询访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return resp, nil
+}
+
+// advance 更新申请的审批进度并记录审批事件，申请已通过时先校验职责分离约束，通过后授予角色
+// 违反约束时申请保持审批中；申请通过时需在职责分离锁内调用
+func (l *ApproveAccessRequestLogic) advance(ctx context.Context, request *model.AccessRequests, fromStep int64, in *iam.ApproveAccessRequestRequest, now time.Time) error {
+	approved := request.Status == model.AccessRequestApproved
+	if approved {
+		violation, err := checkRequestedRole(ctx, l.svcCtx, request, now)
+		if err != nil {
+			eInfo := "[AAR016] 校验职责分离约束失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return status.Error(codes.Internal, eInfo)
+		}
+		if violation != nil {
+			return status.Error(codes.FailedPrecondition, "[AAR017] Separation of duty constraint violated: "+violation.Error())
+		}
+	}
+
+	// 仅在申请仍处于原步骤时更新，防止并发重复处理
+	ok, err := l.svcCtx.AccessRequestsModel.Transition(ctx, request, fromStep)
+	if err != nil {
+		eInfo := "[AAR010] 更新访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	if !ok {
+		return status.Error(codes.Aborted, "[AAR011] Access request was modified concurrently, please retry")
+	}
+
+	// 记录审批事件
+	err = recordEvent(ctx, l.svcCtx, request.Id, in.ApproverId, model.AccessRequestEventApproved, fromStep, in.Comment)
+	if err != nil {
+		eInfo := "[AAR012] 记录申请事件失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	if !approved {
+		return nil
+	}
+
+	// 审批链全部通过，授予申请的角色
+	err = grantRequestedRole(ctx, l.svcCtx, request, in.ApproverId, now)
+	if err != nil {
+		eInfo := "[AAR013] 授予角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	err = recordEvent(ctx, l.svcCtx, request.Id, 0, model.AccessRequestEventGranted, fromStep, "")
+	if err != nil {
+		eInfo := "[AAR014] 记录申请事件失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package accessrequestservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/rpcauth"
+	"github.com/ziptako/iam/internal/sod"
+	"github.com/ziptako/iam/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type requestFixture struct {
+	svcCtx    *svc.ServiceContext
+	requests  *modeltest.AccessRequests
+	events    *modeltest.AccessRequestEvents
+	userRoles *modeltest.UserRoles
+}
+
+// newRequestFixture 用户1申请角色10，审批链第一步由用户1或2审批，第二步由用户3审批
+func newRequestFixture() *requestFixture {
+	f := &requestFixture{
+		requests: &modeltest.AccessRequests{Rows: []*model.AccessRequests{
+			{Id: 1, RequesterId: 1, RoleId: 10, Status: model.AccessRequestPending, CurrentStep: 1, TotalSteps: 2},
+		}},
+		events:    &modeltest.AccessRequestEvents{},
+		userRoles: &modeltest.UserRoles{},
+	}
+	approvers := &modeltest.RoleApprovers{Rows: []*model.RoleApprovers{
+		{RoleId: 10, Step: 1, ApproverId: 1}, {RoleId: 10, Step: 1, ApproverId: 2}, {RoleId: 10, Step: 2, ApproverId: 3},
+	}}
+	groupRoles := &modeltest.GroupRoles{Members: &modeltest.GroupMembers{}}
+	f.svcCtx = &svc.ServiceContext{
+		AccessRequestsModel:      f.requests,
+		AccessRequestEventsModel: f.events,
+		RoleApproversModel:       approvers,
+		UserRolesModel:           f.userRoles,
+		Constraints:              sod.NewChecker(&modeltest.RoleConstraints{}, &modeltest.RoleConstraintRoles{}, f.userRoles, groupRoles),
+	}
+	return f
+}
+
+// asUser 以用户令牌认证的调用
+func asUser(userId int64) context.Context {
+	return rpcauth.WithPrincipal(context.Background(), &rpcauth.Principal{Kind: rpcauth.PrincipalUser, UserId: userId})
+}
+
+func (f *requestFixture) approve(callerId, approverId int64) (*iam.AccessRequest, error) {
+	return NewApproveAccessRequestLogic(asUser(callerId), f.svcCtx).ApproveAccessRequest(&iam.ApproveAccessRequestRequest{Id: 1, ApproverId: approverId})
+}
+
+func TestApproveAccessRequestChain(t *testing.T) {
+	f := newRequestFixture()
+
+	resp, err := f.approve(2, 2)
+	if err != nil {
+		t.Fatalf("approve step 1: %v", err)
+	}
+	if resp.Status != model.AccessRequestPending || resp.CurrentStep != 2 {
+		t.Fatalf("after step 1: status %s step %d, want pending step 2", resp.Status, resp.CurrentStep)
+	}
+	if ok, _ := f.userRoles.HasRole(context.Background(), 1, 10); ok {
+		t.Fatalf("role granted before the last step")
+	}
+
+	// 第一步的审批人不能审批第二步
+	if _, err = f.approve(2, 2); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("step 1 approver on step 2: %v, want PermissionDenied", err)
+	}
+
+	resp, err = f.approve(3, 3)
+	if err != nil {
+		t.Fatalf("approve step 2: %v", err)
+	}
+	if resp.Status != model.AccessRequestApproved {
+		t.Fatalf("status = %s, want approved", resp.Status)
+	}
+	ur, err := f.userRoles.FindOneByUserIdRoleId(context.Background(), 1, 10)
+	if err != nil || ur.CreatedBy.Int64 != 3 {
+		t.Fatalf("granted role = %+v, %v, want created by 3", ur, err)
+	}
+	var types []string
+	for _, e := range f.events.Rows {
+		types = append(types, e.EventType)
+	}
+	if len(types) != 3 || types[0] != model.AccessRequestEventApproved || types[2] != model.AccessRequestEventGranted {
+		t.Fatalf("events = %v", types)
+	}
+	if f.events.Rows[0].ActorId.Int64 != 2 || f.events.Rows[1].ActorId.Int64 != 3 {
+		t.Fatalf("approval events recorded actors %d and %d", f.events.Rows[0].ActorId.Int64, f.events.Rows[1].ActorId.Int64)
+	}
+}
+
+func TestApproveAccessRequestRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		in   *iam.ApproveAccessRequestRequest
+		code codes.Code
+	}{
+		{"no caller", context.Background(), &iam.ApproveAccessRequestRequest{Id: 1, ApproverId: 2}, codes.Unauthenticated},
+		{"spoofed approver", asUser(1), &iam.ApproveAccessRequestRequest{Id: 1, ApproverId: 2}, codes.PermissionDenied},
+		{"spoofed by other user", asUser(4), &iam.ApproveAccessRequestRequest{Id: 1, ApproverId: 2}, codes.PermissionDenied},
+		// 用户1是第一步的审批人，但不能审批自己的申请
+		{"self approval", asUser(1), &iam.ApproveAccessRequestRequest{Id: 1, ApproverId: 1}, codes.PermissionDenied},
+		{"not an approver", asUser(4), &iam.ApproveAccessRequestRequest{Id: 1, ApproverId: 4}, codes.PermissionDenied},
+		{"missing request", asUser(2), &iam.ApproveAccessRequestRequest{Id: 9, ApproverId: 2}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newRequestFixture()
+			_, err := NewApproveAccessRequestLogic(tt.ctx, f.svcCtx).ApproveAccessRequest(tt.in)
+			if status.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+			if r := f.requests.Row(1); r.Status != model.AccessRequestPending || r.CurrentStep != 1 || len(f.events.Rows) != 0 {
+				t.Fatalf("request changed to %+v", r)
+			}
+		})
+	}
+}
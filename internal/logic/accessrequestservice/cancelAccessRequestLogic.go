@@ -0,0 +1,104 @@
+package accessrequestservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/delegation"
+	"github.com/ziptako/iam/internal/svc"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CancelAccessRequestLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelAccessRequestLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelAccessRequestLogic {
+	return &CancelAccessRequestLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CancelAccessRequest 申请人撤回审批中的申请
+func (l *CancelAccessRequestLogic) CancelAccessRequest(in *iam.CancelAccessRequestRequest) (*iam.AccessRequest, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CNAR001] Access request ID is required")
+	}
+	if in.RequesterId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CNAR002] Requester ID is required")
+	}
+	if utf8.RuneCountInString(in.Comment) > 1000 {
+		return nil, status.Error(codes.InvalidArgument, "[CNAR003] Comment must not exceed 1000 characters")
+	}
+
+	// 撤回人取自已认证的调用人，请求中的申请人必须与调用人一致
+	actorId, ok := delegation.ActorIdFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "[CNAR012] Authenticated caller identity is required")
+	}
+	if in.RequesterId != actorId {
+		return nil, status.Error(codes.PermissionDenied, "[CNAR013] Requester must be the caller")
+	}
+
+	// 查询申请
+	request, err := l.svcCtx.AccessRequestsModel.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[CNAR004] Access request not found")
+		}
+		eInfo := "[CNAR005] 查询访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if request.RequesterId != in.RequesterId {
+		return nil, status.Error(codes.PermissionDenied, "[CNAR006] Only the requester can cancel the access request")
+	}
+	if request.Status != model.AccessRequestPending {
+		return nil, status.Error(codes.FailedPrecondition, "[CNAR007] Access request is not pending")
+	}
+
+	// 撤回申请
+	fromStep := request.CurrentStep
+	request.Status = model.AccessRequestCancelled
+	request.DecidedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	// 仅在申请仍处于原步骤时更新，防止并发重复处理
+	ok, err = l.svcCtx.AccessRequestsModel.Transition(l.ctx, request, fromStep)
+	if err != nil {
+		eInfo := "[CNAR008] 更新访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if !ok {
+		return nil, status.Error(codes.Aborted, "[CNAR009] Access request was modified concurrently, please retry")
+	}
+
+	// 记录撤回事件
+	err = recordEvent(l.ctx, l.svcCtx, request.Id, in.RequesterId, model.AccessRequestEventCancelled, fromStep, in.Comment)
+	if err != nil {
+		eInfo := "[CNAR010] 记录申请事件失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	resp, err := reloadAccessRequest(l.ctx, l.svcCtx, request.Id)
+	if err != nil {
+		eInfo := "[CNAR011] 查询访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return resp, nil
+}
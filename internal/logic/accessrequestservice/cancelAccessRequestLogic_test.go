@@ -0,0 +1,65 @@
+package accessrequestservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/delegation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCancelAccessRequest(t *testing.T) {
+	f := newRequestFixture()
+	resp, err := NewCancelAccessRequestLogic(asUser(1), f.svcCtx).CancelAccessRequest(&iam.CancelAccessRequestRequest{Id: 1, RequesterId: 1})
+	if err != nil {
+		t.Fatalf("CancelAccessRequest: %v", err)
+	}
+	if resp.Status != model.AccessRequestCancelled || f.events.Rows[0].ActorId.Int64 != 1 {
+		t.Fatalf("status = %s, events %+v", resp.Status, f.events.Rows)
+	}
+}
+
+func TestCancelAccessRequestRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		in   *iam.CancelAccessRequestRequest
+		code codes.Code
+	}{
+		{"no caller", context.Background(), &iam.CancelAccessRequestRequest{Id: 1, RequesterId: 1}, codes.Unauthenticated},
+		{"spoofed requester", asUser(2), &iam.CancelAccessRequestRequest{Id: 1, RequesterId: 1}, codes.PermissionDenied},
+		{"delegation caller differs", delegation.WithCallerId(context.Background(), 2), &iam.CancelAccessRequestRequest{Id: 1, RequesterId: 1}, codes.PermissionDenied},
+		{"not the requester", asUser(2), &iam.CancelAccessRequestRequest{Id: 1, RequesterId: 2}, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newRequestFixture()
+			_, err := NewCancelAccessRequestLogic(tt.ctx, f.svcCtx).CancelAccessRequest(tt.in)
+			if status.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+			if f.requests.Row(1).Status != model.AccessRequestPending {
+				t.Fatalf("request cancelled despite rejection")
+			}
+		})
+	}
+}
+
+// TestDecideAsOtherApprover 拒绝和评论同样只能以调用人自己的身份进行
+func TestDecideAsOtherApprover(t *testing.T) {
+	f := newRequestFixture()
+	_, err := NewRejectAccessRequestLogic(asUser(1), f.svcCtx).RejectAccessRequest(&iam.RejectAccessRequestRequest{Id: 1, ApproverId: 2})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("reject as other approver: %v, want PermissionDenied", err)
+	}
+	_, err = NewCommentAccessRequestLogic(asUser(4), f.svcCtx).CommentAccessRequest(&iam.CommentAccessRequestRequest{Id: 1, UserId: 1, Comment: "ok"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("comment as requester: %v, want PermissionDenied", err)
+	}
+	if f.requests.Row(1).Status != model.AccessRequestPending || len(f.events.Rows) != 0 {
+		t.Fatalf("request changed despite rejection")
+	}
+}
@@ -0,0 +1,96 @@
+package accessrequestservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/delegation"
+	"github.com/ziptako/iam/internal/svc"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CommentAccessRequestLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCommentAccessRequestLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CommentAccessRequestLogic {
+	return &CommentAccessRequestLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CommentAccessRequest 申请人或审批人对申请发表评论
+func (l *CommentAccessRequestLogic) CommentAccessRequest(in *iam.CommentAccessRequestRequest) (*iam.AccessRequest, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CMAR001] Access request ID is required")
+	}
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CMAR002] User ID is required")
+	}
+	if in.Comment == "" {
+		return nil, status.Error(codes.InvalidArgument, "[CMAR003] Comment is required")
+	}
+	if utf8.RuneCountInString(in.Comment) > 1000 {
+		return nil, status.Error(codes.InvalidArgument, "[CMAR004] Comment must not exceed 1000 characters")
+	}
+
+	// 评论人取自已认证的调用人，请求中的评论人必须与调用人一致
+	actorId, ok := delegation.ActorIdFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "[CMAR011] Authenticated caller identity is required")
+	}
+	if in.UserId != actorId {
+		return nil, status.Error(codes.PermissionDenied, "[CMAR012] Commenter must be the caller")
+	}
+
+	// 查询申请
+	request, err := l.svcCtx.AccessRequestsModel.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[CMAR005] Access request not found")
+		}
+		eInfo := "[CMAR006] 查询访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 只有申请人和角色的审批人可以评论
+	if request.RequesterId != in.UserId {
+		isApprover, err := l.svcCtx.RoleApproversModel.IsRoleApprover(l.ctx, request.RoleId, in.UserId)
+		if err != nil {
+			eInfo := "[CMAR007] 查询审批人失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		if !isApprover {
+			return nil, status.Error(codes.PermissionDenied, "[CMAR008] Only the requester or an approver can comment")
+		}
+	}
+
+	// 记录评论事件
+	err = recordEvent(l.ctx, l.svcCtx, request.Id, in.UserId, model.AccessRequestEventCommented, request.CurrentStep, in.Comment)
+	if err != nil {
+		eInfo := "[CMAR009] 记录申请事件失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	resp, err := reloadAccessRequest(l.ctx, l.svcCtx, request.Id)
+	if err != nil {
+		eInfo := "[CMAR010] 查询访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return resp, nil
+}
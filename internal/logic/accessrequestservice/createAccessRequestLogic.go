@@ -0,0 +1,153 @@
+package accessrequestservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreateAccessRequestLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateAccessRequestLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateAccessRequestLogic {
+	return &CreateAccessRequestLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateAccessRequest 创建角色访问申请，同一用户对同一角色只能存在一个审批中的申请
+func (l *CreateAccessRequestLogic) CreateAccessRequest(in *iam.CreateAccessRequestRequest) (*iam.AccessRequest, error) {
+	// 参数验证
+	if in.RequesterId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CAR001] Requester ID is required")
+	}
+	if in.RoleId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CAR002] Role ID is required")
+	}
+	if in.Justification == "" {
+		return nil, status.Error(codes.InvalidArgument, "[CAR003] Justification is required")
+	}
+	if utf8.RuneCountInString(in.Justification) > 500 {
+		return nil, status.Error(codes.InvalidArgument, "[CAR004] Justification must not exceed 500 characters")
+	}
+	if in.DurationSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CAR005] Duration must not be negative")
+	}
+
+	// 检查申请人是否存在
+	_, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, in.RequesterId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[CAR006] Requester not found")
+		}
+		eInfo := "[CAR007] 查询申请人失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 检查角色是否存在
+	_, err = l.svcCtx.RolesModel.FindActiveById(l.ctx, in.RoleId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[CAR008] Role not found")
+		}
+		eInfo := "[CAR009] 查询角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 未配置审批链的角色不接受申请
+	totalSteps, err := l.svcCtx.RoleApproversModel.CountSteps(l.ctx, in.RoleId)
+	if err != nil {
+		eInfo := "[CAR010] 查询角色审批链失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if totalSteps == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "[CAR011] Role does not accept access requests")
+	}
+
+	// 已永久拥有该角色时无需申请
+	userRole, err := l.svcCtx.UserRolesModel.FindOneByUserIdRoleId(l.ctx, in.RequesterId, in.RoleId)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		eInfo := "[CAR012] 查询用户角色关联失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if userRole != nil {
+		validity := userRole.Validity()
+		if !validity.Until.Valid && validity.Effective(time.Now()) {
+			return nil, status.Error(codes.AlreadyExists, "[CAR013] User already has this role")
+		}
+	}
+
+	// 同一角色只能存在一个审批中的申请
+	_, err = l.svcCtx.AccessRequestsModel.FindPendingByRequesterRole(l.ctx, in.RequesterId, in.RoleId)
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, "[CAR014] A pending access request for this role already exists")
+	}
+	if !errors.Is(err, model.ErrNotFound) {
+		eInfo := "[CAR015] 查询审批中的申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 提前校验职责分离约束，审批通过时会再次校验
+	violation, err := checkRequestedRole(l.ctx, l.svcCtx, &model.AccessRequests{RequesterId: in.RequesterId, RoleId: in.RoleId, DurationSeconds: in.DurationSeconds}, time.Now())
+	if err != nil {
+		eInfo := "[CAR019] 校验职责分离约束失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if violation != nil {
+		return nil, status.Error(codes.FailedPrecondition, "[CAR020] Separation of duty constraint violated: "+violation.Error())
+	}
+
+	// 创建申请
+	request := &model.AccessRequests{
+		RequesterId:     in.RequesterId,
+		RoleId:          in.RoleId,
+		Justification:   in.Justification,
+		DurationSeconds: in.DurationSeconds,
+		Status:          model.AccessRequestPending,
+		CurrentStep:     1,
+		TotalSteps:      totalSteps,
+	}
+	_, err = l.svcCtx.AccessRequestsModel.Insert(l.ctx, request)
+	if err != nil {
+		eInfo := "[CAR016] 创建访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 记录创建事件
+	err = recordEvent(l.ctx, l.svcCtx, request.Id, in.RequesterId, model.AccessRequestEventCreated, request.CurrentStep, in.Justification)
+	if err != nil {
+		eInfo := "[CAR017] 记录申请事件失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 重新查询以获取数据库生成的时间
+	resp, err := reloadAccessRequest(l.ctx, l.svcCtx, request.Id)
+	if err != nil {
+		eInfo := "[CAR018] 查询访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,55 @@
+package accessrequestservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetAccessRequestLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAccessRequestLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAccessRequestLogic {
+	return &GetAccessRequestLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetAccessRequest 获取访问申请详情，包含事件历史
+func (l *GetAccessRequestLogic) GetAccessRequest(in *iam.GetAccessRequestRequest) (*iam.AccessRequest, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[GAR001] Access request ID is required")
+	}
+
+	// 查询申请
+	request, err := l.svcCtx.AccessRequestsModel.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[GAR002] Access request not found")
+		}
+		eInfo := "[GAR003] 查询访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	resp, err := accessRequestDetail(l.ctx, l.svcCtx, request)
+	if err != nil {
+		eInfo := "[GAR004] 查询申请事件失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,67 @@
+package accessrequestservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetRoleApproversLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetRoleApproversLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRoleApproversLogic {
+	return &GetRoleApproversLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetRoleApprovers 获取角色的审批链
+func (l *GetRoleApproversLogic) GetRoleApprovers(in *iam.GetRoleApproversRequest) (*iam.GetRoleApproversResponse, error) {
+	// 参数验证
+	if in.RoleId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[GRA001] Role ID is required")
+	}
+
+	// 检查角色是否存在
+	_, err := l.svcCtx.RolesModel.FindActiveById(l.ctx, in.RoleId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[GRA002] Role not found")
+		}
+		eInfo := "[GRA003] 查询角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 查询审批链，结果已按步骤排序
+	approvers, err := l.svcCtx.RoleApproversModel.FindByRoleId(l.ctx, in.RoleId)
+	if err != nil {
+		eInfo := "[GRA004] 查询角色审批链失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	var steps []*iam.ApprovalStep
+	for _, ra := range approvers {
+		if len(steps) == 0 || steps[len(steps)-1].Step != int32(ra.Step) {
+			steps = append(steps, &iam.ApprovalStep{Step: int32(ra.Step)})
+		}
+		last := steps[len(steps)-1]
+		last.ApproverIds = append(last.ApproverIds, ra.ApproverId)
+	}
+
+	return &iam.GetRoleApproversResponse{
+		Steps: steps,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package accessrequestservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/ziptako/common/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListAccessRequestsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListAccessRequestsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAccessRequestsLogic {
+	return &ListAccessRequestsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListAccessRequests 分页查询访问申请，指定审批人时返回当前步骤等待其处理的申请
+func (l *ListAccessRequestsLogic) ListAccessRequests(in *iam.ListAccessRequestsRequest) (*iam.ListAccessRequestsResponse, error) {
+	// 参数验证
+	if in.RequesterId < 0 || in.ApproverId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[LAR001] Invalid user ID")
+	}
+	switch in.Status {
+	case "", model.AccessRequestPending, model.AccessRequestApproved, model.AccessRequestRejected, model.AccessRequestCancelled:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "[LAR002] Invalid status")
+	}
+
+	// 使用工具函数计算分页参数
+	pagination := utils.CalculatePagination(in.Page, in.PageSize)
+
+	var (
+		requests []*model.AccessRequests
+		total    int64
+		err      error
+	)
+	if in.ApproverId > 0 {
+		// 查询当前步骤等待该审批人处理的申请
+		requests, err = l.svcCtx.AccessRequestsModel.FindPendingByApprover(l.ctx, in.ApproverId, pagination.Limit, pagination.Offset)
+		if err == nil {
+			total, err = l.svcCtx.AccessRequestsModel.CountPendingByApprover(l.ctx, in.ApproverId)
+		}
+	} else {
+		requests, err = l.svcCtx.AccessRequestsModel.FindWithFilters(l.ctx, in.RequesterId, in.Status, pagination.Limit, pagination.Offset)
+		if err == nil {
+			total, err = l.svcCtx.AccessRequestsModel.CountWithFilters(l.ctx, in.RequesterId, in.Status)
+		}
+	}
+	if err != nil {
+		eInfo := "[LAR003] 查询访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	items := make([]*iam.AccessRequest, 0, len(requests))
+	for _, request := range requests {
+		items = append(items, logic.ModelToProtoAccessRequest(request))
+	}
+
+	return &iam.ListAccessRequestsResponse{
+		Items: items,
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package accessrequestservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/delegation"
+	"github.com/ziptako/iam/internal/svc"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RejectAccessRequestLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRejectAccessRequestLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RejectAccessRequestLogic {
+	return &RejectAccessRequestLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RejectAccessRequest 拒绝访问申请
+func (l *RejectAccessRequestLogic) RejectAccessRequest(in *iam.RejectAccessRequestRequest) (*iam.AccessRequest, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[RAR001] Access request ID is required")
+	}
+	if in.ApproverId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[RAR002] Approver ID is required")
+	}
+	if utf8.RuneCountInString(in.Comment) > 1000 {
+		return nil, status.Error(codes.InvalidArgument, "[RAR003] Comment must not exceed 1000 characters")
+	}
+
+	// 审批人取自已认证的调用人，请求中的审批人必须与调用人一致
+	actorId, ok := delegation.ActorIdFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "[RAR014] Authenticated caller identity is required")
+	}
+	if in.ApproverId != actorId {
+		return nil, status.Error(codes.PermissionDenied, "[RAR015] Approver must be the caller")
+	}
+
+	// 查询申请
+	request, err := l.svcCtx.AccessRequestsModel.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[RAR004] Access request not found")
+		}
+		eInfo := "[RAR005] 查询访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if request.Status != model.AccessRequestPending {
+		return nil, status.Error(codes.FailedPrecondition, "[RAR006] Access request is not pending")
+	}
+
+	// 申请人不能审批自己的申请
+	if request.RequesterId == in.ApproverId {
+		return nil, status.Error(codes.PermissionDenied, "[RAR007] Requester cannot decide their own access request")
+	}
+
+	// 检查是否为当前步骤的审批人
+	isApprover, err := l.svcCtx.RoleApproversModel.IsApprover(l.ctx, request.RoleId, request.CurrentStep, in.ApproverId)
+	if err != nil {
+		eInfo := "[RAR008] 查询审批人失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if !isApprover {
+		return nil, status.Error(codes.PermissionDenied, "[RAR009] User is not an approver for the current step")
+	}
+
+	// 任一步骤拒绝即结束申请
+	fromStep := request.CurrentStep
+	request.Status = model.AccessRequestRejected
+	request.DecidedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	// 仅在申请仍处于原步骤时更新，防止并发重复处理
+	ok, err = l.svcCtx.AccessRequestsModel.Transition(l.ctx, request, fromStep)
+	if err != nil {
+		eInfo := "[RAR010] 更新访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if !ok {
+		return nil, status.Error(codes.Aborted, "[RAR011] Access request was modified concurrently, please retry")
+	}
+
+	// 记录拒绝事件
+	err = recordEvent(l.ctx, l.svcCtx, request.Id, in.ApproverId, model.AccessRequestEventRejected, fromStep, in.Comment)
+	if err != nil {
+		eInfo := "[RAR012] 记录申请事件失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	resp, err := reloadAccessRequest(l.ctx, l.svcCtx, request.Id)
+	if err != nil {
+		eInfo := "[RAR013] 查询访问申请失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return resp, nil
+}
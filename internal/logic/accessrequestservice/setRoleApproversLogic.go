@@ -0,0 +1,97 @@
+package accessrequestservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetRoleApproversLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetRoleApproversLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetRoleApproversLogic {
+	return &SetRoleApproversLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetRoleApprovers 设置角色的审批链，覆盖原有配置，步骤为空表示该角色不接受申请
+func (l *SetRoleApproversLogic) SetRoleApprovers(in *iam.SetRoleApproversRequest) (*iam.SetRoleApproversResponse, error) {
+	// 参数验证
+	if in.RoleId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[SRA001] Role ID is required")
+	}
+	steps := make([][]int64, 0, len(in.Steps))
+	for i, step := range in.Steps {
+		if step.Step != int32(i+1) {
+			return nil, status.Error(codes.InvalidArgument, "[SRA002] Steps must be numbered consecutively from 1")
+		}
+		if len(step.ApproverIds) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "[SRA003] Each step requires at least one approver")
+		}
+		// 去除同一步骤中重复的审批人
+		seen := make(map[int64]struct{}, len(step.ApproverIds))
+		approverIds := make([]int64, 0, len(step.ApproverIds))
+		for _, approverId := range step.ApproverIds {
+			if approverId <= 0 {
+				return nil, status.Error(codes.InvalidArgument, "[SRA004] Invalid approver ID")
+			}
+			if _, ok := seen[approverId]; ok {
+				continue
+			}
+			seen[approverId] = struct{}{}
+			approverIds = append(approverIds, approverId)
+		}
+		steps = append(steps, approverIds)
+	}
+
+	// 检查角色是否存在
+	_, err := l.svcCtx.RolesModel.FindActiveById(l.ctx, in.RoleId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[SRA005] Role not found")
+		}
+		eInfo := "[SRA006] 查询角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 检查审批人是否存在
+	for _, approverIds := range steps {
+		for _, approverId := range approverIds {
+			_, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, approverId)
+			if err != nil {
+				if errors.Is(err, model.ErrNotFound) {
+					return nil, status.Error(codes.NotFound, "[SRA007] Approver not found")
+				}
+				eInfo := "[SRA008] 查询审批人失败"
+				l.Logger.Errorf("%v: %v", eInfo, err)
+				return nil, status.Error(codes.Internal, eInfo)
+			}
+		}
+	}
+
+	// 替换审批链，审批中的申请仍按创建时的步骤数推进
+	err = l.svcCtx.RoleApproversModel.ReplaceRoleApprovers(l.ctx, in.RoleId, steps, sql.NullInt64{})
+	if err != nil {
+		eInfo := "[SRA009] 设置角色审批链失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.SetRoleApproversResponse{
+		Success: true,
+	}, nil
+}
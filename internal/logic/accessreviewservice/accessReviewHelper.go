@@ -0,0 +1,136 @@
+package accessreviewservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/delegation"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+	"slices"
+)
+
+// campaignDetail 转换访问复核活动并填充复核进度
+func campaignDetail(ctx context.Context, svcCtx *svc.ServiceContext, campaign *model.ReviewCampaigns) (*iam.ReviewCampaign, error) {
+	counts, err := svcCtx.ReviewItemsModel.CountByDecision(ctx, campaign.Id)
+	if err != nil {
+		return nil, err
+	}
+	return logic.ModelToProtoReviewCampaign(campaign, counts), nil
+}
+
+// itemDetails 转换访问复核条目并填充复核人
+func itemDetails(ctx context.Context, svcCtx *svc.ServiceContext, items []*model.ReviewItems) ([]*iam.ReviewItem, error) {
+	itemIds := make([]int64, 0, len(items))
+	for _, item := range items {
+		itemIds = append(itemIds, item.Id)
+	}
+	reviewers, err := svcCtx.ReviewItemReviewersModel.FindByItemIds(ctx, itemIds)
+	if err != nil {
+		return nil, err
+	}
+	byItem := make(map[int64][]*model.ReviewItemReviewers)
+	for _, r := range reviewers {
+		byItem[r.ItemId] = append(byItem[r.ItemId], r)
+	}
+
+	resp := make([]*iam.ReviewItem, 0, len(items))
+	for _, item := range items {
+		resp = append(resp, logic.ModelToProtoReviewItem(item, byItem[item.Id]))
+	}
+	return resp, nil
+}
+
+// reviewerResolver 按活动的复核人来源为角色持有人确定复核人，查询结果在一次活动创建中复用
+type reviewerResolver struct {
+	ctx      context.Context
+	svcCtx   *svc.ServiceContext
+	source   string
+	defaults []int64
+
+	roleOwners map[int64][]int64   // 角色ID -> 角色审批人
+	managers   []*delegation.Scope // 委派管理员的管理范围，首次使用时加载
+	loaded     bool
+}
+
+// newReviewerResolver 创建复核人解析器，defaults为按来源找不到复核人时指派的复核人
+func newReviewerResolver(ctx context.Context, svcCtx *svc.ServiceContext, source string, defaults []int64) *reviewerResolver {
+	return &reviewerResolver{
+		ctx:        ctx,
+		svcCtx:     svcCtx,
+		source:     source,
+		defaults:   defaults,
+		roleOwners: make(map[int64][]int64),
+	}
+}
+
+// Resolve 确定用户角色的复核人，持有人不能复核自己的角色
+func (r *reviewerResolver) Resolve(user *model.Users, roleId int64) ([]int64, error) {
+	var reviewers []int64
+	var err error
+	switch r.source {
+	case model.ReviewerSourceRoleOwners:
+		reviewers, err = r.owners(roleId)
+	case model.ReviewerSourceManagers:
+		reviewers, err = r.managersOf(user, roleId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	reviewers = slices.DeleteFunc(slices.Clone(reviewers), func(id int64) bool { return id == user.Id })
+	if len(reviewers) == 0 {
+		reviewers = slices.DeleteFunc(slices.Clone(r.defaults), func(id int64) bool { return id == user.Id })
+	}
+	return reviewers, nil
+}
+
+// owners 查询角色审批链中任一步骤的审批人
+func (r *reviewerResolver) owners(roleId int64) ([]int64, error) {
+	if owners, ok := r.roleOwners[roleId]; ok {
+		return owners, nil
+	}
+	approvers, err := r.svcCtx.RoleApproversModel.FindByRoleId(r.ctx, roleId)
+	if err != nil {
+		return nil, err
+	}
+	owners := make([]int64, 0, len(approvers))
+	for _, approver := range approvers {
+		owners = append(owners, approver.ApproverId)
+	}
+	slices.Sort(owners)
+	owners = slices.Compact(owners)
+	r.roleOwners[roleId] = owners
+	return owners, nil
+}
+
+// managersOf 查询管理范围覆盖用户且可以分配和移除该角色的委派管理员
+func (r *reviewerResolver) managersOf(user *model.Users, roleId int64) ([]int64, error) {
+	if !r.loaded {
+		scopes, err := r.svcCtx.AdminScopesModel.FindAll(r.ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range scopes {
+			scope, err := r.svcCtx.Delegation.ScopeOf(r.ctx, s.AdminId)
+			if err != nil {
+				// 已禁用的管理员不参与复核
+				if errors.Is(err, delegation.ErrUnknownCaller) || errors.Is(err, delegation.ErrNoScope) {
+					continue
+				}
+				return nil, err
+			}
+			r.managers = append(r.managers, scope)
+		}
+		r.loaded = true
+	}
+
+	managers := make([]int64, 0)
+	for _, scope := range r.managers {
+		if scope.CanGrant(roleId) && scope.CoversUser(user) {
+			managers = append(managers, scope.CallerId)
+		}
+	}
+	return managers, nil
+}
@@ -0,0 +1,144 @@
+package accessreviewservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/delegation"
+	userservicelogic "github.com/ziptako/iam/internal/logic/userservice"
+	"github.com/ziptako/iam/internal/svc"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CloseReviewCampaignLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCloseReviewCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CloseReviewCampaignLogic {
+	return &CloseReviewCampaignLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CloseReviewCampaign 关闭活动，决定撤销的角色通过RemoveUserRole自动撤销
+func (l *CloseReviewCampaignLogic) CloseReviewCampaign(in *iam.CloseReviewCampaignRequest) (*iam.ReviewCampaign, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CLR001] Campaign ID is required")
+	}
+	if in.ClosedBy < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CLR002] Invalid closer ID")
+	}
+
+	// 关闭人取自已认证的调用人，关闭会自动撤销角色，不信任请求中的关闭人ID
+	actorId, ok := delegation.ActorIdFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "[CLR011] Authenticated caller identity is required")
+	}
+	if in.ClosedBy != 0 && in.ClosedBy != actorId {
+		return nil, status.Error(codes.PermissionDenied, "[CLR012] Closer must be the caller")
+	}
+
+	// 查询活动
+	campaign, err := l.svcCtx.ReviewCampaignsModel.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[CLR003] Review campaign not found")
+		}
+		eInfo := "[CLR004] 查询复核活动失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if campaign.Status != model.ReviewCampaignOpen {
+		return nil, status.Error(codes.FailedPrecondition, "[CLR005] Review campaign is already closed")
+	}
+
+	// 先逐个处理条目，全部处理完成后才关闭活动，中途失败时可以重试关闭，已处理的条目不会重复处理
+	// 条目处理后复核人不能再修改其决定
+	items, err := l.svcCtx.ReviewItemsModel.FindByCampaignId(l.ctx, campaign.Id)
+	if err != nil {
+		eInfo := "[CLR007] 查询复核条目失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	for _, item := range items {
+		if item.Outcome != "" {
+			continue
+		}
+		switch {
+		case item.Decision == model.ReviewDecisionRevoke,
+			item.Decision == model.ReviewDecisionPending && in.RevokeUnreviewed:
+			item.Outcome, item.OutcomeDetail, err = l.revoke(item)
+			if err != nil {
+				eInfo := "[CLR008] 查询用户角色关联失败"
+				l.Logger.Errorf("%v: %v", eInfo, err)
+				return nil, status.Error(codes.Internal, eInfo)
+			}
+		case item.Decision == model.ReviewDecisionKeep:
+			item.Outcome = model.ReviewOutcomeKept
+		default:
+			item.Outcome = model.ReviewOutcomeUnreviewed
+		}
+		if err := l.svcCtx.ReviewItemsModel.SetOutcome(l.ctx, item); err != nil {
+			eInfo := "[CLR009] 记录处理结果失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	// 关闭活动，并发关闭时只有一个请求成功
+	campaign.Status = model.ReviewCampaignClosed
+	campaign.ClosedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	campaign.ClosedBy = sql.NullInt64{Int64: actorId, Valid: true}
+	closed, err := l.svcCtx.ReviewCampaignsModel.Close(l.ctx, campaign)
+	if err != nil {
+		eInfo := "[CLR006] 关闭复核活动失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if !closed {
+		return nil, status.Error(codes.FailedPrecondition, "[CLR005] Review campaign is already closed")
+	}
+
+	resp, err := campaignDetail(l.ctx, l.svcCtx, campaign)
+	if err != nil {
+		eInfo := "[CLR010] 查询复核进度失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return resp, nil
+}
+
+// revoke 通过RemoveUserRole撤销条目对应的角色，返回处理结果和说明
+// 单个条目撤销失败不影响其他条目，失败原因记录在处理结果说明中
+func (l *CloseReviewCampaignLogic) revoke(item *model.ReviewItems) (string, string, error) {
+	// 活动期间角色已被移除或已过期清理
+	_, err := l.svcCtx.UserRolesModel.FindOneByUserIdRoleId(l.ctx, item.UserId, item.RoleId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.ReviewOutcomeAlreadyRemoved, "", nil
+		}
+		return "", "", err
+	}
+
+	_, err = userservicelogic.NewRemoveUserRoleLogic(l.ctx, l.svcCtx).RemoveUserRole(&iam.RemoveUserRoleRequest{
+		UserId: item.UserId,
+		RoleId: item.RoleId,
+	})
+	if err != nil {
+		l.Logger.Errorf("[CLR011] 撤销复核条目%d的角色失败: %v", item.Id, err)
+		return model.ReviewOutcomeFailed, status.Convert(err).Message(), nil
+	}
+	return model.ReviewOutcomeRevoked, "", nil
+}
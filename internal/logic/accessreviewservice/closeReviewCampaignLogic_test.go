@@ -0,0 +1,52 @@
+package accessreviewservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCloseReviewCampaign(t *testing.T) {
+	svcCtx, campaigns, items := newReviewTestContext()
+	resp, err := NewCloseReviewCampaignLogic(asUser(1), svcCtx).CloseReviewCampaign(&iam.CloseReviewCampaignRequest{Id: 1})
+	if err != nil {
+		t.Fatalf("CloseReviewCampaign: %v", err)
+	}
+	// 关闭人取自调用人
+	if c := campaigns.Row(1); c.Status != model.ReviewCampaignClosed || c.ClosedBy.Int64 != 1 || resp.ClosedBy != 1 {
+		t.Fatalf("campaign = %+v, want closed by 1", c)
+	}
+	if items.Row(1).Outcome != model.ReviewOutcomeUnreviewed || items.Row(2).Outcome != model.ReviewOutcomeKept {
+		t.Fatalf("outcomes = %q, %q", items.Row(1).Outcome, items.Row(2).Outcome)
+	}
+}
+
+func TestCloseReviewCampaignRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		in   *iam.CloseReviewCampaignRequest
+		code codes.Code
+	}{
+		{"no caller", context.Background(), &iam.CloseReviewCampaignRequest{Id: 1}, codes.Unauthenticated},
+		{"spoofed closer", asUser(1), &iam.CloseReviewCampaignRequest{Id: 1, ClosedBy: 9}, codes.PermissionDenied},
+		{"negative closer", asUser(1), &iam.CloseReviewCampaignRequest{Id: 1, ClosedBy: -1}, codes.InvalidArgument},
+		{"missing campaign", asUser(1), &iam.CloseReviewCampaignRequest{Id: 9}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcCtx, campaigns, items := newReviewTestContext()
+			_, err := NewCloseReviewCampaignLogic(tt.ctx, svcCtx).CloseReviewCampaign(tt.in)
+			if status.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+			if campaigns.Row(1).Status != model.ReviewCampaignOpen || items.Row(1).Outcome != "" {
+				t.Fatalf("campaign closed despite rejection")
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package accessreviewservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/delegation"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DecideReviewItemLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDecideReviewItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DecideReviewItemLogic {
+	return &DecideReviewItemLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DecideReviewItem 复核人决定保留或撤销角色，活动关闭前可以修改决定
+func (l *DecideReviewItemLogic) DecideReviewItem(in *iam.DecideReviewItemRequest) (*iam.ReviewItem, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[DRI001] Review item ID is required")
+	}
+	if in.ReviewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[DRI002] Reviewer ID is required")
+	}
+	if in.Decision != model.ReviewDecisionKeep && in.Decision != model.ReviewDecisionRevoke {
+		return nil, status.Error(codes.InvalidArgument, "[DRI003] Decision must be keep or revoke")
+	}
+	comment := strings.TrimSpace(in.Comment)
+	if utf8.RuneCountInString(comment) > 1000 {
+		return nil, status.Error(codes.InvalidArgument, "[DRI004] Comment must not exceed 1000 characters")
+	}
+	if in.Decision == model.ReviewDecisionRevoke && comment == "" {
+		return nil, status.Error(codes.InvalidArgument, "[DRI005] Comment is required when revoking")
+	}
+
+	// 复核人取自已认证的调用人，请求中的复核人必须与调用人一致
+	actorId, ok := delegation.ActorIdFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "[DRI015] Authenticated caller identity is required")
+	}
+	if in.ReviewerId != actorId {
+		return nil, status.Error(codes.PermissionDenied, "[DRI016] Reviewer must be the caller")
+	}
+
+	// 查询条目和所属活动
+	item, err := l.svcCtx.ReviewItemsModel.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[DRI006] Review item not found")
+		}
+		eInfo := "[DRI007] 查询复核条目失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	campaign, err := l.svcCtx.ReviewCampaignsModel.FindOne(l.ctx, item.CampaignId)
+	if err != nil {
+		eInfo := "[DRI008] 查询复核活动失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if campaign.Status != model.ReviewCampaignOpen {
+		return nil, status.Error(codes.FailedPrecondition, "[DRI009] Review campaign is closed")
+	}
+
+	// 只有指派的复核人可以作出决定
+	ok, err = l.svcCtx.ReviewItemReviewersModel.IsReviewer(l.ctx, item.Id, in.ReviewerId)
+	if err != nil {
+		eInfo := "[DRI010] 查询复核人失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "[DRI011] User is not a reviewer of this item")
+	}
+
+	// 记录决定，活动在此期间被关闭或条目已在关闭过程中处理时拒绝
+	item.Decision = in.Decision
+	item.Comment = comment
+	item.DecidedBy = sql.NullInt64{Int64: in.ReviewerId, Valid: true}
+	item.DecidedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	decided, err := l.svcCtx.ReviewItemsModel.Decide(l.ctx, item)
+	if err != nil {
+		eInfo := "[DRI012] 记录复核决定失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if !decided {
+		return nil, status.Error(codes.FailedPrecondition, "[DRI014] Review campaign is closed or being closed")
+	}
+
+	items, err := itemDetails(l.ctx, l.svcCtx, []*model.ReviewItems{item})
+	if err != nil {
+		eInfo := "[DRI013] 查询复核人失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return items[0], nil
+}
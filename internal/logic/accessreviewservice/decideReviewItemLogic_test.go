@@ -0,0 +1,106 @@
+package accessreviewservicelogic
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/delegation"
+	"github.com/ziptako/iam/internal/rpcauth"
+	"github.com/ziptako/iam/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newReviewTestContext 活动1进行中：条目1待复核，复核人为用户2和3；条目2已由用户3决定保留
+func newReviewTestContext() (*svc.ServiceContext, *modeltest.ReviewCampaigns, *modeltest.ReviewItems) {
+	campaigns := &modeltest.ReviewCampaigns{Rows: []*model.ReviewCampaigns{{Id: 1, Name: "q3", Status: model.ReviewCampaignOpen}}}
+	items := &modeltest.ReviewItems{Rows: []*model.ReviewItems{
+		{Id: 1, CampaignId: 1, UserId: 5, RoleId: 10, Decision: model.ReviewDecisionPending},
+		{Id: 2, CampaignId: 1, UserId: 6, RoleId: 10, Decision: model.ReviewDecisionKeep, DecidedBy: modeltest.NullInt64(3)},
+	}, Campaigns: campaigns}
+	reviewers := &modeltest.ReviewItemReviewers{Rows: []*model.ReviewItemReviewers{
+		{ItemId: 1, ReviewerId: 2}, {ItemId: 1, ReviewerId: 3}, {ItemId: 2, ReviewerId: 3},
+	}}
+	return &svc.ServiceContext{
+		ReviewCampaignsModel:     campaigns,
+		ReviewItemsModel:         items,
+		ReviewItemReviewersModel: reviewers,
+	}, campaigns, items
+}
+
+// asUser 以用户令牌认证的调用
+func asUser(userId int64) context.Context {
+	return rpcauth.WithPrincipal(context.Background(), &rpcauth.Principal{Kind: rpcauth.PrincipalUser, UserId: userId})
+}
+
+func TestDecideReviewItem(t *testing.T) {
+	svcCtx, _, items := newReviewTestContext()
+	resp, err := NewDecideReviewItemLogic(asUser(2), svcCtx).DecideReviewItem(&iam.DecideReviewItemRequest{
+		Id: 1, ReviewerId: 2, Decision: model.ReviewDecisionRevoke, Comment: "left the team",
+	})
+	if err != nil {
+		t.Fatalf("DecideReviewItem: %v", err)
+	}
+	if item := items.Row(1); item.Decision != model.ReviewDecisionRevoke || item.DecidedBy.Int64 != 2 || resp.Decision != model.ReviewDecisionRevoke {
+		t.Fatalf("item = %+v, want revoke decided by 2", item)
+	}
+
+	// 已认证的服务调用方转发的用户同样作为复核人
+	ctx := rpcauth.WithPrincipal(context.Background(), &rpcauth.Principal{Kind: rpcauth.PrincipalService, Name: "portal"})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(delegation.MetadataKey, strconv.Itoa(3)))
+	if _, err = NewDecideReviewItemLogic(ctx, svcCtx).DecideReviewItem(&iam.DecideReviewItemRequest{
+		Id: 1, ReviewerId: 3, Decision: model.ReviewDecisionKeep,
+	}); err != nil {
+		t.Fatalf("DecideReviewItem via service: %v", err)
+	}
+	if item := items.Row(1); item.Decision != model.ReviewDecisionKeep || item.DecidedBy.Int64 != 3 {
+		t.Fatalf("item = %+v, want keep decided by 3", item)
+	}
+}
+
+func TestDecideReviewItemRejects(t *testing.T) {
+	keep := func(reviewerId int64) *iam.DecideReviewItemRequest {
+		return &iam.DecideReviewItemRequest{Id: 1, ReviewerId: reviewerId, Decision: model.ReviewDecisionKeep}
+	}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		in   *iam.DecideReviewItemRequest
+		code codes.Code
+	}{
+		{"no caller", context.Background(), keep(2), codes.Unauthenticated},
+		{"unauthenticated metadata", metadata.NewIncomingContext(context.Background(), metadata.Pairs(delegation.MetadataKey, "2")), keep(2), codes.Unauthenticated},
+		{"spoofed reviewer", asUser(4), keep(2), codes.PermissionDenied},
+		{"other assigned reviewer", asUser(2), keep(3), codes.PermissionDenied},
+		{"delegation caller differs", delegation.WithCallerId(context.Background(), 4), keep(2), codes.PermissionDenied},
+		{"not a reviewer", asUser(4), keep(4), codes.PermissionDenied},
+		{"revoke without comment", asUser(2), &iam.DecideReviewItemRequest{Id: 1, ReviewerId: 2, Decision: model.ReviewDecisionRevoke}, codes.InvalidArgument},
+		{"missing item", asUser(2), &iam.DecideReviewItemRequest{Id: 9, ReviewerId: 2, Decision: model.ReviewDecisionKeep}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcCtx, _, items := newReviewTestContext()
+			_, err := NewDecideReviewItemLogic(tt.ctx, svcCtx).DecideReviewItem(tt.in)
+			if status.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+			if item := items.Row(1); item.Decision != model.ReviewDecisionPending || item.DecidedBy.Valid {
+				t.Fatalf("item changed to %+v", item)
+			}
+		})
+	}
+}
+
+func TestDecideReviewItemClosedCampaign(t *testing.T) {
+	svcCtx, campaigns, _ := newReviewTestContext()
+	campaigns.Row(1).Status = model.ReviewCampaignClosed
+	_, err := NewDecideReviewItemLogic(asUser(2), svcCtx).DecideReviewItem(&iam.DecideReviewItemRequest{Id: 1, ReviewerId: 2, Decision: model.ReviewDecisionKeep})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("err = %v, want FailedPrecondition", err)
+	}
+}
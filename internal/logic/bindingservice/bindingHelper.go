@@ -0,0 +1,74 @@
+package bindingservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+)
+
+// 资源类型和资源ID的最大长度，与数据库字段保持一致
+const (
+	maxResourceTypeLength = 50
+	maxResourceIdLength   = 100
+)
+
+// validResourceRef 检查资源引用是否合法
+func validResourceRef(resourceType, resourceId string) bool {
+	resourceType, resourceId = strings.TrimSpace(resourceType), strings.TrimSpace(resourceId)
+	return resourceType != "" && len(resourceType) <= maxResourceTypeLength &&
+		resourceId != "" && len(resourceId) <= maxResourceIdLength
+}
+
+// findRoleIdsByPermissionCode 查询允许指定权限的角色ID，权限不存在时返回空列表
+func findRoleIdsByPermissionCode(ctx context.Context, svcCtx *svc.ServiceContext, permissionCode string) ([]int64, error) {
+	permission, err := svcCtx.PermissionsModel.FindOneByCode(ctx, permissionCode)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return []int64{}, nil
+		}
+		return nil, err
+	}
+	rolePermissions, err := svcCtx.RolePermissionsModel.FindRolesByPermissionId(ctx, permission.Id)
+	if err != nil {
+		return nil, err
+	}
+	roleIds := make([]int64, 0, len(rolePermissions))
+	for _, rp := range rolePermissions {
+		if rp.Effect != model.EffectDeny {
+			roleIds = append(roleIds, rp.RoleId)
+		}
+	}
+	return roleIds, nil
+}
+
+// toProtoBindings 转换资源绑定列表，并填充绑定的用户和角色详情
+func toProtoBindings(ctx context.Context, svcCtx *svc.ServiceContext, bindings []*model.ResourceBindings) ([]*iam.ResourceBinding, error) {
+	users := make(map[int64]*iam.User)
+	roles := make(map[int64]*iam.Role)
+	result := make([]*iam.ResourceBinding, 0, len(bindings))
+	for _, b := range bindings {
+		item := logic.ModelToProtoResourceBinding(b)
+		if _, ok := users[b.UserId]; !ok {
+			user, err := svcCtx.UsersModel.FindActiveById(ctx, b.UserId)
+			if err != nil && !errors.Is(err, model.ErrNotFound) {
+				return nil, err
+			}
+			users[b.UserId] = logic.ModelToProtoUser(user)
+		}
+		if _, ok := roles[b.RoleId]; !ok {
+			role, err := svcCtx.RolesModel.FindActiveById(ctx, b.RoleId)
+			if err != nil && !errors.Is(err, model.ErrNotFound) {
+				return nil, err
+			}
+			roles[b.RoleId] = logic.ModelToProtoRole(role)
+		}
+		item.User = users[b.UserId]
+		item.Role = roles[b.RoleId]
+		result = append(result, item)
+	}
+	return result, nil
+}
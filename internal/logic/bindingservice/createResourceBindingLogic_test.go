@@ -0,0 +1,116 @@
+package bindingservicelogic
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bindingFixture 用户1、2有效，用户3已删除；角色10、11有效，角色12已禁用；
+// 权限project:write只授予角色11，角色10拒绝该权限。已有绑定：
+// 1 用户1-角色10-project/p1，2 用户2-角色11-project/*，3 用户3-角色11-project/p1，
+// 4 用户1-角色12-project/p1，5 用户1-角色11-repo/r1
+type bindingFixture struct {
+	svcCtx   *svc.ServiceContext
+	bindings *modeltest.ResourceBindings
+}
+
+func newBindingFixture() *bindingFixture {
+	now := time.Now()
+	users := &modeltest.Users{Rows: []*model.Users{
+		{Id: 1, Username: "alice"}, {Id: 2, Username: "bob"}, {Id: 3, Username: "carol", DeletedAt: modeltest.NullTime(now)},
+	}}
+	roles := &modeltest.Roles{Rows: []*model.Roles{
+		{Id: 10, Code: "viewer"}, {Id: 11, Code: "developer"}, {Id: 12, Code: "legacy", DisabledAt: modeltest.NullTime(now)},
+	}}
+	bindings := &modeltest.ResourceBindings{Rows: []*model.ResourceBindings{
+		{Id: 1, UserId: 1, RoleId: 10, ResourceType: "project", ResourceId: "p1"},
+		{Id: 2, UserId: 2, RoleId: 11, ResourceType: "project", ResourceId: model.ResourceWildcard},
+		{Id: 3, UserId: 3, RoleId: 11, ResourceType: "project", ResourceId: "p1"},
+		{Id: 4, UserId: 1, RoleId: 12, ResourceType: "project", ResourceId: "p1"},
+		{Id: 5, UserId: 1, RoleId: 11, ResourceType: "repo", ResourceId: "r1"},
+	}, Users: users, Roles: roles}
+	return &bindingFixture{
+		svcCtx: &svc.ServiceContext{
+			UsersModel:       users,
+			RolesModel:       roles,
+			PermissionsModel: &modeltest.Permissions{Rows: []*model.Permissions{{Id: 1, Code: "project:write"}}},
+			RolePermissionsModel: &modeltest.RolePermissions{Rows: []*model.RolePermissions{
+				{RoleId: 11, PermissionId: 1}, {RoleId: 10, PermissionId: 1, Effect: model.EffectDeny},
+			}},
+			ResourceBindingsModel: bindings,
+		},
+		bindings: bindings,
+	}
+}
+
+func requireError(t *testing.T, err error, code codes.Code, errCode string) {
+	t.Helper()
+	if status.Code(err) != code || !strings.Contains(err.Error(), errCode) {
+		t.Fatalf("error = %v, want %s %s", err, code, errCode)
+	}
+}
+
+func TestCreateResourceBinding(t *testing.T) {
+	f := newBindingFixture()
+	resp, err := NewCreateResourceBindingLogic(context.Background(), f.svcCtx).CreateResourceBinding(&iam.CreateResourceBindingRequest{
+		UserId: 2, RoleId: 10, ResourceType: " project ", ResourceId: " p1 ",
+	})
+	if err != nil {
+		t.Fatalf("CreateResourceBinding: %v", err)
+	}
+	b := f.bindings.Row(resp.Id)
+	if b == nil || b.UserId != 2 || b.RoleId != 10 || b.ResourceType != "project" || b.ResourceId != "p1" {
+		t.Fatalf("binding = %+v, want user 2 role 10 on project/p1", b)
+	}
+}
+
+func TestCreateResourceBindingRejects(t *testing.T) {
+	long := strings.Repeat("x", maxResourceIdLength+1)
+	tests := []struct {
+		name    string
+		in      *iam.CreateResourceBindingRequest
+		code    codes.Code
+		errCode string
+	}{
+		{"missing user", &iam.CreateResourceBindingRequest{RoleId: 10, ResourceType: "project", ResourceId: "p1"}, codes.InvalidArgument, "CRB001"},
+		{"missing role", &iam.CreateResourceBindingRequest{UserId: 1, ResourceType: "project", ResourceId: "p1"}, codes.InvalidArgument, "CRB002"},
+		{"blank resource type", &iam.CreateResourceBindingRequest{UserId: 1, RoleId: 10, ResourceType: " ", ResourceId: "p1"}, codes.InvalidArgument, "CRB003"},
+		{"resource id too long", &iam.CreateResourceBindingRequest{UserId: 1, RoleId: 10, ResourceType: "project", ResourceId: long}, codes.InvalidArgument, "CRB003"},
+		{"deleted user", &iam.CreateResourceBindingRequest{UserId: 3, RoleId: 10, ResourceType: "project", ResourceId: "p2"}, codes.NotFound, "CRB004"},
+		{"disabled role", &iam.CreateResourceBindingRequest{UserId: 1, RoleId: 12, ResourceType: "project", ResourceId: "p2"}, codes.NotFound, "CRB006"},
+		{"duplicate", &iam.CreateResourceBindingRequest{UserId: 1, RoleId: 10, ResourceType: "project", ResourceId: "p1"}, codes.AlreadyExists, "CRB009"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newBindingFixture()
+			_, err := NewCreateResourceBindingLogic(context.Background(), f.svcCtx).CreateResourceBinding(tt.in)
+			requireError(t, err, tt.code, tt.errCode)
+			if len(f.bindings.Rows) != 5 {
+				t.Fatalf("bindings = %d, want unchanged", len(f.bindings.Rows))
+			}
+		})
+	}
+}
+
+func TestDeleteResourceBinding(t *testing.T) {
+	f := newBindingFixture()
+	if _, err := NewDeleteResourceBindingLogic(context.Background(), f.svcCtx).DeleteResourceBinding(&iam.DeleteResourceBindingRequest{Id: 1}); err != nil {
+		t.Fatalf("DeleteResourceBinding: %v", err)
+	}
+	if f.bindings.Row(1) != nil {
+		t.Fatal("binding 1 still exists")
+	}
+	_, err := NewDeleteResourceBindingLogic(context.Background(), f.svcCtx).DeleteResourceBinding(&iam.DeleteResourceBindingRequest{Id: 1})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("second delete err = %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,57 @@
+package bindingservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeleteResourceBindingLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteResourceBindingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteResourceBindingLogic {
+	return &DeleteResourceBindingLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteResourceBinding 删除资源绑定
+func (l *DeleteResourceBindingLogic) DeleteResourceBinding(in *iam.DeleteResourceBindingRequest) (*iam.DeleteResourceBindingResponse, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[DRB001] Binding ID is required")
+	}
+
+	// 检查绑定是否存在
+	_, err := l.svcCtx.ResourceBindingsModel.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[DRB002] Resource binding not found")
+		}
+		eInfo := "[DRB003] 查询资源绑定失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 删除绑定
+	if err = l.svcCtx.ResourceBindingsModel.Delete(l.ctx, in.Id); err != nil {
+		eInfo := "[DRB004] 删除资源绑定失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.DeleteResourceBindingResponse{
+		Success: true,
+	}, nil
+}
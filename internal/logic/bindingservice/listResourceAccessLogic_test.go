@@ -0,0 +1,89 @@
+package bindingservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ziptako/iam/iam"
+)
+
+func bindingIds(items []*iam.ResourceBinding) []int64 {
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Id)
+	}
+	return ids
+}
+
+func TestListResourceAccess(t *testing.T) {
+	tests := []struct {
+		name           string
+		resourceId     string
+		permissionCode string
+		want           []int64
+	}{
+		// 已删除用户3和已禁用角色12的绑定不返回，通配绑定2作用于所有项目
+		{"exact and wildcard", "p1", "", []int64{1, 2}},
+		{"wildcard only", "p9", "", []int64{2}},
+		{"filtered by permission", "p1", "project:write", []int64{2}},
+		{"unknown permission matches nothing", "p1", "project:delete", []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newBindingFixture()
+			resp, err := NewListResourceAccessLogic(context.Background(), f.svcCtx).ListResourceAccess(&iam.ListResourceAccessRequest{
+				ResourceType: "project", ResourceId: tt.resourceId, PermissionCode: tt.permissionCode,
+			})
+			if err != nil {
+				t.Fatalf("ListResourceAccess: %v", err)
+			}
+			got := bindingIds(resp.Items)
+			if len(got) != len(tt.want) || resp.Total != int64(len(tt.want)) {
+				t.Fatalf("bindings = %v (total %d), want %v", got, resp.Total, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("bindings = %v, want %v", got, tt.want)
+				}
+			}
+			for _, item := range resp.Items {
+				if item.User == nil || item.Role == nil {
+					t.Fatalf("binding %d missing user or role details", item.Id)
+				}
+			}
+		})
+	}
+}
+
+func TestListUserResources(t *testing.T) {
+	tests := []struct {
+		name           string
+		resourceType   string
+		permissionCode string
+		want           []int64
+	}{
+		{"all types", "", "", []int64{1, 5}},
+		{"by type", "repo", "", []int64{5}},
+		{"by permission", "", "project:write", []int64{5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newBindingFixture()
+			resp, err := NewListUserResourcesLogic(context.Background(), f.svcCtx).ListUserResources(&iam.ListUserResourcesRequest{
+				UserId: 1, ResourceType: tt.resourceType, PermissionCode: tt.permissionCode,
+			})
+			if err != nil {
+				t.Fatalf("ListUserResources: %v", err)
+			}
+			got := bindingIds(resp.Items)
+			if len(got) != len(tt.want) || resp.Total != int64(len(tt.want)) {
+				t.Fatalf("bindings = %v (total %d), want %v", got, resp.Total, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("bindings = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
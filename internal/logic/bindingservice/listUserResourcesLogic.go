@@ -0,0 +1,92 @@
+package bindingservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/common/utils"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListUserResourcesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListUserResourcesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUserResourcesLogic {
+	return &ListUserResourcesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListUserResources 分页查询用户通过资源绑定可操作的资源
+func (l *ListUserResourcesLogic) ListUserResources(in *iam.ListUserResourcesRequest) (*iam.ListResourceBindingsResponse, error) {
+	// 参数验证
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[LUR001] User ID is required")
+	}
+	resourceType := strings.TrimSpace(in.ResourceType)
+	if len(resourceType) > maxResourceTypeLength {
+		return nil, status.Error(codes.InvalidArgument, "[LUR002] Resource type is too long")
+	}
+
+	// 检查用户是否存在
+	_, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[LUR003] User not found")
+		}
+		eInfo := "[LUR004] 查询用户失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 按权限过滤时只保留具备该权限的角色
+	var roleIds []int64
+	if strings.TrimSpace(in.PermissionCode) != "" {
+		roleIds, err = findRoleIdsByPermissionCode(l.ctx, l.svcCtx, in.PermissionCode)
+		if err != nil {
+			eInfo := "[LUR005] 查询具备权限的角色失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	// 使用工具函数计算分页参数
+	pagination := utils.CalculatePagination(in.Page, in.PageSize)
+
+	bindings, err := l.svcCtx.ResourceBindingsModel.FindByUserId(l.ctx, in.UserId, resourceType, roleIds, pagination.Limit, pagination.Offset)
+	if err != nil {
+		eInfo := "[LUR006] 查询资源绑定失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	total, err := l.svcCtx.ResourceBindingsModel.CountByUserId(l.ctx, in.UserId, resourceType, roleIds)
+	if err != nil {
+		eInfo := "[LUR007] 查询资源绑定总数失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	items, err := toProtoBindings(l.ctx, l.svcCtx, bindings)
+	if err != nil {
+		eInfo := "[LUR008] 查询绑定详情失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.ListResourceBindingsResponse{
+		Items: items,
+		Total: total,
+	}, nil
+}
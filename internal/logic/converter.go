@@ -2,8 +2,11 @@ package logic
 
 import (
 	"database/sql"
+	"errors"
 	"github.com/ziptako/iam/db/model"
 	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/policy"
+	"github.com/ziptako/iam/internal/sod"
 
 	"time"
 )
@@ -31,6 +34,15 @@ func ModelToProtoUser(source *model.Users) *iam.User {
 	if source.Phone.Valid {
 		res.Phone = source.Phone.String
 	}
+	if source.EmailVerifiedAt.Valid {
+		res.EmailVerifiedAt = source.EmailVerifiedAt.Time.Unix()
+	}
+	if source.PhoneVerifiedAt.Valid {
+		res.PhoneVerifiedAt = source.PhoneVerifiedAt.Time.Unix()
+	}
+	if source.DepartmentId.Valid {
+		res.DepartmentId = source.DepartmentId.Int64
+	}
 
 	return res
 }
@@ -72,6 +84,7 @@ func ModelToProtoRole(source *model.Roles) *iam.Role {
 		Name:      source.Name,
 		Code:      source.Code,
 		SortOrder: int32(source.SortOrder),
+		DataScope: source.DataScope,
 		CreatedAt: source.CreatedAt.Unix(),
 		UpdatedAt: source.UpdatedAt.Unix(),
 	}
@@ -124,6 +137,12 @@ func ModelToProtoPermission(source *model.Permissions) *iam.Permission {
 	if source.Description.Valid {
 		res.Description = source.Description.String
 	}
+	if source.Namespace.Valid {
+		res.Namespace = source.Namespace.String
+	}
+	if source.DeprecatedAt.Valid {
+		res.DeprecatedAt = source.DeprecatedAt.Time.Unix()
+	}
 
 	return res
 }
@@ -193,3 +212,417 @@ func ModelPermissionsToProtoPermissions(sources []*model.Permissions) []*iam.Per
 	}
 	return result
 }
+
+// ModelToProtoSession 将model会话转换为proto会话
+func ModelToProtoSession(source *model.Sessions) *iam.Session {
+	if source == nil {
+		return nil
+	}
+
+	res := &iam.Session{
+		Id:         source.Id,
+		UserId:     source.UserId,
+		CreatedAt:  source.CreatedAt.Unix(),
+		LastSeenAt: source.LastSeenAt.Unix(),
+	}
+
+	// 处理可空字段
+	if source.Client.Valid {
+		res.Client = source.Client.String
+	}
+	if source.Ip.Valid {
+		res.Ip = source.Ip.String
+	}
+	if source.UserAgent.Valid {
+		res.UserAgent = source.UserAgent.String
+	}
+	if source.RevokedAt.Valid {
+		res.RevokedAt = source.RevokedAt.Time.Unix()
+	}
+	if source.RevokeReason.Valid {
+		res.RevokeReason = source.RevokeReason.String
+	}
+
+	return res
+}
+
+// ModelSessionsToProtoSessions 批量转换会话列表
+func ModelSessionsToProtoSessions(sources []*model.Sessions) []*iam.Session {
+	if sources == nil {
+		return nil
+	}
+
+	result := make([]*iam.Session, 0, len(sources))
+	for _, source := range sources {
+		if session := ModelToProtoSession(source); session != nil {
+			result = append(result, session)
+		}
+	}
+	return result
+}
+
+// ModelToProtoGroup 将model用户组转换为proto用户组
+func ModelToProtoGroup(source *model.Groups) *iam.Group {
+	if source == nil {
+		return nil
+	}
+
+	res := &iam.Group{
+		Id:        source.Id,
+		Name:      source.Name,
+		Code:      source.Code,
+		CreatedAt: source.CreatedAt.Unix(),
+		UpdatedAt: source.UpdatedAt.Unix(),
+	}
+
+	// 处理可空字段
+	if source.Description.Valid {
+		res.Description = source.Description.String
+	}
+
+	return res
+}
+
+// ModelGroupsToProtoGroups 批量转换用户组列表
+func ModelGroupsToProtoGroups(sources []*model.Groups) []*iam.Group {
+	if sources == nil {
+		return nil
+	}
+
+	result := make([]*iam.Group, 0, len(sources))
+	for _, source := range sources {
+		if group := ModelToProtoGroup(source); group != nil {
+			result = append(result, group)
+		}
+	}
+	return result
+}
+
+// ModelToProtoDepartment 将model部门转换为proto部门
+func ModelToProtoDepartment(source *model.Departments) *iam.Department {
+	if source == nil {
+		return nil
+	}
+
+	res := &iam.Department{
+		Id:        source.Id,
+		Path:      source.Path,
+		Name:      source.Name,
+		Code:      source.Code,
+		SortOrder: int32(source.SortOrder),
+		CreatedAt: source.CreatedAt.Unix(),
+		UpdatedAt: source.UpdatedAt.Unix(),
+	}
+
+	// 处理可空字段
+	if source.ParentId.Valid {
+		res.ParentId = source.ParentId.Int64
+	}
+
+	return res
+}
+
+// ModelDepartmentsToProtoDepartments 批量转换部门列表
+func ModelDepartmentsToProtoDepartments(sources []*model.Departments) []*iam.Department {
+	if sources == nil {
+		return nil
+	}
+
+	result := make([]*iam.Department, 0, len(sources))
+	for _, source := range sources {
+		if department := ModelToProtoDepartment(source); department != nil {
+			result = append(result, department)
+		}
+	}
+	return result
+}
+
+// ModelToProtoResourceBinding 将model资源绑定转换为proto资源绑定
+func ModelToProtoResourceBinding(source *model.ResourceBindings) *iam.ResourceBinding {
+	if source == nil {
+		return nil
+	}
+
+	return &iam.ResourceBinding{
+		Id:           source.Id,
+		UserId:       source.UserId,
+		RoleId:       source.RoleId,
+		ResourceType: source.ResourceType,
+		ResourceId:   source.ResourceId,
+		CreatedAt:    source.CreatedAt.Unix(),
+	}
+}
+
+// ModelToProtoPermissionGrant 将model角色权限关联转换为proto授权
+func ModelToProtoPermissionGrant(source *model.RolePermissions, permissionCode string) *iam.PermissionGrant {
+	if source == nil {
+		return nil
+	}
+
+	return &iam.PermissionGrant{
+		RoleId:         source.RoleId,
+		PermissionId:   source.PermissionId,
+		PermissionCode: permissionCode,
+		Effect:         source.Effect,
+		Condition:      source.Condition,
+		CreatedAt:      source.CreatedAt.Unix(),
+		ValidFrom:      nullTimeUnix(source.ValidFrom),
+		ValidUntil:     nullTimeUnix(source.ValidUntil),
+	}
+}
+
+// nullTimeUnix 可空时间转换为时间戳，为空时返回0
+func nullTimeUnix(t sql.NullTime) int64 {
+	if !t.Valid {
+		return 0
+	}
+	return t.Time.Unix()
+}
+
+// ProtoToModelValidity 将请求中的生效和失效时间戳转换为model有效期，时间戳为0表示不限制
+// 失效时间必须晚于当前时间和生效时间
+func ProtoToModelValidity(validFrom, validUntil int64) (model.Validity, error) {
+	if validFrom < 0 || validUntil < 0 {
+		return model.Validity{}, errors.New("timestamps must not be negative")
+	}
+	var validity model.Validity
+	if validFrom > 0 {
+		validity.From = sql.NullTime{Time: time.Unix(validFrom, 0), Valid: true}
+	}
+	if validUntil > 0 {
+		validity.Until = sql.NullTime{Time: time.Unix(validUntil, 0), Valid: true}
+		if !validity.Until.Time.After(time.Now()) {
+			return model.Validity{}, errors.New("valid_until must be in the future")
+		}
+		if validity.From.Valid && !validity.Until.Time.After(validity.From.Time) {
+			return model.Validity{}, errors.New("valid_until must be later than valid_from")
+		}
+	}
+	return validity, nil
+}
+
+// ModelToProtoAccessRequest 将model访问申请转换为proto访问申请，事件历史需另行填充
+func ModelToProtoAccessRequest(source *model.AccessRequests) *iam.AccessRequest {
+	if source == nil {
+		return nil
+	}
+
+	return &iam.AccessRequest{
+		Id:              source.Id,
+		RequesterId:     source.RequesterId,
+		RoleId:          source.RoleId,
+		Justification:   source.Justification,
+		DurationSeconds: source.DurationSeconds,
+		Status:          source.Status,
+		CurrentStep:     int32(source.CurrentStep),
+		TotalSteps:      int32(source.TotalSteps),
+		DecidedAt:       nullTimeUnix(source.DecidedAt),
+		CreatedAt:       source.CreatedAt.Unix(),
+		UpdatedAt:       source.UpdatedAt.Unix(),
+	}
+}
+
+// ModelToProtoAccessRequestEvent 将model访问申请事件转换为proto访问申请事件
+func ModelToProtoAccessRequestEvent(source *model.AccessRequestEvents) *iam.AccessRequestEvent {
+	if source == nil {
+		return nil
+	}
+
+	return &iam.AccessRequestEvent{
+		Id:        source.Id,
+		ActorId:   source.ActorId.Int64,
+		EventType: source.EventType,
+		Step:      int32(source.Step),
+		Comment:   source.Comment,
+		CreatedAt: source.CreatedAt.Unix(),
+	}
+}
+
+// ModelAccessRequestEventsToProtoEvents 批量转换model访问申请事件为proto访问申请事件
+func ModelAccessRequestEventsToProtoEvents(sources []*model.AccessRequestEvents) []*iam.AccessRequestEvent {
+	if len(sources) == 0 {
+		return nil
+	}
+
+	result := make([]*iam.AccessRequestEvent, 0, len(sources))
+	for _, source := range sources {
+		if event := ModelToProtoAccessRequestEvent(source); event != nil {
+			result = append(result, event)
+		}
+	}
+	return result
+}
+
+// ModelToProtoRoleConstraint 将职责分离约束转换为proto约束
+func ModelToProtoRoleConstraint(source *sod.Constraint) *iam.RoleConstraint {
+	if source == nil {
+		return nil
+	}
+
+	return &iam.RoleConstraint{
+		Id:             source.Id,
+		Code:           source.Code,
+		Name:           source.Name,
+		Description:    source.Description.String,
+		ConstraintType: source.ConstraintType,
+		Enforcement:    source.Enforcement,
+		MaxCount:       int32(source.MaxCount),
+		RoleIds:        source.RoleIds,
+		CreatedAt:      source.CreatedAt.Unix(),
+		UpdatedAt:      source.UpdatedAt.Unix(),
+	}
+}
+
+// SodViolationToProto 将职责分离约束违规转换为proto违规
+func SodViolationToProto(source *sod.Violation) *iam.ConstraintViolation {
+	if source == nil {
+		return nil
+	}
+
+	return &iam.ConstraintViolation{
+		ConstraintId:   source.Constraint.Id,
+		ConstraintCode: source.Constraint.Code,
+		ConstraintType: source.Constraint.ConstraintType,
+		UserId:         source.UserId,
+		RoleIds:        source.RoleIds,
+		RoleId:         source.RoleId,
+		UserIds:        source.UserIds,
+		Count:          int32(source.Count),
+		MaxCount:       int32(source.Constraint.MaxCount),
+		Message:        source.Error(),
+	}
+}
+
+// ModelToProtoAdminScope 将数据库管理范围及其可分配角色转换为proto管理范围
+func ModelToProtoAdminScope(source *model.AdminScopes, roles []*model.AdminScopeRoles) *iam.AdminScope {
+	if source == nil {
+		return nil
+	}
+
+	roleIds := make([]int64, 0, len(roles))
+	for _, role := range roles {
+		roleIds = append(roleIds, role.RoleId)
+	}
+	return &iam.AdminScope{
+		AdminId:          source.AdminId,
+		DepartmentId:     source.DepartmentId.Int64,
+		GrantableRoleIds: roleIds,
+		CreatedAt:        source.CreatedAt.Unix(),
+		UpdatedAt:        source.UpdatedAt.Unix(),
+	}
+}
+
+// ModelToProtoReviewCampaign 将model访问复核活动转换为proto访问复核活动，counts为按决定统计的条目数量
+func ModelToProtoReviewCampaign(source *model.ReviewCampaigns, counts []*model.ReviewDecisionCount) *iam.ReviewCampaign {
+	if source == nil {
+		return nil
+	}
+
+	progress := &iam.ReviewProgress{}
+	for _, c := range counts {
+		progress.Total += c.Count
+		switch c.Decision {
+		case model.ReviewDecisionPending:
+			progress.Pending = c.Count
+		case model.ReviewDecisionKeep:
+			progress.Keep = c.Count
+		case model.ReviewDecisionRevoke:
+			progress.Revoke = c.Count
+		}
+	}
+	return &iam.ReviewCampaign{
+		Id:             source.Id,
+		Name:           source.Name,
+		Description:    source.Description,
+		ReviewerSource: source.ReviewerSource,
+		Status:         source.Status,
+		DueAt:          nullTimeUnix(source.DueAt),
+		ClosedAt:       nullTimeUnix(source.ClosedAt),
+		ClosedBy:       source.ClosedBy.Int64,
+		CreatedBy:      source.CreatedBy.Int64,
+		CreatedAt:      source.CreatedAt.Unix(),
+		UpdatedAt:      source.UpdatedAt.Unix(),
+		Progress:       progress,
+	}
+}
+
+// ModelToProtoReviewItem 将model访问复核条目及其复核人转换为proto访问复核条目
+func ModelToProtoReviewItem(source *model.ReviewItems, reviewers []*model.ReviewItemReviewers) *iam.ReviewItem {
+	if source == nil {
+		return nil
+	}
+
+	reviewerIds := make([]int64, 0, len(reviewers))
+	for _, r := range reviewers {
+		reviewerIds = append(reviewerIds, r.ReviewerId)
+	}
+	return &iam.ReviewItem{
+		Id:            source.Id,
+		CampaignId:    source.CampaignId,
+		UserId:        source.UserId,
+		RoleId:        source.RoleId,
+		GrantedAt:     source.GrantedAt.Unix(),
+		ValidFrom:     nullTimeUnix(source.ValidFrom),
+		ValidUntil:    nullTimeUnix(source.ValidUntil),
+		Decision:      source.Decision,
+		Comment:       source.Comment,
+		DecidedBy:     source.DecidedBy.Int64,
+		DecidedAt:     nullTimeUnix(source.DecidedAt),
+		Outcome:       source.Outcome,
+		OutcomeDetail: source.OutcomeDetail,
+		ReviewerIds:   reviewerIds,
+	}
+}
+
+// ModelToProtoGrantUsage 将model未使用授权转换为proto授权使用情况
+func ModelToProtoGrantUsage(source *model.UnusedGrant, roleCode, permissionCode string) *iam.GrantUsage {
+	if source == nil {
+		return nil
+	}
+
+	return &iam.GrantUsage{
+		RoleId:         source.RoleId,
+		RoleCode:       roleCode,
+		PermissionId:   source.PermissionId,
+		PermissionCode: permissionCode,
+		GrantedAt:      source.GrantedAt.Unix(),
+		LastUsedAt:     nullTimeUnix(source.LastUsedAt),
+		UseCount:       source.UseCount,
+	}
+}
+
+// ModelToProtoRoleUsage 将model未使用角色转换为proto角色使用情况
+func ModelToProtoRoleUsage(source *model.UnusedRole) *iam.RoleUsage {
+	if source == nil {
+		return nil
+	}
+
+	return &iam.RoleUsage{
+		RoleId:     source.RoleId,
+		Code:       source.Code,
+		Name:       source.Name,
+		CreatedAt:  source.CreatedAt.Unix(),
+		LastUsedAt: nullTimeUnix(source.LastUsedAt),
+	}
+}
+
+// PolicyPlanToProto 将策略清单差异转换为proto，applied表示差异是否已应用
+func PolicyPlanToProto(source *policy.Plan, applied bool) *iam.PolicyPlan {
+	res := &iam.PolicyPlan{
+		Changes:  make([]*iam.PolicyChange, 0, len(source.Changes)),
+		Warnings: source.Warnings,
+		Creates:  int64(source.Count(policy.ActionCreate)),
+		Updates:  int64(source.Count(policy.ActionUpdate)),
+		Deletes:  int64(source.Count(policy.ActionDelete)),
+		Applied:  applied,
+	}
+	for _, change := range source.Changes {
+		res.Changes = append(res.Changes, &iam.PolicyChange{
+			Action:  change.Action,
+			Kind:    change.Kind,
+			Key:     change.Key,
+			Details: change.Details,
+		})
+	}
+	return res
+}
@@ -0,0 +1,95 @@
+package departmentservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreateDepartmentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateDepartmentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateDepartmentLogic {
+	return &CreateDepartmentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateDepartment 创建部门
+func (l *CreateDepartmentLogic) CreateDepartment(in *iam.CreateDepartmentRequest) (*iam.CreateDepartmentResponse, error) {
+	// 参数验证
+	if strings.TrimSpace(in.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "[CDP001] Department name is required")
+	}
+	if strings.TrimSpace(in.Code) == "" {
+		return nil, status.Error(codes.InvalidArgument, "[CDP002] Department code is required")
+	}
+	if in.ParentId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CDP003] Invalid parent department ID")
+	}
+
+	// 检查部门编码是否已存在
+	exists, err := l.svcCtx.DepartmentsModel.ExistsByCode(l.ctx, in.Code, 0)
+	if err != nil {
+		eInfo := "[CDP004] 检查部门编码是否存在失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if exists {
+		return nil, status.Error(codes.AlreadyExists, "[CDP005] Department code already exists")
+	}
+
+	// 构建部门数据，下级部门的祖先路径由上级部门推导
+	department := &model.Departments{
+		Path:      model.DepartmentRootPath,
+		Name:      in.Name,
+		Code:      in.Code,
+		SortOrder: int64(in.SortOrder),
+	}
+	if in.ParentId > 0 {
+		parent, err := l.svcCtx.DepartmentsModel.FindActiveById(l.ctx, in.ParentId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "[CDP006] Parent department not found")
+			}
+			eInfo := "[CDP007] 查询上级部门失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		department.ParentId = sql.NullInt64{Int64: parent.Id, Valid: true}
+		department.Path = parent.ChildPath()
+	}
+
+	// 插入数据库
+	result, err := l.svcCtx.DepartmentsModel.Insert(l.ctx, department)
+	if err != nil {
+		eInfo := "[CDP008] 创建部门失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 获取插入的ID
+	id, err := result.LastInsertId()
+	if err != nil {
+		eInfo := "[CDP009] 获取部门ID失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.CreateDepartmentResponse{
+		Id: id,
+	}, nil
+}
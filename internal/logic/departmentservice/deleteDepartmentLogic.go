@@ -0,0 +1,80 @@
+package departmentservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeleteDepartmentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteDepartmentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteDepartmentLogic {
+	return &DeleteDepartmentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteDepartment 删除部门（软删除），存在下级部门或成员时不允许删除
+func (l *DeleteDepartmentLogic) DeleteDepartment(in *iam.DeleteDepartmentRequest) (*iam.DeleteDepartmentResponse, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[DDP001] Department ID is required")
+	}
+
+	// 检查部门是否存在
+	_, err := l.svcCtx.DepartmentsModel.FindActiveById(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[DDP002] Department not found")
+		}
+		eInfo := "[DDP003] 查询部门失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 检查是否存在下级部门
+	children, err := l.svcCtx.DepartmentsModel.CountChildren(l.ctx, in.Id)
+	if err != nil {
+		eInfo := "[DDP004] 统计下级部门失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if children > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "[DDP005] Department has sub-departments and cannot be deleted")
+	}
+
+	// 检查部门中是否还有用户
+	members, err := l.svcCtx.DepartmentsModel.CountMembers(l.ctx, in.Id)
+	if err != nil {
+		eInfo := "[DDP006] 统计部门成员失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if members > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "[DDP007] Department has members and cannot be deleted")
+	}
+
+	// 软删除部门，角色的自定义数据权限中引用的已删除部门在解析时被忽略
+	err = l.svcCtx.DepartmentsModel.SoftDelete(l.ctx, in.Id)
+	if err != nil {
+		eInfo := "[DDP008] 删除部门失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.DeleteDepartmentResponse{
+		Success: true,
+	}, nil
+}
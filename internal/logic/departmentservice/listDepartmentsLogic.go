@@ -0,0 +1,69 @@
+package departmentservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListDepartmentsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListDepartmentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListDepartmentsLogic {
+	return &ListDepartmentsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListDepartments 查询组织树，按层级顺序返回指定部门及其所有下级部门
+func (l *ListDepartmentsLogic) ListDepartments(in *iam.ListDepartmentsRequest) (*iam.ListDepartmentsResponse, error) {
+	// 参数验证
+	if in.RootId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[LDP001] Invalid root department ID")
+	}
+
+	departments, err := l.svcCtx.DepartmentsModel.FindAllActive(l.ctx)
+	if err != nil {
+		eInfo := "[LDP002] 查询部门列表失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 只保留指定部门及其下级部门
+	if in.RootId > 0 {
+		root, err := l.svcCtx.DepartmentsModel.FindActiveById(l.ctx, in.RootId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "[LDP003] Root department not found")
+			}
+			eInfo := "[LDP004] 查询根部门失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		childPath := root.ChildPath()
+		subtree := make([]*model.Departments, 0, len(departments))
+		for _, d := range departments {
+			if d.Id == root.Id || strings.HasPrefix(d.Path, childPath) {
+				subtree = append(subtree, d)
+			}
+		}
+		departments = subtree
+	}
+
+	return &iam.ListDepartmentsResponse{
+		Items: logic.ModelDepartmentsToProtoDepartments(departments),
+	}, nil
+}
@@ -0,0 +1,77 @@
+package departmentservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetUserDepartmentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetUserDepartmentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetUserDepartmentLogic {
+	return &SetUserDepartmentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetUserDepartment 设置用户所属部门
+func (l *SetUserDepartmentLogic) SetUserDepartment(in *iam.SetUserDepartmentRequest) (*iam.SetUserDepartmentResponse, error) {
+	// 参数验证
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[SUD001] User ID is required")
+	}
+	if in.DepartmentId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[SUD002] Invalid department ID")
+	}
+
+	// 检查用户是否存在
+	user, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[SUD003] User not found")
+		}
+		eInfo := "[SUD004] 查询用户失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 检查部门是否存在，部门ID为0表示移出部门
+	user.DepartmentId = sql.NullInt64{}
+	if in.DepartmentId > 0 {
+		_, err := l.svcCtx.DepartmentsModel.FindActiveById(l.ctx, in.DepartmentId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "[SUD005] Department not found")
+			}
+			eInfo := "[SUD006] 查询部门失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		user.DepartmentId = sql.NullInt64{Int64: in.DepartmentId, Valid: true}
+	}
+
+	// 更新数据库
+	err = l.svcCtx.UsersModel.Update(l.ctx, user)
+	if err != nil {
+		eInfo := "[SUD007] 更新用户部门失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.SetUserDepartmentResponse{
+		Success: true,
+	}, nil
+}
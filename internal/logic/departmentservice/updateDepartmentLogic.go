@@ -0,0 +1,117 @@
+package departmentservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UpdateDepartmentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateDepartmentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateDepartmentLogic {
+	return &UpdateDepartmentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateDepartment 更新部门信息，调整上级部门时同步更新所有下级部门
+func (l *UpdateDepartmentLogic) UpdateDepartment(in *iam.UpdateDepartmentRequest) (*iam.Department, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[UDP001] Department ID is required")
+	}
+	if in.ParentId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[UDP002] Invalid parent department ID")
+	}
+
+	// 查询现有部门
+	existingDepartment, err := l.svcCtx.DepartmentsModel.FindActiveById(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[UDP003] Department not found")
+		}
+		eInfo := "[UDP004] 查询部门失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 更新名称
+	if strings.TrimSpace(in.Name) != "" {
+		existingDepartment.Name = in.Name
+	}
+
+	// 检查部门编码是否已被其他部门使用
+	if strings.TrimSpace(in.Code) != "" && in.Code != existingDepartment.Code {
+		exists, err := l.svcCtx.DepartmentsModel.ExistsByCode(l.ctx, in.Code, in.Id)
+		if err != nil {
+			eInfo := "[UDP005] 检查部门编码是否存在失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		if exists {
+			return nil, status.Error(codes.AlreadyExists, "[UDP006] Department code already exists")
+		}
+		existingDepartment.Code = in.Code
+	}
+	if in.SortOrder >= 0 {
+		existingDepartment.SortOrder = int64(in.SortOrder)
+	}
+
+	// 调整上级部门，新的上级不能是自身或自身的下级部门
+	oldChildPath := existingDepartment.ChildPath()
+	if in.ParentId != existingDepartment.ParentId.Int64 {
+		existingDepartment.ParentId = sql.NullInt64{}
+		existingDepartment.Path = model.DepartmentRootPath
+		if in.ParentId > 0 {
+			parent, err := l.svcCtx.DepartmentsModel.FindActiveById(l.ctx, in.ParentId)
+			if err != nil {
+				if errors.Is(err, model.ErrNotFound) {
+					return nil, status.Error(codes.NotFound, "[UDP007] Parent department not found")
+				}
+				eInfo := "[UDP008] 查询上级部门失败"
+				l.Logger.Errorf("%v: %v", eInfo, err)
+				return nil, status.Error(codes.Internal, eInfo)
+			}
+			if parent.Id == existingDepartment.Id || strings.HasPrefix(parent.Path, oldChildPath) {
+				return nil, status.Error(codes.InvalidArgument, "[UDP009] Parent department cannot be itself or its descendant")
+			}
+			existingDepartment.ParentId = sql.NullInt64{Int64: parent.Id, Valid: true}
+			existingDepartment.Path = parent.ChildPath()
+		}
+	}
+
+	// 更新数据库
+	err = l.svcCtx.DepartmentsModel.Update(l.ctx, existingDepartment)
+	if err != nil {
+		eInfo := "[UDP010] 更新部门失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 同步所有下级部门的祖先路径
+	if newChildPath := existingDepartment.ChildPath(); newChildPath != oldChildPath {
+		if err = l.svcCtx.DepartmentsModel.MoveSubtree(l.ctx, oldChildPath, newChildPath); err != nil {
+			eInfo := "[UDP011] 更新下级部门路径失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	// 返回更新后的部门信息
+	return logic.ModelToProtoDepartment(existingDepartment), nil
+}
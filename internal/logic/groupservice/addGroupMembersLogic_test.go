@@ -0,0 +1,124 @@
+package groupservicelogic
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/sod"
+	"github.com/ziptako/iam/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// groupFixture 测试数据：
+//
+//	用户1、2、4活跃，用户3已禁用，用户4直接持有角色13
+//	角色10、11、13可用，角色12已禁用，角色10和13互斥
+//	用户组100有成员1和角色10，用户组101为空，用户组102已删除
+type groupFixture struct {
+	svcCtx     *svc.ServiceContext
+	groups     *modeltest.Groups
+	members    *modeltest.GroupMembers
+	groupRoles *modeltest.GroupRoles
+}
+
+func newGroupFixture() *groupFixture {
+	now := time.Now()
+	f := &groupFixture{
+		groups: &modeltest.Groups{Rows: []*model.Groups{
+			{Id: 100, Code: "dev", Name: "Developers"},
+			{Id: 101, Code: "ops", Name: "Operators"},
+			{Id: 102, Code: "old", Name: "Old", DeletedAt: modeltest.NullTime(now)},
+		}},
+		members: &modeltest.GroupMembers{Rows: []*model.GroupMembers{{GroupId: 100, UserId: 1}}},
+	}
+	f.groupRoles = &modeltest.GroupRoles{Rows: []*model.GroupRoles{{GroupId: 100, RoleId: 10}}, Members: f.members, Groups: f.groups}
+	f.svcCtx = &svc.ServiceContext{
+		UsersModel: &modeltest.Users{Rows: []*model.Users{
+			{Id: 1, Username: "alice"},
+			{Id: 2, Username: "bob"},
+			{Id: 3, Username: "carol", DisabledAt: modeltest.NullTime(now)},
+			{Id: 4, Username: "dave"},
+		}},
+		RolesModel: &modeltest.Roles{Rows: []*model.Roles{
+			{Id: 10, Code: "developer", Name: "Developer"},
+			{Id: 11, Code: "auditor", Name: "Auditor"},
+			{Id: 12, Code: "legacy", Name: "Legacy", DisabledAt: modeltest.NullTime(now)},
+			{Id: 13, Code: "approver", Name: "Approver"},
+		}},
+		GroupsModel:       f.groups,
+		GroupMembersModel: f.members,
+		GroupRolesModel:   f.groupRoles,
+	}
+	f.svcCtx.Constraints = sod.NewChecker(
+		&modeltest.RoleConstraints{Rows: []*model.RoleConstraints{
+			{Id: 1, Code: "maker_checker", ConstraintType: model.RoleConstraintExclusive, Enforcement: model.RoleConstraintStatic, MaxCount: 1},
+		}},
+		&modeltest.RoleConstraintRoles{Rows: []*model.RoleConstraintRoles{{ConstraintId: 1, RoleId: 10}, {ConstraintId: 1, RoleId: 13}}},
+		&modeltest.UserRoles{Rows: []*model.UserRoles{{UserId: 4, RoleId: 13}}},
+		f.groupRoles,
+	)
+	return f
+}
+
+// requireError 校验gRPC状态码和错误编码
+func requireError(t *testing.T, err error, code codes.Code, errCode string) {
+	t.Helper()
+	if status.Code(err) != code || !strings.Contains(err.Error(), errCode) {
+		t.Fatalf("error = %v, want %s %s", err, code, errCode)
+	}
+}
+
+func TestAddGroupMembers(t *testing.T) {
+	f := newGroupFixture()
+	ctx := context.Background()
+	_, err := NewAddGroupMembersLogic(ctx, f.svcCtx).AddGroupMembers(&iam.AddGroupMembersRequest{GroupId: 100, UserIds: []int64{1, 2}})
+	if err != nil {
+		t.Fatalf("AddGroupMembers: %v", err)
+	}
+	// 已是成员的用户跳过
+	if got := f.members.Members(100); !slices.Equal(got, []int64{1, 2}) {
+		t.Fatalf("members = %v, want [1 2]", got)
+	}
+
+	_, err = NewRemoveGroupMembersLogic(ctx, f.svcCtx).RemoveGroupMembers(&iam.RemoveGroupMembersRequest{GroupId: 100, UserIds: []int64{1}})
+	if err != nil {
+		t.Fatalf("RemoveGroupMembers: %v", err)
+	}
+	if got := f.members.Members(100); !slices.Equal(got, []int64{2}) {
+		t.Fatalf("members = %v, want [2]", got)
+	}
+}
+
+func TestAddGroupMembersRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *iam.AddGroupMembersRequest
+		code    codes.Code
+		errCode string
+	}{
+		{"missing group", &iam.AddGroupMembersRequest{UserIds: []int64{2}}, codes.InvalidArgument, "[AGM001]"},
+		{"missing users", &iam.AddGroupMembersRequest{GroupId: 100}, codes.InvalidArgument, "[AGM002]"},
+		{"deleted group", &iam.AddGroupMembersRequest{GroupId: 102, UserIds: []int64{2}}, codes.NotFound, "[AGM003]"},
+		{"invalid user", &iam.AddGroupMembersRequest{GroupId: 100, UserIds: []int64{2, 0}}, codes.InvalidArgument, "[AGM005]"},
+		{"disabled user", &iam.AddGroupMembersRequest{GroupId: 100, UserIds: []int64{2, 3}}, codes.NotFound, "[AGM006]"},
+		{"exclusive role inherited", &iam.AddGroupMembersRequest{GroupId: 100, UserIds: []int64{2, 4}}, codes.FailedPrecondition, "[AGM011]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newGroupFixture()
+			_, err := NewAddGroupMembersLogic(context.Background(), f.svcCtx).AddGroupMembers(tt.in)
+			requireError(t, err, tt.code, tt.errCode)
+			// 校验失败时不添加任何成员
+			if got := f.members.Members(100); !slices.Equal(got, []int64{1}) {
+				t.Fatalf("members = %v, want [1]", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package groupservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/sod"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AssignGroupRolesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAssignGroupRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AssignGroupRolesLogic {
+	return &AssignGroupRolesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AssignGroupRoles 为用户组批量分配角色，组内成员继承这些角色
+func (l *AssignGroupRolesLogic) AssignGroupRoles(in *iam.AssignGroupRolesRequest) (*iam.AssignGroupRolesResponse, error) {
+	// 参数验证
+	if in.GroupId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[AGR001] Group ID is required")
+	}
+	if len(in.RoleIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "[AGR002] Role IDs are required")
+	}
+
+	// 检查用户组是否存在
+	_, err := l.svcCtx.GroupsModel.FindActiveById(l.ctx, in.GroupId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[AGR003] Group not found")
+		}
+		eInfo := "[AGR004] 查询用户组失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 检查所有角色是否存在
+	for _, roleId := range in.RoleIds {
+		if roleId <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "[AGR005] Invalid role ID")
+		}
+		_, err := l.svcCtx.RolesModel.FindActiveById(l.ctx, roleId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "[AGR006] Role not found")
+			}
+			eInfo := "[AGR007] 查询角色失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	// 在职责分离锁内校验约束并分配角色，用户组的成员也在锁内查询，避免与并发的授予交错
+	var failed error
+	err = l.svcCtx.Constraints.Locked(l.ctx, func(ctx context.Context) error {
+		failed = l.assignRoles(ctx, in)
+		return failed
+	})
+	if failed != nil {
+		return nil, failed
+	}
+	if err != nil {
+		eInfo := "[AGR012] 获取职责分离锁失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.AssignGroupRolesResponse{
+		Success: true,
+	}, nil
+}
+
+// assignRoles 校验职责分离约束后分配角色，用户组的所有成员都将继承这些角色，需在职责分离锁内调用
+func (l *AssignGroupRolesLogic) assignRoles(ctx context.Context, in *iam.AssignGroupRolesRequest) error {
+	memberIds, err := l.svcCtx.GroupMembersModel.FindUserIdsByGroupId(ctx, in.GroupId)
+	if err != nil {
+		eInfo := "[AGR009] 查询用户组成员失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	grants := make([]sod.Grant, 0, len(memberIds)*len(in.RoleIds))
+	for _, userId := range memberIds {
+		for _, roleId := range in.RoleIds {
+			grants = append(grants, sod.Grant{UserId: userId, RoleId: roleId, Inherited: true})
+		}
+	}
+	violation, err := l.svcCtx.Constraints.Check(ctx, grants)
+	if err != nil {
+		eInfo := "[AGR010] 校验职责分离约束失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	if violation != nil {
+		return status.Error(codes.FailedPrecondition, "[AGR011] Separation of duty constraint violated: "+violation.Error())
+	}
+
+	err = l.svcCtx.GroupRolesModel.AssignRoles(ctx, in.GroupId, in.RoleIds, sql.NullInt64{})
+	if err != nil {
+		eInfo := "[AGR008] 为用户组分配角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	return nil
+}
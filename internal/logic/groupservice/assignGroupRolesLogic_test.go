@@ -0,0 +1,66 @@
+package groupservicelogic
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/ziptako/iam/iam"
+	"google.golang.org/grpc/codes"
+)
+
+func TestAssignGroupRoles(t *testing.T) {
+	f := newGroupFixture()
+	ctx := context.Background()
+	_, err := NewAssignGroupRolesLogic(ctx, f.svcCtx).AssignGroupRoles(&iam.AssignGroupRolesRequest{GroupId: 100, RoleIds: []int64{10, 11}})
+	if err != nil {
+		t.Fatalf("AssignGroupRoles: %v", err)
+	}
+	if got := f.groupRoles.Roles(100); !slices.Equal(got, []int64{10, 11}) {
+		t.Fatalf("group roles = %v, want [10 11]", got)
+	}
+
+	// 已禁用的角色不在用户组角色中返回
+	f.groupRoles.Rows[0].RoleId = 12
+	resp, err := NewGetGroupRolesLogic(ctx, f.svcCtx).GetGroupRoles(&iam.GetGroupRolesRequest{GroupId: 100})
+	if err != nil {
+		t.Fatalf("GetGroupRoles: %v", err)
+	}
+	if len(resp.Roles) != 1 || resp.Roles[0].Id != 11 {
+		t.Fatalf("group roles = %v, want [11]", resp.Roles)
+	}
+
+	_, err = NewRemoveGroupRolesLogic(ctx, f.svcCtx).RemoveGroupRoles(&iam.RemoveGroupRolesRequest{GroupId: 100, RoleIds: []int64{11}})
+	if err != nil {
+		t.Fatalf("RemoveGroupRoles: %v", err)
+	}
+	if got := f.groupRoles.Roles(100); !slices.Equal(got, []int64{12}) {
+		t.Fatalf("group roles = %v, want [12]", got)
+	}
+}
+
+func TestAssignGroupRolesRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *iam.AssignGroupRolesRequest
+		code    codes.Code
+		errCode string
+	}{
+		{"missing group", &iam.AssignGroupRolesRequest{RoleIds: []int64{11}}, codes.InvalidArgument, "[AGR001]"},
+		{"missing roles", &iam.AssignGroupRolesRequest{GroupId: 100}, codes.InvalidArgument, "[AGR002]"},
+		{"deleted group", &iam.AssignGroupRolesRequest{GroupId: 102, RoleIds: []int64{11}}, codes.NotFound, "[AGR003]"},
+		{"invalid role", &iam.AssignGroupRolesRequest{GroupId: 100, RoleIds: []int64{-1}}, codes.InvalidArgument, "[AGR005]"},
+		{"disabled role", &iam.AssignGroupRolesRequest{GroupId: 100, RoleIds: []int64{11, 12}}, codes.NotFound, "[AGR006]"},
+		{"exclusive role for member", &iam.AssignGroupRolesRequest{GroupId: 100, RoleIds: []int64{11, 13}}, codes.FailedPrecondition, "[AGR011]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newGroupFixture()
+			_, err := NewAssignGroupRolesLogic(context.Background(), f.svcCtx).AssignGroupRoles(tt.in)
+			requireError(t, err, tt.code, tt.errCode)
+			if got := f.groupRoles.Roles(100); !slices.Equal(got, []int64{10}) {
+				t.Fatalf("group roles = %v, want [10]", got)
+			}
+		})
+	}
+}
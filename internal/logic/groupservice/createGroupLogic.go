@@ -0,0 +1,79 @@
+package groupservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreateGroupLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateGroupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateGroupLogic {
+	return &CreateGroupLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateGroup 创建用户组
+func (l *CreateGroupLogic) CreateGroup(in *iam.CreateGroupRequest) (*iam.CreateGroupResponse, error) {
+	// 参数验证
+	if strings.TrimSpace(in.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "[CG001] Group name is required")
+	}
+	if strings.TrimSpace(in.Code) == "" {
+		return nil, status.Error(codes.InvalidArgument, "[CG002] Group code is required")
+	}
+
+	// 检查用户组编码是否已存在
+	exists, err := l.svcCtx.GroupsModel.ExistsByCode(l.ctx, in.Code, 0)
+	if err != nil {
+		eInfo := "[CG003] 检查用户组编码是否存在失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if exists {
+		return nil, status.Error(codes.AlreadyExists, "[CG004] Group code already exists")
+	}
+
+	// 构建用户组数据
+	group := &model.Groups{
+		Name: in.Name,
+		Code: in.Code,
+	}
+	if strings.TrimSpace(in.Description) != "" {
+		group.Description = sql.NullString{String: in.Description, Valid: true}
+	}
+
+	// 插入数据库
+	result, err := l.svcCtx.GroupsModel.Insert(l.ctx, group)
+	if err != nil {
+		eInfo := "[CG005] 创建用户组失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 获取插入的ID
+	id, err := result.LastInsertId()
+	if err != nil {
+		eInfo := "[CG006] 获取用户组ID失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.CreateGroupResponse{
+		Id: id,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package groupservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeleteGroupLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteGroupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteGroupLogic {
+	return &DeleteGroupLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteGroup 删除用户组（软删除），同时解除成员和角色关联
+func (l *DeleteGroupLogic) DeleteGroup(in *iam.DeleteGroupRequest) (*iam.DeleteGroupResponse, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[DG001] Group ID is required")
+	}
+
+	// 检查用户组是否存在
+	existingGroup, err := l.svcCtx.GroupsModel.FindActiveById(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[DG002] Group not found")
+		}
+		eInfo := "[DG003] 查询用户组失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 软删除用户组，删除后成员立即不再继承组内角色
+	err = l.svcCtx.GroupsModel.SoftDelete(l.ctx, existingGroup.Id)
+	if err != nil {
+		eInfo := "[DG004] 删除用户组失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 解除成员和角色关联
+	if err = l.svcCtx.GroupMembersModel.RemoveAllGroupMembers(l.ctx, in.Id); err != nil {
+		// 记录警告但不阻止删除操作
+		l.Logger.Errorf("[DG005] 删除用户组成员失败: %v", err)
+	}
+	if err = l.svcCtx.GroupRolesModel.RemoveAllGroupRoles(l.ctx, in.Id); err != nil {
+		// 记录警告但不阻止删除操作
+		l.Logger.Errorf("[DG006] 删除用户组角色关联失败: %v", err)
+	}
+
+	return &iam.DeleteGroupResponse{
+		Success: true,
+	}, nil
+}
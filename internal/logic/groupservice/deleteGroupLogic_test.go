@@ -0,0 +1,31 @@
+package groupservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ziptako/iam/iam"
+	"google.golang.org/grpc/codes"
+)
+
+// TestDeleteGroup 删除用户组后成员不再继承组内角色
+func TestDeleteGroup(t *testing.T) {
+	f := newGroupFixture()
+	ctx := context.Background()
+	if inherited, _ := f.groupRoles.FindByUserId(ctx, 1); len(inherited) != 1 {
+		t.Fatalf("inherited roles before delete = %v", inherited)
+	}
+
+	if _, err := NewDeleteGroupLogic(ctx, f.svcCtx).DeleteGroup(&iam.DeleteGroupRequest{Id: 100}); err != nil {
+		t.Fatalf("DeleteGroup: %v", err)
+	}
+	if !f.groups.Row(100).DeletedAt.Valid || len(f.members.Members(100)) != 0 || len(f.groupRoles.Roles(100)) != 0 {
+		t.Fatalf("group not deleted with its members and roles")
+	}
+	if inherited, _ := f.groupRoles.FindByUserId(ctx, 1); len(inherited) != 0 {
+		t.Fatalf("inherited roles after delete = %v", inherited)
+	}
+
+	_, err := NewDeleteGroupLogic(ctx, f.svcCtx).DeleteGroup(&iam.DeleteGroupRequest{Id: 100})
+	requireError(t, err, codes.NotFound, "[DG002]")
+}
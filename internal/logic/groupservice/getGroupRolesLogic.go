@@ -0,0 +1,75 @@
+package groupservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetGroupRolesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetGroupRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetGroupRolesLogic {
+	return &GetGroupRolesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetGroupRoles 获取用户组拥有的所有角色
+func (l *GetGroupRolesLogic) GetGroupRoles(in *iam.GetGroupRolesRequest) (*iam.GetGroupRolesResponse, error) {
+	// 参数验证
+	if in.GroupId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[GGR001] Group ID is required")
+	}
+
+	// 检查用户组是否存在
+	_, err := l.svcCtx.GroupsModel.FindActiveById(l.ctx, in.GroupId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[GGR002] Group not found")
+		}
+		eInfo := "[GGR003] 查询用户组失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 获取用户组角色关联
+	groupRoles, err := l.svcCtx.GroupRolesModel.FindRolesByGroupId(l.ctx, in.GroupId)
+	if err != nil {
+		eInfo := "[GGR004] 查询用户组角色关联失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 获取角色详情
+	roles := make([]*model.Roles, 0, len(groupRoles))
+	for _, gr := range groupRoles {
+		role, err := l.svcCtx.RolesModel.FindActiveById(l.ctx, gr.RoleId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				// 角色可能已被删除或禁用，跳过
+				continue
+			}
+			eInfo := "[GGR005] 查询角色详情失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		roles = append(roles, role)
+	}
+
+	return &iam.GetGroupRolesResponse{
+		Roles: logic.ModelRolesToProtoRoles(roles),
+	}, nil
+}
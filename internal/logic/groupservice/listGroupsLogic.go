@@ -0,0 +1,80 @@
+package groupservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+
+	"github.com/ziptako/common/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListGroupsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListGroupsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListGroupsLogic {
+	return &ListGroupsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListGroups 分页查询用户组列表
+func (l *ListGroupsLogic) ListGroups(in *iam.ListGroupsRequest) (*iam.ListGroupsResponse, error) {
+	// 使用工具函数计算分页参数
+	pagination := utils.CalculatePagination(in.Page, in.PageSize)
+
+	search := strings.TrimSpace(in.Search)
+
+	// 查询用户组列表和总数
+	var groups []*model.Groups
+	var total int64
+	var err error
+
+	if search == "" {
+		// 无搜索条件的分页查询
+		groups, err = l.svcCtx.GroupsModel.FindActiveWithPagination(l.ctx, pagination.Limit, pagination.Offset)
+		if err != nil {
+			eInfo := "[LG001] 查询用户组列表失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+
+		total, err = l.svcCtx.GroupsModel.CountActive(l.ctx)
+		if err != nil {
+			eInfo := "[LG002] 查询用户组总数失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	} else {
+		// 有搜索条件的分页查询
+		groups, err = l.svcCtx.GroupsModel.SearchActiveByKeyword(l.ctx, search, pagination.Limit, pagination.Offset)
+		if err != nil {
+			eInfo := "[LG003] 搜索用户组列表失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+
+		total, err = l.svcCtx.GroupsModel.CountActiveByKeyword(l.ctx, search)
+		if err != nil {
+			eInfo := "[LG004] 查询搜索结果总数失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	return &iam.ListGroupsResponse{
+		Items: logic.ModelGroupsToProtoGroups(groups),
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package groupservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/ziptako/common/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListUserGroupsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListUserGroupsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUserGroupsLogic {
+	return &ListUserGroupsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListUserGroups 分页查询用户所属的用户组
+func (l *ListUserGroupsLogic) ListUserGroups(in *iam.ListUserGroupsRequest) (*iam.ListUserGroupsResponse, error) {
+	// 参数验证
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[LUG001] User ID is required")
+	}
+
+	// 检查用户是否存在
+	_, err := l.svcCtx.UsersModel.FindById(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[LUG002] User not found")
+		}
+		eInfo := "[LUG003] 查询用户失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 使用工具函数计算分页参数
+	pagination := utils.CalculatePagination(in.Page, in.PageSize)
+
+	groups, err := l.svcCtx.GroupMembersModel.FindUserGroups(l.ctx, in.UserId, pagination.Limit, pagination.Offset)
+	if err != nil {
+		eInfo := "[LUG004] 查询用户所属用户组失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	total, err := l.svcCtx.GroupMembersModel.CountUserGroups(l.ctx, in.UserId)
+	if err != nil {
+		eInfo := "[LUG005] 查询用户所属用户组总数失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.ListUserGroupsResponse{
+		Items: logic.ModelGroupsToProtoGroups(groups),
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package groupservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RemoveGroupMembersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRemoveGroupMembersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveGroupMembersLogic {
+	return &RemoveGroupMembersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RemoveGroupMembers 从用户组批量移除成员
+func (l *RemoveGroupMembersLogic) RemoveGroupMembers(in *iam.RemoveGroupMembersRequest) (*iam.RemoveGroupMembersResponse, error) {
+	// 参数验证
+	if in.GroupId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[RGM001] Group ID is required")
+	}
+	if len(in.UserIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "[RGM002] User IDs are required")
+	}
+
+	// 检查用户组是否存在
+	_, err := l.svcCtx.GroupsModel.FindActiveById(l.ctx, in.GroupId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[RGM003] Group not found")
+		}
+		eInfo := "[RGM004] 查询用户组失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 批量移除成员
+	err = l.svcCtx.GroupMembersModel.RemoveMembers(l.ctx, in.GroupId, in.UserIds)
+	if err != nil {
+		eInfo := "[RGM005] 移除用户组成员失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.RemoveGroupMembersResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package groupservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RemoveGroupRolesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRemoveGroupRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveGroupRolesLogic {
+	return &RemoveGroupRolesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RemoveGroupRoles 批量移除用户组的角色
+func (l *RemoveGroupRolesLogic) RemoveGroupRoles(in *iam.RemoveGroupRolesRequest) (*iam.RemoveGroupRolesResponse, error) {
+	// 参数验证
+	if in.GroupId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[RGR001] Group ID is required")
+	}
+	if len(in.RoleIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "[RGR002] Role IDs are required")
+	}
+
+	// 检查用户组是否存在
+	_, err := l.svcCtx.GroupsModel.FindActiveById(l.ctx, in.GroupId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[RGR003] Group not found")
+		}
+		eInfo := "[RGR004] 查询用户组失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 批量移除角色
+	err = l.svcCtx.GroupRolesModel.RemoveRoles(l.ctx, in.GroupId, in.RoleIds)
+	if err != nil {
+		eInfo := "[RGR005] 移除用户组角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.RemoveGroupRolesResponse{
+		Success: true,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ziptako/iam/db/model"
 	"github.com/ziptako/iam/iam"
 	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"strings"
@@ -35,6 +36,9 @@ func (l *CreatePermissionLogic) CreatePermission(in *iam.CreatePermissionRequest
 	if strings.TrimSpace(in.Code) == "" {
 		return nil, status.Error(codes.InvalidArgument, "[CP002] 权限编码不能为空")
 	}
+	if !utils.ValidPermissionCode(in.Code) {
+		return nil, status.Error(codes.InvalidArgument, "[CP013] 权限编码格式错误，各段以:分隔且不能为空，*只能单独作为一段")
+	}
 	if strings.TrimSpace(in.Type) == "" {
 		return nil, status.Error(codes.InvalidArgument, "[CP012] 权限类型不能为空")
 	}
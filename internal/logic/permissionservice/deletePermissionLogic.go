@@ -34,7 +34,7 @@ func (l *DeletePermissionLogic) DeletePermission(in *iam.DeletePermissionRequest
 	}
 
 	// 检查权限是否存在
-	_, err := l.svcCtx.PermissionsModel.FindOne(l.ctx, in.Id)
+	permission, err := l.svcCtx.PermissionsModel.FindOne(l.ctx, in.Id)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "[DP002] 权限不存在")
@@ -43,9 +43,17 @@ func (l *DeletePermissionLogic) DeletePermission(in *iam.DeletePermissionRequest
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
+	if permission.IsGlobal() && model.TenantIdFromContext(l.ctx) != model.DefaultTenantId {
+		return nil, status.Error(codes.PermissionDenied, "[DP007] 全局系统权限只能由默认租户维护")
+	}
 
-	// 检查权限是否被角色使用
-	count, err := l.svcCtx.RolePermissionsModel.CountRolesByPermissionId(l.ctx, in.Id)
+	// 检查权限是否被角色使用，全局权限需检查所有租户
+	var count int64
+	if permission.IsGlobal() {
+		count, err = l.svcCtx.RolePermissionsModel.CountRolesByPermissionIdAcrossTenants(l.ctx, in.Id)
+	} else {
+		count, err = l.svcCtx.RolePermissionsModel.CountRolesByPermissionId(l.ctx, in.Id)
+	}
 	if err != nil {
 		eInfo := "[DP004] 检查权限使用情况失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
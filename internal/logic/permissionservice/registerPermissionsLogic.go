@@ -0,0 +1,200 @@
+package permissionservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RegisterPermissionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRegisterPermissionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RegisterPermissionsLogic {
+	return &RegisterPermissionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RegisterPermissions 服务按命名空间注册完整的权限目录，幂等地新建和更新权限，目录中不再包含的权限标记为弃用
+func (l *RegisterPermissionsLogic) RegisterPermissions(in *iam.RegisterPermissionsRequest) (*iam.RegisterPermissionsResponse, error) {
+	namespace := strings.TrimSpace(in.Namespace)
+	if !namespacePattern.MatchString(namespace) {
+		return nil, status.Error(codes.InvalidArgument, "[RGP001] namespace must start with a lowercase letter and contain only lowercase letters, digits, _ or -, at most 64 characters")
+	}
+	if len(in.Permissions) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "[RGP002] permission catalog cannot be empty")
+	}
+	catalog := make([]*model.Permissions, 0, len(in.Permissions))
+	seen := make(map[string]bool, len(in.Permissions))
+	for i, entry := range in.Permissions {
+		p, err := catalogPermission(entry)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[RGP003] permissions[%d]: %v", i, err)
+		}
+		if seen[p.Code] {
+			return nil, status.Errorf(codes.InvalidArgument, "[RGP004] permissions[%d]: duplicate code %q", i, p.Code)
+		}
+		seen[p.Code] = true
+		p.Namespace = sql.NullString{String: namespace, Valid: true}
+		catalog = append(catalog, p)
+	}
+
+	existing, err := l.svcCtx.PermissionsModel.FindAllVisible(l.ctx)
+	if err != nil {
+		eInfo := "[RGP005] 查询权限列表失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	byCode := make(map[string]*model.Permissions, len(existing))
+	for _, p := range existing {
+		byCode[p.Code] = p
+	}
+
+	tenantId := model.TenantIdFromContext(l.ctx)
+	// owns 全局系统权限只能由默认租户维护
+	owns := func(p *model.Permissions) bool {
+		return !p.IsGlobal() || tenantId == model.DefaultTenantId
+	}
+
+	resp := &iam.RegisterPermissionsResponse{}
+	conflict := func(p *model.Permissions, reason string) {
+		resp.Conflicts = append(resp.Conflicts, &iam.PermissionConflict{Code: p.Code, Namespace: p.Namespace.String, Reason: reason})
+	}
+	for _, p := range catalog {
+		current := byCode[p.Code]
+		if current == nil {
+			// 默认租户新建的是全局权限，编码不能与任何租户的自定义权限重复
+			exists, err := l.svcCtx.PermissionsModel.ExistsByCode(l.ctx, p.Code, 0)
+			if err != nil {
+				eInfo := "[RGP009] 检查权限编码是否存在失败"
+				l.Logger.Errorf("%v: %v", eInfo, err)
+				return nil, status.Error(codes.Internal, eInfo)
+			}
+			if exists {
+				conflict(&model.Permissions{Code: p.Code}, "code is used by a tenant permission")
+				continue
+			}
+			if _, err = l.svcCtx.PermissionsModel.Insert(l.ctx, p); err != nil {
+				eInfo := "[RGP006] 创建权限失败"
+				l.Logger.Errorf("%v: %v", eInfo, err)
+				return nil, status.Error(codes.Internal, eInfo)
+			}
+			resp.Created = append(resp.Created, p.Code)
+			continue
+		}
+		if current.Namespace.Valid && current.Namespace.String != namespace {
+			conflict(current, "code is registered by another namespace")
+			continue
+		}
+		if !owns(current) {
+			conflict(current, "code is a global permission maintained by the default tenant")
+			continue
+		}
+		if current.Namespace.Valid && !current.DeprecatedAt.Valid && sameCatalogEntry(current, p) {
+			resp.Unchanged++
+			continue
+		}
+		// 未注册的手工权限被命名空间接管，已弃用的权限重新出现时恢复
+		p.Id = current.Id
+		p.TenantId = current.TenantId
+		if err = l.svcCtx.PermissionsModel.Update(l.ctx, p); err != nil {
+			eInfo := "[RGP007] 更新权限失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		resp.Updated = append(resp.Updated, p.Code)
+	}
+
+	// 目录中不再包含的权限只标记弃用，保留已有授权，由管理员确认后再删除
+	now := time.Now()
+	for _, p := range existing {
+		if p.Namespace.String != namespace || p.DeprecatedAt.Valid || seen[p.Code] || !owns(p) {
+			continue
+		}
+		p.DeprecatedAt = sql.NullTime{Time: now, Valid: true}
+		if err = l.svcCtx.PermissionsModel.Update(l.ctx, p); err != nil {
+			eInfo := "[RGP008] 标记权限弃用失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		resp.Deprecated = append(resp.Deprecated, p.Code)
+	}
+
+	return resp, nil
+}
+
+// namespacePattern 命名空间格式，通常为服务名
+var namespacePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,63}$`)
+
+// catalogPermission 校验目录条目并转换为权限，名称为空时使用编码
+func catalogPermission(entry *iam.PermissionCatalogEntry) (*model.Permissions, error) {
+	if entry == nil {
+		return nil, fmt.Errorf("entry is required")
+	}
+	p := &model.Permissions{
+		Code:     strings.TrimSpace(entry.Code),
+		Name:     strings.TrimSpace(entry.Name),
+		Type:     strings.TrimSpace(entry.Type),
+		Resource: strings.TrimSpace(entry.Resource),
+		Action:   strings.TrimSpace(entry.Action),
+	}
+	if !utils.ValidPermissionCode(p.Code) {
+		return nil, fmt.Errorf("invalid code %q", p.Code)
+	}
+	if p.Name == "" {
+		p.Name = p.Code
+	}
+	if p.Resource == "" {
+		return nil, fmt.Errorf("resource is required")
+	}
+	if p.Action == "" {
+		return nil, fmt.Errorf("action is required")
+	}
+	httpMethod := strings.ToUpper(strings.TrimSpace(entry.HttpMethod))
+	switch p.Type {
+	case "path":
+		switch httpMethod {
+		case "GET", "POST", "PUT", "DELETE":
+		default:
+			return nil, fmt.Errorf("path permission requires http_method GET, POST, PUT or DELETE")
+		}
+		p.HttpMethod = sql.NullString{String: httpMethod, Valid: true}
+	case "button", "menu":
+		if httpMethod != "" {
+			return nil, fmt.Errorf("%s permission cannot have http_method", p.Type)
+		}
+	default:
+		return nil, fmt.Errorf("type must be path, button or menu")
+	}
+	if description := strings.TrimSpace(entry.Description); description != "" {
+		p.Description = sql.NullString{String: description, Valid: true}
+	}
+	return p, nil
+}
+
+// sameCatalogEntry 已有权限与目录条目的声明字段是否一致
+func sameCatalogEntry(current, p *model.Permissions) bool {
+	return current.Name == p.Name &&
+		current.Type == p.Type &&
+		current.Resource == p.Resource &&
+		current.Action == p.Action &&
+		current.HttpMethod == p.HttpMethod &&
+		current.Description == p.Description
+}
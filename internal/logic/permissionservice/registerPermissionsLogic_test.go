@@ -0,0 +1,174 @@
+package permissionservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func namespaced(namespace string) sql.NullString {
+	return sql.NullString{String: namespace, Valid: true}
+}
+
+// newTestPermissions 全局权限1-5，权限6为租户3自定义的权限
+func newTestPermissions() *modeltest.Permissions {
+	return &modeltest.Permissions{
+		Rows: []*model.Permissions{
+			{Id: 1, Code: "GET:/orders", Name: "GET:/orders", Type: "path", Resource: "orders", Action: "read",
+				HttpMethod: namespaced("GET"), Namespace: namespaced("order")},
+			{Id: 2, Code: "POST:/orders", Name: "Create", Type: "path", Resource: "orders", Action: "write",
+				HttpMethod: namespaced("POST"), Namespace: namespaced("order")},
+			{Id: 3, Code: "DELETE:/orders/:id", Name: "DELETE:/orders/:id", Type: "path", Resource: "orders", Action: "write",
+				HttpMethod: namespaced("DELETE"), Namespace: namespaced("order")},
+			{Id: 4, Code: "GET:/invoices", Name: "GET:/invoices", Type: "path", Resource: "invoices", Action: "read",
+				HttpMethod: namespaced("GET"), Namespace: namespaced("billing")},
+			{Id: 5, Code: "order:export", Name: "Export", Type: "button", Resource: "order", Action: "export"},
+			{Id: 6, Code: "order:custom", Name: "Custom", Type: "button", Resource: "order", Action: "custom", TenantId: sql.NullInt64{Int64: 3, Valid: true}},
+		},
+	}
+}
+
+func register(permissions *modeltest.Permissions, in *iam.RegisterPermissionsRequest) (*iam.RegisterPermissionsResponse, error) {
+	svcCtx := &svc.ServiceContext{PermissionsModel: permissions}
+	return NewRegisterPermissionsLogic(context.Background(), svcCtx).RegisterPermissions(in)
+}
+
+func TestRegisterPermissions(t *testing.T) {
+	permissions := newTestPermissions()
+	resp, err := register(permissions, &iam.RegisterPermissionsRequest{
+		Namespace: " order ",
+		Permissions: []*iam.PermissionCatalogEntry{
+			{Code: "GET:/orders", Type: "path", Resource: "orders", Action: "read", HttpMethod: "get"},
+			{Code: "POST:/orders", Name: "Create order", Type: "path", Resource: "orders", Action: "write", HttpMethod: "POST"},
+			{Code: "PUT:/orders/:id", Type: "path", Resource: "orders", Action: "write", HttpMethod: "PUT"},
+			{Code: "order:export", Name: "Export", Type: "button", Resource: "order", Action: "export"},
+			{Code: "GET:/invoices", Type: "path", Resource: "invoices", Action: "read", HttpMethod: "GET"},
+			{Code: "order:custom", Type: "button", Resource: "order", Action: "custom"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("RegisterPermissions: %v", err)
+	}
+
+	if resp.Unchanged != 1 {
+		t.Fatalf("unchanged = %d, want 1", resp.Unchanged)
+	}
+	if !slices.Equal(resp.Created, []string{"PUT:/orders/:id"}) {
+		t.Fatalf("created = %v", resp.Created)
+	}
+	// 名称变更的权限更新，未注册的手工权限被命名空间接管
+	if !slices.Equal(resp.Updated, []string{"POST:/orders", "order:export"}) {
+		t.Fatalf("updated = %v", resp.Updated)
+	}
+	if !slices.Equal(resp.Deprecated, []string{"DELETE:/orders/:id"}) {
+		t.Fatalf("deprecated = %v", resp.Deprecated)
+	}
+	var conflicts []string
+	for _, c := range resp.Conflicts {
+		conflicts = append(conflicts, c.Code+" "+c.Reason)
+	}
+	want := []string{
+		"GET:/invoices code is registered by another namespace",
+		"order:custom code is used by a tenant permission",
+	}
+	if !slices.Equal(conflicts, want) {
+		t.Fatalf("conflicts = %v, want %v", conflicts, want)
+	}
+
+	if p := permissions.RowByCode("PUT:/orders/:id"); p == nil || p.TenantId.Valid || p.Namespace.String != "order" || p.HttpMethod.String != "PUT" || p.Name != p.Code {
+		t.Fatalf("unexpected inserted permission %+v", p)
+	}
+	if p := permissions.Row(2); p.Name != "Create order" {
+		t.Fatalf("permission name not updated: %+v", p)
+	}
+	if p := permissions.Row(5); p.Namespace.String != "order" {
+		t.Fatalf("manual permission not adopted: %+v", p)
+	}
+	if p := permissions.Row(3); !p.DeprecatedAt.Valid {
+		t.Fatalf("missing permission not deprecated: %+v", p)
+	}
+	if p := permissions.Row(4); p.Namespace.String != "billing" || p.DeprecatedAt.Valid {
+		t.Fatalf("other namespace changed: %+v", p)
+	}
+}
+
+// TestRegisterPermissionsRestoresDeprecated 已弃用的权限重新出现在目录中时恢复
+func TestRegisterPermissionsRestoresDeprecated(t *testing.T) {
+	permissions := newTestPermissions()
+	permissions.Rows[0].DeprecatedAt = sql.NullTime{Valid: true}
+	resp, err := register(permissions, &iam.RegisterPermissionsRequest{
+		Namespace: "order",
+		Permissions: []*iam.PermissionCatalogEntry{
+			{Code: "GET:/orders", Type: "path", Resource: "orders", Action: "read", HttpMethod: "GET"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("RegisterPermissions: %v", err)
+	}
+	if !slices.Equal(resp.Updated, []string{"GET:/orders"}) || permissions.Row(1).DeprecatedAt.Valid {
+		t.Fatalf("deprecated permission not restored: %v", resp.Updated)
+	}
+}
+
+// TestRegisterPermissionsForeignTenant 非默认租户不能接管或弃用全局权限
+func TestRegisterPermissionsForeignTenant(t *testing.T) {
+	permissions := newTestPermissions()
+	svcCtx := &svc.ServiceContext{PermissionsModel: permissions}
+	ctx := model.WithTenantId(context.Background(), 2)
+	resp, err := NewRegisterPermissionsLogic(ctx, svcCtx).RegisterPermissions(&iam.RegisterPermissionsRequest{
+		Namespace: "order",
+		Permissions: []*iam.PermissionCatalogEntry{
+			{Code: "order:export", Type: "button", Resource: "order", Action: "export"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("RegisterPermissions: %v", err)
+	}
+	if len(resp.Conflicts) != 1 || !strings.Contains(resp.Conflicts[0].Reason, "global permission") {
+		t.Fatalf("conflicts = %v", resp.Conflicts)
+	}
+	if p := permissions.Row(5); p.Namespace.Valid || len(resp.Deprecated) != 0 {
+		t.Fatalf("global permissions changed by tenant: %+v, deprecated %v", p, resp.Deprecated)
+	}
+}
+
+func TestRegisterPermissionsRejects(t *testing.T) {
+	entry := &iam.PermissionCatalogEntry{Code: "order:read", Type: "button", Resource: "order", Action: "read"}
+	tests := []struct {
+		name    string
+		in      *iam.RegisterPermissionsRequest
+		errCode string
+	}{
+		{"empty namespace", &iam.RegisterPermissionsRequest{Permissions: []*iam.PermissionCatalogEntry{entry}}, "[RGP001]"},
+		{"uppercase namespace", &iam.RegisterPermissionsRequest{Namespace: "Order", Permissions: []*iam.PermissionCatalogEntry{entry}}, "[RGP001]"},
+		{"long namespace", &iam.RegisterPermissionsRequest{Namespace: "o" + strings.Repeat("x", 64), Permissions: []*iam.PermissionCatalogEntry{entry}}, "[RGP001]"},
+		{"empty catalog", &iam.RegisterPermissionsRequest{Namespace: "order"}, "[RGP002]"},
+		{"nil entry", &iam.RegisterPermissionsRequest{Namespace: "order", Permissions: []*iam.PermissionCatalogEntry{nil}}, "[RGP003]"},
+		{"invalid code", &iam.RegisterPermissionsRequest{Namespace: "order", Permissions: []*iam.PermissionCatalogEntry{
+			{Code: "order read", Type: "button", Resource: "order", Action: "read"}}}, "[RGP003]"},
+		{"path without method", &iam.RegisterPermissionsRequest{Namespace: "order", Permissions: []*iam.PermissionCatalogEntry{
+			{Code: "GET:/orders", Type: "path", Resource: "orders", Action: "read"}}}, "[RGP003]"},
+		{"button with method", &iam.RegisterPermissionsRequest{Namespace: "order", Permissions: []*iam.PermissionCatalogEntry{
+			{Code: "order:read", Type: "button", Resource: "order", Action: "read", HttpMethod: "GET"}}}, "[RGP003]"},
+		{"missing action", &iam.RegisterPermissionsRequest{Namespace: "order", Permissions: []*iam.PermissionCatalogEntry{
+			{Code: "order:read", Type: "menu", Resource: "order"}}}, "[RGP003]"},
+		{"duplicate code", &iam.RegisterPermissionsRequest{Namespace: "order", Permissions: []*iam.PermissionCatalogEntry{entry, entry}}, "[RGP004]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := register(newTestPermissions(), tt.in)
+			if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), tt.errCode) {
+				t.Fatalf("error = %v, want %s", err, tt.errCode)
+			}
+		})
+	}
+}
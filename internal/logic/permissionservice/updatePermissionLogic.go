@@ -10,6 +10,7 @@ import (
 	"github.com/ziptako/iam/iam"
 	"github.com/ziptako/iam/internal/logic"
 	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -42,6 +43,9 @@ func (l *UpdatePermissionLogic) UpdatePermission(in *iam.UpdatePermissionRequest
 	if strings.TrimSpace(in.Code) == "" {
 		return nil, status.Error(codes.InvalidArgument, "[UP003] 权限编码不能为空")
 	}
+	if !utils.ValidPermissionCode(in.Code) {
+		return nil, status.Error(codes.InvalidArgument, "[UP012] 权限编码格式错误，各段以:分隔且不能为空，*只能单独作为一段")
+	}
 	if strings.TrimSpace(in.Resource) == "" {
 		return nil, status.Error(codes.InvalidArgument, "[UP004] 资源标识不能为空")
 	}
@@ -59,6 +63,9 @@ func (l *UpdatePermissionLogic) UpdatePermission(in *iam.UpdatePermissionRequest
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
+	if existingPermission.IsGlobal() && model.TenantIdFromContext(l.ctx) != model.DefaultTenantId {
+		return nil, status.Error(codes.PermissionDenied, "[UP011] 全局系统权限只能由默认租户维护")
+	}
 
 	// 检查权限编码是否已被其他权限使用
 	if existingPermission.Code != in.Code {
@@ -0,0 +1,68 @@
+package policyservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/delegation"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/policy"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ApplyPolicyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewApplyPolicyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ApplyPolicyLogic {
+	return &ApplyPolicyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ApplyPolicy 在一个事务中应用清单，任一变更失败时全部回滚
+func (l *ApplyPolicyLogic) ApplyPolicy(in *iam.ApplyPolicyRequest) (*iam.PolicyPlan, error) {
+	// 参数验证
+	if len(in.Content) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "[APL001] Manifest content is required")
+	}
+	manifest, err := policy.Parse(in.Content, in.Format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "[APL002] "+err.Error())
+	}
+
+	// 调用人记录为新建授权和用户角色的创建人
+	var createdBy sql.NullInt64
+	if callerId, ok := delegation.CallerIdFromContext(l.ctx); ok {
+		createdBy = sql.NullInt64{Int64: callerId, Valid: true}
+	}
+
+	// 计算差异并在事务中应用
+	plan, err := l.svcCtx.Policy.Apply(l.ctx, manifest, in.Prune, createdBy)
+	if err != nil {
+		if errors.Is(err, policy.ErrInvalid) {
+			return nil, status.Error(codes.FailedPrecondition, "[APL003] "+err.Error())
+		}
+		if errors.Is(err, policy.ErrConstraint) {
+			return nil, status.Error(codes.FailedPrecondition, "[APL004] "+err.Error())
+		}
+		if errors.Is(err, model.ErrPolicyConflict) {
+			return nil, status.Error(codes.Aborted, "[APL006] Policy was changed concurrently, please plan and apply again")
+		}
+		eInfo := "[APL005] 应用策略清单失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return logic.PolicyPlanToProto(plan, true), nil
+}
@@ -0,0 +1,61 @@
+package policyservicelogic
+
+import (
+	"context"
+	"fmt"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/policy"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ExportPolicyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExportPolicyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportPolicyLogic {
+	return &ExportPolicyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ExportPolicy 导出当前租户的策略清单
+func (l *ExportPolicyLogic) ExportPolicy(in *iam.ExportPolicyRequest) (*iam.ExportPolicyResponse, error) {
+	// 参数验证
+	format, err := policy.NormalizeFormat(in.Format, nil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "[EPL001] Format must be yaml or json")
+	}
+
+	// 导出清单
+	manifest, err := l.svcCtx.Policy.Export(l.ctx, in.IncludeUsers)
+	if err != nil {
+		eInfo := "[EPL002] 导出策略清单失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	content, err := policy.Marshal(manifest, format)
+	if err != nil {
+		eInfo := "[EPL003] 生成策略清单失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	contentType := "application/yaml"
+	if format == policy.FormatJson {
+		contentType = "application/json"
+	}
+	return &iam.ExportPolicyResponse{
+		Filename:    fmt.Sprintf("policy-tenant-%d.%s", model.TenantIdFromContext(l.ctx), format),
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package policyservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/policy"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PlanPolicyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPlanPolicyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PlanPolicyLogic {
+	return &PlanPolicyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// PlanPolicy 计算清单与数据库现状的差异，不做修改
+func (l *PlanPolicyLogic) PlanPolicy(in *iam.PlanPolicyRequest) (*iam.PolicyPlan, error) {
+	// 参数验证
+	if len(in.Content) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "[PPL001] Manifest content is required")
+	}
+	manifest, err := policy.Parse(in.Content, in.Format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "[PPL002] "+err.Error())
+	}
+
+	// 计算差异
+	plan, err := l.svcCtx.Policy.Plan(l.ctx, manifest, in.Prune)
+	if err != nil {
+		if errors.Is(err, policy.ErrInvalid) {
+			return nil, status.Error(codes.FailedPrecondition, "[PPL003] "+err.Error())
+		}
+		eInfo := "[PPL004] 计算策略差异失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return logic.PolicyPlanToProto(plan, false), nil
+}
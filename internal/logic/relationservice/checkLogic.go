@@ -0,0 +1,79 @@
+package relationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/rebac"
+	"github.com/ziptako/iam/internal/svc"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CheckLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckLogic {
+	return &CheckLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Check 检查主体是否与对象存在指定关系
+// 主体可以是用户，也可以是主体集合，如检查 role:teacher#member 是否是文档的查看者
+func (l *CheckLogic) Check(in *iam.CheckRelationRequest) (*iam.CheckRelationResponse, error) {
+	// 参数验证
+	if !validObjectRef(in.Namespace, in.ObjectId, in.Relation) {
+		return nil, status.Error(codes.InvalidArgument, "[CHK001] Namespace, object ID and relation are required")
+	}
+	namespace, objectId, relation := strings.TrimSpace(in.Namespace), strings.TrimSpace(in.ObjectId), strings.TrimSpace(in.Relation)
+	subject := toSubject(in.Subject)
+
+	switch err := l.svcCtx.Rebac.ValidateRelation(namespace, relation); {
+	case errors.Is(err, rebac.ErrUnknownNamespace):
+		return nil, status.Error(codes.InvalidArgument, "[CHK002] Namespace is not defined: "+namespace)
+	case errors.Is(err, rebac.ErrUnknownRelation):
+		return nil, status.Error(codes.InvalidArgument, "[CHK003] Relation is not defined: "+namespace+"#"+relation)
+	}
+	if err := l.svcCtx.Rebac.ValidateSubject(subject); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "[CHK004] Invalid subject: "+subject.String())
+	}
+
+	// 用户主体必须是活跃用户
+	if subject.Namespace == rebac.UserNamespace {
+		userId, _ := strconv.ParseInt(subject.Id, 10, 64)
+		_, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, userId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "[CHK005] User not found")
+			}
+			eInfo := "[CHK006] 查询用户失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	allowed, err := l.svcCtx.Rebac.Check(l.ctx, namespace, objectId, relation, subject)
+	if err != nil {
+		if errors.Is(err, rebac.ErrMaxDepthExceeded) {
+			return nil, status.Error(codes.FailedPrecondition, "[CHK007] Relation graph is too deep or contains a cycle")
+		}
+		eInfo := "[CHK008] 检查关系失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.CheckRelationResponse{
+		Allowed: allowed,
+	}, nil
+}
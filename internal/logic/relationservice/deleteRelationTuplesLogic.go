@@ -0,0 +1,75 @@
+package relationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeleteRelationTuplesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteRelationTuplesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteRelationTuplesLogic {
+	return &DeleteRelationTuplesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteRelationTuples 批量删除关系元组，不存在的元组跳过
+// 删除不校验命名空间配置，已从配置中移除的关系遗留的元组也可以删除
+func (l *DeleteRelationTuplesLogic) DeleteRelationTuples(in *iam.DeleteRelationTuplesRequest) (*iam.DeleteRelationTuplesResponse, error) {
+	// 参数验证
+	if len(in.Tuples) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "[DRT001] At least one tuple is required")
+	}
+	if len(in.Tuples) > maxTuplesPerRequest {
+		return nil, status.Error(codes.InvalidArgument, "[DRT002] Too many tuples in one request")
+	}
+	for _, t := range in.Tuples {
+		if !validObjectRef(t.Namespace, t.ObjectId, t.Relation) {
+			return nil, status.Error(codes.InvalidArgument, "[DRT003] Namespace, object ID and relation are required")
+		}
+		subject := toSubject(t.Subject)
+		if subject.Namespace == "" || subject.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "[DRT004] Subject namespace and ID are required")
+		}
+	}
+
+	tenantId := model.TenantIdFromContext(l.ctx)
+	var deleted int32
+	for _, t := range in.Tuples {
+		subject := toSubject(t.Subject)
+		tuple, err := l.svcCtx.RelationTuplesModel.FindOneByTenantIdNamespaceObjectIdRelationSubjectNamespaceSubjectIdSubjectRelation(l.ctx, tenantId,
+			strings.TrimSpace(t.Namespace), strings.TrimSpace(t.ObjectId), strings.TrimSpace(t.Relation), subject.Namespace, subject.Id, subject.Relation)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				continue
+			}
+			eInfo := "[DRT005] 查询关系元组失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		if err := l.svcCtx.RelationTuplesModel.Delete(l.ctx, tuple.Id); err != nil {
+			eInfo := "[DRT006] 删除关系元组失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		deleted++
+	}
+
+	return &iam.DeleteRelationTuplesResponse{
+		Deleted: deleted,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package relationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/rebac"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ExpandLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExpandLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExpandLogic {
+	return &ExpandLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Expand 展开对象上指定关系的改写规则，返回关系展开树
+// 直接写入的主体集合作为叶子返回，调用方可对其再次调用Expand
+func (l *ExpandLogic) Expand(in *iam.ExpandRelationRequest) (*iam.ExpandRelationResponse, error) {
+	// 参数验证
+	if !validObjectRef(in.Namespace, in.ObjectId, in.Relation) {
+		return nil, status.Error(codes.InvalidArgument, "[EXP001] Namespace, object ID and relation are required")
+	}
+	namespace, objectId, relation := strings.TrimSpace(in.Namespace), strings.TrimSpace(in.ObjectId), strings.TrimSpace(in.Relation)
+
+	switch err := l.svcCtx.Rebac.ValidateRelation(namespace, relation); {
+	case errors.Is(err, rebac.ErrUnknownNamespace):
+		return nil, status.Error(codes.InvalidArgument, "[EXP002] Namespace is not defined: "+namespace)
+	case errors.Is(err, rebac.ErrUnknownRelation):
+		return nil, status.Error(codes.InvalidArgument, "[EXP003] Relation is not defined: "+namespace+"#"+relation)
+	}
+
+	tree, err := l.svcCtx.Rebac.Expand(l.ctx, namespace, objectId, relation)
+	if err != nil {
+		if errors.Is(err, rebac.ErrMaxDepthExceeded) {
+			return nil, status.Error(codes.FailedPrecondition, "[EXP004] Relation graph is too deep or contains a cycle")
+		}
+		eInfo := "[EXP005] 展开关系失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.ExpandRelationResponse{
+		Tree: toProtoTree(tree),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package relationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/rebac"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListObjectsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListObjectsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListObjectsLogic {
+	return &ListObjectsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListObjects 查询主体与之存在指定关系的对象
+// 候选对象为命名空间中出现在元组里的所有对象，逐个检查关系
+func (l *ListObjectsLogic) ListObjects(in *iam.ListObjectsRequest) (*iam.ListObjectsResponse, error) {
+	// 参数验证
+	if !validName(in.Namespace) || !validName(in.Relation) {
+		return nil, status.Error(codes.InvalidArgument, "[LOB001] Namespace and relation are required")
+	}
+	namespace, relation := strings.TrimSpace(in.Namespace), strings.TrimSpace(in.Relation)
+	subject := toSubject(in.Subject)
+
+	if namespace == rebac.RoleNamespace {
+		return nil, status.Error(codes.InvalidArgument, "[LOB002] Role namespace cannot be listed, use GetUserRoles instead")
+	}
+	switch err := l.svcCtx.Rebac.ValidateRelation(namespace, relation); {
+	case errors.Is(err, rebac.ErrUnknownNamespace):
+		return nil, status.Error(codes.InvalidArgument, "[LOB003] Namespace is not defined: "+namespace)
+	case errors.Is(err, rebac.ErrUnknownRelation):
+		return nil, status.Error(codes.InvalidArgument, "[LOB004] Relation is not defined: "+namespace+"#"+relation)
+	}
+	if err := l.svcCtx.Rebac.ValidateSubject(subject); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "[LOB005] Invalid subject: "+subject.String())
+	}
+
+	objectIds, err := l.svcCtx.Rebac.ListObjects(l.ctx, namespace, relation, subject)
+	if err != nil {
+		if errors.Is(err, rebac.ErrMaxDepthExceeded) {
+			return nil, status.Error(codes.FailedPrecondition, "[LOB006] Relation graph is too deep or contains a cycle")
+		}
+		eInfo := "[LOB007] 查询对象失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.ListObjectsResponse{
+		ObjectIds: objectIds,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package relationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/rebac"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListSubjectsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListSubjectsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListSubjectsLogic {
+	return &ListSubjectsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListSubjects 查询与对象存在指定关系的所有用户，主体集合和角色会被完全展开，只返回活跃用户
+func (l *ListSubjectsLogic) ListSubjects(in *iam.ListSubjectsRequest) (*iam.ListSubjectsResponse, error) {
+	// 参数验证
+	if !validObjectRef(in.Namespace, in.ObjectId, in.Relation) {
+		return nil, status.Error(codes.InvalidArgument, "[LSB001] Namespace, object ID and relation are required")
+	}
+	namespace, objectId, relation := strings.TrimSpace(in.Namespace), strings.TrimSpace(in.ObjectId), strings.TrimSpace(in.Relation)
+
+	switch err := l.svcCtx.Rebac.ValidateRelation(namespace, relation); {
+	case errors.Is(err, rebac.ErrUnknownNamespace):
+		return nil, status.Error(codes.InvalidArgument, "[LSB002] Namespace is not defined: "+namespace)
+	case errors.Is(err, rebac.ErrUnknownRelation):
+		return nil, status.Error(codes.InvalidArgument, "[LSB003] Relation is not defined: "+namespace+"#"+relation)
+	}
+
+	userIds, err := l.svcCtx.Rebac.ListSubjects(l.ctx, namespace, objectId, relation)
+	if err != nil {
+		if errors.Is(err, rebac.ErrMaxDepthExceeded) {
+			return nil, status.Error(codes.FailedPrecondition, "[LSB004] Relation graph is too deep or contains a cycle")
+		}
+		eInfo := "[LSB005] 查询主体失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.ListSubjectsResponse{
+		UserIds: userIds,
+	}, nil
+}
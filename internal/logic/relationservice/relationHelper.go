@@ -0,0 +1,71 @@
+package relationservicelogic
+
+import (
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/rebac"
+	"strings"
+)
+
+// 命名空间、关系和对象ID的最大长度，与数据库字段保持一致
+const (
+	maxNameLength     = 64
+	maxObjectIdLength = 128
+)
+
+// validObjectRef 检查对象和关系引用是否合法
+func validObjectRef(namespace, objectId, relation string) bool {
+	return validName(namespace) && validName(relation) && validObjectId(objectId)
+}
+
+func validName(name string) bool {
+	name = strings.TrimSpace(name)
+	return name != "" && len(name) <= maxNameLength
+}
+
+func validObjectId(objectId string) bool {
+	objectId = strings.TrimSpace(objectId)
+	return objectId != "" && len(objectId) <= maxObjectIdLength
+}
+
+// toSubject 转换请求中的主体，并去除首尾空白
+func toSubject(s *iam.Subject) rebac.Subject {
+	if s == nil {
+		return rebac.Subject{}
+	}
+	return rebac.Subject{
+		Namespace: strings.TrimSpace(s.Namespace),
+		Id:        strings.TrimSpace(s.Id),
+		Relation:  strings.TrimSpace(s.Relation),
+	}
+}
+
+// toProtoSubject 转换主体为proto消息
+func toProtoSubject(s rebac.Subject) *iam.Subject {
+	return &iam.Subject{
+		Namespace: s.Namespace,
+		Id:        s.Id,
+		Relation:  s.Relation,
+	}
+}
+
+// toProtoTree 递归转换关系展开树
+func toProtoTree(t *rebac.Tree) *iam.SubjectTree {
+	if t == nil {
+		return nil
+	}
+	node := &iam.SubjectTree{
+		Operation: t.Operation,
+		Namespace: t.Namespace,
+		ObjectId:  t.ObjectId,
+		Relation:  t.Relation,
+		Subjects:  make([]*iam.Subject, 0, len(t.Subjects)),
+		Children:  make([]*iam.SubjectTree, 0, len(t.Children)),
+	}
+	for _, s := range t.Subjects {
+		node.Subjects = append(node.Subjects, toProtoSubject(s))
+	}
+	for _, child := range t.Children {
+		node.Children = append(node.Children, toProtoTree(child))
+	}
+	return node
+}
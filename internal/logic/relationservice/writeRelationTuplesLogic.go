@@ -0,0 +1,128 @@
+package relationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/rebac"
+	"github.com/ziptako/iam/internal/svc"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxTuplesPerRequest 单次请求最多写入或删除的元组数量
+const maxTuplesPerRequest = 100
+
+type WriteRelationTuplesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewWriteRelationTuplesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WriteRelationTuplesLogic {
+	return &WriteRelationTuplesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// WriteRelationTuples 批量写入关系元组，已存在的元组跳过
+// 所有元组校验通过后才开始写入；用户主体和角色主体必须存在
+func (l *WriteRelationTuplesLogic) WriteRelationTuples(in *iam.WriteRelationTuplesRequest) (*iam.WriteRelationTuplesResponse, error) {
+	// 参数验证
+	if len(in.Tuples) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "[WRT001] At least one tuple is required")
+	}
+	if len(in.Tuples) > maxTuplesPerRequest {
+		return nil, status.Error(codes.InvalidArgument, "[WRT002] Too many tuples in one request")
+	}
+
+	tuples := make([]*model.RelationTuples, 0, len(in.Tuples))
+	for _, t := range in.Tuples {
+		if !validObjectRef(t.Namespace, t.ObjectId, t.Relation) {
+			return nil, status.Error(codes.InvalidArgument, "[WRT003] Namespace, object ID and relation are required")
+		}
+		namespace, objectId, relation := strings.TrimSpace(t.Namespace), strings.TrimSpace(t.ObjectId), strings.TrimSpace(t.Relation)
+		subject := toSubject(t.Subject)
+		if !validName(subject.Namespace) || !validObjectId(subject.Id) || len(subject.Relation) > maxNameLength {
+			return nil, status.Error(codes.InvalidArgument, "[WRT004] Subject namespace and ID are required")
+		}
+
+		err := l.svcCtx.Rebac.ValidateTuple(namespace, relation, subject)
+		switch {
+		case errors.Is(err, rebac.ErrUnknownNamespace):
+			return nil, status.Error(codes.InvalidArgument, "[WRT005] Namespace is not defined: "+namespace)
+		case errors.Is(err, rebac.ErrUnknownRelation):
+			return nil, status.Error(codes.InvalidArgument, "[WRT006] Relation is not defined: "+namespace+"#"+relation)
+		case errors.Is(err, rebac.ErrNotDirect):
+			return nil, status.Error(codes.InvalidArgument, "[WRT007] Relation does not accept direct tuples: "+namespace+"#"+relation)
+		case errors.Is(err, rebac.ErrInvalidSubject):
+			return nil, status.Error(codes.InvalidArgument, "[WRT008] Invalid subject: "+subject.String())
+		}
+
+		// 检查用户主体和角色主体是否存在
+		switch subject.Namespace {
+		case rebac.UserNamespace:
+			userId, _ := strconv.ParseInt(subject.Id, 10, 64)
+			_, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, userId)
+			if err != nil {
+				if errors.Is(err, model.ErrNotFound) {
+					return nil, status.Error(codes.NotFound, "[WRT009] User not found: "+subject.Id)
+				}
+				eInfo := "[WRT010] 查询用户失败"
+				l.Logger.Errorf("%v: %v", eInfo, err)
+				return nil, status.Error(codes.Internal, eInfo)
+			}
+		case rebac.RoleNamespace:
+			_, err := l.svcCtx.RolesModel.FindActiveByCode(l.ctx, subject.Id)
+			if err != nil {
+				if errors.Is(err, model.ErrNotFound) {
+					return nil, status.Error(codes.NotFound, "[WRT011] Role not found: "+subject.Id)
+				}
+				eInfo := "[WRT012] 查询角色失败"
+				l.Logger.Errorf("%v: %v", eInfo, err)
+				return nil, status.Error(codes.Internal, eInfo)
+			}
+		}
+
+		tuples = append(tuples, &model.RelationTuples{
+			Namespace:        namespace,
+			ObjectId:         objectId,
+			Relation:         relation,
+			SubjectNamespace: subject.Namespace,
+			SubjectId:        subject.Id,
+			SubjectRelation:  subject.Relation,
+		})
+	}
+
+	// 写入元组，已存在的跳过
+	tenantId := model.TenantIdFromContext(l.ctx)
+	var written int32
+	for _, t := range tuples {
+		_, err := l.svcCtx.RelationTuplesModel.FindOneByTenantIdNamespaceObjectIdRelationSubjectNamespaceSubjectIdSubjectRelation(l.ctx, tenantId, t.Namespace, t.ObjectId, t.Relation, t.SubjectNamespace, t.SubjectId, t.SubjectRelation)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, model.ErrNotFound) {
+			eInfo := "[WRT013] 查询关系元组失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		if _, err := l.svcCtx.RelationTuplesModel.Insert(l.ctx, t); err != nil {
+			eInfo := "[WRT014] 写入关系元组失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		written++
+	}
+
+	return &iam.WriteRelationTuplesResponse{
+		Written: written,
+	}, nil
+}
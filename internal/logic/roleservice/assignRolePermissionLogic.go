@@ -5,7 +5,10 @@ import (
 	"errors"
 	"github.com/ziptako/iam/db/model"
 	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
 	"github.com/ziptako/iam/internal/svc"
+	"strings"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -26,7 +29,9 @@ func NewAssignRolePermissionLogic(ctx context.Context, svcCtx *svc.ServiceContex
 	}
 }
 
-// AssignRolePermission 为角色分配权限
+// AssignRolePermission 为角色分配权限，condition非空时校验条件表达式，授权仅在条件满足时生效
+// effect为deny时为拒绝授权，响应中返回其他角色对同一权限效果相反的授权，便于管理员发现冲突
+// 可指定有效期，已过期但尚未清理的授权会被本次授权覆盖
 func (l *AssignRolePermissionLogic) AssignRolePermission(in *iam.AssignRolePermissionRequest) (*iam.AssignRolePermissionResponse, error) {
 	// 参数验证
 	if in.RoleId <= 0 {
@@ -35,9 +40,26 @@ func (l *AssignRolePermissionLogic) AssignRolePermission(in *iam.AssignRolePermi
 	if in.PermissionId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "[ARP002] Permission ID is required")
 	}
+	cond := strings.TrimSpace(in.Condition)
+	if cond != "" {
+		if err := l.svcCtx.Conditions.Validate(cond); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "[ARP010] Invalid condition: "+err.Error())
+		}
+	}
+	effect := strings.TrimSpace(in.Effect)
+	if effect == "" {
+		effect = model.EffectAllow
+	}
+	if effect != model.EffectAllow && effect != model.EffectDeny {
+		return nil, status.Error(codes.InvalidArgument, "[ARP011] Effect must be allow or deny")
+	}
+	validity, err := logic.ProtoToModelValidity(in.ValidFrom, in.ValidUntil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "[ARP013] Invalid validity: "+err.Error())
+	}
 
 	// 检查角色是否存在
-	_, err := l.svcCtx.RolesModel.FindActiveById(l.ctx, in.RoleId)
+	_, err = l.svcCtx.RolesModel.FindActiveById(l.ctx, in.RoleId)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "[ARP003] Role not found")
@@ -48,7 +70,7 @@ func (l *AssignRolePermissionLogic) AssignRolePermission(in *iam.AssignRolePermi
 	}
 
 	// 检查权限是否存在
-	_, err = l.svcCtx.PermissionsModel.FindOne(l.ctx, in.PermissionId)
+	permission, err := l.svcCtx.PermissionsModel.FindOne(l.ctx, in.PermissionId)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "[ARP005] Permission not found")
@@ -58,31 +80,57 @@ func (l *AssignRolePermissionLogic) AssignRolePermission(in *iam.AssignRolePermi
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
-	// 检查角色权限关联是否已存在
-	exists, err := l.svcCtx.RolePermissionsModel.HasPermission(l.ctx, in.RoleId, in.PermissionId)
-	if err != nil {
+	// 检查角色权限关联是否已存在，未生效的授权也视为已存在
+	existing, err := l.svcCtx.RolePermissionsModel.FindOneByRoleIdPermissionId(l.ctx, in.RoleId, in.PermissionId)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
 		eInfo := "[ARP007] 检查角色权限关联失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
-	if exists {
+	if existing != nil && !existing.Validity().Expired(time.Now()) {
 		return nil, status.Error(codes.AlreadyExists, "[ARP008] Role permission already exists")
 	}
 
-	// 创建角色权限关联
-	rolePermission := &model.RolePermissions{
-		RoleId:       in.RoleId,
-		PermissionId: in.PermissionId,
+	if existing != nil {
+		// 覆盖已过期的授权
+		existing.Effect = effect
+		existing.Condition = cond
+		existing.ValidFrom = validity.From
+		existing.ValidUntil = validity.Until
+		err = l.svcCtx.RolePermissionsModel.Update(l.ctx, existing)
+	} else {
+		// 创建角色权限关联
+		_, err = l.svcCtx.RolePermissionsModel.Insert(l.ctx, &model.RolePermissions{
+			RoleId:       in.RoleId,
+			PermissionId: in.PermissionId,
+			Effect:       effect,
+			Condition:    cond,
+			ValidFrom:    validity.From,
+			ValidUntil:   validity.Until,
+		})
 	}
-
-	_, err = l.svcCtx.RolePermissionsModel.Insert(l.ctx, rolePermission)
 	if err != nil {
 		eInfo := "[ARP009] 分配角色权限失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
+	// 查询其他角色对同一权限效果相反的授权
+	grants, err := l.svcCtx.RolePermissionsModel.FindRolesByPermissionId(l.ctx, in.PermissionId)
+	if err != nil {
+		eInfo := "[ARP012] 查询权限授权失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	conflicts := make([]*iam.PermissionGrant, 0)
+	for _, grant := range grants {
+		if grant.RoleId != in.RoleId && grant.Effect != effect {
+			conflicts = append(conflicts, logic.ModelToProtoPermissionGrant(grant, permission.Code))
+		}
+	}
+
 	return &iam.AssignRolePermissionResponse{
-		Success: true,
+		Success:           true,
+		ConflictingGrants: conflicts,
 	}, nil
 }
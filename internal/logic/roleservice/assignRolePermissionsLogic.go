@@ -5,7 +5,9 @@ import (
 	"errors"
 	"github.com/ziptako/iam/db/model"
 	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
 	"github.com/ziptako/iam/internal/svc"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -26,7 +28,7 @@ func NewAssignRolePermissionsLogic(ctx context.Context, svcCtx *svc.ServiceConte
 	}
 }
 
-// AssignRolePermissions 为角色批量分配权限
+// AssignRolePermissions 为角色批量分配权限，有效期对本次分配的所有权限生效，已存在且未过期的授权保持不变
 func (l *AssignRolePermissionsLogic) AssignRolePermissions(in *iam.AssignRolePermissionsRequest) (*iam.AssignRolePermissionsResponse, error) {
 	// 参数验证
 	if in.RoleId <= 0 {
@@ -35,9 +37,13 @@ func (l *AssignRolePermissionsLogic) AssignRolePermissions(in *iam.AssignRolePer
 	if len(in.PermissionIds) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "[ARPS002] Permission IDs are required")
 	}
+	validity, err := logic.ProtoToModelValidity(in.ValidFrom, in.ValidUntil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "[ARPS010] Invalid validity: "+err.Error())
+	}
 
 	// 检查角色是否存在
-	_, err := l.svcCtx.RolesModel.FindActiveById(l.ctx, in.RoleId)
+	_, err = l.svcCtx.RolesModel.FindActiveById(l.ctx, in.RoleId)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "[ARPS003] Role not found")
@@ -69,24 +75,33 @@ func (l *AssignRolePermissionsLogic) AssignRolePermissions(in *iam.AssignRolePer
 
 	for _, permissionId := range in.PermissionIds {
 		// 检查角色权限关联是否已存在
-		exists, err := l.svcCtx.RolePermissionsModel.HasPermission(l.ctx, in.RoleId, permissionId)
-		if err != nil {
+		existing, err := l.svcCtx.RolePermissionsModel.FindOneByRoleIdPermissionId(l.ctx, in.RoleId, permissionId)
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
 			l.Logger.Errorf("[ARPS008] 检查角色权限关联失败 (RoleId: %d, PermissionId: %d): %v", in.RoleId, permissionId, err)
 			failedPermissions = append(failedPermissions, permissionId)
 			continue
 		}
-		if exists {
+		if existing != nil && !existing.Validity().Expired(time.Now()) {
 			// 已存在的关联跳过，不算作失败
 			continue
 		}
 
-		// 创建角色权限关联
-		rolePermission := &model.RolePermissions{
-			RoleId:       in.RoleId,
-			PermissionId: permissionId,
+		if existing != nil {
+			// 覆盖已过期的授权
+			existing.Effect = model.EffectAllow
+			existing.Condition = ""
+			existing.ValidFrom = validity.From
+			existing.ValidUntil = validity.Until
+			err = l.svcCtx.RolePermissionsModel.Update(l.ctx, existing)
+		} else {
+			// 创建角色权限关联
+			_, err = l.svcCtx.RolePermissionsModel.Insert(l.ctx, &model.RolePermissions{
+				RoleId:       in.RoleId,
+				PermissionId: permissionId,
+				ValidFrom:    validity.From,
+				ValidUntil:   validity.Until,
+			})
 		}
-
-		_, err = l.svcCtx.RolePermissionsModel.Insert(l.ctx, rolePermission)
 		if err != nil {
 			l.Logger.Errorf("[ARPS009] 分配角色权限失败 (RoleId: %d, PermissionId: %d): %v", in.RoleId, permissionId, err)
 			failedPermissions = append(failedPermissions, permissionId)
@@ -5,8 +5,11 @@ import (
 	"errors"
 	"github.com/ziptako/iam/db/model"
 	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/condition"
+	"github.com/ziptako/iam/internal/decision"
 	"github.com/ziptako/iam/internal/svc"
 	"strings"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -61,15 +64,30 @@ func (l *CheckRolePermissionLogic) CheckRolePermission(in *iam.CheckRolePermissi
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
-	// 检查角色权限关联是否存在
-	exists, err := l.svcCtx.RolePermissionsModel.HasPermission(l.ctx, in.RoleId, permission.Id)
+	// 查询角色当前生效的授权及其权限编码，按与用户权限检查相同的规则求值：拒绝授权优先，带条件的授权在请求上下文上求值
+	rolePermissions, err := l.svcCtx.RolePermissionsModel.FindByRoleId(l.ctx, in.RoleId)
 	if err != nil {
 		eInfo := "[CRP006] 检查角色权限关联失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
+	permissionIds := make([]int64, 0, len(rolePermissions))
+	for _, rp := range rolePermissions {
+		permissionIds = append(permissionIds, rp.PermissionId)
+	}
+	grants, err := l.svcCtx.PermissionsModel.FindByIds(l.ctx, permissionIds)
+	if err != nil {
+		eInfo := "[CRP007] 查询授权权限失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	permissionCodes := make(map[int64]string, len(grants))
+	for _, p := range grants {
+		permissionCodes[p.Id] = p.Code
+	}
 
+	attrs := condition.Attributes{Request: in.Context, Now: time.Now()}
 	return &iam.CheckRolePermissionResponse{
-		HasPermission: exists,
+		HasPermission: decision.Decide(l.svcCtx.Conditions, rolePermissions, permissionCodes, permission.Code, attrs).Allowed(),
 	}, nil
 }
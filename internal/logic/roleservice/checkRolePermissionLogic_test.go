@@ -0,0 +1,71 @@
+package roleservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/condition"
+	"github.com/ziptako/iam/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckRolePermission(t *testing.T) {
+	conditions, err := condition.NewEvaluator()
+	if err != nil {
+		t.Fatalf("NewEvaluator: %v", err)
+	}
+	// 角色10授予order:*并拒绝order:delete，order:export仅限内网；租户2的权限对当前租户不可见
+	svcCtx := &svc.ServiceContext{
+		RolesModel: &modeltest.Roles{Rows: []*model.Roles{{Id: 10, Code: "operator"}}},
+		PermissionsModel: &modeltest.Permissions{Rows: []*model.Permissions{
+			{Id: 1, Code: "order:*"},
+			{Id: 2, Code: "order:read"},
+			{Id: 3, Code: "order:delete"},
+			{Id: 4, Code: "order:export"},
+			{Id: 5, Code: "report:*", TenantId: modeltest.NullInt64(2)},
+			{Id: 6, Code: "report:view"},
+		}},
+		RolePermissionsModel: &modeltest.RolePermissions{Rows: []*model.RolePermissions{
+			{RoleId: 10, PermissionId: 1},
+			{RoleId: 10, PermissionId: 3, Effect: model.EffectDeny},
+			{RoleId: 10, PermissionId: 4, Effect: model.EffectDeny, Condition: `!inCidr(request.ip, "10.0.0.0/8")`},
+			{RoleId: 10, PermissionId: 5},
+		}},
+		Conditions: conditions,
+	}
+	tests := []struct {
+		name string
+		code string
+		ctx  map[string]string
+		want bool
+	}{
+		{"wildcard", "order:read", nil, true},
+		{"deny overrides wildcard", "order:delete", nil, false},
+		{"condition satisfied", "order:export", map[string]string{"ip": "10.0.0.1"}, true},
+		{"condition denies", "order:export", map[string]string{"ip": "192.168.0.1"}, false},
+		// 缺少求值所需的上下文时拒绝授权生效
+		{"condition without context", "order:export", nil, false},
+		{"invisible grant", "report:view", nil, false},
+		{"unknown permission", "order:unknown", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := NewCheckRolePermissionLogic(context.Background(), svcCtx).CheckRolePermission(&iam.CheckRolePermissionRequest{RoleId: 10, PermissionCode: tt.code, Context: tt.ctx})
+			if err != nil {
+				t.Fatalf("CheckRolePermission: %v", err)
+			}
+			if resp.HasPermission != tt.want {
+				t.Fatalf("HasPermission = %v, want %v", resp.HasPermission, tt.want)
+			}
+		})
+	}
+
+	_, err = NewCheckRolePermissionLogic(context.Background(), svcCtx).CheckRolePermission(&iam.CheckRolePermissionRequest{RoleId: 11, PermissionCode: "order:read"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("missing role: %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,146 @@
+package roleservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/sod"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreateRoleConstraintLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateRoleConstraintLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateRoleConstraintLogic {
+	return &CreateRoleConstraintLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateRoleConstraint 创建职责分离约束，角色分配、用户组继承和访问申请授权时校验
+func (l *CreateRoleConstraintLogic) CreateRoleConstraint(in *iam.CreateRoleConstraintRequest) (*iam.RoleConstraint, error) {
+	// 参数验证
+	if strings.TrimSpace(in.Code) == "" {
+		return nil, status.Error(codes.InvalidArgument, "[CRC001] Constraint code is required")
+	}
+	if strings.TrimSpace(in.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "[CRC002] Constraint name is required")
+	}
+	switch in.ConstraintType {
+	case model.RoleConstraintExclusive, model.RoleConstraintRoleCardinality, model.RoleConstraintUserCardinality:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "[CRC003] Constraint type must be exclusive, role_cardinality or user_cardinality")
+	}
+	enforcement := in.Enforcement
+	if enforcement == "" {
+		enforcement = model.RoleConstraintStatic
+	}
+	if enforcement != model.RoleConstraintStatic && enforcement != model.RoleConstraintDynamic {
+		return nil, status.Error(codes.InvalidArgument, "[CRC004] Enforcement must be static or dynamic")
+	}
+	maxCount := in.MaxCount
+	if maxCount == 0 && in.ConstraintType == model.RoleConstraintExclusive {
+		maxCount = 1 // 默认互斥
+	}
+	if maxCount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CRC005] Max count must be positive")
+	}
+
+	// 去除重复的角色ID
+	roleIds := make([]int64, 0, len(in.RoleIds))
+	seen := make(map[int64]bool, len(in.RoleIds))
+	for _, roleId := range in.RoleIds {
+		if roleId <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "[CRC006] Invalid role ID")
+		}
+		if !seen[roleId] {
+			seen[roleId] = true
+			roleIds = append(roleIds, roleId)
+		}
+	}
+	switch in.ConstraintType {
+	case model.RoleConstraintExclusive:
+		if int32(len(roleIds)) <= maxCount {
+			return nil, status.Error(codes.InvalidArgument, "[CRC007] Exclusive constraint requires more roles than max count")
+		}
+	case model.RoleConstraintRoleCardinality:
+		if len(roleIds) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "[CRC008] Role cardinality constraint requires at least one role")
+		}
+	case model.RoleConstraintUserCardinality:
+		if len(roleIds) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "[CRC009] User cardinality constraint applies to all roles and must not list roles")
+		}
+	}
+
+	// 检查角色是否存在
+	for _, roleId := range roleIds {
+		_, err := l.svcCtx.RolesModel.FindActiveById(l.ctx, roleId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "[CRC010] Role not found")
+			}
+			eInfo := "[CRC011] 查询角色失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	// 检查约束编码是否已存在
+	_, err := l.svcCtx.RoleConstraintsModel.FindOneByTenantIdCode(l.ctx, model.TenantIdFromContext(l.ctx), in.Code)
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, "[CRC012] Constraint code already exists")
+	}
+	if !errors.Is(err, model.ErrNotFound) {
+		eInfo := "[CRC013] 检查约束编码是否存在失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 创建约束，已存在的违规不阻止创建，可通过ValidateConstraints查看
+	constraint := &model.RoleConstraints{
+		Code:           in.Code,
+		Name:           in.Name,
+		ConstraintType: in.ConstraintType,
+		Enforcement:    enforcement,
+		MaxCount:       int64(maxCount),
+	}
+	if strings.TrimSpace(in.Description) != "" {
+		constraint.Description = sql.NullString{String: in.Description, Valid: true}
+	}
+	_, err = l.svcCtx.RoleConstraintsModel.Insert(l.ctx, constraint)
+	if err != nil {
+		eInfo := "[CRC014] 创建约束失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	err = l.svcCtx.RoleConstraintRolesModel.AddRoles(l.ctx, constraint.Id, roleIds)
+	if err != nil {
+		eInfo := "[CRC015] 添加约束角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 重新查询以获取数据库生成的时间
+	created, err := l.svcCtx.RoleConstraintsModel.FindOne(l.ctx, constraint.Id)
+	if err != nil {
+		eInfo := "[CRC016] 查询约束失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return logic.ModelToProtoRoleConstraint(&sod.Constraint{RoleConstraints: created, RoleIds: roleIds}), nil
+}
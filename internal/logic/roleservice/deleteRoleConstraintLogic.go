@@ -0,0 +1,58 @@
+package roleservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeleteRoleConstraintLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteRoleConstraintLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteRoleConstraintLogic {
+	return &DeleteRoleConstraintLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteRoleConstraint 删除职责分离约束
+func (l *DeleteRoleConstraintLogic) DeleteRoleConstraint(in *iam.DeleteRoleConstraintRequest) (*iam.DeleteRoleConstraintResponse, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[DRC001] Constraint ID is required")
+	}
+
+	// 检查约束是否存在
+	_, err := l.svcCtx.RoleConstraintsModel.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[DRC002] Constraint not found")
+		}
+		eInfo := "[DRC003] 查询约束失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 删除约束，约束角色随外键级联删除
+	err = l.svcCtx.RoleConstraintsModel.Delete(l.ctx, in.Id)
+	if err != nil {
+		eInfo := "[DRC004] 删除约束失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.DeleteRoleConstraintResponse{
+		Success: true,
+	}, nil
+}
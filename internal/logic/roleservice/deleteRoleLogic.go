@@ -45,17 +45,39 @@ func (l *DeleteRoleLogic) DeleteRole(in *iam.DeleteRoleRequest) (*iam.DeleteRole
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
-	// 检查是否有用户正在使用该角色
-	userRoles, err := l.svcCtx.UserRolesModel.FindUsersByRoleId(l.ctx, in.Id)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+	// 检查是否有用户正在使用该角色，包括尚未生效的分配
+	userCount, err := l.svcCtx.UserRolesModel.CountUsersByRoleId(l.ctx, in.Id)
+	if err != nil {
 		eInfo := "[DR004] 查询角色使用情况失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
-	if len(userRoles) > 0 {
+	if userCount > 0 {
 		return nil, status.Error(codes.FailedPrecondition, "[DR005] Role is being used by users and cannot be deleted")
 	}
 
+	// 检查是否有用户组正在使用该角色
+	groupCount, err := l.svcCtx.GroupRolesModel.CountGroupsByRoleId(l.ctx, in.Id)
+	if err != nil {
+		eInfo := "[DR008] 查询角色在用户组中的使用情况失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if groupCount > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "[DR009] Role is being used by groups and cannot be deleted")
+	}
+
+	// 检查是否有资源绑定正在使用该角色
+	bindingCount, err := l.svcCtx.ResourceBindingsModel.CountByRoleId(l.ctx, in.Id)
+	if err != nil {
+		eInfo := "[DR011] 查询角色在资源绑定中的使用情况失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if bindingCount > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "[DR012] Role is being used by resource bindings and cannot be deleted")
+	}
+
 	// 软删除角色
 	err = l.svcCtx.RolesModel.SoftDelete(l.ctx, existingRole.Id)
 	if err != nil {
@@ -71,6 +93,12 @@ func (l *DeleteRoleLogic) DeleteRole(in *iam.DeleteRoleRequest) (*iam.DeleteRole
 		l.Logger.Errorf("[DR007] 删除角色权限关联失败: %v", err)
 	}
 
+	// 删除角色的自定义数据权限部门
+	if err = l.svcCtx.RoleDepartmentsModel.RemoveAllRoleDepartments(l.ctx, in.Id); err != nil {
+		// 记录警告但不阻止删除操作
+		l.Logger.Errorf("[DR010] 删除角色数据权限部门失败: %v", err)
+	}
+
 	return &iam.DeleteRoleResponse{
 		Success: true,
 	}, nil
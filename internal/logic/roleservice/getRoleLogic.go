@@ -46,5 +46,20 @@ func (l *GetRoleLogic) GetRole(in *iam.GetRoleRequest) (*iam.Role, error) {
 	}
 
 	// 转换为proto消息
-	return logic.ModelToProtoRole(role), nil
+	protoRole := logic.ModelToProtoRole(role)
+
+	// 自定义数据权限时返回部门列表
+	if role.DataScope == model.DataScopeCustom {
+		roleDepartments, err := l.svcCtx.RoleDepartmentsModel.FindByRoleId(l.ctx, role.Id)
+		if err != nil {
+			eInfo := "[GR004] 查询角色数据权限部门失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		for _, rd := range roleDepartments {
+			protoRole.DataScopeDepartmentIds = append(protoRole.DataScopeDepartmentIds, rd.DepartmentId)
+		}
+	}
+
+	return protoRole, nil
 }
@@ -28,7 +28,7 @@ func NewGetRolePermissionsLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 	}
 }
 
-// GetRolePermissions 获取角色拥有的所有权限
+// GetRolePermissions 获取角色拥有的所有权限及授权效果
 func (l *GetRolePermissionsLogic) GetRolePermissions(in *iam.GetRolePermissionsRequest) (*iam.GetRolePermissionsResponse, error) {
 	// 参数验证
 	if in.RoleId <= 0 {
@@ -53,14 +53,18 @@ func (l *GetRolePermissionsLogic) GetRolePermissions(in *iam.GetRolePermissionsR
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
-	// 获取权限详情
+	// 获取权限详情，permissions只包含允许授权，grants包含所有授权及其效果
 	var permissions []*model.Permissions
+	grants := make([]*iam.PermissionGrant, 0, len(rolePermissions))
 	for _, permission := range rolePermissions {
 		modelPermission, err := l.svcCtx.PermissionsModel.FindOne(l.ctx, permission.PermissionId)
 		if err != nil {
 			return nil, err
 		}
-		permissions = append(permissions, modelPermission)
+		if permission.Effect != model.EffectDeny {
+			permissions = append(permissions, modelPermission)
+		}
+		grants = append(grants, logic.ModelToProtoPermissionGrant(permission, modelPermission.Code))
 	}
 
 	// 转换为proto格式
@@ -68,5 +72,6 @@ func (l *GetRolePermissionsLogic) GetRolePermissions(in *iam.GetRolePermissionsR
 
 	return &iam.GetRolePermissionsResponse{
 		Permissions: protoPermissions,
+		Grants:      grants,
 	}, nil
 }
@@ -0,0 +1,45 @@
+package roleservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListRoleConstraintsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListRoleConstraintsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListRoleConstraintsLogic {
+	return &ListRoleConstraintsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListRoleConstraints 查询所有职责分离约束
+func (l *ListRoleConstraintsLogic) ListRoleConstraints(in *iam.ListRoleConstraintsRequest) (*iam.ListRoleConstraintsResponse, error) {
+	constraints, err := l.svcCtx.Constraints.Constraints(l.ctx)
+	if err != nil {
+		eInfo := "[LRC001] 查询约束失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	items := make([]*iam.RoleConstraint, 0, len(constraints))
+	for _, constraint := range constraints {
+		items = append(items, logic.ModelToProtoRoleConstraint(constraint))
+	}
+
+	return &iam.ListRoleConstraintsResponse{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package roleservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/ziptako/common/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListUnusedRolesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListUnusedRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUnusedRolesLogic {
+	return &ListUnusedRolesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListUnusedRoles 查询最近N天内没有任何授权被权限检查使用过的角色
+func (l *ListUnusedRolesLogic) ListUnusedRoles(in *iam.ListUnusedRolesRequest) (*iam.ListUnusedRolesResponse, error) {
+	// 参数验证
+	if in.Days <= 0 || in.Days > maxUsageDays {
+		return nil, status.Error(codes.InvalidArgument, "[LUR001] Days must be between 1 and 3650")
+	}
+
+	// 使用工具函数计算分页参数
+	pagination := utils.CalculatePagination(in.Page, in.PageSize)
+	since := usageSince(in.Days)
+
+	roles, err := l.svcCtx.PermissionUsageRolesModel.FindUnusedRoles(l.ctx, since, pagination.Limit, pagination.Offset)
+	if err != nil {
+		eInfo := "[LUR002] 查询未使用角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	total, err := l.svcCtx.PermissionUsageRolesModel.CountUnusedRoles(l.ctx, since)
+	if err != nil {
+		eInfo := "[LUR003] 统计未使用角色数量失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	items := make([]*iam.RoleUsage, 0, len(roles))
+	for _, role := range roles {
+		items = append(items, logic.ModelToProtoRoleUsage(role))
+	}
+
+	return &iam.ListUnusedRolesResponse{
+		Items: items,
+		Total: total,
+	}, nil
+}
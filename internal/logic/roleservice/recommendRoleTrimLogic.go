@@ -0,0 +1,149 @@
+package roleservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/utils"
+	"slices"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 权限精简建议的原因
+const (
+	trimReasonUnused    = "unused"    // 统计期内未使用
+	trimReasonRedundant = "redundant" // 已被角色的其他授权覆盖
+)
+
+type RecommendRoleTrimLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRecommendRoleTrimLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RecommendRoleTrimLogic {
+	return &RecommendRoleTrimLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RecommendRoleTrim 根据使用记录和授权覆盖关系，建议从角色中移除的权限
+func (l *RecommendRoleTrimLogic) RecommendRoleTrim(in *iam.RecommendRoleTrimRequest) (*iam.RecommendRoleTrimResponse, error) {
+	// 参数验证
+	if in.RoleId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[RRT001] Role ID is required")
+	}
+	days := in.Days
+	if days == 0 {
+		days = defaultTrimDays
+	}
+	if days < 0 || days > maxUsageDays {
+		return nil, status.Error(codes.InvalidArgument, "[RRT002] Days must be between 1 and 3650")
+	}
+
+	// 检查角色是否存在
+	_, err := l.svcCtx.RolesModel.FindActiveById(l.ctx, in.RoleId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[RRT003] Role not found")
+		}
+		eInfo := "[RRT004] 查询角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 角色当前生效的允许授权，拒绝授权只会收窄权限，不在建议范围内
+	rolePermissions, err := l.svcCtx.RolePermissionsModel.FindByRoleId(l.ctx, in.RoleId)
+	if err != nil {
+		eInfo := "[RRT005] 查询角色权限失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	lookup := newCodeLookup(l.ctx, l.svcCtx)
+	allows := make([]*model.RolePermissions, 0, len(rolePermissions))
+	for _, rp := range rolePermissions {
+		if rp.Effect != model.EffectAllow {
+			continue
+		}
+		code, err := lookup.permission(rp.PermissionId)
+		if err != nil {
+			eInfo := "[RRT006] 查询权限失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		if code != "" {
+			allows = append(allows, rp)
+		}
+	}
+
+	usages, err := l.svcCtx.PermissionUsageRolesModel.FindByRoleId(l.ctx, in.RoleId)
+	if err != nil {
+		eInfo := "[RRT007] 查询权限使用记录失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	byPermission := make(map[int64]*model.PermissionUsageRoles, len(usages))
+	for _, u := range usages {
+		byPermission[u.PermissionId] = u
+	}
+
+	since := usageSince(days)
+	recommendations := make([]*iam.TrimRecommendation, 0)
+	for _, rp := range allows {
+		code := lookup.permissions[rp.PermissionId]
+		recommendation := &iam.TrimRecommendation{
+			PermissionId:   rp.PermissionId,
+			PermissionCode: code,
+		}
+		u, used := byPermission[rp.PermissionId]
+		if used {
+			recommendation.LastUsedAt = u.LastUsedAt.Unix()
+		}
+
+		if cover := coveringGrant(rp, allows, lookup.permissions); cover != nil {
+			// 被无条件且永久有效的授权覆盖，移除后角色的权限不变
+			recommendation.Reason = trimReasonRedundant
+			recommendation.CoveredByPermissionId = cover.PermissionId
+			recommendation.CoveredByCode = lookup.permissions[cover.PermissionId]
+		} else if rp.CreatedAt.Before(since) && (!used || u.LastUsedAt.Before(since)) {
+			// 授权早于统计期开始且期内没有使权限检查通过
+			recommendation.Reason = trimReasonUnused
+		} else {
+			continue
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+	slices.SortFunc(recommendations, func(a, b *iam.TrimRecommendation) int {
+		return strings.Compare(a.PermissionCode, b.PermissionCode)
+	})
+
+	return &iam.RecommendRoleTrimResponse{
+		RoleId:          in.RoleId,
+		Days:            days,
+		TotalGrants:     int64(len(allows)),
+		Recommendations: recommendations,
+	}, nil
+}
+
+// coveringGrant 查找覆盖指定授权的其他授权，覆盖授权须无条件且永久有效，否则移除后在某些情况下会失去权限
+func coveringGrant(grant *model.RolePermissions, allows []*model.RolePermissions, codes map[int64]string) *model.RolePermissions {
+	code := codes[grant.PermissionId]
+	for _, other := range allows {
+		otherCode := codes[other.PermissionId]
+		if other == grant || otherCode == code || other.Condition != "" || other.ValidUntil.Valid {
+			continue
+		}
+		if utils.PermissionCodeCovers(otherCode, code) {
+			return other
+		}
+	}
+	return nil
+}
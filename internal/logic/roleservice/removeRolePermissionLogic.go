@@ -58,16 +58,16 @@ func (l *RemoveRolePermissionLogic) RemoveRolePermission(in *iam.RemoveRolePermi
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
-	// 检查角色权限关联是否存在
-	exists, err := l.svcCtx.RolePermissionsModel.HasPermission(l.ctx, in.RoleId, in.PermissionId)
+	// 检查角色权限关联是否存在，未生效和已过期的授权同样可以移除
+	_, err = l.svcCtx.RolePermissionsModel.FindOneByRoleIdPermissionId(l.ctx, in.RoleId, in.PermissionId)
 	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[RRP008] Role permission not found")
+		}
 		eInfo := "[RRP007] 检查角色权限关联失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
-	if !exists {
-		return nil, status.Error(codes.NotFound, "[RRP008] Role permission not found")
-	}
 
 	// 移除角色权限关联
 	err = l.svcCtx.RolePermissionsModel.RemovePermission(l.ctx, in.RoleId, in.PermissionId)
@@ -59,17 +59,17 @@ func (l *RemoveRolePermissionsLogic) RemoveRolePermissions(in *iam.RemoveRolePer
 	var failedPermissions []int64
 
 	for _, permissionId := range in.PermissionIds {
-		// 检查角色权限关联是否存在
-		exists, err := l.svcCtx.RolePermissionsModel.HasPermission(l.ctx, in.RoleId, permissionId)
+		// 检查角色权限关联是否存在，未生效和已过期的授权同样移除
+		_, err := l.svcCtx.RolePermissionsModel.FindOneByRoleIdPermissionId(l.ctx, in.RoleId, permissionId)
 		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				// 不存在的关联跳过，不算作失败
+				continue
+			}
 			l.Logger.Errorf("[RRPS006] 检查角色权限关联失败 (RoleId: %d, PermissionId: %d): %v", in.RoleId, permissionId, err)
 			failedPermissions = append(failedPermissions, permissionId)
 			continue
 		}
-		if !exists {
-			// 不存在的关联跳过，不算作失败
-			continue
-		}
 
 		// 移除角色权限关联
 		err = l.svcCtx.RolePermissionsModel.RemovePermission(l.ctx, in.RoleId, permissionId)
@@ -0,0 +1,67 @@
+package roleservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/svc"
+	"time"
+)
+
+const (
+	defaultTrimDays = 90   // 角色权限精简建议的默认统计天数
+	maxUsageDays    = 3650 // 使用统计的最大天数
+)
+
+// usageSince 统计期的开始时间
+func usageSince(days int32) time.Time {
+	return time.Now().AddDate(0, 0, -int(days))
+}
+
+// codeLookup 查询并缓存角色和权限编码，已删除的角色和权限返回空编码
+type codeLookup struct {
+	ctx         context.Context
+	svcCtx      *svc.ServiceContext
+	roles       map[int64]string
+	permissions map[int64]string
+}
+
+// newCodeLookup 创建编码查询
+func newCodeLookup(ctx context.Context, svcCtx *svc.ServiceContext) *codeLookup {
+	return &codeLookup{
+		ctx:         ctx,
+		svcCtx:      svcCtx,
+		roles:       make(map[int64]string),
+		permissions: make(map[int64]string),
+	}
+}
+
+// role 查询角色编码
+func (c *codeLookup) role(id int64) (string, error) {
+	if code, ok := c.roles[id]; ok {
+		return code, nil
+	}
+	role, err := c.svcCtx.RolesModel.FindById(c.ctx, id)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return "", err
+	}
+	if role != nil {
+		c.roles[id] = role.Code
+	}
+	return c.roles[id], nil
+}
+
+// permission 查询权限编码
+func (c *codeLookup) permission(id int64) (string, error) {
+	if code, ok := c.permissions[id]; ok {
+		return code, nil
+	}
+	permission, err := c.svcCtx.PermissionsModel.FindOne(c.ctx, id)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return "", err
+	}
+	if permission != nil {
+		c.permissions[id] = permission.Code
+	}
+	return c.permissions[id], nil
+}
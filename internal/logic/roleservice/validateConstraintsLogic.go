@@ -0,0 +1,45 @@
+package roleservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ValidateConstraintsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewValidateConstraintsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ValidateConstraintsLogic {
+	return &ValidateConstraintsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ValidateConstraints 检查已有数据中违反职责分离约束的情况
+func (l *ValidateConstraintsLogic) ValidateConstraints(in *iam.ValidateConstraintsRequest) (*iam.ValidateConstraintsResponse, error) {
+	violations, err := l.svcCtx.Constraints.Validate(l.ctx)
+	if err != nil {
+		eInfo := "[VC001] 检查职责分离约束失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	items := make([]*iam.ConstraintViolation, 0, len(violations))
+	for _, violation := range violations {
+		items = append(items, logic.SodViolationToProto(violation))
+	}
+
+	return &iam.ValidateConstraintsResponse{
+		Violations: items,
+	}, nil
+}
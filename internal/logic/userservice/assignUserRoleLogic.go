@@ -6,6 +6,8 @@ import (
 	"errors"
 	"github.com/ziptako/iam/db/model"
 	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/sod"
 	"github.com/ziptako/iam/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -27,7 +29,7 @@ func NewAssignUserRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *As
 	}
 }
 
-// AssignUserRole 为用户分配单个角色
+// AssignUserRole 为用户分配单个角色，可指定有效期，已分配的角色更新为新的有效期
 func (l *AssignUserRoleLogic) AssignUserRole(in *iam.AssignUserRoleRequest) (*iam.AssignUserRoleResponse, error) {
 	// 参数验证
 	if in.UserId <= 0 {
@@ -36,9 +38,13 @@ func (l *AssignUserRoleLogic) AssignUserRole(in *iam.AssignUserRoleRequest) (*ia
 	if in.RoleId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "[AUR002] Role ID is required")
 	}
+	validity, err := logic.ProtoToModelValidity(in.ValidFrom, in.ValidUntil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "[AUR008] Invalid validity: "+err.Error())
+	}
 
 	// 检查用户是否存在
-	_, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, in.UserId)
+	_, err = l.svcCtx.UsersModel.FindActiveById(l.ctx, in.UserId)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "[AUR003] User not found")
@@ -59,13 +65,25 @@ func (l *AssignUserRoleLogic) AssignUserRole(in *iam.AssignUserRoleRequest) (*ia
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
-	// 为用户分配角色
-	err = l.svcCtx.UserRolesModel.AssignRole(l.ctx, in.UserId, in.RoleId, sql.NullInt64{})
-	if err != nil {
+	// 在职责分离锁内校验约束并分配角色，避免并发分配各自通过校验
+	var assignErr error
+	violation, err := l.svcCtx.Constraints.Grant(l.ctx, []sod.Grant{{UserId: in.UserId, RoleId: in.RoleId, Validity: validity}}, func(ctx context.Context) error {
+		assignErr = l.svcCtx.UserRolesModel.AssignRole(ctx, in.UserId, in.RoleId, sql.NullInt64{}, validity)
+		return assignErr
+	})
+	if assignErr != nil {
 		eInfo := "[AUR007] 分配角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, assignErr)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if err != nil {
+		eInfo := "[AUR009] 校验职责分离约束失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
+	if violation != nil {
+		return nil, status.Error(codes.FailedPrecondition, "[AUR010] Separation of duty constraint violated: "+violation.Error())
+	}
 
 	return &iam.AssignUserRoleResponse{
 		Success: true,
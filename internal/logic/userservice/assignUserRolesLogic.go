@@ -6,6 +6,8 @@ import (
 	"errors"
 	"github.com/ziptako/iam/db/model"
 	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/sod"
 	"github.com/ziptako/iam/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -27,7 +29,7 @@ func NewAssignUserRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *A
 	}
 }
 
-// AssignUserRoles 为用户批量分配角色
+// AssignUserRoles 为用户批量分配角色，有效期对本次分配的所有角色生效
 func (l *AssignUserRolesLogic) AssignUserRoles(in *iam.AssignUserRolesRequest) (*iam.AssignUserRolesResponse, error) {
 	// 参数验证
 	if in.UserId <= 0 {
@@ -36,9 +38,13 @@ func (l *AssignUserRolesLogic) AssignUserRoles(in *iam.AssignUserRolesRequest) (
 	if len(in.RoleIds) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "[AURS002] Role IDs are required")
 	}
+	validity, err := logic.ProtoToModelValidity(in.ValidFrom, in.ValidUntil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "[AURS009] Invalid validity: "+err.Error())
+	}
 
 	// 检查用户是否存在
-	_, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, in.UserId)
+	_, err = l.svcCtx.UsersModel.FindActiveById(l.ctx, in.UserId)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "[AURS003] User not found")
@@ -64,13 +70,29 @@ func (l *AssignUserRolesLogic) AssignUserRoles(in *iam.AssignUserRolesRequest) (
 		}
 	}
 
-	// 批量分配角色
-	err = l.svcCtx.UserRolesModel.AssignRoles(l.ctx, in.UserId, in.RoleIds, sql.NullInt64{})
-	if err != nil {
+	// 在职责分离锁内校验约束并批量分配角色，避免并发分配各自通过校验
+	grants := make([]sod.Grant, 0, len(in.RoleIds))
+	for _, roleId := range in.RoleIds {
+		grants = append(grants, sod.Grant{UserId: in.UserId, RoleId: roleId, Validity: validity})
+	}
+	var assignErr error
+	violation, err := l.svcCtx.Constraints.Grant(l.ctx, grants, func(ctx context.Context) error {
+		assignErr = l.svcCtx.UserRolesModel.AssignRoles(ctx, in.UserId, in.RoleIds, sql.NullInt64{}, validity)
+		return assignErr
+	})
+	if assignErr != nil {
 		eInfo := "[AURS008] 批量分配角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, assignErr)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if err != nil {
+		eInfo := "[AURS010] 校验职责分离约束失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
+	if violation != nil {
+		return nil, status.Error(codes.FailedPrecondition, "[AURS011] Separation of duty constraint violated: "+violation.Error())
+	}
 
 	return &iam.AssignUserRolesResponse{
 		Success: true,
@@ -0,0 +1,51 @@
+package userservicelogic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/sod"
+	"github.com/ziptako/iam/internal/svc"
+)
+
+// TestAssignUserRolesKeepsWiderValidity 重复分配已有角色时保留覆盖范围更大的有效期
+func TestAssignUserRolesKeepsWiderValidity(t *testing.T) {
+	now := time.Now()
+	userRoles := &modeltest.UserRoles{Rows: []*model.UserRoles{
+		// 角色10永久有效，角色11一天后失效，角色12已过期
+		{Id: 1, UserId: 1, RoleId: 10},
+		{Id: 2, UserId: 1, RoleId: 11, ValidUntil: modeltest.NullTime(now.Add(24 * time.Hour))},
+		{Id: 3, UserId: 1, RoleId: 12, ValidUntil: modeltest.NullTime(now.Add(-time.Hour))},
+	}}
+	svcCtx := &svc.ServiceContext{
+		UsersModel:     &modeltest.Users{Rows: []*model.Users{{Id: 1, Username: "alice"}}},
+		RolesModel:     &modeltest.Roles{Rows: []*model.Roles{{Id: 10, Code: "a"}, {Id: 11, Code: "b"}, {Id: 12, Code: "c"}}},
+		UserRolesModel: userRoles,
+		Constraints:    sod.NewChecker(&modeltest.RoleConstraints{}, &modeltest.RoleConstraintRoles{}, userRoles, &modeltest.GroupRoles{Members: &modeltest.GroupMembers{}}),
+	}
+
+	until := now.Add(time.Hour).Unix()
+	_, err := NewAssignUserRolesLogic(context.Background(), svcCtx).AssignUserRoles(&iam.AssignUserRolesRequest{UserId: 1, RoleIds: []int64{10, 11, 12}, ValidUntil: until})
+	if err != nil {
+		t.Fatalf("AssignUserRoles: %v", err)
+	}
+
+	got := map[int64]model.Validity{}
+	for _, ur := range userRoles.Rows {
+		got[ur.RoleId] = ur.Validity()
+	}
+	if v := got[10]; v.From.Valid || v.Until.Valid {
+		t.Fatalf("role 10 validity = %v, want permanent", v)
+	}
+	if v := got[11]; !v.Until.Valid || !v.Until.Time.After(now.Add(23*time.Hour)) {
+		t.Fatalf("role 11 validity = %v, want the later expiry kept", v)
+	}
+	// 已过期的关联不再保留，按本次的有效期重新分配
+	if v := got[12]; !v.Until.Valid || v.Until.Time.Unix() != until {
+		t.Fatalf("role 12 validity = %v, want until %v", v, time.Unix(until, 0))
+	}
+}
@@ -0,0 +1,209 @@
+package userservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/ldap"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/utils"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 账号不存在时用于比对的占位盐值和哈希，保证与账号存在时执行相同的计算
+const (
+	dummySalt         = "0000000000000000000000000000000000000000000000000000000000000000"
+	dummyPasswordHash = "0000000000000000000000000000000000000000000000000000000000000000"
+)
+
+// phonePattern 去除空格、横线和括号后的手机号格式
+var phonePattern = regexp.MustCompile(`^\+?\d{6,20}$`)
+
+type AuthenticateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAuthenticateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AuthenticateLogic {
+	return &AuthenticateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Authenticate 使用用户名、邮箱或手机号加密码登录认证
+// 账号不存在与密码错误返回相同的原因，且所有结果都补齐到配置的最短响应时间，避免通过响应内容或耗时枚举账号
+func (l *AuthenticateLogic) Authenticate(in *iam.AuthenticateRequest) (*iam.AuthenticateResponse, error) {
+	defer l.padResponseTime(time.Now())
+
+	// 参数验证
+	identifier := strings.TrimSpace(in.Identifier)
+	if identifier == "" {
+		return nil, status.Error(codes.InvalidArgument, "[AU001] 登录标识不能为空")
+	}
+	if in.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "[AU002] 密码不能为空")
+	}
+
+	// 按登录标识查询用户（未删除，包含已禁用）
+	user, err := l.findUser(identifier)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		eInfo := "[AU003] 查询用户失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if user == nil {
+		// 账号不存在时仍执行一次密码哈希
+		utils.VerifyPasswordWithSalt(in.Password, dummySalt, dummyPasswordHash)
+		return authenticateFailed(iam.AuthenticateReason_AUTHENTICATE_REASON_INVALID_CREDENTIALS), nil
+	}
+
+	valid, err := l.verifyPassword(user, in.Password)
+	if err != nil {
+		eInfo := "[AU004] LDAP认证失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Unavailable, eInfo)
+	}
+
+	// 锁定期间不再校验密码结果，也不累加失败次数
+	if isLocked(user) {
+		resp := authenticateFailed(iam.AuthenticateReason_AUTHENTICATE_REASON_ACCOUNT_LOCKED)
+		resp.LockedUntil = user.LockedUntil.Time.Unix()
+		return resp, nil
+	}
+
+	if !valid {
+		user, err = l.svcCtx.UsersModel.RecordLoginFailure(l.ctx, user.Id, l.svcCtx.Config.Auth.MaxFailedAttempts, l.svcCtx.Config.Auth.LockDuration)
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
+			eInfo := "[AU005] 记录登录失败次数失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		// 本次失败触发了锁定
+		if user != nil && isLocked(user) {
+			resp := authenticateFailed(iam.AuthenticateReason_AUTHENTICATE_REASON_ACCOUNT_LOCKED)
+			resp.LockedUntil = user.LockedUntil.Time.Unix()
+			return resp, nil
+		}
+		return authenticateFailed(iam.AuthenticateReason_AUTHENTICATE_REASON_INVALID_CREDENTIALS), nil
+	}
+
+	// 密码正确，清零失败次数
+	if user.FailedLoginAttempts > 0 || user.LockedUntil.Valid {
+		err = l.svcCtx.UsersModel.ResetLoginFailures(l.ctx, user.Id)
+		if err != nil {
+			eInfo := "[AU006] 重置登录失败次数失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	// 密码正确后才返回账号状态
+	if user.DisabledAt.Valid {
+		return authenticateFailed(iam.AuthenticateReason_AUTHENTICATE_REASON_ACCOUNT_DISABLED), nil
+	}
+	if l.isPasswordExpired(user) {
+		resp := authenticateFailed(iam.AuthenticateReason_AUTHENTICATE_REASON_PASSWORD_EXPIRED)
+		resp.User = logic.ModelToProtoUser(user)
+		return resp, nil
+	}
+
+	return &iam.AuthenticateResponse{
+		Success: true,
+		User:    logic.ModelToProtoUser(user),
+		Reason:  iam.AuthenticateReason_AUTHENTICATE_REASON_NONE,
+	}, nil
+}
+
+// findUser 根据登录标识的格式选择查询方式
+// 包含@时按邮箱忽略大小写查询；形如手机号时先按手机号查询，未找到再按用户名查询；其余按用户名查询
+func (l *AuthenticateLogic) findUser(identifier string) (*model.Users, error) {
+	if strings.Contains(identifier, "@") {
+		return l.svcCtx.UsersModel.FindByEmailFold(l.ctx, strings.ToLower(identifier))
+	}
+
+	if phone := normalizePhone(identifier); phone != "" {
+		user, err := l.svcCtx.UsersModel.FindByPhone(l.ctx, phone)
+		if err == nil || !errors.Is(err, model.ErrNotFound) {
+			return user, err
+		}
+	}
+
+	return l.svcCtx.UsersModel.FindByUsername(l.ctx, identifier)
+}
+
+// verifyPassword 校验密码，LDAP来源的用户通过目录绑定认证
+func (l *AuthenticateLogic) verifyPassword(user *model.Users, password string) (bool, error) {
+	if user.Source != model.UserSourceLdap {
+		return utils.VerifyPasswordWithSalt(password, user.Salt, user.PasswordHash), nil
+	}
+
+	if l.svcCtx.LdapConnector == nil {
+		l.Logger.Infof("LDAP未启用，拒绝目录用户 %d 登录", user.Id)
+		utils.VerifyPasswordWithSalt(password, dummySalt, dummyPasswordHash)
+		return false, nil
+	}
+	err := l.svcCtx.LdapConnector.Authenticate(user.ExternalId.String, password)
+	if err != nil {
+		if errors.Is(err, ldap.ErrInvalidCredentials) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// isPasswordExpired 判断本地用户密码是否超过有效期，LDAP用户的密码策略由目录管理
+func (l *AuthenticateLogic) isPasswordExpired(user *model.Users) bool {
+	maxAge := l.svcCtx.Config.Auth.PasswordMaxAge
+	if maxAge <= 0 || user.Source == model.UserSourceLdap {
+		return false
+	}
+	return time.Now().After(user.PasswordChangedAt.AddDate(0, 0, int(maxAge)))
+}
+
+// padResponseTime 将响应补齐到配置的最短响应时间
+func (l *AuthenticateLogic) padResponseTime(start time.Time) {
+	remaining := time.Duration(l.svcCtx.Config.Auth.MinResponseTime)*time.Millisecond - time.Since(start)
+	if remaining <= 0 {
+		return
+	}
+	timer := time.NewTimer(remaining)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-l.ctx.Done():
+	}
+}
+
+// normalizePhone 去除手机号中的空格、横线和括号，不符合手机号格式时返回空字符串
+func normalizePhone(identifier string) string {
+	phone := strings.NewReplacer(" ", "", "-", "", "(", "", ")", "").Replace(identifier)
+	if !phonePattern.MatchString(phone) {
+		return ""
+	}
+	return phone
+}
+
+// isLocked 判断账号是否处于锁定期
+func isLocked(user *model.Users) bool {
+	return user.LockedUntil.Valid && user.LockedUntil.Time.After(time.Now())
+}
+
+// authenticateFailed 构造认证失败响应
+func authenticateFailed(reason iam.AuthenticateReason) *iam.AuthenticateResponse {
+	return &iam.AuthenticateResponse{
+		Success: false,
+		Reason:  reason,
+	}
+}
@@ -2,11 +2,13 @@ package userservicelogic
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"github.com/ziptako/iam/db/model"
 	"github.com/ziptako/iam/iam"
 	"github.com/ziptako/iam/internal/svc"
 	"github.com/ziptako/iam/internal/utils"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -51,6 +53,11 @@ func (l *ChangePasswordLogic) ChangePassword(in *iam.ChangePasswordRequest) (*ia
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
+	// LDAP来源的用户密码由目录管理
+	if user.Source == model.UserSourceLdap {
+		return nil, status.Error(codes.FailedPrecondition, "[CP008] LDAP用户请在目录中修改密码")
+	}
+
 	// 生成新的盐值
 	newSalt, err := utils.GenerateSalt()
 	if err != nil {
@@ -62,9 +69,12 @@ func (l *ChangePasswordLogic) ChangePassword(in *iam.ChangePasswordRequest) (*ia
 	// 使用新盐值对新密码进行哈希处理
 	newPasswordHash := utils.HashPasswordWithSalt(in.NewPassword, newSalt)
 
-	// 更新用户密码信息
+	// 更新用户密码信息，修改密码同时解除登录锁定
 	user.PasswordHash = newPasswordHash
 	user.Salt = newSalt
+	user.PasswordChangedAt = time.Now()
+	user.FailedLoginAttempts = 0
+	user.LockedUntil = sql.NullTime{}
 
 	// 更新数据库
 	err = l.svcCtx.UsersModel.Update(l.ctx, user)
@@ -74,6 +84,14 @@ func (l *ChangePasswordLogic) ChangePassword(in *iam.ChangePasswordRequest) (*ia
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
+	// 修改密码后撤销用户的所有会话
+	_, err = l.svcCtx.SessionsModel.RevokeAllByUserId(l.ctx, user.Id, model.SessionRevokePasswordChanged)
+	if err != nil {
+		eInfo := "[CP009] 撤销用户会话失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
 	return &iam.ChangePasswordResponse{
 		Success: true,
 	}, nil
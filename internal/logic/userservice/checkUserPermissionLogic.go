@@ -38,7 +38,7 @@ func (l *CheckUserPermissionLogic) CheckUserPermission(in *iam.CheckUserPermissi
 	}
 
 	// 检查用户是否存在
-	_, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, in.UserId)
+	user, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, in.UserId)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "[CUP003] User not found")
@@ -69,17 +69,28 @@ func (l *CheckUserPermissionLogic) CheckUserPermission(in *iam.CheckUserPermissi
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
+	// 获取用户通过用户组继承的角色
+	groupRoles, err := l.svcCtx.GroupRolesModel.FindByUserId(l.ctx, in.UserId)
+	if err != nil {
+		eInfo := "[CUP009] 查询用户组角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
 	// 如果用户没有任何角色，直接返回false
-	if len(userRoles) == 0 {
+	if len(userRoles) == 0 && len(groupRoles) == 0 {
 		return &iam.CheckUserPermissionResponse{
 			HasPermission: false,
 		}, nil
 	}
 
 	// 提取角色ID列表
-	roleIds := make([]int64, len(userRoles))
-	for i, ur := range userRoles {
-		roleIds[i] = ur.RoleId
+	roleIds := make([]int64, 0, len(userRoles)+len(groupRoles))
+	for _, ur := range userRoles {
+		roleIds = append(roleIds, ur.RoleId)
+	}
+	for _, gr := range groupRoles {
+		roleIds = append(roleIds, gr.RoleId)
 	}
 
 	// 查询这些角色是否拥有指定权限
@@ -90,16 +101,19 @@ func (l *CheckUserPermissionLogic) CheckUserPermission(in *iam.CheckUserPermissi
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
-	// 检查是否有任何角色拥有该权限
-	hasPermission := false
-	for _, rp := range rolePermissions {
-		if rp.PermissionId == permission.Id {
-			hasPermission = true
-			break
-		}
+	// 查询授权对应的权限编码，用于匹配层级和通配授权
+	permissionCodes, err := grantCodes(l.ctx, l.svcCtx, rolePermissions)
+	if err != nil {
+		eInfo := "[CUP010] 查询授权权限失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
 	}
 
-	return &iam.CheckUserPermissionResponse{
-		HasPermission: hasPermission,
-	}, nil
+	// 检查是否有任何角色拥有该权限，拒绝授权优先，带条件的授权需满足条件
+	decision := decideGrants(l.svcCtx, user, rolePermissions, permissionCodes, permission, in.Context)
+
+	// 记录使检查通过的授权，异步批量写入，不影响检查耗时
+	l.svcCtx.Usage.Record(l.ctx, in.UserId, decision.usedGrants())
+
+	return decision.response(permissionCodes), nil
 }
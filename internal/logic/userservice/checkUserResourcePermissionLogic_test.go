@@ -0,0 +1,132 @@
+package userservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/config"
+	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/usage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newResourceTestContext 用户1全局拥有读者角色10，在项目p1上绑定开发者角色11，
+// 在所有仓库上通配绑定拥有repo:*的维护者角色12，在项目p3上绑定拒绝读取的封禁角色13；用户2在项目p2上绑定开发者角色11
+func newResourceTestContext() *svc.ServiceContext {
+	return &svc.ServiceContext{
+		UsersModel: &modeltest.Users{Rows: []*model.Users{{Id: 1, Username: "alice"}, {Id: 2, Username: "bob"}}},
+		PermissionsModel: &modeltest.Permissions{Rows: []*model.Permissions{
+			{Id: 1, Code: "project:read"},
+			{Id: 2, Code: "project:write"},
+			{Id: 3, Code: "repo:*"},
+			{Id: 4, Code: "repo:push"},
+		}},
+		RolePermissionsModel: &modeltest.RolePermissions{Rows: []*model.RolePermissions{
+			{RoleId: 10, PermissionId: 1},
+			{RoleId: 11, PermissionId: 1}, {RoleId: 11, PermissionId: 2},
+			{RoleId: 12, PermissionId: 3},
+			{RoleId: 13, PermissionId: 1, Effect: model.EffectDeny},
+		}},
+		UserRolesModel:  &modeltest.UserRoles{Rows: []*model.UserRoles{{UserId: 1, RoleId: 10}}},
+		GroupRolesModel: &modeltest.GroupRoles{Members: &modeltest.GroupMembers{}},
+		ResourceBindingsModel: &modeltest.ResourceBindings{Rows: []*model.ResourceBindings{
+			{Id: 1, UserId: 1, RoleId: 11, ResourceType: "project", ResourceId: "p1"},
+			{Id: 2, UserId: 1, RoleId: 12, ResourceType: "repo", ResourceId: model.ResourceWildcard},
+			{Id: 3, UserId: 2, RoleId: 11, ResourceType: "project", ResourceId: "p2"},
+			{Id: 4, UserId: 1, RoleId: 13, ResourceType: "project", ResourceId: "p3"},
+		}},
+		Usage: usage.NewRecorder(config.UsageConf{}, nil, nil),
+	}
+}
+
+func TestCheckUserResourcePermission(t *testing.T) {
+	svcCtx := newResourceTestContext()
+	tests := []struct {
+		name         string
+		userId       int64
+		code         string
+		resourceType string
+		resourceId   string
+		want         bool
+	}{
+		{"global role applies to any resource", 1, "project:read", "project", "p9", true},
+		{"binding grants on bound resource", 1, "project:write", "project", "p1", true},
+		{"binding does not leak to other resource", 1, "project:write", "project", "p2", false},
+		{"binding does not leak to other type", 1, "project:write", "repo", "p1", false},
+		{"wildcard binding grants on every resource", 1, "repo:push", "repo", "api", true},
+		{"wildcard code not granted outside binding", 2, "repo:push", "repo", "api", false},
+		{"other user's binding", 2, "project:write", "project", "p1", false},
+		{"own binding of other user", 2, "project:write", "project", "p2", true},
+		{"trimmed resource", 1, "project:write", " project ", " p1 ", true},
+		{"unknown permission", 1, "project:delete", "project", "p1", false},
+		{"deny binding overrides global allow", 1, "project:read", "project", "p3", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := NewCheckUserResourcePermissionLogic(context.Background(), svcCtx).CheckUserResourcePermission(&iam.CheckUserResourcePermissionRequest{
+				UserId: tt.userId, PermissionCode: tt.code, ResourceType: tt.resourceType, ResourceId: tt.resourceId,
+			})
+			if err != nil {
+				t.Fatalf("CheckUserResourcePermission: %v", err)
+			}
+			if resp.HasPermission != tt.want {
+				t.Fatalf("HasPermission = %v, want %v", resp.HasPermission, tt.want)
+			}
+			// 拒绝生效时返回冲突的允许和拒绝授权
+			if tt.resourceId == "p3" && len(resp.ConflictingGrants) != 2 {
+				t.Fatalf("ConflictingGrants = %v, want deny and global allow", resp.ConflictingGrants)
+			}
+		})
+	}
+}
+
+// TestCheckUserResourcePermissionRecordsUsage 资源绑定的角色授权计入使用记录，未通过的检查不记录
+func TestCheckUserResourcePermissionRecordsUsage(t *testing.T) {
+	svcCtx := newResourceTestContext()
+	userUsage, roleUsage := &modeltest.PermissionUsageUsers{}, &modeltest.PermissionUsageRoles{}
+	svcCtx.Usage = usage.NewRecorder(config.UsageConf{Enabled: true, FlushInterval: 3600, MaxPending: 100}, userUsage, roleUsage)
+	ctx := context.Background()
+
+	for _, in := range []*iam.CheckUserResourcePermissionRequest{
+		{UserId: 1, PermissionCode: "project:write", ResourceType: "project", ResourceId: "p1"},
+		{UserId: 1, PermissionCode: "project:write", ResourceType: "project", ResourceId: "p2"},
+	} {
+		if _, err := NewCheckUserResourcePermissionLogic(ctx, svcCtx).CheckUserResourcePermission(in); err != nil {
+			t.Fatalf("CheckUserResourcePermission: %v", err)
+		}
+	}
+	svcCtx.Usage.Flush(ctx)
+
+	if len(userUsage.Rows) != 1 || userUsage.Rows[0].UserId != 1 || userUsage.Rows[0].PermissionId != 2 || userUsage.Rows[0].UseCount != 1 {
+		t.Fatalf("user usage = %+v, want one use of permission 2", userUsage.Rows)
+	}
+	if len(roleUsage.Rows) != 1 || roleUsage.Rows[0].RoleId != 11 {
+		t.Fatalf("role usage = %+v, want bound role 11", roleUsage.Rows)
+	}
+}
+
+func TestCheckUserResourcePermissionRejects(t *testing.T) {
+	svcCtx := newResourceTestContext()
+	tests := []struct {
+		name string
+		in   *iam.CheckUserResourcePermissionRequest
+		code codes.Code
+	}{
+		{"missing user", &iam.CheckUserResourcePermissionRequest{PermissionCode: "project:read", ResourceType: "project", ResourceId: "p1"}, codes.InvalidArgument},
+		{"missing permission", &iam.CheckUserResourcePermissionRequest{UserId: 1, ResourceType: "project", ResourceId: "p1"}, codes.InvalidArgument},
+		{"missing resource id", &iam.CheckUserResourcePermissionRequest{UserId: 1, PermissionCode: "project:read", ResourceType: "project", ResourceId: " "}, codes.InvalidArgument},
+		{"unknown user", &iam.CheckUserResourcePermissionRequest{UserId: 9, PermissionCode: "project:read", ResourceType: "project", ResourceId: "p1"}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCheckUserResourcePermissionLogic(context.Background(), svcCtx).CheckUserResourcePermission(tt.in)
+			if status.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+		})
+	}
+}
@@ -58,17 +58,28 @@ func (l *CheckUserRoleLogic) CheckUserRole(in *iam.CheckUserRoleRequest) (*iam.C
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
-	// 检查用户角色关联是否存在
-	_, err = l.svcCtx.UserRolesModel.FindOneByUserIdRoleId(l.ctx, in.UserId, in.RoleId)
-	hasRole := true
+	// 检查用户角色关联是否存在且当前生效
+	hasRole, err := l.svcCtx.UserRolesModel.HasRole(l.ctx, in.UserId, in.RoleId)
 	if err != nil {
-		if errors.Is(err, model.ErrNotFound) {
-			hasRole = false
-		} else {
-			eInfo := "[CUR007] 查询用户角色关联失败"
+		eInfo := "[CUR007] 查询用户角色关联失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 未直接分配时，检查是否通过用户组继承
+	if !hasRole {
+		groupRoles, err := l.svcCtx.GroupRolesModel.FindByUserId(l.ctx, in.UserId)
+		if err != nil {
+			eInfo := "[CUR008] 查询用户组角色关联失败"
 			l.Logger.Errorf("%v: %v", eInfo, err)
 			return nil, status.Error(codes.Internal, eInfo)
 		}
+		for _, gr := range groupRoles {
+			if gr.RoleId == in.RoleId {
+				hasRole = true
+				break
+			}
+		}
 	}
 
 	return &iam.CheckUserRoleResponse{
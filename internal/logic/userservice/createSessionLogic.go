@@ -0,0 +1,98 @@
+package userservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreateSessionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateSessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateSessionLogic {
+	return &CreateSessionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateSession 登录成功后记录用户会话
+func (l *CreateSessionLogic) CreateSession(in *iam.CreateSessionRequest) (*iam.Session, error) {
+	// 参数验证
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CS001] User ID is required")
+	}
+
+	// 只允许为活跃用户创建会话
+	_, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[CS002] User not found")
+		}
+		eInfo := "[CS003] 查询用户失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	session := &model.Sessions{
+		UserId:    in.UserId,
+		Client:    nullString(in.Client),
+		Ip:        nullString(in.Ip),
+		UserAgent: nullString(in.UserAgent),
+	}
+	result, err := l.svcCtx.SessionsModel.Insert(l.ctx, session)
+	if err != nil {
+		eInfo := "[CS004] 创建会话失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		eInfo := "[CS005] 获取会话ID失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	created, err := l.svcCtx.SessionsModel.FindOne(l.ctx, id)
+	if err != nil {
+		eInfo := "[CS006] 查询会话失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 配置了令牌签名密钥时签发绑定该会话的用户令牌
+	resp := logic.ModelToProtoSession(created)
+	if l.svcCtx.RpcTokens.Enabled() {
+		token, expiresAt, err := l.svcCtx.RpcTokens.Issue(created.Id, created.UserId, model.TenantIdFromContext(l.ctx), time.Now())
+		if err != nil {
+			eInfo := "[CS007] 签发用户令牌失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		resp.AccessToken = token
+		resp.AccessTokenExpiresAt = expiresAt.Unix()
+	}
+
+	return resp, nil
+}
+
+// nullString 去除首尾空白，空字符串视为NULL
+func nullString(s string) sql.NullString {
+	s = strings.TrimSpace(s)
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,96 @@
+package userservicelogic
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/config"
+	"github.com/ziptako/iam/internal/rpcauth"
+	"github.com/ziptako/iam/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newSessionTestContext 活跃用户1、已禁用用户2和LDAP用户3
+func newSessionTestContext() (*svc.ServiceContext, *modeltest.Sessions) {
+	users := &modeltest.Users{Rows: []*model.Users{
+		{Id: 1, Username: "alice", Source: model.UserSourceLocal},
+		{Id: 2, Username: "bob", Source: model.UserSourceLocal, DisabledAt: modeltest.NullTime(time.Now())},
+		{Id: 3, Username: "carol", Source: model.UserSourceLdap},
+	}}
+	sessions := &modeltest.Sessions{}
+	return &svc.ServiceContext{
+		UsersModel:    users,
+		SessionsModel: sessions,
+		RpcTokens:     rpcauth.NewTokenIssuer(config.RpcAuthConf{}),
+	}, sessions
+}
+
+func TestCreateSession(t *testing.T) {
+	svcCtx, sessions := newSessionTestContext()
+	resp, err := NewCreateSessionLogic(context.Background(), svcCtx).CreateSession(&iam.CreateSessionRequest{
+		UserId: 1, Client: " web ", Ip: "10.0.0.1", UserAgent: "  ",
+	})
+	if err != nil {
+		t.Fatalf("CreateSession: %v", err)
+	}
+	if resp.Id != 1 || resp.UserId != 1 || resp.Client != "web" || resp.Ip != "10.0.0.1" || resp.CreatedAt == 0 {
+		t.Fatalf("unexpected session %v", resp)
+	}
+	// 空白的客户端信息存为NULL
+	if s := sessions.Row(1); s == nil || s.UserAgent.Valid || s.RevokedAt.Valid {
+		t.Fatalf("unexpected stored session %+v", s)
+	}
+	// 未配置令牌签名密钥时不签发用户令牌
+	if resp.AccessToken != "" {
+		t.Fatalf("access token issued without secret")
+	}
+}
+
+func TestCreateSessionIssuesToken(t *testing.T) {
+	svcCtx, _ := newSessionTestContext()
+	svcCtx.RpcTokens = rpcauth.NewTokenIssuer(config.RpcAuthConf{TokenSecret: "secret", TokenTTL: 60})
+	ctx := model.WithTenantId(context.Background(), 2)
+	resp, err := NewCreateSessionLogic(ctx, svcCtx).CreateSession(&iam.CreateSessionRequest{UserId: 1})
+	if err != nil {
+		t.Fatalf("CreateSession: %v", err)
+	}
+	claims, err := svcCtx.RpcTokens.Parse(resp.AccessToken)
+	if err != nil {
+		t.Fatalf("Parse access token: %v", err)
+	}
+	// 令牌绑定会话、用户和调用所属租户
+	if claims.SessionId != resp.Id || claims.UserId != 1 || claims.TenantId != 2 || claims.ExpiresAt.Unix() != resp.AccessTokenExpiresAt {
+		t.Fatalf("unexpected token claims %+v for session %v", claims, resp)
+	}
+}
+
+func TestCreateSessionRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		userId  int64
+		code    codes.Code
+		errCode string
+	}{
+		{"missing user", 0, codes.InvalidArgument, "[CS001]"},
+		{"disabled user", 2, codes.NotFound, "[CS002]"},
+		{"unknown user", 9, codes.NotFound, "[CS002]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcCtx, sessions := newSessionTestContext()
+			_, err := NewCreateSessionLogic(context.Background(), svcCtx).CreateSession(&iam.CreateSessionRequest{UserId: tt.userId})
+			if status.Code(err) != tt.code || !strings.Contains(err.Error(), tt.errCode) {
+				t.Fatalf("error = %v, want %s", err, tt.errCode)
+			}
+			if len(sessions.Rows) != 0 {
+				t.Fatalf("session created for rejected request")
+			}
+		})
+	}
+}
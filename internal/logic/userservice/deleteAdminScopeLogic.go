@@ -0,0 +1,61 @@
+package userservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeleteAdminScopeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteAdminScopeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteAdminScopeLogic {
+	return &DeleteAdminScopeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteAdminScope 删除委派管理员的管理范围，删除后该用户不能再调用管理接口
+func (l *DeleteAdminScopeLogic) DeleteAdminScope(in *iam.DeleteAdminScopeRequest) (*iam.DeleteAdminScopeResponse, error) {
+	// 参数验证
+	if in.AdminId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[DAS001] Admin ID is required")
+	}
+
+	scope, err := l.svcCtx.AdminScopesModel.FindByAdminId(l.ctx, in.AdminId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[DAS002] Admin scope not found")
+		}
+		eInfo := "[DAS003] 查询管理范围失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 先清空可分配角色以清除缓存，再删除管理范围
+	if err = l.svcCtx.AdminScopeRolesModel.ReplaceRoles(l.ctx, scope.Id, nil); err != nil {
+		eInfo := "[DAS004] 删除可分配角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if err = l.svcCtx.AdminScopesModel.Delete(l.ctx, scope.Id); err != nil {
+		eInfo := "[DAS005] 删除管理范围失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.DeleteAdminScopeResponse{
+		Success: true,
+	}, nil
+}
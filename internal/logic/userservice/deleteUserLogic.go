@@ -45,6 +45,14 @@ func (l *DeleteUserLogic) DeleteUser(in *iam.DeleteUserRequest) (*iam.DeleteUser
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
+	// 撤销用户的所有会话
+	_, err = l.svcCtx.SessionsModel.RevokeAllByUserId(l.ctx, existingUser.Id, model.SessionRevokeUserDeleted)
+	if err != nil {
+		eInfo := "[DU006] 撤销用户会话失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
 	// 软删除用户（设置deleted_at字段）
 	err = l.svcCtx.UsersModel.Delete(l.ctx, existingUser.Id)
 	if err != nil {
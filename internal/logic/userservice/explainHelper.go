@@ -0,0 +1,287 @@
+package userservicelogic
+
+import (
+	"fmt"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/utils"
+	"strings"
+	"time"
+)
+
+// 决策轨迹中的状态取值
+const (
+	traceStatusActive   = "active"
+	traceStatusDisabled = "disabled"
+	traceStatusDeleted  = "deleted"
+	traceStatusNotFound = "not_found"
+
+	traceSourceDirect = "direct"
+	traceSourceGroup  = "group"
+
+	traceAssignmentEffective = "effective"
+	traceAssignmentExpired   = "expired"
+	traceAssignmentPending   = "pending"
+)
+
+// decisionReasonText 决策原因的可读说明
+var decisionReasonText = map[iam.DecisionReason]string{
+	iam.DecisionReason_DECISION_REASON_ALLOWED:              "an allow grant matched and no deny grant applies",
+	iam.DecisionReason_DECISION_REASON_USER_NOT_ACTIVE:      "the user is disabled",
+	iam.DecisionReason_DECISION_REASON_PERMISSION_NOT_FOUND: "the permission code does not exist",
+	iam.DecisionReason_DECISION_REASON_NO_ROLES:             "the user has no effective roles",
+	iam.DecisionReason_DECISION_REASON_NO_MATCHING_GRANT:    "no effective grant covers the permission",
+	iam.DecisionReason_DECISION_REASON_CONDITION_NOT_MET:    "grants covering the permission exist but none of their conditions are met",
+	iam.DecisionReason_DECISION_REASON_DENIED:               "a deny grant matched and overrides all allow grants",
+}
+
+// permissionTracer 按CheckUserPermission的规则逐条评估授权并记录结果
+type permissionTracer struct {
+	svcCtx *svc.ServiceContext
+	user   *model.Users
+	code   string
+	reqCtx map[string]string
+	now    time.Time
+}
+
+// userTrace 构造决策轨迹中的用户状态
+func (t *permissionTracer) userTrace() *iam.UserTrace {
+	trace := &iam.UserTrace{
+		Id:           t.user.Id,
+		Username:     t.user.Username,
+		Status:       traceStatusActive,
+		DepartmentId: t.user.DepartmentId.Int64,
+	}
+	if t.user.DisabledAt.Valid {
+		trace.Status = traceStatusDisabled
+	}
+	if t.user.LockedUntil.Valid && t.user.LockedUntil.Time.After(t.now) {
+		trace.LockedUntil = t.user.LockedUntil.Time.Unix()
+	}
+	return trace
+}
+
+// roleTrace 构造决策轨迹中的角色，assignment为空表示通过用户组继承的角色，始终生效
+func (t *permissionTracer) roleTrace(role *model.Roles, roleId int64, assignment *model.UserRoles, groupId int64,
+	grants []*model.RolePermissions, codes map[int64]string) *iam.RoleTrace {
+	trace := &iam.RoleTrace{
+		RoleId:     roleId,
+		RoleStatus: traceStatusNotFound,
+		Source:     traceSourceGroup,
+		GroupId:    groupId,
+		Assignment: traceAssignmentEffective,
+	}
+	if role != nil {
+		trace.RoleCode = role.Code
+		trace.RoleName = role.Name
+		switch {
+		case role.DeletedAt.Valid:
+			trace.RoleStatus = traceStatusDeleted
+		case role.DisabledAt.Valid:
+			trace.RoleStatus = traceStatusDisabled
+		default:
+			trace.RoleStatus = traceStatusActive
+		}
+	}
+	if assignment != nil {
+		trace.Source = traceSourceDirect
+		trace.GroupId = 0
+		validity := assignment.Validity()
+		switch {
+		case validity.Expired(t.now):
+			trace.Assignment = traceAssignmentExpired
+		case !validity.Effective(t.now):
+			trace.Assignment = traceAssignmentPending
+		}
+		if validity.From.Valid {
+			trace.ValidFrom = validity.From.Time.Unix()
+		}
+		if validity.Until.Valid {
+			trace.ValidUntil = validity.Until.Time.Unix()
+		}
+	}
+
+	// 与CheckUserPermission一致，已禁用、删除或不存在的角色的授权不参与决策
+	effective := roleEffective(trace)
+	for _, rp := range grants {
+		code, ok := codes[rp.PermissionId]
+		grant := t.grantTrace(rp, code, ok, effective)
+		if grant.Outcome == iam.GrantOutcome_GRANT_OUTCOME_ROLE_NOT_EFFECTIVE && trace.RoleStatus != traceStatusActive {
+			grant.Detail = "role is " + trace.RoleStatus
+		}
+		trace.Grants = append(trace.Grants, grant)
+	}
+	return trace
+}
+
+// roleEffective 角色是否有效且用户角色当前生效
+func roleEffective(role *iam.RoleTrace) bool {
+	return role.RoleStatus == traceStatusActive && role.Assignment == traceAssignmentEffective
+}
+
+// grantTrace 评估单条授权，拒绝授权的条件求值出错时与CheckUserPermission一样视为生效
+func (t *permissionTracer) grantTrace(rp *model.RolePermissions, code string, hasCode, effective bool) *iam.GrantTrace {
+	trace := &iam.GrantTrace{
+		Grant: logic.ModelToProtoPermissionGrant(rp, code),
+	}
+	validity := rp.Validity()
+	switch {
+	case !hasCode:
+		trace.Outcome = iam.GrantOutcome_GRANT_OUTCOME_PERMISSION_DELETED
+	case !utils.PermissionCodeCovers(code, t.code):
+		trace.Outcome = iam.GrantOutcome_GRANT_OUTCOME_NOT_APPLICABLE
+	case !effective:
+		trace.Outcome = iam.GrantOutcome_GRANT_OUTCOME_ROLE_NOT_EFFECTIVE
+	case validity.Expired(t.now):
+		trace.Outcome = iam.GrantOutcome_GRANT_OUTCOME_EXPIRED
+	case !validity.Effective(t.now):
+		trace.Outcome = iam.GrantOutcome_GRANT_OUTCOME_NOT_YET_EFFECTIVE
+	case rp.Condition != "":
+		ok, err := t.svcCtx.Conditions.Evaluate(rp.Condition, grantAttributes(t.user, t.reqCtx, t.now))
+		switch {
+		case err != nil && rp.Effect == model.EffectDeny:
+			trace.Outcome = iam.GrantOutcome_GRANT_OUTCOME_MATCHED
+			trace.Detail = "condition evaluation failed, deny applied: " + err.Error()
+		case err != nil:
+			trace.Outcome = iam.GrantOutcome_GRANT_OUTCOME_CONDITION_ERROR
+			trace.Detail = err.Error()
+		case !ok:
+			trace.Outcome = iam.GrantOutcome_GRANT_OUTCOME_CONDITION_FALSE
+			trace.Detail = "condition evaluated to false"
+		default:
+			trace.Outcome = iam.GrantOutcome_GRANT_OUTCOME_MATCHED
+		}
+	default:
+		trace.Outcome = iam.GrantOutcome_GRANT_OUTCOME_MATCHED
+	}
+	return trace
+}
+
+// decide 根据角色轨迹得出决策原因，生效的拒绝授权存在时将生效的允许授权标记为被覆盖
+func decide(user *iam.UserTrace, permissionExists bool, roles []*iam.RoleTrace) iam.DecisionReason {
+	denied, allowed, effectiveRole, conditional := false, false, false, false
+	for _, role := range roles {
+		if roleEffective(role) {
+			effectiveRole = true
+		}
+		for _, grant := range role.Grants {
+			switch grant.Outcome {
+			case iam.GrantOutcome_GRANT_OUTCOME_MATCHED:
+				if grant.Grant.Effect == model.EffectDeny {
+					denied = true
+				} else {
+					allowed = true
+				}
+			case iam.GrantOutcome_GRANT_OUTCOME_CONDITION_FALSE, iam.GrantOutcome_GRANT_OUTCOME_CONDITION_ERROR:
+				if grant.Grant.Effect != model.EffectDeny {
+					conditional = true
+				}
+			}
+		}
+	}
+	if denied {
+		for _, role := range roles {
+			for _, grant := range role.Grants {
+				if grant.Outcome == iam.GrantOutcome_GRANT_OUTCOME_MATCHED && grant.Grant.Effect != model.EffectDeny {
+					grant.Outcome = iam.GrantOutcome_GRANT_OUTCOME_OVERRIDDEN
+					grant.Detail = "overridden by a matching deny grant"
+				}
+			}
+		}
+	}
+
+	switch {
+	case user.Status != traceStatusActive:
+		return iam.DecisionReason_DECISION_REASON_USER_NOT_ACTIVE
+	case !permissionExists:
+		return iam.DecisionReason_DECISION_REASON_PERMISSION_NOT_FOUND
+	case denied:
+		return iam.DecisionReason_DECISION_REASON_DENIED
+	case allowed:
+		return iam.DecisionReason_DECISION_REASON_ALLOWED
+	case !effectiveRole:
+		return iam.DecisionReason_DECISION_REASON_NO_ROLES
+	case conditional:
+		return iam.DecisionReason_DECISION_REASON_CONDITION_NOT_MET
+	default:
+		return iam.DecisionReason_DECISION_REASON_NO_MATCHING_GRANT
+	}
+}
+
+// renderExplanation 将决策轨迹渲染为可读文本，不覆盖目标权限的授权只统计数量
+func renderExplanation(code string, resp *iam.ExplainUserPermissionResponse) string {
+	var b strings.Builder
+	decision := "DENIED"
+	if resp.HasPermission {
+		decision = "ALLOWED"
+	}
+	fmt.Fprintf(&b, "Permission %q for user %d (%s): %s\n", code, resp.User.Id, resp.User.Username, decision)
+	fmt.Fprintf(&b, "Reason: %s\n", decisionReasonText[resp.Reason])
+
+	fmt.Fprintf(&b, "User: %s", resp.User.Status)
+	if resp.User.LockedUntil > 0 {
+		fmt.Fprintf(&b, ", login locked until %s (does not affect permissions)", formatTraceTime(resp.User.LockedUntil))
+	}
+	b.WriteString("\n")
+
+	if len(resp.Roles) == 0 {
+		b.WriteString("Roles: none\n")
+		return b.String()
+	}
+	b.WriteString("Roles:\n")
+	for _, role := range resp.Roles {
+		name := role.RoleCode
+		if name == "" {
+			name = "<unknown>"
+		}
+		source := "direct assignment"
+		if role.Source == traceSourceGroup {
+			source = fmt.Sprintf("group %d", role.GroupId)
+		}
+		fmt.Fprintf(&b, "  - %s (id=%d) via %s: role %s, assignment %s", name, role.RoleId, source, role.RoleStatus, role.Assignment)
+		if role.ValidFrom > 0 {
+			fmt.Fprintf(&b, ", from %s", formatTraceTime(role.ValidFrom))
+		}
+		if role.ValidUntil > 0 {
+			fmt.Fprintf(&b, ", until %s", formatTraceTime(role.ValidUntil))
+		}
+		b.WriteString("\n")
+
+		unrelated := 0
+		for _, grant := range role.Grants {
+			if grant.Outcome == iam.GrantOutcome_GRANT_OUTCOME_NOT_APPLICABLE {
+				unrelated++
+				continue
+			}
+			code := grant.Grant.PermissionCode
+			if code == "" {
+				code = fmt.Sprintf("<permission %d>", grant.Grant.PermissionId)
+			}
+			fmt.Fprintf(&b, "      [%s] %s %s", strings.TrimPrefix(grant.Outcome.String(), "GRANT_OUTCOME_"), grant.Grant.Effect, code)
+			if grant.Grant.Condition != "" {
+				fmt.Fprintf(&b, " if %s", grant.Grant.Condition)
+			}
+			if grant.Grant.ValidFrom > 0 {
+				fmt.Fprintf(&b, ", from %s", formatTraceTime(grant.Grant.ValidFrom))
+			}
+			if grant.Grant.ValidUntil > 0 {
+				fmt.Fprintf(&b, ", until %s", formatTraceTime(grant.Grant.ValidUntil))
+			}
+			if grant.Detail != "" {
+				fmt.Fprintf(&b, " (%s)", grant.Detail)
+			}
+			b.WriteString("\n")
+		}
+		if unrelated > 0 {
+			fmt.Fprintf(&b, "      %d other grant(s) do not cover the permission\n", unrelated)
+		}
+	}
+	return b.String()
+}
+
+// formatTraceTime 时间戳格式化为UTC的RFC3339时间
+func formatTraceTime(ts int64) string {
+	return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+}
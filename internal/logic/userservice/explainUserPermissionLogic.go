@@ -0,0 +1,150 @@
+package userservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ExplainUserPermissionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExplainUserPermissionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExplainUserPermissionLogic {
+	return &ExplainUserPermissionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ExplainUserPermission 解释用户权限检查的决策过程，返回结构化的决策轨迹和可读文本
+func (l *ExplainUserPermissionLogic) ExplainUserPermission(in *iam.ExplainUserPermissionRequest) (*iam.ExplainUserPermissionResponse, error) {
+	// 参数验证
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[EUP001] User ID is required")
+	}
+	if strings.TrimSpace(in.PermissionCode) == "" {
+		return nil, status.Error(codes.InvalidArgument, "[EUP002] Permission code is required")
+	}
+
+	// 查询用户，已禁用的用户同样给出决策轨迹
+	user, err := l.svcCtx.UsersModel.FindOne(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[EUP003] User not found")
+		}
+		eInfo := "[EUP004] 查询用户失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 查询目标权限，权限不存在时仍列出覆盖该编码的授权
+	permission, err := l.svcCtx.PermissionsModel.FindOneByCode(l.ctx, in.PermissionCode)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		eInfo := "[EUP005] 查询权限失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 查询直接分配的角色（包括已过期和尚未生效的关联）和通过用户组继承的角色
+	userRoles, err := l.svcCtx.UserRolesModel.FindAllByUserId(l.ctx, in.UserId)
+	if err != nil {
+		eInfo := "[EUP006] 查询用户角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	groupRoles, err := l.svcCtx.GroupRolesModel.FindByUserId(l.ctx, in.UserId)
+	if err != nil {
+		eInfo := "[EUP007] 查询用户组角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 查询角色信息和角色的全部授权
+	roleIds := make([]int64, 0, len(userRoles)+len(groupRoles))
+	roles := make(map[int64]*model.Roles)
+	for _, roleId := range append(userRoleIds(userRoles), groupRoleIds(groupRoles)...) {
+		if _, ok := roles[roleId]; ok {
+			continue
+		}
+		role, err := l.svcCtx.RolesModel.FindById(l.ctx, roleId)
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
+			eInfo := "[EUP008] 查询角色失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		roles[roleId] = role
+		roleIds = append(roleIds, roleId)
+	}
+	rolePermissions, err := l.svcCtx.RolePermissionsModel.FindAllByRoleIds(l.ctx, roleIds)
+	if err != nil {
+		eInfo := "[EUP009] 查询角色权限失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	permissionCodes, err := grantCodes(l.ctx, l.svcCtx, rolePermissions)
+	if err != nil {
+		eInfo := "[EUP010] 查询授权权限失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	grantsByRole := make(map[int64][]*model.RolePermissions)
+	for _, rp := range rolePermissions {
+		grantsByRole[rp.RoleId] = append(grantsByRole[rp.RoleId], rp)
+	}
+
+	// 逐个角色评估授权
+	tracer := &permissionTracer{
+		svcCtx: l.svcCtx,
+		user:   user,
+		code:   in.PermissionCode,
+		reqCtx: in.Context,
+		now:    time.Now(),
+	}
+	resp := &iam.ExplainUserPermissionResponse{
+		User: tracer.userTrace(),
+	}
+	if permission != nil {
+		resp.PermissionId = permission.Id
+	}
+	for _, ur := range userRoles {
+		resp.Roles = append(resp.Roles, tracer.roleTrace(roles[ur.RoleId], ur.RoleId, ur, 0, grantsByRole[ur.RoleId], permissionCodes))
+	}
+	for _, gr := range groupRoles {
+		resp.Roles = append(resp.Roles, tracer.roleTrace(roles[gr.RoleId], gr.RoleId, nil, gr.GroupId, grantsByRole[gr.RoleId], permissionCodes))
+	}
+
+	resp.Reason = decide(resp.User, permission != nil, resp.Roles)
+	resp.HasPermission = resp.Reason == iam.DecisionReason_DECISION_REASON_ALLOWED
+	resp.Text = renderExplanation(in.PermissionCode, resp)
+	return resp, nil
+}
+
+// userRoleIds 提取用户角色关联中的角色ID
+func userRoleIds(userRoles []*model.UserRoles) []int64 {
+	ids := make([]int64, 0, len(userRoles))
+	for _, ur := range userRoles {
+		ids = append(ids, ur.RoleId)
+	}
+	return ids
+}
+
+// groupRoleIds 提取用户组角色关联中的角色ID
+func groupRoleIds(groupRoles []*model.GroupRoles) []int64 {
+	ids := make([]int64, 0, len(groupRoles))
+	for _, gr := range groupRoles {
+		ids = append(ids, gr.RoleId)
+	}
+	return ids
+}
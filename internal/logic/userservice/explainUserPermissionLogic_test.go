@@ -0,0 +1,120 @@
+package userservicelogic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+)
+
+// traceGrant 构造指定结果的授权轨迹
+func traceGrant(effect string, outcome iam.GrantOutcome) *iam.GrantTrace {
+	return &iam.GrantTrace{Grant: &iam.PermissionGrant{Effect: effect}, Outcome: outcome}
+}
+
+// traceRole 构造生效的直接分配角色轨迹
+func traceRole(grants ...*iam.GrantTrace) *iam.RoleTrace {
+	return &iam.RoleTrace{RoleStatus: traceStatusActive, Assignment: traceAssignmentEffective, Source: traceSourceDirect, Grants: grants}
+}
+
+func TestDecide(t *testing.T) {
+	active := &iam.UserTrace{Status: traceStatusActive}
+	allow := func() *iam.GrantTrace { return traceGrant(model.EffectAllow, iam.GrantOutcome_GRANT_OUTCOME_MATCHED) }
+	disabledRole := traceRole(traceGrant(model.EffectAllow, iam.GrantOutcome_GRANT_OUTCOME_ROLE_NOT_EFFECTIVE))
+	disabledRole.RoleStatus = traceStatusDisabled
+	expiredRole := traceRole(traceGrant(model.EffectAllow, iam.GrantOutcome_GRANT_OUTCOME_ROLE_NOT_EFFECTIVE))
+	expiredRole.Assignment = traceAssignmentExpired
+
+	tests := []struct {
+		name             string
+		user             *iam.UserTrace
+		permissionExists bool
+		roles            []*iam.RoleTrace
+		want             iam.DecisionReason
+	}{
+		{"allowed", active, true, []*iam.RoleTrace{traceRole(allow())}, iam.DecisionReason_DECISION_REASON_ALLOWED},
+		{"denied", active, true, []*iam.RoleTrace{
+			traceRole(allow()), traceRole(traceGrant(model.EffectDeny, iam.GrantOutcome_GRANT_OUTCOME_MATCHED)),
+		}, iam.DecisionReason_DECISION_REASON_DENIED},
+		{"user disabled", &iam.UserTrace{Status: traceStatusDisabled}, true, []*iam.RoleTrace{traceRole(allow())}, iam.DecisionReason_DECISION_REASON_USER_NOT_ACTIVE},
+		{"permission not found", active, false, []*iam.RoleTrace{traceRole(allow())}, iam.DecisionReason_DECISION_REASON_PERMISSION_NOT_FOUND},
+		{"no roles", active, true, nil, iam.DecisionReason_DECISION_REASON_NO_ROLES},
+		{"only disabled role", active, true, []*iam.RoleTrace{disabledRole}, iam.DecisionReason_DECISION_REASON_NO_ROLES},
+		{"only expired role", active, true, []*iam.RoleTrace{expiredRole}, iam.DecisionReason_DECISION_REASON_NO_ROLES},
+		{"condition not met", active, true, []*iam.RoleTrace{
+			traceRole(traceGrant(model.EffectAllow, iam.GrantOutcome_GRANT_OUTCOME_CONDITION_FALSE)),
+		}, iam.DecisionReason_DECISION_REASON_CONDITION_NOT_MET},
+		// 拒绝授权的条件不满足不算作条件未满足
+		{"deny condition false", active, true, []*iam.RoleTrace{
+			traceRole(traceGrant(model.EffectDeny, iam.GrantOutcome_GRANT_OUTCOME_CONDITION_FALSE)),
+		}, iam.DecisionReason_DECISION_REASON_NO_MATCHING_GRANT},
+		{"no matching grant", active, true, []*iam.RoleTrace{
+			traceRole(traceGrant(model.EffectAllow, iam.GrantOutcome_GRANT_OUTCOME_NOT_APPLICABLE)),
+		}, iam.DecisionReason_DECISION_REASON_NO_MATCHING_GRANT},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := decide(tt.user, tt.permissionExists, tt.roles); got != tt.want {
+				t.Fatalf("decide = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecideMarksOverridden(t *testing.T) {
+	allow := traceGrant(model.EffectAllow, iam.GrantOutcome_GRANT_OUTCOME_MATCHED)
+	deny := traceGrant(model.EffectDeny, iam.GrantOutcome_GRANT_OUTCOME_MATCHED)
+	decide(&iam.UserTrace{Status: traceStatusActive}, true, []*iam.RoleTrace{traceRole(allow), traceRole(deny)})
+	if allow.Outcome != iam.GrantOutcome_GRANT_OUTCOME_OVERRIDDEN || deny.Outcome != iam.GrantOutcome_GRANT_OUTCOME_MATCHED {
+		t.Fatalf("outcomes = %v, %v, want allow overridden by deny", allow.Outcome, deny.Outcome)
+	}
+}
+
+func TestExplainUserPermissionDisabledRole(t *testing.T) {
+	// 角色10授予order:read，已禁用的角色12授予order:*
+	svcCtx := &svc.ServiceContext{
+		UsersModel: &modeltest.Users{Rows: []*model.Users{{Id: 1, Username: "alice"}}},
+		RolesModel: &modeltest.Roles{Rows: []*model.Roles{
+			{Id: 10, Code: "viewer"},
+			{Id: 12, Code: "legacy", DisabledAt: modeltest.NullTime(time.Now())},
+		}},
+		PermissionsModel: &modeltest.Permissions{Rows: []*model.Permissions{
+			{Id: 1, Code: "order:read"},
+			{Id: 2, Code: "order:write"},
+			{Id: 3, Code: "order:*"},
+		}},
+		RolePermissionsModel: &modeltest.RolePermissions{Rows: []*model.RolePermissions{
+			{RoleId: 10, PermissionId: 1},
+			{RoleId: 12, PermissionId: 3},
+		}},
+		UserRolesModel:  &modeltest.UserRoles{Rows: []*model.UserRoles{{UserId: 1, RoleId: 10}, {UserId: 1, RoleId: 12}}},
+		GroupRolesModel: &modeltest.GroupRoles{Members: &modeltest.GroupMembers{}},
+	}
+	explain := func(code string) *iam.ExplainUserPermissionResponse {
+		resp, err := NewExplainUserPermissionLogic(context.Background(), svcCtx).ExplainUserPermission(&iam.ExplainUserPermissionRequest{UserId: 1, PermissionCode: code})
+		if err != nil {
+			t.Fatalf("ExplainUserPermission(%s): %v", code, err)
+		}
+		return resp
+	}
+
+	if resp := explain("order:read"); !resp.HasPermission || resp.Reason != iam.DecisionReason_DECISION_REASON_ALLOWED {
+		t.Fatalf("order:read = %v %v, want allowed", resp.HasPermission, resp.Reason)
+	}
+
+	resp := explain("order:write")
+	if resp.HasPermission || resp.Reason != iam.DecisionReason_DECISION_REASON_NO_MATCHING_GRANT {
+		t.Fatalf("order:write = %v %v, want no matching grant", resp.HasPermission, resp.Reason)
+	}
+	legacy := resp.Roles[1]
+	if legacy.RoleStatus != traceStatusDisabled || len(legacy.Grants) != 1 {
+		t.Fatalf("legacy role trace = %+v", legacy)
+	}
+	if g := legacy.Grants[0]; g.Outcome != iam.GrantOutcome_GRANT_OUTCOME_ROLE_NOT_EFFECTIVE || g.Detail != "role is disabled" {
+		t.Fatalf("grant of disabled role = %v %q, want role not effective", g.Outcome, g.Detail)
+	}
+}
@@ -0,0 +1,97 @@
+package userservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/sod"
+	"github.com/ziptako/iam/internal/svc"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ExtendUserRoleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExtendUserRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExtendUserRoleLogic {
+	return &ExtendUserRoleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ExtendUserRole 调整用户直接分配角色的失效时间，valid_until为0时改为永久有效
+// 已过期的分配不能延期，需要重新分配
+func (l *ExtendUserRoleLogic) ExtendUserRole(in *iam.ExtendUserRoleRequest) (*iam.ExtendUserRoleResponse, error) {
+	// 参数验证
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[EUR001] User ID is required")
+	}
+	if in.RoleId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[EUR002] Role ID is required")
+	}
+	if in.ValidUntil < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[EUR003] Valid until must not be negative")
+	}
+
+	// 查询用户角色关联
+	userRole, err := l.svcCtx.UserRolesModel.FindOneByUserIdRoleId(l.ctx, in.UserId, in.RoleId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[EUR004] User role not found")
+		}
+		eInfo := "[EUR005] 查询用户角色关联失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	now := time.Now()
+	if userRole.Validity().Expired(now) {
+		return nil, status.Error(codes.FailedPrecondition, "[EUR006] User role has expired and must be assigned again")
+	}
+
+	validity := userRole.Validity()
+	validity.Until = sql.NullTime{}
+	if in.ValidUntil > 0 {
+		validUntil := time.Unix(in.ValidUntil, 0)
+		if !validUntil.After(now) {
+			return nil, status.Error(codes.InvalidArgument, "[EUR007] Valid until must be in the future")
+		}
+		if validity.From.Valid && !validUntil.After(validity.From.Time) {
+			return nil, status.Error(codes.InvalidArgument, "[EUR008] Valid until must be later than valid from")
+		}
+		validity.Until = sql.NullTime{Time: validUntil, Valid: true}
+	}
+
+	// 延长有效期可能与其他互斥角色的分配产生时间重叠，在职责分离锁内按新的有效期校验约束并更新失效时间
+	var updateErr error
+	violation, err := l.svcCtx.Constraints.Grant(l.ctx, []sod.Grant{{UserId: in.UserId, RoleId: in.RoleId, Validity: validity}}, func(ctx context.Context) error {
+		updateErr = l.svcCtx.UserRolesModel.UpdateValidity(ctx, userRole, validity)
+		return updateErr
+	})
+	if updateErr != nil {
+		eInfo := "[EUR009] 更新用户角色有效期失败"
+		l.Logger.Errorf("%v: %v", eInfo, updateErr)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if err != nil {
+		eInfo := "[EUR010] 校验职责分离约束失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if violation != nil {
+		return nil, status.Error(codes.FailedPrecondition, "[EUR011] Separation of duty constraint violated: "+violation.Error())
+	}
+
+	return &iam.ExtendUserRoleResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package userservicelogic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/sod"
+	"github.com/ziptako/iam/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newExtendTestContext 用户1的角色10一小时后失效，角色11已过期，角色12两小时后才生效
+// 角色14两天后生效，与角色10不能同时有效
+func newExtendTestContext(now time.Time) (*svc.ServiceContext, *modeltest.UserRoles) {
+	userRoles := &modeltest.UserRoles{Rows: []*model.UserRoles{
+		{Id: 1, UserId: 1, RoleId: 10, ValidUntil: modeltest.NullTime(now.Add(time.Hour))},
+		{Id: 2, UserId: 1, RoleId: 11, ValidUntil: modeltest.NullTime(now.Add(-time.Minute))},
+		{Id: 3, UserId: 1, RoleId: 12, ValidFrom: modeltest.NullTime(now.Add(2 * time.Hour))},
+		{Id: 4, UserId: 1, RoleId: 14, ValidFrom: modeltest.NullTime(now.Add(48 * time.Hour))},
+	}}
+	constraints := &modeltest.RoleConstraints{Rows: []*model.RoleConstraints{
+		{Id: 1, Code: "shift", ConstraintType: model.RoleConstraintExclusive, Enforcement: model.RoleConstraintDynamic, MaxCount: 1},
+	}}
+	constraintRoles := &modeltest.RoleConstraintRoles{Rows: []*model.RoleConstraintRoles{
+		{ConstraintId: 1, RoleId: 10}, {ConstraintId: 1, RoleId: 14},
+	}}
+	return &svc.ServiceContext{
+		UserRolesModel: userRoles,
+		Constraints:    sod.NewChecker(constraints, constraintRoles, userRoles, &modeltest.GroupRoles{Members: &modeltest.GroupMembers{}}),
+	}, userRoles
+}
+
+func TestExtendUserRole(t *testing.T) {
+	now := time.Now()
+	svcCtx, userRoles := newExtendTestContext(now)
+	until := now.Add(24 * time.Hour).Unix()
+	if _, err := NewExtendUserRoleLogic(context.Background(), svcCtx).ExtendUserRole(&iam.ExtendUserRoleRequest{UserId: 1, RoleId: 10, ValidUntil: until}); err != nil {
+		t.Fatalf("ExtendUserRole: %v", err)
+	}
+	if ur := userRoles.Rows[0]; !ur.ValidUntil.Valid || ur.ValidUntil.Time.Unix() != until {
+		t.Fatalf("valid until = %v, want %v", ur.ValidUntil, time.Unix(until, 0))
+	}
+
+	// 失效时间为0表示改为永久有效，生效时间保持不变
+	if _, err := NewExtendUserRoleLogic(context.Background(), svcCtx).ExtendUserRole(&iam.ExtendUserRoleRequest{UserId: 1, RoleId: 12}); err != nil {
+		t.Fatalf("ExtendUserRole permanent: %v", err)
+	}
+	if ur := userRoles.Rows[2]; ur.ValidUntil.Valid || !ur.ValidFrom.Valid {
+		t.Fatalf("validity = %v..%v, want from kept and no expiry", ur.ValidFrom, ur.ValidUntil)
+	}
+}
+
+func TestExtendUserRoleRejects(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		in   *iam.ExtendUserRoleRequest
+		code codes.Code
+	}{
+		{"missing user", &iam.ExtendUserRoleRequest{RoleId: 10}, codes.InvalidArgument},
+		{"negative until", &iam.ExtendUserRoleRequest{UserId: 1, RoleId: 10, ValidUntil: -1}, codes.InvalidArgument},
+		{"not assigned", &iam.ExtendUserRoleRequest{UserId: 1, RoleId: 13}, codes.NotFound},
+		{"already expired", &iam.ExtendUserRoleRequest{UserId: 1, RoleId: 11, ValidUntil: now.Add(time.Hour).Unix()}, codes.FailedPrecondition},
+		{"until in the past", &iam.ExtendUserRoleRequest{UserId: 1, RoleId: 10, ValidUntil: now.Add(-time.Hour).Unix()}, codes.InvalidArgument},
+		{"overlaps exclusive role", &iam.ExtendUserRoleRequest{UserId: 1, RoleId: 10, ValidUntil: now.Add(72 * time.Hour).Unix()}, codes.FailedPrecondition},
+		{"permanent overlaps exclusive role", &iam.ExtendUserRoleRequest{UserId: 1, RoleId: 10}, codes.FailedPrecondition},
+		{"until before from", &iam.ExtendUserRoleRequest{UserId: 1, RoleId: 12, ValidUntil: now.Add(time.Hour).Unix()}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcCtx, userRoles := newExtendTestContext(now)
+			_, err := NewExtendUserRoleLogic(context.Background(), svcCtx).ExtendUserRole(tt.in)
+			if status.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+			if ur := userRoles.Rows[0]; !ur.ValidUntil.Time.Equal(now.Add(time.Hour)) {
+				t.Fatalf("role 10 validity changed to %v", ur.ValidUntil)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package userservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetAdminScopeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAdminScopeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAdminScopeLogic {
+	return &GetAdminScopeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetAdminScope 获取委派管理员的管理范围
+func (l *GetAdminScopeLogic) GetAdminScope(in *iam.GetAdminScopeRequest) (*iam.AdminScope, error) {
+	// 参数验证
+	if in.AdminId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[GAS001] Admin ID is required")
+	}
+
+	scope, err := l.svcCtx.AdminScopesModel.FindByAdminId(l.ctx, in.AdminId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[GAS002] Admin scope not found")
+		}
+		eInfo := "[GAS003] 查询管理范围失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	scopeRoles, err := l.svcCtx.AdminScopeRolesModel.FindByScopeId(l.ctx, scope.Id)
+	if err != nil {
+		eInfo := "[GAS004] 查询可分配角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return logic.ModelToProtoAdminScope(scope, scopeRoles), nil
+}
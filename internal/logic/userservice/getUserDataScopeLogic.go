@@ -0,0 +1,172 @@
+package userservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"slices"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetUserDataScopeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUserDataScopeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserDataScopeLogic {
+	return &GetUserDataScopeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetUserDataScope 获取用户的数据权限范围，合并用户所有角色的数据权限并解析为部门ID列表
+// 多个角色的数据权限取并集；用户没有任何角色时仅可访问本人数据
+func (l *GetUserDataScopeLogic) GetUserDataScope(in *iam.GetUserDataScopeRequest) (*iam.GetUserDataScopeResponse, error) {
+	// 参数验证
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[GUDS001] User ID is required")
+	}
+
+	// 检查用户是否存在
+	user, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[GUDS002] User not found")
+		}
+		eInfo := "[GUDS003] 查询用户失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	resp := &iam.GetUserDataScopeResponse{}
+
+	// 用户所属部门已删除时视为未分配部门
+	var departmentId int64
+	if user.DepartmentId.Valid {
+		department, err := l.svcCtx.DepartmentsModel.FindActiveById(l.ctx, user.DepartmentId.Int64)
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
+			eInfo := "[GUDS004] 查询用户部门失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		if err == nil {
+			departmentId = department.Id
+		}
+	}
+	resp.DepartmentId = departmentId
+
+	// 获取用户直接分配及通过用户组继承的角色
+	userRoles, err := l.svcCtx.UserRolesModel.FindRolesByUserId(l.ctx, in.UserId)
+	if err != nil {
+		eInfo := "[GUDS005] 查询用户角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	groupRoles, err := l.svcCtx.GroupRolesModel.FindByUserId(l.ctx, in.UserId)
+	if err != nil {
+		eInfo := "[GUDS006] 查询用户组角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	roleIds := make([]int64, 0, len(userRoles)+len(groupRoles))
+	seen := make(map[int64]bool, len(userRoles)+len(groupRoles))
+	for _, ur := range userRoles {
+		if !seen[ur.RoleId] {
+			seen[ur.RoleId] = true
+			roleIds = append(roleIds, ur.RoleId)
+		}
+	}
+	for _, gr := range groupRoles {
+		if !seen[gr.RoleId] {
+			seen[gr.RoleId] = true
+			roleIds = append(roleIds, gr.RoleId)
+		}
+	}
+
+	// 合并各角色的数据权限范围
+	var subtreeRoots, departmentIds, customRoleIds []int64
+	activeRoles := 0
+	for _, roleId := range roleIds {
+		role, err := l.svcCtx.RolesModel.FindActiveById(l.ctx, roleId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				// 角色可能已被删除或禁用，跳过
+				continue
+			}
+			eInfo := "[GUDS007] 查询角色详情失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		activeRoles++
+
+		switch role.DataScope {
+		case model.DataScopeAll:
+			// 全部数据权限覆盖其他所有范围
+			return &iam.GetUserDataScopeResponse{
+				All:          true,
+				DepartmentId: departmentId,
+			}, nil
+		case model.DataScopeDeptAndChild:
+			if departmentId > 0 {
+				subtreeRoots = append(subtreeRoots, departmentId)
+			}
+		case model.DataScopeDept:
+			if departmentId > 0 {
+				departmentIds = append(departmentIds, departmentId)
+			}
+		case model.DataScopeCustom:
+			customRoleIds = append(customRoleIds, role.Id)
+		default:
+			resp.Self = true
+		}
+	}
+	if activeRoles == 0 {
+		resp.Self = true
+	}
+
+	// 展开本部门及以下的部门
+	if len(subtreeRoots) > 0 {
+		subtreeIds, err := l.svcCtx.DepartmentsModel.FindSubtreeIds(l.ctx, subtreeRoots)
+		if err != nil {
+			eInfo := "[GUDS008] 查询下级部门失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		departmentIds = append(departmentIds, subtreeIds...)
+	}
+
+	// 自定义部门只包含所列部门本身，并忽略已删除的部门
+	if len(customRoleIds) > 0 {
+		roleDepartments, err := l.svcCtx.RoleDepartmentsModel.FindByRoleIds(l.ctx, customRoleIds)
+		if err != nil {
+			eInfo := "[GUDS009] 查询角色数据权限部门失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		customIds := make([]int64, 0, len(roleDepartments))
+		for _, rd := range roleDepartments {
+			customIds = append(customIds, rd.DepartmentId)
+		}
+		departments, err := l.svcCtx.DepartmentsModel.FindActiveByIds(l.ctx, customIds)
+		if err != nil {
+			eInfo := "[GUDS010] 查询自定义部门失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		for _, d := range departments {
+			departmentIds = append(departmentIds, d.Id)
+		}
+	}
+
+	slices.Sort(departmentIds)
+	resp.DepartmentIds = slices.Compact(departmentIds)
+	return resp, nil
+}
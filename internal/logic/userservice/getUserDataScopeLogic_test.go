@@ -0,0 +1,120 @@
+package userservicelogic
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newDataScopeTestContext 部门树：
+//
+//	1 总部
+//	├── 2 研发部
+//	│   ├── 3 后端组
+//	│   ├── 4 前端组
+//	│   └── 7 已删除
+//	└── 5 销售部
+//	6 已删除的顶级部门
+//
+// 用户1在部门2，用户2未分配部门，用户3所在的部门6已删除。
+// userRoles和groupRoles为用户直接分配和通过用户组100继承的角色。
+func newDataScopeTestContext(userId int64, userRoles, groupRoles []int64) *svc.ServiceContext {
+	deleted := modeltest.NullTime(time.Now())
+	roles := &modeltest.Roles{Rows: []*model.Roles{
+		{Id: 10, Code: "all", DataScope: model.DataScopeAll},
+		{Id: 11, Code: "dept_and_child", DataScope: model.DataScopeDeptAndChild},
+		{Id: 12, Code: "dept", DataScope: model.DataScopeDept},
+		{Id: 13, Code: "self", DataScope: model.DataScopeSelf},
+		{Id: 14, Code: "sales", DataScope: model.DataScopeCustom},
+		{Id: 15, Code: "disabled_all", DataScope: model.DataScopeAll, DisabledAt: deleted},
+		{Id: 16, Code: "backend", DataScope: model.DataScopeCustom},
+	}}
+	direct := &modeltest.UserRoles{}
+	for _, roleId := range userRoles {
+		direct.Rows = append(direct.Rows, &model.UserRoles{UserId: userId, RoleId: roleId})
+	}
+	inherited := &modeltest.GroupRoles{Members: &modeltest.GroupMembers{Rows: []*model.GroupMembers{{GroupId: 100, UserId: userId}}}}
+	for _, roleId := range groupRoles {
+		inherited.Rows = append(inherited.Rows, &model.GroupRoles{GroupId: 100, RoleId: roleId})
+	}
+	return &svc.ServiceContext{
+		UsersModel: &modeltest.Users{Rows: []*model.Users{
+			{Id: 1, Username: "alice", DepartmentId: modeltest.NullInt64(2)},
+			{Id: 2, Username: "bob"},
+			{Id: 3, Username: "carol", DepartmentId: modeltest.NullInt64(6)},
+		}},
+		RolesModel:      roles,
+		UserRolesModel:  direct,
+		GroupRolesModel: inherited,
+		DepartmentsModel: &modeltest.Departments{Rows: []*model.Departments{
+			{Id: 1, Path: "/", Code: "hq"},
+			{Id: 2, Path: "/1/", Code: "eng", ParentId: modeltest.NullInt64(1)},
+			{Id: 3, Path: "/1/2/", Code: "backend", ParentId: modeltest.NullInt64(2)},
+			{Id: 4, Path: "/1/2/", Code: "frontend", ParentId: modeltest.NullInt64(2)},
+			{Id: 5, Path: "/1/", Code: "sales", ParentId: modeltest.NullInt64(1)},
+			{Id: 6, Path: "/", Code: "archive", DeletedAt: deleted},
+			{Id: 7, Path: "/1/2/", Code: "removed", ParentId: modeltest.NullInt64(2), DeletedAt: deleted},
+		}},
+		RoleDepartmentsModel: &modeltest.RoleDepartments{Rows: []*model.RoleDepartments{
+			{RoleId: 14, DepartmentId: 5}, {RoleId: 14, DepartmentId: 6}, {RoleId: 16, DepartmentId: 3},
+		}},
+	}
+}
+
+func TestGetUserDataScope(t *testing.T) {
+	tests := []struct {
+		name          string
+		userId        int64
+		userRoles     []int64
+		groupRoles    []int64
+		all           bool
+		self          bool
+		departmentId  int64
+		departmentIds []int64
+	}{
+		{"no roles", 1, nil, nil, false, true, 2, nil},
+		{"self", 1, []int64{13}, nil, false, true, 2, nil},
+		{"department only", 1, []int64{12}, nil, false, false, 2, []int64{2}},
+		{"department and children", 1, []int64{11}, nil, false, false, 2, []int64{2, 3, 4}},
+		{"custom ignores deleted departments", 1, []int64{14}, nil, false, false, 2, []int64{5}},
+		{"all overrides others", 1, []int64{13, 10, 14}, nil, true, false, 2, nil},
+		{"disabled role ignored", 1, []int64{15}, nil, false, true, 2, nil},
+		{"merge with self", 1, []int64{12, 16, 13}, nil, false, true, 2, []int64{2, 3}},
+		{"merge overlapping", 1, []int64{11, 14, 16}, nil, false, false, 2, []int64{2, 3, 4, 5}},
+		{"inherited from group", 1, []int64{12}, []int64{14}, false, false, 2, []int64{2, 5}},
+		{"inherited all", 1, []int64{13}, []int64{10}, true, false, 2, nil},
+		{"no department", 2, []int64{11, 16}, nil, false, false, 0, []int64{3}},
+		{"deleted department", 3, []int64{12}, nil, false, false, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcCtx := newDataScopeTestContext(tt.userId, tt.userRoles, tt.groupRoles)
+			resp, err := NewGetUserDataScopeLogic(context.Background(), svcCtx).GetUserDataScope(&iam.GetUserDataScopeRequest{UserId: tt.userId})
+			if err != nil {
+				t.Fatalf("GetUserDataScope: %v", err)
+			}
+			if resp.All != tt.all || resp.Self != tt.self || resp.DepartmentId != tt.departmentId || !slices.Equal(resp.DepartmentIds, tt.departmentIds) {
+				t.Fatalf("scope = all %v self %v department %d %v, want all %v self %v department %d %v",
+					resp.All, resp.Self, resp.DepartmentId, resp.DepartmentIds, tt.all, tt.self, tt.departmentId, tt.departmentIds)
+			}
+		})
+	}
+}
+
+func TestGetUserDataScopeRejects(t *testing.T) {
+	svcCtx := newDataScopeTestContext(1, nil, nil)
+	if _, err := NewGetUserDataScopeLogic(context.Background(), svcCtx).GetUserDataScope(&iam.GetUserDataScopeRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("missing user = %v, want InvalidArgument", err)
+	}
+	if _, err := NewGetUserDataScopeLogic(context.Background(), svcCtx).GetUserDataScope(&iam.GetUserDataScopeRequest{UserId: 9}); status.Code(err) != codes.NotFound {
+		t.Fatalf("unknown user = %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,110 @@
+package userservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetUserPermissionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUserPermissionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserPermissionsLogic {
+	return &GetUserPermissionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetUserPermissions 获取用户拥有的全部具体权限，通配授权展开为其覆盖的权限
+// 每个具体权限按 CheckUserPermission 相同的规则判定：拒绝授权优先，带条件的授权需在请求上下文上满足条件
+func (l *GetUserPermissionsLogic) GetUserPermissions(in *iam.GetUserPermissionsRequest) (*iam.GetUserPermissionsResponse, error) {
+	// 参数验证
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[GUP001] User ID is required")
+	}
+
+	// 检查用户是否存在
+	user, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[GUP002] User not found")
+		}
+		eInfo := "[GUP003] 查询用户失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 获取用户直接分配及通过用户组继承的角色
+	userRoles, err := l.svcCtx.UserRolesModel.FindRolesByUserId(l.ctx, in.UserId)
+	if err != nil {
+		eInfo := "[GUP004] 查询用户角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	groupRoles, err := l.svcCtx.GroupRolesModel.FindByUserId(l.ctx, in.UserId)
+	if err != nil {
+		eInfo := "[GUP005] 查询用户组角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if len(userRoles) == 0 && len(groupRoles) == 0 {
+		return &iam.GetUserPermissionsResponse{
+			Permissions: []*iam.Permission{},
+		}, nil
+	}
+
+	roleIds := make([]int64, 0, len(userRoles)+len(groupRoles))
+	for _, ur := range userRoles {
+		roleIds = append(roleIds, ur.RoleId)
+	}
+	for _, gr := range groupRoles {
+		roleIds = append(roleIds, gr.RoleId)
+	}
+
+	rolePermissions, err := l.svcCtx.RolePermissionsModel.FindByRoleIds(l.ctx, roleIds)
+	if err != nil {
+		eInfo := "[GUP006] 查询角色权限失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	permissionCodes, err := grantCodes(l.ctx, l.svcCtx, rolePermissions)
+	if err != nil {
+		eInfo := "[GUP007] 查询授权权限失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 逐个判定当前租户可见的具体权限，通配权限本身只作为授权使用，不出现在结果中
+	permissions, err := l.svcCtx.PermissionsModel.FindAllVisible(l.ctx)
+	if err != nil {
+		eInfo := "[GUP008] 查询权限列表失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	resp := &iam.GetUserPermissionsResponse{
+		Permissions: make([]*iam.Permission, 0),
+	}
+	for _, permission := range permissions {
+		if utils.IsWildcardPermissionCode(permission.Code) {
+			continue
+		}
+		if evaluateGrants(l.svcCtx, user, rolePermissions, permissionCodes, permission, in.Context).HasPermission {
+			resp.Permissions = append(resp.Permissions, logic.ModelToProtoPermission(permission))
+		}
+	}
+
+	return resp, nil
+}
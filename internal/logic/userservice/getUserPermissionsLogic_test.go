@@ -0,0 +1,52 @@
+package userservicelogic
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+)
+
+func TestGetUserPermissions(t *testing.T) {
+	// 用户1的角色10授予order:*，角色11拒绝order:write；租户2的权限对当前租户不可见
+	svcCtx := &svc.ServiceContext{
+		UsersModel: &modeltest.Users{Rows: []*model.Users{{Id: 1, Username: "alice"}, {Id: 2, Username: "bob"}}},
+		PermissionsModel: &modeltest.Permissions{Rows: []*model.Permissions{
+			{Id: 1, Code: "order:*"},
+			{Id: 2, Code: "order:read"},
+			{Id: 3, Code: "order:write"},
+			{Id: 4, Code: "order:export", TenantId: modeltest.NullInt64(2)},
+			{Id: 5, Code: "report:view"},
+		}},
+		RolePermissionsModel: &modeltest.RolePermissions{Rows: []*model.RolePermissions{
+			{RoleId: 10, PermissionId: 1},
+			{RoleId: 11, PermissionId: 3, Effect: model.EffectDeny},
+		}},
+		UserRolesModel:  &modeltest.UserRoles{Rows: []*model.UserRoles{{UserId: 1, RoleId: 10}, {UserId: 1, RoleId: 11}}},
+		GroupRolesModel: &modeltest.GroupRoles{Members: &modeltest.GroupMembers{}},
+	}
+	tests := []struct {
+		userId int64
+		want   []string
+	}{
+		{1, []string{"order:read"}},
+		{2, []string{}},
+	}
+	for _, tt := range tests {
+		resp, err := NewGetUserPermissionsLogic(context.Background(), svcCtx).GetUserPermissions(&iam.GetUserPermissionsRequest{UserId: tt.userId})
+		if err != nil {
+			t.Fatalf("GetUserPermissions(%d): %v", tt.userId, err)
+		}
+		got := make([]string, 0, len(resp.Permissions))
+		for _, p := range resp.Permissions {
+			got = append(got, p.Code)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Fatalf("GetUserPermissions(%d) = %v, want %v", tt.userId, got, tt.want)
+		}
+	}
+}
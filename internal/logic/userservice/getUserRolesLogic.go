@@ -27,7 +27,7 @@ func NewGetUserRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetU
 	}
 }
 
-// GetUserRoles 获取用户拥有的所有角色
+// GetUserRoles 获取用户拥有的所有角色，包括通过用户组继承的角色
 func (l *GetUserRolesLogic) GetUserRoles(in *iam.GetUserRolesRequest) (*iam.GetUserRolesResponse, error) {
 	// 参数验证
 	if in.UserId <= 0 {
@@ -53,10 +53,35 @@ func (l *GetUserRolesLogic) GetUserRoles(in *iam.GetUserRolesRequest) (*iam.GetU
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
-	// 获取角色详情
-	roles := make([]*model.Roles, 0, len(userRoles))
+	// 获取通过用户组继承的角色
+	groupRoles, err := l.svcCtx.GroupRolesModel.FindByUserId(l.ctx, in.UserId)
+	if err != nil {
+		eInfo := "[GUR006] 查询用户组角色关联失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 合并直接分配与继承的角色，保持直接分配的角色在前
+	roleIds := make([]int64, 0, len(userRoles)+len(groupRoles))
+	direct := make(map[int64]*model.UserRoles, len(userRoles))
+	groupIds := make(map[int64][]int64)
 	for _, ur := range userRoles {
-		role, err := l.svcCtx.RolesModel.FindActiveById(l.ctx, ur.RoleId)
+		if direct[ur.RoleId] == nil {
+			direct[ur.RoleId] = ur
+			roleIds = append(roleIds, ur.RoleId)
+		}
+	}
+	for _, gr := range groupRoles {
+		if direct[gr.RoleId] == nil && groupIds[gr.RoleId] == nil {
+			roleIds = append(roleIds, gr.RoleId)
+		}
+		groupIds[gr.RoleId] = append(groupIds[gr.RoleId], gr.GroupId)
+	}
+
+	// 获取角色详情
+	protoRoles := make([]*iam.Role, 0, len(roleIds))
+	for _, roleId := range roleIds {
+		role, err := l.svcCtx.RolesModel.FindActiveById(l.ctx, roleId)
 		if err != nil {
 			if errors.Is(err, model.ErrNotFound) {
 				// 角色可能已被删除，跳过
@@ -66,12 +91,20 @@ func (l *GetUserRolesLogic) GetUserRoles(in *iam.GetUserRolesRequest) (*iam.GetU
 			l.Logger.Errorf("%v: %v", eInfo, err)
 			return nil, status.Error(codes.Internal, eInfo)
 		}
-		roles = append(roles, role)
+		protoRole := logic.ModelToProtoRole(role)
+		protoRole.Inherited = direct[roleId] == nil
+		protoRole.GroupIds = groupIds[roleId]
+		if ur := direct[roleId]; ur != nil {
+			if ur.ValidFrom.Valid {
+				protoRole.ValidFrom = ur.ValidFrom.Time.Unix()
+			}
+			if ur.ValidUntil.Valid {
+				protoRole.ValidUntil = ur.ValidUntil.Time.Unix()
+			}
+		}
+		protoRoles = append(protoRoles, protoRole)
 	}
 
-	// 转换为proto消息
-	protoRoles := logic.ModelRolesToProtoRoles(roles)
-
 	return &iam.GetUserRolesResponse{
 		Roles: protoRoles,
 	}, nil
@@ -0,0 +1,77 @@
+package userservicelogic
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+)
+
+// newGroupRoleTestContext 用户1直接拥有角色10，并通过用户组100和101继承角色10、11和已禁用的角色12，
+// 已删除的用户组102的角色13不再继承
+func newGroupRoleTestContext() *svc.ServiceContext {
+	members := &modeltest.GroupMembers{Rows: []*model.GroupMembers{
+		{GroupId: 100, UserId: 1}, {GroupId: 101, UserId: 1}, {GroupId: 102, UserId: 1},
+	}}
+	groups := &modeltest.Groups{Rows: []*model.Groups{
+		{Id: 100}, {Id: 101}, {Id: 102, DeletedAt: modeltest.NullTime(time.Now())},
+	}}
+	return &svc.ServiceContext{
+		UsersModel: &modeltest.Users{Rows: []*model.Users{{Id: 1, Username: "alice"}, {Id: 2, Username: "bob"}}},
+		RolesModel: &modeltest.Roles{Rows: []*model.Roles{
+			{Id: 10, Code: "developer"},
+			{Id: 11, Code: "auditor"},
+			{Id: 12, Code: "legacy", DisabledAt: modeltest.NullTime(time.Now())},
+			{Id: 13, Code: "operator"},
+		}},
+		UserRolesModel: &modeltest.UserRoles{Rows: []*model.UserRoles{{UserId: 1, RoleId: 10}}},
+		GroupRolesModel: &modeltest.GroupRoles{Rows: []*model.GroupRoles{
+			{GroupId: 100, RoleId: 10}, {GroupId: 100, RoleId: 11}, {GroupId: 101, RoleId: 11}, {GroupId: 101, RoleId: 12},
+			{GroupId: 102, RoleId: 13},
+		}, Members: members, Groups: groups},
+	}
+}
+
+func TestGetUserRolesInherited(t *testing.T) {
+	resp, err := NewGetUserRolesLogic(context.Background(), newGroupRoleTestContext()).GetUserRoles(&iam.GetUserRolesRequest{UserId: 1})
+	if err != nil {
+		t.Fatalf("GetUserRoles: %v", err)
+	}
+	// 直接分配的角色在前，同时记录提供该角色的用户组
+	if len(resp.Roles) != 2 {
+		t.Fatalf("roles = %v, want developer and auditor", resp.Roles)
+	}
+	if r := resp.Roles[0]; r.Id != 10 || r.Inherited || !slices.Equal(r.GroupIds, []int64{100}) {
+		t.Fatalf("direct role = %v", r)
+	}
+	if r := resp.Roles[1]; r.Id != 11 || !r.Inherited || !slices.Equal(r.GroupIds, []int64{100, 101}) {
+		t.Fatalf("inherited role = %v", r)
+	}
+}
+
+func TestCheckUserRoleInherited(t *testing.T) {
+	svcCtx := newGroupRoleTestContext()
+	tests := []struct {
+		userId, roleId int64
+		want           bool
+	}{
+		{1, 10, true},
+		{1, 11, true},
+		{1, 13, false},
+		{2, 11, false},
+	}
+	for _, tt := range tests {
+		resp, err := NewCheckUserRoleLogic(context.Background(), svcCtx).CheckUserRole(&iam.CheckUserRoleRequest{UserId: tt.userId, RoleId: tt.roleId})
+		if err != nil {
+			t.Fatalf("CheckUserRole(%d, %d): %v", tt.userId, tt.roleId, err)
+		}
+		if resp.HasRole != tt.want {
+			t.Fatalf("CheckUserRole(%d, %d) = %v, want %v", tt.userId, tt.roleId, resp.HasRole, tt.want)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package userservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/delegation"
+	"github.com/ziptako/iam/internal/impersonation"
+	"github.com/ziptako/iam/internal/rpcauth"
+	"github.com/ziptako/iam/internal/svc"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ImpersonateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewImpersonateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImpersonateLogic {
+	return &ImpersonateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Impersonate 管理员以指定用户身份访问，签发限时模拟凭证
+func (l *ImpersonateLogic) Impersonate(in *iam.ImpersonateRequest) (*iam.ImpersonateResponse, error) {
+	// 发起人取自已认证的调用人，不信任请求中的发起人ID
+	actorId, ok := l.actorId()
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "[IMP101] Authenticated caller identity is required")
+	}
+	if in.ActorUserId != 0 && in.ActorUserId != actorId {
+		return nil, status.Error(codes.PermissionDenied, "[IMP116] Impersonation actor must be the caller")
+	}
+	in.ActorUserId = actorId
+
+	// 参数验证
+	if in.SubjectUserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[IMP102] Subject user ID is required")
+	}
+	if in.ActorUserId == in.SubjectUserId {
+		return nil, status.Error(codes.InvalidArgument, "[IMP103] Cannot impersonate yourself")
+	}
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "[IMP104] Reason is required")
+	}
+	if !l.svcCtx.Impersonation.Enabled() {
+		return nil, status.Error(codes.FailedPrecondition, "[IMP105] Impersonation is disabled")
+	}
+	// 模拟期间不允许再次发起模拟
+	if _, ok := impersonation.FromContext(l.ctx); ok {
+		return nil, status.Error(codes.PermissionDenied, "[IMP106] Nested impersonation is not allowed")
+	}
+
+	// 发起人必须拥有模拟登录权限
+	check, err := NewCheckUserPermissionLogic(l.ctx, l.svcCtx).CheckUserPermission(&iam.CheckUserPermissionRequest{
+		UserId:         in.ActorUserId,
+		PermissionCode: impersonation.PermissionCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !check.HasPermission {
+		l.audit(in, sql.NullInt64{}, codes.PermissionDenied, "missing permission "+impersonation.PermissionCode)
+		return nil, status.Error(codes.PermissionDenied, "[IMP107] Permission denied")
+	}
+
+	// 被模拟用户必须存在且处于活跃状态
+	_, err = l.svcCtx.UsersModel.FindActiveById(l.ctx, in.SubjectUserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[IMP108] Subject user not found")
+		}
+		eInfo := "[IMP109] 查询被模拟用户失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 直接或通过用户组拥有受保护角色的用户不能被模拟
+	userRoles, err := l.svcCtx.UserRolesModel.FindRolesByUserId(l.ctx, in.SubjectUserId)
+	if err != nil {
+		eInfo := "[IMP110] 查询被模拟用户角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	groupRoles, err := l.svcCtx.GroupRolesModel.FindByUserId(l.ctx, in.SubjectUserId)
+	if err != nil {
+		eInfo := "[IMP117] 查询被模拟用户的用户组角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	roleIds := make([]int64, 0, len(userRoles)+len(groupRoles))
+	for _, ur := range userRoles {
+		roleIds = append(roleIds, ur.RoleId)
+	}
+	for _, gr := range groupRoles {
+		roleIds = append(roleIds, gr.RoleId)
+	}
+	slices.Sort(roleIds)
+	for _, roleId := range slices.Compact(roleIds) {
+		role, err := l.svcCtx.RolesModel.FindOne(l.ctx, roleId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				continue
+			}
+			eInfo := "[IMP111] 查询角色失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		if l.svcCtx.Impersonation.IsProtectedRole(role.Code) {
+			l.audit(in, sql.NullInt64{}, codes.PermissionDenied, "subject holds protected role "+role.Code)
+			return nil, status.Error(codes.PermissionDenied, "[IMP112] Subject user holds a protected role")
+		}
+	}
+
+	// 记录模拟登录并签发凭证
+	now := time.Now()
+	record := &model.Impersonations{
+		ActorId:   in.ActorUserId,
+		SubjectId: in.SubjectUserId,
+		Reason:    reason,
+		ExpiresAt: now.Add(l.svcCtx.Impersonation.TTL(in.TtlSeconds)),
+	}
+	if _, err := l.svcCtx.ImpersonationsModel.Insert(l.ctx, record); err != nil {
+		eInfo := "[IMP113] 创建模拟登录记录失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	token, err := l.svcCtx.Impersonation.Issue(record.Id, in.ActorUserId, in.SubjectUserId, now, record.ExpiresAt)
+	if err != nil {
+		eInfo := "[IMP114] 签发模拟凭证失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	l.audit(in, sql.NullInt64{Int64: record.Id, Valid: true}, codes.OK, fmt.Sprintf("reason: %s, expires_at: %s", reason, record.ExpiresAt.Format(time.RFC3339)))
+	l.Logger.Infof("user %d started impersonating user %d (impersonation %d)", in.ActorUserId, in.SubjectUserId, record.Id)
+
+	return &iam.ImpersonateResponse{
+		ImpersonationId: record.Id,
+		Token:           token,
+		ExpiresAt:       record.ExpiresAt.Unix(),
+	}, nil
+}
+
+// actorId 已认证的调用人：用户令牌认证的用户，或经委派管理校验的调用人
+func (l *ImpersonateLogic) actorId() (int64, bool) {
+	if principal, ok := rpcauth.FromContext(l.ctx); ok && principal.Kind == rpcauth.PrincipalUser {
+		return principal.UserId, true
+	}
+	return delegation.CallerIdFromContext(l.ctx)
+}
+
+// audit 记录模拟登录申请结果，写入失败只记录日志
+func (l *ImpersonateLogic) audit(in *iam.ImpersonateRequest, impersonationId sql.NullInt64, code codes.Code, detail string) {
+	_, err := l.svcCtx.AuditLogsModel.Insert(l.ctx, &model.AuditLogs{
+		ActorId:         sql.NullInt64{Int64: in.ActorUserId, Valid: true},
+		SubjectId:       sql.NullInt64{Int64: in.SubjectUserId, Valid: true},
+		ImpersonationId: impersonationId,
+		Action:          "impersonate",
+		Result:          code.String(),
+		Detail:          sql.NullString{String: detail, Valid: detail != ""},
+	})
+	if err != nil {
+		l.Logger.Errorf("[IMP115] 写入模拟登录审计记录失败: %v", err)
+	}
+}
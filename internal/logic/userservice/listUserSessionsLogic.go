@@ -0,0 +1,57 @@
+package userservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/common/utils"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListUserSessionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListUserSessionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUserSessionsLogic {
+	return &ListUserSessionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListUserSessions 分页查询用户的会话
+func (l *ListUserSessionsLogic) ListUserSessions(in *iam.ListUserSessionsRequest) (*iam.ListUserSessionsResponse, error) {
+	// 参数验证
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[LUS001] User ID is required")
+	}
+
+	// 使用工具函数计算分页参数
+	pagination := utils.CalculatePagination(in.Page, in.PageSize)
+
+	sessions, err := l.svcCtx.SessionsModel.FindByUserId(l.ctx, in.UserId, in.IncludeRevoked, pagination.Limit, pagination.Offset)
+	if err != nil {
+		eInfo := "[LUS002] 查询会话列表失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	total, err := l.svcCtx.SessionsModel.CountByUserId(l.ctx, in.UserId, in.IncludeRevoked)
+	if err != nil {
+		eInfo := "[LUS003] 查询会话总数失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.ListUserSessionsResponse{
+		Items: logic.ModelSessionsToProtoSessions(sessions),
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,108 @@
+package userservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/condition"
+	"github.com/ziptako/iam/internal/decision"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/usage"
+	"slices"
+	"time"
+)
+
+// grantCodes 查询角色权限关联对应的权限编码，已删除的权限不包含在结果中
+func grantCodes(ctx context.Context, svcCtx *svc.ServiceContext, rolePermissions []*model.RolePermissions) (map[int64]string, error) {
+	codes := make(map[int64]string)
+	for _, rp := range rolePermissions {
+		if _, ok := codes[rp.PermissionId]; ok {
+			continue
+		}
+		permission, err := svcCtx.PermissionsModel.FindOne(ctx, rp.PermissionId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		codes[rp.PermissionId] = permission.Code
+	}
+	return codes, nil
+}
+
+// grantDecision 权限检查中相关授权的求值结果
+type grantDecision struct {
+	*decision.Decision
+}
+
+// evaluateGrants 根据用户角色的授权判断是否具备指定权限，详见 decideGrants
+func evaluateGrants(svcCtx *svc.ServiceContext, user *model.Users, rolePermissions []*model.RolePermissions, codes map[int64]string, permission *model.Permissions, reqCtx map[string]string) *iam.CheckUserPermissionResponse {
+	return decideGrants(svcCtx, user, rolePermissions, codes, permission, reqCtx).response(codes)
+}
+
+// decideGrants 在用户属性和请求上下文上对用户角色的授权求值，求值规则见 decision.Decide
+func decideGrants(svcCtx *svc.ServiceContext, user *model.Users, rolePermissions []*model.RolePermissions, codes map[int64]string, permission *model.Permissions, reqCtx map[string]string) *grantDecision {
+	attrs := grantAttributes(user, reqCtx, time.Now())
+	return &grantDecision{decision.Decide(svcCtx.Conditions, rolePermissions, codes, permission.Code, attrs)}
+}
+
+// response 构造权限检查响应，存在拒绝授权时返回冲突的授权
+func (d *grantDecision) response(codes map[int64]string) *iam.CheckUserPermissionResponse {
+	var failed []*iam.FailedCondition
+	for _, f := range d.Failed {
+		failed = append(failed, &iam.FailedCondition{
+			RoleId:    f.Grant.RoleId,
+			Condition: f.Grant.Condition,
+			Reason:    f.Reason,
+		})
+	}
+	if len(d.Denies) > 0 {
+		conflicts := make([]*iam.PermissionGrant, 0, len(d.Denies)+len(d.Allows))
+		for _, rp := range slices.Concat(d.Denies, d.Allows) {
+			conflicts = append(conflicts, logic.ModelToProtoPermissionGrant(rp, codes[rp.PermissionId]))
+		}
+		return &iam.CheckUserPermissionResponse{
+			HasPermission:     false,
+			FailedConditions:  failed,
+			ConflictingGrants: conflicts,
+		}
+	}
+	if len(d.Allows) > 0 {
+		return &iam.CheckUserPermissionResponse{
+			HasPermission: true,
+		}
+	}
+	return &iam.CheckUserPermissionResponse{
+		HasPermission:    false,
+		FailedConditions: failed,
+	}
+}
+
+// usedGrants 使权限检查通过的授权，用于记录权限使用情况
+func (d *grantDecision) usedGrants() []usage.Grant {
+	if !d.Allowed() {
+		return nil
+	}
+	grants := make([]usage.Grant, 0, len(d.Allows))
+	for _, rp := range d.Allows {
+		grants = append(grants, usage.Grant{RoleId: rp.RoleId, PermissionId: rp.PermissionId})
+	}
+	return grants
+}
+
+// grantAttributes 构造授权条件求值的属性
+func grantAttributes(user *model.Users, reqCtx map[string]string, now time.Time) condition.Attributes {
+	return condition.Attributes{
+		User: map[string]any{
+			"id":            user.Id,
+			"username":      user.Username,
+			"tenant_id":     user.TenantId,
+			"department_id": user.DepartmentId.Int64,
+		},
+		Request: reqCtx,
+		Now:     now,
+	}
+}
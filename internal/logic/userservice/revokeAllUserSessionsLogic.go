@@ -0,0 +1,51 @@
+package userservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RevokeAllUserSessionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRevokeAllUserSessionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeAllUserSessionsLogic {
+	return &RevokeAllUserSessionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RevokeAllUserSessions 撤销用户的所有活跃会话
+func (l *RevokeAllUserSessionsLogic) RevokeAllUserSessions(in *iam.RevokeAllUserSessionsRequest) (*iam.RevokeAllUserSessionsResponse, error) {
+	// 参数验证
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[RAS001] User ID is required")
+	}
+
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" {
+		reason = model.SessionRevokeManual
+	}
+
+	revoked, err := l.svcCtx.SessionsModel.RevokeAllByUserId(l.ctx, in.UserId, reason)
+	if err != nil {
+		eInfo := "[RAS002] 撤销用户会话失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.RevokeAllUserSessionsResponse{
+		Revoked: revoked,
+	}, nil
+}
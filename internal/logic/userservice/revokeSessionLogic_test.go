@@ -0,0 +1,85 @@
+package userservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRevokeSession(t *testing.T) {
+	svcCtx, sessions := newSessionTestContext()
+	sessions.Rows = []*model.Sessions{{Id: 1, UserId: 1}, {Id: 2, UserId: 1}, {Id: 3, UserId: 3}}
+	ctx := context.Background()
+
+	if _, err := NewRevokeSessionLogic(ctx, svcCtx).RevokeSession(&iam.RevokeSessionRequest{Id: 1}); err != nil {
+		t.Fatalf("RevokeSession: %v", err)
+	}
+	if s := sessions.Row(1); !s.RevokedAt.Valid || s.RevokeReason.String != model.SessionRevokeManual {
+		t.Fatalf("session not revoked: %+v", s)
+	}
+	// 已撤销的会话不能再次撤销
+	_, err := NewRevokeSessionLogic(ctx, svcCtx).RevokeSession(&iam.RevokeSessionRequest{Id: 1})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("revoke twice = %v, want NotFound", err)
+	}
+	if _, err = NewRevokeSessionLogic(ctx, svcCtx).RevokeSession(&iam.RevokeSessionRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("revoke without id = %v, want InvalidArgument", err)
+	}
+
+	// 已撤销的会话不再刷新活跃时间
+	for id, want := range map[int64]bool{1: false, 2: true, 9: false} {
+		resp, err := NewTouchSessionLogic(ctx, svcCtx).TouchSession(&iam.TouchSessionRequest{Id: id})
+		if err != nil || resp.Active != want {
+			t.Fatalf("TouchSession(%d) = %v, %v, want %v", id, resp, err, want)
+		}
+	}
+
+	list, err := NewListUserSessionsLogic(ctx, svcCtx).ListUserSessions(&iam.ListUserSessionsRequest{UserId: 1})
+	if err != nil || list.Total != 1 || len(list.Items) != 1 || list.Items[0].Id != 2 {
+		t.Fatalf("active sessions = %v, %v", list, err)
+	}
+	list, err = NewListUserSessionsLogic(ctx, svcCtx).ListUserSessions(&iam.ListUserSessionsRequest{UserId: 1, IncludeRevoked: true})
+	if err != nil || list.Total != 2 || list.Items[0].Id != 2 || list.Items[1].RevokeReason != model.SessionRevokeManual {
+		t.Fatalf("all sessions = %v, %v", list, err)
+	}
+}
+
+func TestRevokeAllUserSessions(t *testing.T) {
+	svcCtx, sessions := newSessionTestContext()
+	sessions.Rows = []*model.Sessions{{Id: 1, UserId: 1}, {Id: 2, UserId: 1}, {Id: 3, UserId: 3}}
+	resp, err := NewRevokeAllUserSessionsLogic(context.Background(), svcCtx).RevokeAllUserSessions(&iam.RevokeAllUserSessionsRequest{
+		UserId: 1, Reason: " logout ",
+	})
+	if err != nil || resp.Revoked != 2 {
+		t.Fatalf("RevokeAllUserSessions = %v, %v", resp, err)
+	}
+	if s := sessions.Row(2); s.RevokeReason.String != "logout" {
+		t.Fatalf("revoke reason = %q, want logout", s.RevokeReason.String)
+	}
+	if sessions.Row(3).RevokedAt.Valid {
+		t.Fatalf("session of another user revoked")
+	}
+}
+
+// TestChangePasswordRevokesSessions 修改密码后撤销用户的所有会话
+func TestChangePasswordRevokesSessions(t *testing.T) {
+	svcCtx, sessions := newSessionTestContext()
+	sessions.Rows = []*model.Sessions{{Id: 1, UserId: 1}, {Id: 2, UserId: 3}}
+	ctx := context.Background()
+
+	if _, err := NewChangePasswordLogic(ctx, svcCtx).ChangePassword(&iam.ChangePasswordRequest{UserId: 1, NewPassword: "secret123"}); err != nil {
+		t.Fatalf("ChangePassword: %v", err)
+	}
+	if s := sessions.Row(1); s.RevokeReason.String != model.SessionRevokePasswordChanged {
+		t.Fatalf("session not revoked after password change: %+v", s)
+	}
+	// LDAP用户的密码由目录管理，会话保持有效
+	_, err := NewChangePasswordLogic(ctx, svcCtx).ChangePassword(&iam.ChangePasswordRequest{UserId: 3, NewPassword: "secret123"})
+	if status.Code(err) != codes.FailedPrecondition || sessions.Row(2).RevokedAt.Valid {
+		t.Fatalf("ldap user password change = %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package userservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/verification"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SendVerificationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendVerificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendVerificationLogic {
+	return &SendVerificationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SendVerification 向用户邮箱或手机号发送验证码
+func (l *SendVerificationLogic) SendVerification(in *iam.SendVerificationRequest) (*iam.SendVerificationResponse, error) {
+	// 参数验证
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[SV001] User ID is required")
+	}
+	if in.Channel != model.VerificationChannelEmail && in.Channel != model.VerificationChannelPhone {
+		return nil, status.Error(codes.InvalidArgument, "[SV002] Channel must be email or phone")
+	}
+
+	user, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[SV003] User not found")
+		}
+		eInfo := "[SV004] 查询用户失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	expiresAt, err := l.svcCtx.Verification.Send(l.ctx, user, in.Channel)
+	switch {
+	case errors.Is(err, verification.ErrNoTarget):
+		return nil, status.Error(codes.FailedPrecondition, "[SV005] User has no "+in.Channel)
+	case errors.Is(err, verification.ErrTooFrequent):
+		return nil, status.Error(codes.ResourceExhausted, "[SV006] Verification code requested too frequently")
+	case err != nil:
+		eInfo := "[SV007] 发送验证码失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.SendVerificationResponse{
+		ExpiresAt: expiresAt.Unix(),
+	}, nil
+}
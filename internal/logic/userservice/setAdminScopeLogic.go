@@ -0,0 +1,144 @@
+package userservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/delegation"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetAdminScopeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetAdminScopeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetAdminScopeLogic {
+	return &SetAdminScopeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetAdminScope 设置委派管理员的管理范围，覆盖原有配置
+func (l *SetAdminScopeLogic) SetAdminScope(in *iam.SetAdminScopeRequest) (*iam.AdminScope, error) {
+	// 参数验证
+	if in.AdminId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[SAS001] Admin ID is required")
+	}
+	if in.DepartmentId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[SAS002] Invalid department ID")
+	}
+	roleIds := make([]int64, 0, len(in.GrantableRoleIds))
+	seen := make(map[int64]bool, len(in.GrantableRoleIds))
+	for _, roleId := range in.GrantableRoleIds {
+		if roleId <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "[SAS003] Invalid role ID")
+		}
+		if !seen[roleId] {
+			seen[roleId] = true
+			roleIds = append(roleIds, roleId)
+		}
+	}
+
+	// 检查管理员用户是否存在
+	_, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, in.AdminId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[SAS004] User not found")
+		}
+		eInfo := "[SAS005] 查询用户失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 检查所辖部门是否存在
+	departmentId := sql.NullInt64{}
+	if in.DepartmentId > 0 {
+		_, err = l.svcCtx.DepartmentsModel.FindActiveById(l.ctx, in.DepartmentId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "[SAS006] Department not found")
+			}
+			eInfo := "[SAS007] 查询部门失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		departmentId = sql.NullInt64{Int64: in.DepartmentId, Valid: true}
+	}
+
+	// 检查可分配角色是否存在
+	for _, roleId := range roleIds {
+		_, err = l.svcCtx.RolesModel.FindActiveById(l.ctx, roleId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, fmt.Sprintf("[SAS008] Role not found: %d", roleId))
+			}
+			eInfo := "[SAS009] 查询角色失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	// 创建或更新管理范围
+	scope, err := l.svcCtx.AdminScopesModel.FindByAdminId(l.ctx, in.AdminId)
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		scope = &model.AdminScopes{
+			AdminId:      in.AdminId,
+			DepartmentId: departmentId,
+		}
+		if callerId, ok := delegation.CallerIdFromContext(l.ctx); ok {
+			scope.CreatedBy = sql.NullInt64{Int64: callerId, Valid: true}
+		}
+		if _, err = l.svcCtx.AdminScopesModel.Insert(l.ctx, scope); err != nil {
+			eInfo := "[SAS011] 创建管理范围失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	case err != nil:
+		eInfo := "[SAS010] 查询管理范围失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	default:
+		scope.DepartmentId = departmentId
+		if err = l.svcCtx.AdminScopesModel.Update(l.ctx, scope); err != nil {
+			eInfo := "[SAS012] 更新管理范围失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	// 替换可分配角色
+	if err = l.svcCtx.AdminScopeRolesModel.ReplaceRoles(l.ctx, scope.Id, roleIds); err != nil {
+		eInfo := "[SAS013] 更新可分配角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 重新查询以获取数据库维护的时间字段
+	scope, err = l.svcCtx.AdminScopesModel.FindOne(l.ctx, scope.Id)
+	if err != nil {
+		eInfo := "[SAS014] 查询管理范围失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	scopeRoles, err := l.svcCtx.AdminScopeRolesModel.FindByScopeId(l.ctx, scope.Id)
+	if err != nil {
+		eInfo := "[SAS015] 查询可分配角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return logic.ModelToProtoAdminScope(scope, scopeRoles), nil
+}
@@ -0,0 +1,280 @@
+package userservicelogic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/logic"
+	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/utils"
+	"slices"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SimulateChangeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSimulateChangeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SimulateChangeLogic {
+	return &SimulateChangeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SimulateChange 模拟一组策略变更，返回受影响用户有效权限的差异；只在内存中求值，不修改任何数据
+func (l *SimulateChangeLogic) SimulateChange(in *iam.SimulateChangeRequest) (*iam.SimulateChangeResponse, error) {
+	if len(in.Mutations) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "[SC001] At least one mutation is required")
+	}
+	if len(in.Mutations) > maxMutationsPerSimulation {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("[SC002] Too many mutations, at most %d are allowed", maxMutationsPerSimulation))
+	}
+	for i, m := range in.Mutations {
+		if err := l.validateMutation(i, m); err != nil {
+			return nil, err
+		}
+	}
+
+	// 校验变更引用的用户、角色和权限，并收集角色级变更涉及的角色
+	// 已禁用的角色不参与求值，对其的变更不影响有效权限
+	users := make(map[int64]*model.Users)
+	checkedRoles := make(map[int64]struct{})
+	var disabledRoleIds []int64
+	checkedPermissions := make(map[int64]struct{})
+	var changedRoleIds []int64
+	for i, m := range in.Mutations {
+		if m.UserId > 0 {
+			if _, ok := users[m.UserId]; !ok {
+				user, err := l.svcCtx.UsersModel.FindActiveById(l.ctx, m.UserId)
+				if err != nil {
+					if errors.Is(err, model.ErrNotFound) {
+						return nil, status.Error(codes.NotFound, fmt.Sprintf("[SC009] Mutation %d: user not found", i))
+					}
+					eInfo := "[SC010] 查询用户失败"
+					l.Logger.Errorf("%v: %v", eInfo, err)
+					return nil, status.Error(codes.Internal, eInfo)
+				}
+				users[m.UserId] = user
+			}
+		}
+		if _, ok := checkedRoles[m.RoleId]; !ok {
+			role, err := l.svcCtx.RolesModel.FindById(l.ctx, m.RoleId)
+			if err == nil && role.DeletedAt.Valid {
+				err = model.ErrNotFound
+			}
+			if err != nil {
+				if errors.Is(err, model.ErrNotFound) {
+					return nil, status.Error(codes.NotFound, fmt.Sprintf("[SC011] Mutation %d: role not found", i))
+				}
+				eInfo := "[SC012] 查询角色失败"
+				l.Logger.Errorf("%v: %v", eInfo, err)
+				return nil, status.Error(codes.Internal, eInfo)
+			}
+			checkedRoles[m.RoleId] = struct{}{}
+			if role.DisabledAt.Valid {
+				disabledRoleIds = append(disabledRoleIds, m.RoleId)
+			}
+		}
+		if m.PermissionId > 0 {
+			if _, ok := checkedPermissions[m.PermissionId]; !ok {
+				_, err := l.svcCtx.PermissionsModel.FindOne(l.ctx, m.PermissionId)
+				if err != nil {
+					if errors.Is(err, model.ErrNotFound) {
+						return nil, status.Error(codes.NotFound, fmt.Sprintf("[SC013] Mutation %d: permission not found", i))
+					}
+					eInfo := "[SC014] 查询权限失败"
+					l.Logger.Errorf("%v: %v", eInfo, err)
+					return nil, status.Error(codes.Internal, eInfo)
+				}
+				checkedPermissions[m.PermissionId] = struct{}{}
+			}
+		}
+		if m.UserId == 0 && !slices.Contains(disabledRoleIds, m.RoleId) {
+			changedRoleIds = append(changedRoleIds, m.RoleId)
+		}
+	}
+
+	// 受影响的用户：变更中直接指定的用户，以及直接拥有或通过用户组继承了被变更角色的用户
+	affected := make([]int64, 0, len(users))
+	for userId := range users {
+		affected = append(affected, userId)
+	}
+	if len(changedRoleIds) > 0 {
+		roleUsers, err := l.svcCtx.UserRolesModel.FindUsersByRoleIds(l.ctx, changedRoleIds)
+		if err != nil {
+			eInfo := "[SC015] 查询角色用户失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		for _, ur := range roleUsers {
+			affected = append(affected, ur.UserId)
+		}
+		inheritedUsers, err := l.svcCtx.GroupRolesModel.FindInheritedByRoleIds(l.ctx, changedRoleIds)
+		if err != nil {
+			eInfo := "[SC016] 查询继承角色的用户失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		for _, ir := range inheritedUsers {
+			affected = append(affected, ir.UserId)
+		}
+	}
+	slices.Sort(affected)
+	affected = slices.Compact(affected)
+
+	// 查询受影响用户当前生效的直接角色和继承角色
+	userRoles, err := l.svcCtx.UserRolesModel.FindRolesByUserIds(l.ctx, affected)
+	if err != nil {
+		eInfo := "[SC017] 查询用户角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	inheritedRoles, err := l.svcCtx.GroupRolesModel.FindInheritedByUserIds(l.ctx, affected)
+	if err != nil {
+		eInfo := "[SC018] 查询用户组角色失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	direct := make(map[int64][]int64)
+	for _, ur := range userRoles {
+		direct[ur.UserId] = append(direct[ur.UserId], ur.RoleId)
+	}
+	inherited := make(map[int64][]int64)
+	for _, ir := range inheritedRoles {
+		inherited[ir.UserId] = append(inherited[ir.UserId], ir.RoleId)
+	}
+
+	// 计算每个用户变更前后的角色，并一次查询涉及的全部授权
+	overlay := newPolicyOverlay(in.Mutations, disabledRoleIds)
+	before := make(map[int64][]int64, len(affected))
+	after := make(map[int64][]int64, len(affected))
+	allRoleIds := make([]int64, 0)
+	for _, userId := range affected {
+		roleIds := slices.Concat(direct[userId], inherited[userId])
+		slices.Sort(roleIds)
+		before[userId] = slices.Compact(roleIds)
+		after[userId] = overlay.roleIds(userId, direct[userId], inherited[userId])
+		allRoleIds = append(allRoleIds, before[userId]...)
+		allRoleIds = append(allRoleIds, after[userId]...)
+	}
+	slices.Sort(allRoleIds)
+	rolePermissions, err := l.svcCtx.RolePermissionsModel.FindByRoleIds(l.ctx, slices.Compact(allRoleIds))
+	if err != nil {
+		eInfo := "[SC019] 查询角色权限失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	permissionCodes, err := grantCodes(l.ctx, l.svcCtx, slices.Concat(rolePermissions, overlay.grantOverrides()))
+	if err != nil {
+		eInfo := "[SC020] 查询授权权限失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	permissions, err := l.svcCtx.PermissionsModel.FindAllVisible(l.ctx)
+	if err != nil {
+		eInfo := "[SC021] 查询权限列表失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	permissions = slices.DeleteFunc(permissions, func(p *model.Permissions) bool {
+		return utils.IsWildcardPermissionCode(p.Code)
+	})
+
+	// 逐个用户按GetUserPermissions相同的规则比较变更前后的有效权限，已禁用的用户没有有效权限，不参与求值
+	resp := &iam.SimulateChangeResponse{
+		Users: make([]*iam.UserPermissionDiff, 0),
+	}
+	for _, userId := range affected {
+		user, ok := users[userId]
+		if !ok {
+			user, err = l.svcCtx.UsersModel.FindActiveById(l.ctx, userId)
+			if err != nil {
+				if errors.Is(err, model.ErrNotFound) {
+					continue
+				}
+				eInfo := "[SC022] 查询用户失败"
+				l.Logger.Errorf("%v: %v", eInfo, err)
+				return nil, status.Error(codes.Internal, eInfo)
+			}
+		}
+		resp.EvaluatedUserCount++
+
+		beforeGrants := filterRolePermissions(rolePermissions, before[userId])
+		afterGrants := overlay.rolePermissions(rolePermissions, after[userId])
+		diff := &iam.UserPermissionDiff{
+			UserId:   user.Id,
+			Username: user.Username,
+			Gained:   make([]*iam.Permission, 0),
+			Lost:     make([]*iam.Permission, 0),
+		}
+		for _, permission := range permissions {
+			had := evaluateGrants(l.svcCtx, user, beforeGrants, permissionCodes, permission, in.Context).HasPermission
+			has := evaluateGrants(l.svcCtx, user, afterGrants, permissionCodes, permission, in.Context).HasPermission
+			switch {
+			case has && !had:
+				diff.Gained = append(diff.Gained, logic.ModelToProtoPermission(permission))
+			case had && !has:
+				diff.Lost = append(diff.Lost, logic.ModelToProtoPermission(permission))
+			}
+		}
+		if len(diff.Gained) > 0 || len(diff.Lost) > 0 {
+			resp.Users = append(resp.Users, diff)
+		}
+	}
+
+	return resp, nil
+}
+
+// validateMutation 校验单项变更的参数，并规范化授权效果和条件
+func (l *SimulateChangeLogic) validateMutation(i int, m *iam.PolicyMutation) error {
+	if m == nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("[SC003] Mutation %d: type is required", i))
+	}
+	switch m.Type {
+	case iam.MutationType_MUTATION_TYPE_ASSIGN_USER_ROLE, iam.MutationType_MUTATION_TYPE_REMOVE_USER_ROLE:
+		if m.UserId <= 0 {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("[SC004] Mutation %d: user ID is required", i))
+		}
+		m.PermissionId = 0
+	case iam.MutationType_MUTATION_TYPE_GRANT_ROLE_PERMISSION, iam.MutationType_MUTATION_TYPE_REVOKE_ROLE_PERMISSION:
+		if m.PermissionId <= 0 {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("[SC006] Mutation %d: permission ID is required", i))
+		}
+		m.UserId = 0
+	case iam.MutationType_MUTATION_TYPE_DISABLE_ROLE:
+		m.UserId, m.PermissionId = 0, 0
+	default:
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("[SC003] Mutation %d: type is required", i))
+	}
+	if m.RoleId <= 0 {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("[SC005] Mutation %d: role ID is required", i))
+	}
+
+	if m.Type != iam.MutationType_MUTATION_TYPE_GRANT_ROLE_PERMISSION {
+		return nil
+	}
+	m.Effect = strings.TrimSpace(m.Effect)
+	if m.Effect == "" {
+		m.Effect = model.EffectAllow
+	}
+	if m.Effect != model.EffectAllow && m.Effect != model.EffectDeny {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("[SC007] Mutation %d: effect must be allow or deny", i))
+	}
+	m.Condition = strings.TrimSpace(m.Condition)
+	if m.Condition != "" {
+		if err := l.svcCtx.Conditions.Validate(m.Condition); err != nil {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("[SC008] Mutation %d: invalid condition: %v", i, err))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package userservicelogic
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/config"
+	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/usage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newSimulateTestContext 角色10授予order:write，角色11授予order:read，已禁用的角色12授予report:view，角色13已删除
+// alice拥有角色10、11和12，bob拥有角色11
+func newSimulateTestContext() *svc.ServiceContext {
+	roles := &modeltest.Roles{Rows: []*model.Roles{
+		{Id: 10, Code: "editor"},
+		{Id: 11, Code: "viewer"},
+		{Id: 12, Code: "legacy", DisabledAt: modeltest.NullTime(time.Now())},
+		{Id: 13, Code: "removed", DeletedAt: modeltest.NullTime(time.Now())},
+	}}
+	return &svc.ServiceContext{
+		UsersModel: &modeltest.Users{Rows: []*model.Users{{Id: 1, Username: "alice"}, {Id: 2, Username: "bob"}}},
+		RolesModel: roles,
+		PermissionsModel: &modeltest.Permissions{Rows: []*model.Permissions{
+			{Id: 1, Code: "order:read"},
+			{Id: 2, Code: "order:write"},
+			{Id: 3, Code: "report:view"},
+		}},
+		RolePermissionsModel: &modeltest.RolePermissions{Roles: roles, Rows: []*model.RolePermissions{
+			{RoleId: 10, PermissionId: 2},
+			{RoleId: 11, PermissionId: 1},
+			{RoleId: 12, PermissionId: 3},
+		}},
+		UserRolesModel: &modeltest.UserRoles{Rows: []*model.UserRoles{
+			{UserId: 1, RoleId: 10}, {UserId: 1, RoleId: 11}, {UserId: 1, RoleId: 12}, {UserId: 2, RoleId: 11},
+		}},
+		GroupRolesModel: &modeltest.GroupRoles{Members: &modeltest.GroupMembers{}},
+	}
+}
+
+// diffCodes 按用户名汇总模拟结果中获得和失去的权限编码
+func diffCodes(resp *iam.SimulateChangeResponse) map[string][]string {
+	res := make(map[string][]string)
+	for _, u := range resp.Users {
+		for _, p := range u.Gained {
+			res[u.Username] = append(res[u.Username], "+"+p.Code)
+		}
+		for _, p := range u.Lost {
+			res[u.Username] = append(res[u.Username], "-"+p.Code)
+		}
+	}
+	return res
+}
+
+func TestSimulateChange(t *testing.T) {
+	tests := []struct {
+		name      string
+		mutations []*iam.PolicyMutation
+		want      map[string][]string
+	}{
+		{"revoke grant", []*iam.PolicyMutation{
+			{Type: iam.MutationType_MUTATION_TYPE_REVOKE_ROLE_PERMISSION, RoleId: 11, PermissionId: 1},
+		}, map[string][]string{"alice": {"-order:read"}, "bob": {"-order:read"}}},
+		{"disable role", []*iam.PolicyMutation{
+			{Type: iam.MutationType_MUTATION_TYPE_DISABLE_ROLE, RoleId: 10},
+		}, map[string][]string{"alice": {"-order:write"}}},
+		{"assign role", []*iam.PolicyMutation{
+			{Type: iam.MutationType_MUTATION_TYPE_ASSIGN_USER_ROLE, UserId: 2, RoleId: 10},
+		}, map[string][]string{"bob": {"+order:write"}}},
+		// 已禁用的角色当前不生效，对其的变更不改变任何人的有效权限
+		{"disable disabled role", []*iam.PolicyMutation{
+			{Type: iam.MutationType_MUTATION_TYPE_DISABLE_ROLE, RoleId: 12},
+		}, map[string][]string{}},
+		{"grant to disabled role", []*iam.PolicyMutation{
+			{Type: iam.MutationType_MUTATION_TYPE_GRANT_ROLE_PERMISSION, RoleId: 12, PermissionId: 3},
+		}, map[string][]string{}},
+		{"assign disabled role", []*iam.PolicyMutation{
+			{Type: iam.MutationType_MUTATION_TYPE_ASSIGN_USER_ROLE, UserId: 2, RoleId: 12},
+		}, map[string][]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := NewSimulateChangeLogic(context.Background(), newSimulateTestContext()).SimulateChange(&iam.SimulateChangeRequest{Mutations: tt.mutations})
+			if err != nil {
+				t.Fatalf("SimulateChange: %v", err)
+			}
+			got := diffCodes(resp)
+			if len(got) != len(tt.want) {
+				t.Fatalf("diff = %v, want %v", got, tt.want)
+			}
+			for username, codes := range tt.want {
+				if !slices.Equal(got[username], codes) {
+					t.Fatalf("diff = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSimulateChangeRejects(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutation *iam.PolicyMutation
+		code     codes.Code
+	}{
+		{"deleted role", &iam.PolicyMutation{Type: iam.MutationType_MUTATION_TYPE_DISABLE_ROLE, RoleId: 13}, codes.NotFound},
+		{"missing role", &iam.PolicyMutation{Type: iam.MutationType_MUTATION_TYPE_DISABLE_ROLE, RoleId: 99}, codes.NotFound},
+		{"missing user", &iam.PolicyMutation{Type: iam.MutationType_MUTATION_TYPE_ASSIGN_USER_ROLE, UserId: 9, RoleId: 10}, codes.NotFound},
+		{"missing permission", &iam.PolicyMutation{Type: iam.MutationType_MUTATION_TYPE_GRANT_ROLE_PERMISSION, RoleId: 10, PermissionId: 9}, codes.NotFound},
+		{"missing type", &iam.PolicyMutation{RoleId: 10}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSimulateChangeLogic(context.Background(), newSimulateTestContext()).SimulateChange(&iam.SimulateChangeRequest{Mutations: []*iam.PolicyMutation{tt.mutation}})
+			if status.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+		})
+	}
+}
+
+// TestCheckUserPermissionDisabledRole 已禁用角色的授权在权限检查中不生效
+func TestCheckUserPermissionDisabledRole(t *testing.T) {
+	svcCtx := newSimulateTestContext()
+	svcCtx.Usage = usage.NewRecorder(config.UsageConf{}, nil, nil)
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{"order:write", true},
+		{"report:view", false},
+	}
+	for _, tt := range tests {
+		resp, err := NewCheckUserPermissionLogic(context.Background(), svcCtx).CheckUserPermission(&iam.CheckUserPermissionRequest{UserId: 1, PermissionCode: tt.code})
+		if err != nil {
+			t.Fatalf("CheckUserPermission(%s): %v", tt.code, err)
+		}
+		if resp.HasPermission != tt.want {
+			t.Fatalf("CheckUserPermission(%s) = %v, want %v", tt.code, resp.HasPermission, tt.want)
+		}
+	}
+}
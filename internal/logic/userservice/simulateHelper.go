@@ -0,0 +1,138 @@
+package userservicelogic
+
+import (
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"slices"
+)
+
+// maxMutationsPerSimulation 单次模拟最多包含的变更数量
+const maxMutationsPerSimulation = 100
+
+// roleGrantKey 角色授权的唯一键，与 role_permissions 表的唯一约束一致
+type roleGrantKey struct {
+	roleId       int64
+	permissionId int64
+}
+
+// policyOverlay 在内存中按顺序叠加模拟的策略变更，不修改任何数据
+type policyOverlay struct {
+	assigned map[int64]map[int64]struct{}            // 用户ID -> 模拟分配的角色ID
+	removed  map[int64]map[int64]struct{}            // 用户ID -> 模拟移除的直接分配角色ID
+	grants   map[roleGrantKey]*model.RolePermissions // 模拟授权覆盖的角色授权，nil表示已撤销
+	order    []roleGrantKey                          // 授权覆盖的首次出现顺序，保证结果稳定
+	disabled map[int64]struct{}                      // 已禁用和模拟禁用的角色ID
+}
+
+// newPolicyOverlay 按顺序叠加变更，同一对象上后出现的变更覆盖先出现的变更，disabledRoleIds为已禁用的角色
+func newPolicyOverlay(mutations []*iam.PolicyMutation, disabledRoleIds []int64) *policyOverlay {
+	o := &policyOverlay{
+		assigned: make(map[int64]map[int64]struct{}),
+		removed:  make(map[int64]map[int64]struct{}),
+		grants:   make(map[roleGrantKey]*model.RolePermissions),
+		disabled: make(map[int64]struct{}),
+	}
+	for _, roleId := range disabledRoleIds {
+		o.disabled[roleId] = struct{}{}
+	}
+	for _, m := range mutations {
+		switch m.Type {
+		case iam.MutationType_MUTATION_TYPE_ASSIGN_USER_ROLE:
+			addId(o.assigned, m.UserId, m.RoleId)
+			delete(o.removed[m.UserId], m.RoleId)
+		case iam.MutationType_MUTATION_TYPE_REMOVE_USER_ROLE:
+			addId(o.removed, m.UserId, m.RoleId)
+			delete(o.assigned[m.UserId], m.RoleId)
+		case iam.MutationType_MUTATION_TYPE_GRANT_ROLE_PERMISSION:
+			o.setGrant(roleGrantKey{m.RoleId, m.PermissionId}, &model.RolePermissions{
+				RoleId:       m.RoleId,
+				PermissionId: m.PermissionId,
+				Effect:       m.Effect,
+				Condition:    m.Condition,
+			})
+		case iam.MutationType_MUTATION_TYPE_REVOKE_ROLE_PERMISSION:
+			o.setGrant(roleGrantKey{m.RoleId, m.PermissionId}, nil)
+		case iam.MutationType_MUTATION_TYPE_DISABLE_ROLE:
+			o.disabled[m.RoleId] = struct{}{}
+		}
+	}
+	return o
+}
+
+// setGrant 记录角色授权的覆盖
+func (o *policyOverlay) setGrant(key roleGrantKey, rp *model.RolePermissions) {
+	if _, ok := o.grants[key]; !ok {
+		o.order = append(o.order, key)
+	}
+	o.grants[key] = rp
+}
+
+// roleIds 计算变更后用户生效的角色，direct为当前直接分配的角色，inherited为通过用户组继承的角色
+func (o *policyOverlay) roleIds(userId int64, direct, inherited []int64) []int64 {
+	ids := make([]int64, 0, len(direct)+len(inherited)+len(o.assigned[userId]))
+	for _, roleId := range direct {
+		if _, ok := o.removed[userId][roleId]; !ok {
+			ids = append(ids, roleId)
+		}
+	}
+	for roleId := range o.assigned[userId] {
+		ids = append(ids, roleId)
+	}
+	ids = append(ids, inherited...)
+	ids = slices.DeleteFunc(ids, func(roleId int64) bool {
+		_, ok := o.disabled[roleId]
+		return ok
+	})
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+// rolePermissions 计算变更后角色集合的授权，current为这些角色当前生效的授权
+func (o *policyOverlay) rolePermissions(current []*model.RolePermissions, roleIds []int64) []*model.RolePermissions {
+	result := make([]*model.RolePermissions, 0, len(current))
+	for _, rp := range current {
+		if !slices.Contains(roleIds, rp.RoleId) {
+			continue
+		}
+		if _, ok := o.grants[roleGrantKey{rp.RoleId, rp.PermissionId}]; ok {
+			continue
+		}
+		result = append(result, rp)
+	}
+	for _, key := range o.order {
+		if rp := o.grants[key]; rp != nil && slices.Contains(roleIds, key.roleId) {
+			result = append(result, rp)
+		}
+	}
+	return result
+}
+
+// grantOverrides 返回模拟新增或覆盖的授权
+func (o *policyOverlay) grantOverrides() []*model.RolePermissions {
+	result := make([]*model.RolePermissions, 0, len(o.order))
+	for _, key := range o.order {
+		if rp := o.grants[key]; rp != nil {
+			result = append(result, rp)
+		}
+	}
+	return result
+}
+
+// filterRolePermissions 筛选属于指定角色集合的授权
+func filterRolePermissions(rolePermissions []*model.RolePermissions, roleIds []int64) []*model.RolePermissions {
+	result := make([]*model.RolePermissions, 0, len(rolePermissions))
+	for _, rp := range rolePermissions {
+		if slices.Contains(roleIds, rp.RoleId) {
+			result = append(result, rp)
+		}
+	}
+	return result
+}
+
+// addId 向分组的ID集合中添加ID
+func addId(sets map[int64]map[int64]struct{}, key, id int64) {
+	if sets[key] == nil {
+		sets[key] = make(map[int64]struct{})
+	}
+	sets[key][id] = struct{}{}
+}
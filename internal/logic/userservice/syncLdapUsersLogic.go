@@ -0,0 +1,67 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SyncLdapUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSyncLdapUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SyncLdapUsersLogic {
+	return &SyncLdapUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SyncLdapUsers 从LDAP目录同步用户及组角色映射
+func (l *SyncLdapUsersLogic) SyncLdapUsers(in *iam.SyncLdapUsersRequest) (*iam.SyncLdapUsersResponse, error) {
+	if l.svcCtx.LdapSyncer == nil {
+		return nil, status.Error(codes.FailedPrecondition, "[SLU001] LDAP is not enabled")
+	}
+	// 目录只同步到配置的租户，其他租户不能触发
+	if model.TenantIdFromContext(l.ctx) != l.svcCtx.Config.Ldap.TenantId {
+		return nil, status.Error(codes.PermissionDenied, "[SLU003] LDAP directory does not belong to this tenant")
+	}
+
+	report, err := l.svcCtx.LdapSyncer.Sync(l.ctx, in.DryRun)
+	if err != nil {
+		eInfo := "[SLU002] LDAP同步失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	l.Logger.Infof("[LDAP] 手动同步完成: %s", report)
+
+	items := make([]*iam.LdapSyncItem, 0, len(report.Items))
+	for _, item := range report.Items {
+		items = append(items, &iam.LdapSyncItem{
+			Action:     item.Action,
+			Username:   item.Username,
+			ExternalId: item.ExternalId,
+			Detail:     item.Detail,
+		})
+	}
+
+	return &iam.SyncLdapUsersResponse{
+		DryRun:      report.DryRun,
+		Created:     int32(report.Created),
+		Updated:     int32(report.Updated),
+		Disabled:    int32(report.Disabled),
+		Enabled:     int32(report.Enabled),
+		RoleChanged: int32(report.RoleChanged),
+		Skipped:     int32(report.Skipped),
+		Items:       items,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package userservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TouchSessionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewTouchSessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TouchSessionLogic {
+	return &TouchSessionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// TouchSession 刷新会话最后活跃时间，并返回会话是否仍有效
+func (l *TouchSessionLogic) TouchSession(in *iam.TouchSessionRequest) (*iam.TouchSessionResponse, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "[TS001] Session ID is required")
+	}
+
+	active, err := l.svcCtx.SessionsModel.Touch(l.ctx, in.Id)
+	if err != nil {
+		eInfo := "[TS002] 刷新会话失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return &iam.TouchSessionResponse{
+		Active: active,
+	}, nil
+}
@@ -62,6 +62,7 @@ func (l *UpdateUserLogic) UpdateUser(in *iam.UpdateUserRequest) (*iam.User, erro
 	}
 
 	// 检查邮箱是否已被其他用户使用
+	emailChanged, phoneChanged := false, false
 	if strings.TrimSpace(in.Email) != "" && in.Email != existingUser.Email.String {
 		exists, err := l.svcCtx.UsersModel.ExistsByEmail(l.ctx, in.Email, in.Id)
 		if err != nil {
@@ -72,10 +73,8 @@ func (l *UpdateUserLogic) UpdateUser(in *iam.UpdateUserRequest) (*iam.User, erro
 		if exists {
 			return nil, status.Error(codes.AlreadyExists, "[UU007] Email already exists")
 		}
-		existingUser.Email = sql.NullString{
-			String: in.Email,
-			Valid:  in.Email != "",
-		}
+		// 邮箱变更后需要重新验证
+		emailChanged = existingUser.SetEmail(in.Email)
 	}
 
 	// 更新可选字段
@@ -83,7 +82,8 @@ func (l *UpdateUserLogic) UpdateUser(in *iam.UpdateUserRequest) (*iam.User, erro
 		existingUser.Nickname = sql.NullString{String: in.Nickname, Valid: true}
 	}
 	if strings.TrimSpace(in.Phone) != "" {
-		existingUser.Phone = sql.NullString{String: in.Phone, Valid: true}
+		// 手机号变更后需要重新验证
+		phoneChanged = existingUser.SetPhone(in.Phone)
 	}
 
 	// 更新数据库
@@ -94,6 +94,21 @@ func (l *UpdateUserLogic) UpdateUser(in *iam.UpdateUserRequest) (*iam.User, erro
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
+	// 向新的邮箱或手机号发送验证码，发送失败不影响更新结果
+	if emailChanged {
+		l.sendVerification(existingUser, model.VerificationChannelEmail)
+	}
+	if phoneChanged {
+		l.sendVerification(existingUser, model.VerificationChannelPhone)
+	}
+
 	// 返回更新后的用户信息
 	return logic.ModelToProtoUser(existingUser), nil
 }
+
+// sendVerification 发送重新验证的验证码
+func (l *UpdateUserLogic) sendVerification(user *model.Users, channel string) {
+	if _, err := l.svcCtx.Verification.Send(l.ctx, user, channel); err != nil {
+		l.Logger.Errorf("[UU009] 发送%s验证码失败: %v", channel, err)
+	}
+}
@@ -3,7 +3,6 @@ package userservicelogic
 import (
 	"context"
 	"errors"
-	"github.com/ziptako/iam/internal/utils"
 
 	"github.com/ziptako/iam/db/model"
 	"github.com/ziptako/iam/iam"
@@ -28,7 +27,7 @@ func NewVerifyPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Ve
 	}
 }
 
-// VerifyPassword 验证用户密码
+// VerifyPassword 验证用户密码，密码错误计入登录失败次数，达到上限后与Authenticate一样锁定账号
 func (l *VerifyPasswordLogic) VerifyPassword(in *iam.VerifyPasswordRequest) (*iam.VerifyPasswordResponse, error) {
 	// 参数验证
 	if in.UserId <= 0 {
@@ -49,10 +48,46 @@ func (l *VerifyPasswordLogic) VerifyPassword(in *iam.VerifyPasswordRequest) (*ia
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
-	// 验证密码
-	isValid := utils.VerifyPasswordWithSalt(in.Password, user.Salt, user.PasswordHash)
+	// LDAP来源的用户通过目录绑定认证
+	if user.Source == model.UserSourceLdap && l.svcCtx.LdapConnector == nil {
+		return nil, status.Error(codes.FailedPrecondition, "[VP005] LDAP未启用，无法验证目录用户")
+	}
+	valid, err := NewAuthenticateLogic(l.ctx, l.svcCtx).verifyPassword(user, in.Password)
+	if err != nil {
+		eInfo := "[VP006] LDAP认证失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Unavailable, eInfo)
+	}
+
+	// 与Authenticate共用锁定状态，锁定期间不再校验密码结果，也不累加失败次数
+	if isLocked(user) {
+		return &iam.VerifyPasswordResponse{Valid: false, LockedUntil: user.LockedUntil.Time.Unix()}, nil
+	}
+
+	if !valid {
+		user, err = l.svcCtx.UsersModel.RecordLoginFailure(l.ctx, user.Id, l.svcCtx.Config.Auth.MaxFailedAttempts, l.svcCtx.Config.Auth.LockDuration)
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
+			eInfo := "[VP007] 记录密码验证失败次数失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		resp := &iam.VerifyPasswordResponse{Valid: false}
+		// 本次失败触发了锁定
+		if user != nil && isLocked(user) {
+			resp.LockedUntil = user.LockedUntil.Time.Unix()
+		}
+		return resp, nil
+	}
+
+	// 密码正确，清零失败次数
+	if user.FailedLoginAttempts > 0 || user.LockedUntil.Valid {
+		err = l.svcCtx.UsersModel.ResetLoginFailures(l.ctx, user.Id)
+		if err != nil {
+			eInfo := "[VP008] 重置密码验证失败次数失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
 
-	return &iam.VerifyPasswordResponse{
-		Valid: isValid,
-	}, nil
+	return &iam.VerifyPasswordResponse{Valid: true}, nil
 }
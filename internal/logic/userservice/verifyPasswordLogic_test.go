@@ -0,0 +1,80 @@
+package userservicelogic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/config"
+	"github.com/ziptako/iam/internal/svc"
+	"github.com/ziptako/iam/internal/utils"
+)
+
+func newVerifyPasswordLogic(users *modeltest.Users) *VerifyPasswordLogic {
+	svcCtx := &svc.ServiceContext{
+		Config:     config.Config{Auth: config.AuthConf{MaxFailedAttempts: 3, LockDuration: 900}},
+		UsersModel: users,
+	}
+	return NewVerifyPasswordLogic(context.Background(), svcCtx)
+}
+
+func verify(t *testing.T, l *VerifyPasswordLogic, password string) *iam.VerifyPasswordResponse {
+	t.Helper()
+	resp, err := l.VerifyPassword(&iam.VerifyPasswordRequest{UserId: 1, Password: password})
+	if err != nil {
+		t.Fatalf("VerifyPassword: %v", err)
+	}
+	return resp
+}
+
+func TestVerifyPasswordLockout(t *testing.T) {
+	users := &modeltest.Users{Rows: []*model.Users{{
+		Id: 1, Username: "alice", Source: model.UserSourceLocal,
+		Salt: "salt", PasswordHash: utils.HashPasswordWithSalt("correct", "salt"),
+	}}}
+	alice := users.Row(1)
+	l := newVerifyPasswordLogic(users)
+
+	if resp := verify(t, l, "wrong"); resp.Valid || resp.LockedUntil != 0 || alice.FailedLoginAttempts != 1 {
+		t.Fatalf("first failure: resp %+v, attempts %d", resp, alice.FailedLoginAttempts)
+	}
+	// 成功后清零失败次数
+	if resp := verify(t, l, "correct"); !resp.Valid || alice.FailedLoginAttempts != 0 {
+		t.Fatalf("correct password: resp %+v, attempts %d", resp, alice.FailedLoginAttempts)
+	}
+
+	verify(t, l, "wrong")
+	verify(t, l, "wrong")
+	resp := verify(t, l, "wrong")
+	if resp.Valid || resp.LockedUntil == 0 {
+		t.Fatalf("third failure did not lock the account: %+v", resp)
+	}
+
+	// 锁定期间正确的密码也不通过，且不累加失败次数
+	if resp := verify(t, l, "correct"); resp.Valid || resp.LockedUntil == 0 {
+		t.Fatalf("locked account verified: %+v", resp)
+	}
+	if alice.FailedLoginAttempts != 0 {
+		t.Fatalf("attempts counted while locked: %d", alice.FailedLoginAttempts)
+	}
+
+	// 锁定到期后可以验证通过并解除锁定
+	alice.LockedUntil.Time = time.Now().Add(-time.Second)
+	if resp := verify(t, l, "correct"); !resp.Valid || resp.LockedUntil != 0 || alice.LockedUntil.Valid {
+		t.Fatalf("expired lock: resp %+v, locked until %v", resp, alice.LockedUntil)
+	}
+}
+
+func TestVerifyPasswordLdapDisabled(t *testing.T) {
+	users := &modeltest.Users{Rows: []*model.Users{{Id: 1, Username: "bob", Source: model.UserSourceLdap}}}
+	_, err := newVerifyPasswordLogic(users).VerifyPassword(&iam.VerifyPasswordRequest{UserId: 1, Password: "secret"})
+	if err == nil {
+		t.Fatalf("LDAP user verified without a connector")
+	}
+	if users.Row(1).FailedLoginAttempts != 0 {
+		t.Fatalf("unavailable LDAP counted as a failed attempt")
+	}
+}
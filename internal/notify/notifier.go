@@ -0,0 +1,106 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ziptako/iam/internal/config"
+)
+
+// 通知发送方式
+const (
+	TypeStdout = "stdout"
+	TypeFile   = "file"
+
+	defaultFilePath = "notifications.log"
+)
+
+// Message 待发送的通知
+type Message struct {
+	Channel string `json:"channel"` // 发送渠道：email、phone
+	To      string `json:"to"`      // 接收地址
+	Subject string `json:"subject"` // 标题，短信渠道可忽略
+	Body    string `json:"body"`    // 正文
+}
+
+// Notifier 通知发送接口，接入邮件或短信服务时实现该接口
+type Notifier interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewNotifier 按配置创建通知发送器
+func NewNotifier(c config.NotifierConf) (Notifier, error) {
+	switch c.Type {
+	case "", TypeStdout:
+		return NewWriterNotifier(os.Stdout), nil
+	case TypeFile:
+		path := c.FilePath
+		if path == "" {
+			path = defaultFilePath
+		}
+		return NewFileNotifier(path), nil
+	}
+	return nil, fmt.Errorf("unknown notifier type %q", c.Type)
+}
+
+// WriterNotifier 将通知以JSON行写入io.Writer，用于本地开发
+type WriterNotifier struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// NewWriterNotifier 创建写入io.Writer的通知发送器
+func NewWriterNotifier(w io.Writer) *WriterNotifier {
+	return &WriterNotifier{w: w}
+}
+
+// Send 写入一行通知记录
+func (n *WriterNotifier) Send(_ context.Context, msg *Message) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return writeLine(n.w, msg)
+}
+
+// FileNotifier 将通知以JSON行追加写入文件，用于本地开发
+type FileNotifier struct {
+	lock sync.Mutex
+	path string
+}
+
+// NewFileNotifier 创建写入文件的通知发送器
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+// Send 追加一行通知记录
+func (n *FileNotifier) Send(_ context.Context, msg *Message) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := writeLine(f, msg); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func writeLine(w io.Writer, msg *Message) error {
+	line, err := json.Marshal(struct {
+		Time string `json:"time"`
+		*Message
+	}{Time: time.Now().Format(time.RFC3339), Message: msg})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,199 @@
+package policy
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// 命令行退出码
+const (
+	exitOk      = 0
+	exitError   = 1
+	exitChanges = 2 // plan指定-exit-code且存在变更
+)
+
+const commandUsage = `usage: iam policy <command> [flags]
+
+commands:
+  export [-o file] [-format yaml|json] [-users]   export the tenant's policy manifest
+  plan   [-prune] [-exit-code] <manifest>         show changes needed to match the manifest
+  apply  [-prune] <manifest>                      apply the manifest in one transaction
+
+common flags:
+  -f       config file (default etc/iam.yaml)
+  -tenant  tenant id (default 1)
+
+use - as manifest to read from stdin
+`
+
+// Command 策略清单命令行，用于在部署流程中让各环境与git中的清单保持一致
+// 命令直接连接配置文件中的数据库，不经过RPC认证和委派管理校验
+type Command struct {
+	Stdout io.Writer
+	Stderr io.Writer
+	Load   func(configFile string) (*Service, error) // 按配置文件创建服务
+}
+
+// Run 执行命令并返回退出码，args为policy之后的参数
+func (c *Command) Run(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(c.Stderr, commandUsage)
+		return exitError
+	}
+
+	name := args[0]
+	fs := flag.NewFlagSet("iam policy "+name, flag.ContinueOnError)
+	fs.SetOutput(c.Stderr)
+	configFile := fs.String("f", "etc/iam.yaml", "the config file")
+	tenantId := fs.Int64("tenant", model.DefaultTenantId, "tenant id")
+	format := fs.String("format", "", "manifest format: yaml or json")
+	output := fs.String("o", "", "export: output file, default stdout")
+	users := fs.Bool("users", false, "export: include direct user roles")
+	prune := fs.Bool("prune", false, "plan/apply: delete objects not declared in the manifest")
+	exitCode := fs.Bool("exit-code", false, "plan: exit with 2 when there are changes")
+
+	switch name {
+	case "export", "plan", "apply":
+	default:
+		fmt.Fprint(c.Stderr, commandUsage)
+		return exitError
+	}
+	if err := fs.Parse(args[1:]); err != nil {
+		return exitError
+	}
+	if name != "export" && fs.NArg() != 1 {
+		fmt.Fprintf(c.Stderr, "iam policy %s: exactly one manifest is required\n", name)
+		return exitError
+	}
+
+	s, err := c.Load(*configFile)
+	if err != nil {
+		fmt.Fprintf(c.Stderr, "load config: %v\n", err)
+		return exitError
+	}
+	ctx := model.WithTenantId(context.Background(), *tenantId)
+
+	if name == "export" {
+		return c.export(ctx, s, *format, *output, *users)
+	}
+
+	path := fs.Arg(0)
+	content, err := readManifest(path)
+	if err != nil {
+		fmt.Fprintf(c.Stderr, "read manifest: %v\n", err)
+		return exitError
+	}
+	if *format == "" && path != "-" {
+		*format = formatFromPath(path)
+	}
+	m, err := Parse(content, *format)
+	if err != nil {
+		fmt.Fprintln(c.Stderr, err)
+		return exitError
+	}
+
+	if name == "plan" {
+		plan, err := s.Plan(ctx, m, *prune)
+		if err != nil {
+			fmt.Fprintln(c.Stderr, err)
+			return exitError
+		}
+		WritePlan(c.Stdout, plan)
+		if *exitCode && len(plan.Changes) > 0 {
+			return exitChanges
+		}
+		return exitOk
+	}
+
+	plan, err := s.Apply(ctx, m, *prune, sql.NullInt64{})
+	if err != nil {
+		fmt.Fprintln(c.Stderr, err)
+		return exitError
+	}
+	WritePlan(c.Stdout, plan)
+	if len(plan.Changes) > 0 {
+		fmt.Fprintln(c.Stdout, "Applied.")
+	}
+	return exitOk
+}
+
+// export 导出清单到文件或标准输出，未指定格式时按输出文件扩展名决定
+func (c *Command) export(ctx context.Context, s *Service, format, output string, includeUsers bool) int {
+	if format == "" && output != "" {
+		format = formatFromPath(output)
+	}
+	m, err := s.Export(ctx, includeUsers)
+	if err != nil {
+		fmt.Fprintf(c.Stderr, "export: %v\n", err)
+		return exitError
+	}
+	content, err := Marshal(m, format)
+	if err != nil {
+		fmt.Fprintf(c.Stderr, "export: %v\n", err)
+		return exitError
+	}
+	if output == "" {
+		_, err = c.Stdout.Write(content)
+	} else {
+		err = os.WriteFile(output, content, 0644)
+	}
+	if err != nil {
+		fmt.Fprintf(c.Stderr, "write manifest: %v\n", err)
+		return exitError
+	}
+	return exitOk
+}
+
+// WritePlan 以文本形式输出差异，+新建，~更新，-删除
+func WritePlan(w io.Writer, plan *Plan) {
+	for _, change := range plan.Changes {
+		sign := "+"
+		switch change.Action {
+		case ActionUpdate:
+			sign = "~"
+		case ActionDelete:
+			sign = "-"
+		}
+		fmt.Fprintf(w, "%s %s %s %s\n", sign, change.Action, change.Kind, change.Key)
+		for _, detail := range change.Details {
+			fmt.Fprintf(w, "    %s\n", detail)
+		}
+	}
+	for _, warning := range plan.Warnings {
+		fmt.Fprintf(w, "warning: %s\n", warning)
+	}
+	if len(plan.Changes) == 0 {
+		fmt.Fprintln(w, "No changes.")
+		return
+	}
+	fmt.Fprintf(w, "Plan: %d to create, %d to update, %d to delete.\n",
+		plan.Count(ActionCreate), plan.Count(ActionUpdate), plan.Count(ActionDelete))
+}
+
+// readManifest 读取清单文件，path为-时读取标准输入
+func readManifest(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%s does not exist", path)
+	}
+	return content, err
+}
+
+// formatFromPath 按文件扩展名识别格式，.json为JSON，其他为YAML
+func formatFromPath(path string) string {
+	if filepath.Ext(path) == ".json" {
+		return FormatJson
+	}
+	return FormatYaml
+}
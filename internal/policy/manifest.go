@@ -0,0 +1,346 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// Version 当前的清单格式版本
+const Version = 1
+
+// 清单格式
+const (
+	FormatYaml = "yaml"
+	FormatJson = "json"
+)
+
+// ErrInvalid 清单内容不合法或无法与数据库现状对齐
+var ErrInvalid = errors.New("invalid policy manifest")
+
+type (
+	// Manifest 策略清单，声明权限、角色和角色授权，可选声明用户的直接角色
+	Manifest struct {
+		Version     int          `yaml:"version" json:"version"`
+		Permissions []Permission `yaml:"permissions,omitempty" json:"permissions,omitempty"`
+		Roles       []Role       `yaml:"roles,omitempty" json:"roles,omitempty"`
+		Users       []User       `yaml:"users,omitempty" json:"users,omitempty"` // 只管理列出的用户，未列出的用户的角色保持不变
+	}
+
+	// Permission 权限声明，以编码标识
+	Permission struct {
+		Code        string `yaml:"code" json:"code"`
+		Name        string `yaml:"name" json:"name"`
+		Type        string `yaml:"type" json:"type"`
+		Resource    string `yaml:"resource" json:"resource"`
+		Action      string `yaml:"action" json:"action"`
+		HttpMethod  string `yaml:"http_method,omitempty" json:"http_method,omitempty"`
+		Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	}
+
+	// Role 角色声明，以编码标识，自定义数据权限范围的部门不由清单管理
+	Role struct {
+		Code        string  `yaml:"code" json:"code"`
+		Name        string  `yaml:"name" json:"name"`
+		Description string  `yaml:"description,omitempty" json:"description,omitempty"`
+		SortOrder   int64   `yaml:"sort_order,omitempty" json:"sort_order,omitempty"`
+		DataScope   string  `yaml:"data_scope,omitempty" json:"data_scope,omitempty"`
+		Permissions []Grant `yaml:"permissions,omitempty" json:"permissions,omitempty"`
+	}
+
+	// Grant 角色授权声明，无条件的允许授权可简写为权限编码字符串
+	Grant struct {
+		Code      string `yaml:"code" json:"code"`
+		Effect    string `yaml:"effect,omitempty" json:"effect,omitempty"`
+		Condition string `yaml:"condition,omitempty" json:"condition,omitempty"`
+	}
+
+	// User 用户的直接角色声明，以用户名标识
+	User struct {
+		Username string   `yaml:"username" json:"username"`
+		Roles    []string `yaml:"roles" json:"roles"`
+	}
+
+	// grantFields 避免Grant自定义编解码时递归
+	grantFields Grant
+)
+
+// simple 是否为可简写的无条件允许授权
+func (g Grant) simple() bool {
+	return g.Effect == model.EffectAllow && g.Condition == ""
+}
+
+// MarshalYAML 无条件的允许授权输出为权限编码字符串
+func (g Grant) MarshalYAML() (interface{}, error) {
+	if g.simple() {
+		return g.Code, nil
+	}
+	return grantFields(g), nil
+}
+
+// UnmarshalYAML 支持权限编码字符串的简写形式
+func (g *Grant) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*g = Grant{Code: value.Value}
+		return nil
+	}
+	var fields grantFields
+	if err := value.Decode(&fields); err != nil {
+		return err
+	}
+	*g = Grant(fields)
+	return nil
+}
+
+// MarshalJSON 无条件的允许授权输出为权限编码字符串
+func (g Grant) MarshalJSON() ([]byte, error) {
+	if g.simple() {
+		return json.Marshal(g.Code)
+	}
+	return json.Marshal(grantFields(g))
+}
+
+// UnmarshalJSON 支持权限编码字符串的简写形式
+func (g *Grant) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '"' {
+		var code string
+		if err := json.Unmarshal(trimmed, &code); err != nil {
+			return err
+		}
+		*g = Grant{Code: code}
+		return nil
+	}
+	var fields grantFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	*g = Grant(fields)
+	return nil
+}
+
+// NormalizeFormat 规范化清单格式，为空时按内容识别，以{开头视为JSON
+func NormalizeFormat(format string, content []byte) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "":
+		if trimmed := bytes.TrimSpace(content); len(trimmed) > 0 && trimmed[0] == '{' {
+			return FormatJson, nil
+		}
+		return FormatYaml, nil
+	case "yaml", "yml":
+		return FormatYaml, nil
+	case "json":
+		return FormatJson, nil
+	}
+	return "", fmt.Errorf("unsupported format %q, expected yaml or json", format)
+}
+
+// Parse 解析清单并做静态校验，未知字段视为错误以便发现拼写问题
+func Parse(content []byte, format string) (*Manifest, error) {
+	format, err := NormalizeFormat(format, content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	m := &Manifest{}
+	if format == FormatJson {
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(m)
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		err = decoder.Decode(m)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	m.normalize()
+	if problems := m.validate(); len(problems) > 0 {
+		return nil, invalid(problems)
+	}
+	return m, nil
+}
+
+// Marshal 按指定格式输出清单
+func Marshal(m *Manifest, format string) ([]byte, error) {
+	format, err := NormalizeFormat(format, nil)
+	if err != nil {
+		return nil, err
+	}
+	if format == FormatJson {
+		content, err := json.MarshalIndent(m, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(content, '\n'), nil
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(m); err != nil {
+		return nil, err
+	}
+	if err = encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// normalize 去除首尾空白并填充默认值
+func (m *Manifest) normalize() {
+	if m.Version == 0 {
+		m.Version = Version
+	}
+	for i := range m.Permissions {
+		p := &m.Permissions[i]
+		p.Code = strings.TrimSpace(p.Code)
+		p.Name = strings.TrimSpace(p.Name)
+		p.Type = strings.TrimSpace(p.Type)
+		p.Resource = strings.TrimSpace(p.Resource)
+		p.Action = strings.TrimSpace(p.Action)
+		p.HttpMethod = strings.ToUpper(strings.TrimSpace(p.HttpMethod))
+		p.Description = strings.TrimSpace(p.Description)
+	}
+	for i := range m.Roles {
+		r := &m.Roles[i]
+		r.Code = strings.TrimSpace(r.Code)
+		r.Name = strings.TrimSpace(r.Name)
+		r.Description = strings.TrimSpace(r.Description)
+		r.DataScope = strings.TrimSpace(r.DataScope)
+		if r.DataScope == "" {
+			r.DataScope = model.DataScopeSelf
+		}
+		for j := range r.Permissions {
+			g := &r.Permissions[j]
+			g.Code = strings.TrimSpace(g.Code)
+			g.Effect = strings.TrimSpace(g.Effect)
+			g.Condition = strings.TrimSpace(g.Condition)
+			if g.Effect == "" {
+				g.Effect = model.EffectAllow
+			}
+		}
+	}
+	for i := range m.Users {
+		u := &m.Users[i]
+		u.Username = strings.TrimSpace(u.Username)
+		for j := range u.Roles {
+			u.Roles[j] = strings.TrimSpace(u.Roles[j])
+		}
+	}
+}
+
+// validate 校验不依赖数据库的规则，返回全部问题
+func (m *Manifest) validate() []string {
+	var problems []string
+	add := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if m.Version != Version {
+		add("unsupported version %d, expected %d", m.Version, Version)
+	}
+
+	permissionCodes := make(map[string]bool, len(m.Permissions))
+	for i, p := range m.Permissions {
+		at := fmt.Sprintf("permissions[%d]", i)
+		if p.Code == "" {
+			add("%s: code is required", at)
+		} else if !utils.ValidPermissionCode(p.Code) {
+			add("%s: invalid code %q", at, p.Code)
+		} else if permissionCodes[p.Code] {
+			add("%s: duplicate code %q", at, p.Code)
+		}
+		permissionCodes[p.Code] = true
+		if p.Name == "" {
+			add("%s: name is required", at)
+		}
+		if p.Resource == "" {
+			add("%s: resource is required", at)
+		}
+		if p.Action == "" {
+			add("%s: action is required", at)
+		}
+		switch p.Type {
+		case "path":
+			switch p.HttpMethod {
+			case "GET", "POST", "PUT", "DELETE":
+			default:
+				add("%s: path permission requires http_method GET, POST, PUT or DELETE", at)
+			}
+		case "button", "menu":
+			if p.HttpMethod != "" {
+				add("%s: %s permission cannot have http_method", at, p.Type)
+			}
+		default:
+			add("%s: type must be path, button or menu", at)
+		}
+	}
+
+	roleCodes := make(map[string]bool, len(m.Roles))
+	roleNames := make(map[string]bool, len(m.Roles))
+	for i, r := range m.Roles {
+		at := fmt.Sprintf("roles[%d]", i)
+		if r.Code == "" {
+			add("%s: code is required", at)
+		} else if roleCodes[r.Code] {
+			add("%s: duplicate code %q", at, r.Code)
+		}
+		roleCodes[r.Code] = true
+		if r.Name == "" {
+			add("%s: name is required", at)
+		} else if roleNames[r.Name] {
+			add("%s: duplicate name %q", at, r.Name)
+		}
+		roleNames[r.Name] = true
+		if !model.IsValidDataScope(r.DataScope) {
+			add("%s: invalid data_scope %q", at, r.DataScope)
+		}
+		granted := make(map[string]bool, len(r.Permissions))
+		for j, g := range r.Permissions {
+			at := fmt.Sprintf("roles[%d].permissions[%d]", i, j)
+			if g.Code == "" {
+				add("%s: code is required", at)
+			} else if granted[g.Code] {
+				add("%s: duplicate grant of %q", at, g.Code)
+			}
+			granted[g.Code] = true
+			if g.Effect != model.EffectAllow && g.Effect != model.EffectDeny {
+				add("%s: effect must be allow or deny", at)
+			}
+		}
+	}
+
+	usernames := make(map[string]bool, len(m.Users))
+	for i, u := range m.Users {
+		at := fmt.Sprintf("users[%d]", i)
+		if u.Username == "" {
+			add("%s: username is required", at)
+		} else if usernames[u.Username] {
+			add("%s: duplicate username %q", at, u.Username)
+		}
+		usernames[u.Username] = true
+		assigned := make(map[string]bool, len(u.Roles))
+		for j, code := range u.Roles {
+			if code == "" {
+				add("%s.roles[%d]: role code is required", at, j)
+			} else if assigned[code] {
+				add("%s.roles[%d]: duplicate role %q", at, j, code)
+			}
+			assigned[code] = true
+		}
+	}
+	return problems
+}
+
+// invalid 将问题列表包装为ErrInvalid
+func invalid(problems []string) error {
+	return fmt.Errorf("%w: %s", ErrInvalid, strings.Join(problems, "; "))
+}
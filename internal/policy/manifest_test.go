@@ -0,0 +1,116 @@
+package policy
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+const testManifestYaml = `
+version: 1
+permissions:
+  - code: order:read
+    name: Read orders
+    type: path
+    resource: order
+    action: read
+    http_method: get
+roles:
+  - code: clerk
+    name: Clerk
+    permissions:
+      - order:read
+      - code: order:delete
+        effect: deny
+      - code: order:*
+        condition: request.ip == "10.0.0.1"
+users:
+  - username: alice
+    roles: [clerk]
+`
+
+func TestParseYaml(t *testing.T) {
+	m, err := Parse([]byte(testManifestYaml), "")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if p := m.Permissions[0]; p.HttpMethod != "GET" {
+		t.Fatalf("http_method not normalized: %q", p.HttpMethod)
+	}
+	r := m.Roles[0]
+	if r.DataScope != model.DataScopeSelf {
+		t.Fatalf("data_scope default = %q, want %q", r.DataScope, model.DataScopeSelf)
+	}
+	want := []Grant{
+		{Code: "order:read", Effect: model.EffectAllow},
+		{Code: "order:delete", Effect: model.EffectDeny},
+		{Code: "order:*", Effect: model.EffectAllow, Condition: `request.ip == "10.0.0.1"`},
+	}
+	for i, g := range want {
+		if r.Permissions[i] != g {
+			t.Fatalf("grant %d = %+v, want %+v", i, r.Permissions[i], g)
+		}
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	m, err := Parse([]byte(testManifestYaml), FormatYaml)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	for _, format := range []string{FormatYaml, FormatJson} {
+		content, err := Marshal(m, format)
+		if err != nil {
+			t.Fatalf("Marshal %s: %v", format, err)
+		}
+		// 无条件的允许授权简写为权限编码
+		if format == FormatYaml && !strings.Contains(string(content), "- order:read\n") {
+			t.Fatalf("simple grant not written in short form:\n%s", content)
+		}
+		parsed, err := Parse(content, "")
+		if err != nil {
+			t.Fatalf("Parse %s output: %v\n%s", format, err, content)
+		}
+		if len(parsed.Roles[0].Permissions) != 3 || parsed.Roles[0].Permissions[2] != m.Roles[0].Permissions[2] {
+			t.Fatalf("%s round trip changed grants: %+v", format, parsed.Roles[0].Permissions)
+		}
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		problem string
+	}{
+		{"unknown field", "version: 1\nroles:\n  - code: a\n    name: A\n    scope: all\n", "scope"},
+		{"version", "version: 2\n", "unsupported version"},
+		{"duplicate permission", `{"permissions":[
+			{"code":"a:read","name":"A","type":"button","resource":"a","action":"read"},
+			{"code":"a:read","name":"A","type":"button","resource":"a","action":"read"}]}`, "duplicate code"},
+		{"path without method", `{"permissions":[{"code":"a:read","name":"A","type":"path","resource":"a","action":"read"}]}`, "requires http_method"},
+		{"button with method", `{"permissions":[{"code":"a:read","name":"A","type":"button","resource":"a","action":"read","http_method":"GET"}]}`, "cannot have http_method"},
+		{"effect", "roles:\n  - code: a\n    name: A\n    permissions:\n      - code: x\n        effect: maybe\n", "effect must be allow or deny"},
+		{"data scope", "roles:\n  - code: a\n    name: A\n    data_scope: galaxy\n", "invalid data_scope"},
+		{"duplicate role name", "roles:\n  - code: a\n    name: A\n  - code: b\n    name: A\n", "duplicate name"},
+		{"duplicate user role", "users:\n  - username: alice\n    roles: [a, a]\n", "duplicate role"},
+		{"format", "{}", "unsupported format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format := ""
+			if tt.name == "format" {
+				format = "toml"
+			}
+			_, err := Parse([]byte(tt.content), format)
+			if !errors.Is(err, ErrInvalid) {
+				t.Fatalf("Parse = %v, want ErrInvalid", err)
+			}
+			if !strings.Contains(err.Error(), tt.problem) {
+				t.Fatalf("Parse = %v, want problem %q", err, tt.problem)
+			}
+		})
+	}
+}
@@ -0,0 +1,491 @@
+package policy
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// 变更动作
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// 变更对象
+const (
+	KindPermission = "permission"
+	KindRole       = "role"
+	KindGrant      = "grant"
+	KindBinding    = "binding"
+)
+
+// 清理时保留的内置对象，IAM自身的权限和管理员角色不随清单清理，避免应用清单后失去管理能力
+const (
+	builtinPermissionPrefix = "iam:"
+	builtinRoleCode         = "iam_admin"
+)
+
+type (
+	// Change 一项变更，Key为对象标识：权限编码、角色编码、角色编码/权限编码或用户名/角色编码
+	Change struct {
+		Action  string
+		Kind    string
+		Key     string
+		Details []string // 字段变化说明
+
+		permission     *model.Permissions // 新建或更新后的权限
+		oldPermission  *model.Permissions // 更新前的权限
+		role           *model.Roles       // 新建或更新后的角色
+		oldRole        *model.Roles       // 更新前的角色
+		grant          *model.RolePermissions
+		binding        *model.UserRoles
+		roleCode       string // 授权和用户角色引用的角色编码，新建角色的ID在执行时解析
+		permissionCode string // 授权引用的权限编码，新建权限的ID在执行时解析
+	}
+
+	// Plan 清单与数据库现状的差异，变更按执行顺序排列：先新建和更新，再由下而上删除
+	Plan struct {
+		Changes  []*Change
+		Warnings []string
+
+		roleIds       map[string]int64 // 角色编码到ID，执行时补充新建的角色
+		permissionIds map[string]int64 // 权限编码到ID，执行时补充新建的权限
+	}
+
+	// state 当前租户的策略现状
+	state struct {
+		tenantId      int64
+		permissions   []*model.Permissions // 当前租户可见的全部权限
+		roles         []*model.Roles       // 当前租户的全部角色，包括已删除的角色
+		grants        []*model.RolePermissions
+		users         map[string]*model.Users      // 清单中声明的用户
+		userRoles     map[int64][]*model.UserRoles // 清单中声明的用户的直接角色
+		rolesInUse    map[int64]bool               // 待清理的角色中仍被用户、用户组或资源绑定使用的角色
+		foreignGrants map[int64]bool               // 待清理的全局权限中被其他租户授予的权限
+	}
+)
+
+// Count 统计指定动作的变更数量
+func (p *Plan) Count(action string) int {
+	count := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// owns 当前租户是否维护该权限：默认租户维护全局权限，其他租户维护本租户的自定义权限
+func (s *state) owns(p *model.Permissions) bool {
+	if p.IsGlobal() {
+		return s.tenantId == model.DefaultTenantId
+	}
+	return p.TenantId.Int64 == s.tenantId
+}
+
+// prunablePermission 清理时是否考虑该权限，服务注册的权限由其命名空间维护，不参与清理
+func (s *state) prunablePermission(p *model.Permissions) bool {
+	return s.owns(p) && !p.Namespace.Valid && !strings.HasPrefix(p.Code, builtinPermissionPrefix)
+}
+
+// prunableRole 清理时是否考虑该角色
+func prunableRole(r *model.Roles) bool {
+	return !r.DeletedAt.Valid && r.Code != builtinRoleCode
+}
+
+// diff 计算清单与现状的差异，prune为true时删除清单未声明的对象
+// 授权和用户角色以(角色, 权限)和(用户, 角色)匹配，清单声明的授权为永久授权，已有授权的有效期会被清除；已有的用户角色保持原有效期
+func diff(m *Manifest, s *state, prune bool) (*Plan, error) {
+	var problems []string
+	problem := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	plan := &Plan{
+		roleIds:       make(map[string]int64, len(s.roles)),
+		permissionIds: make(map[string]int64, len(s.permissions)),
+	}
+	warn := func(format string, args ...any) {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf(format, args...))
+	}
+
+	permissionsByCode := make(map[string]*model.Permissions, len(s.permissions))
+	permissionsById := make(map[int64]*model.Permissions, len(s.permissions))
+	for _, p := range s.permissions {
+		permissionsByCode[p.Code] = p
+		permissionsById[p.Id] = p
+		plan.permissionIds[p.Code] = p.Id
+	}
+	rolesByCode := make(map[string]*model.Roles, len(s.roles))
+	rolesByName := make(map[string]*model.Roles, len(s.roles))
+	for _, r := range s.roles {
+		rolesByCode[r.Code] = r
+		rolesByName[r.Name] = r
+		plan.roleIds[r.Code] = r.Id
+	}
+	grantsByRole := make(map[int64][]*model.RolePermissions)
+	for _, g := range s.grants {
+		grantsByRole[g.RoleId] = append(grantsByRole[g.RoleId], g)
+	}
+
+	var creates, deletes []*Change
+
+	// 权限
+	declaredPermissions := make(map[string]bool, len(m.Permissions))
+	for i, p := range m.Permissions {
+		declaredPermissions[p.Code] = true
+		desired := &model.Permissions{
+			Name:        p.Name,
+			Code:        p.Code,
+			Type:        p.Type,
+			Resource:    p.Resource,
+			Action:      p.Action,
+			HttpMethod:  nullString(p.HttpMethod),
+			Description: nullString(p.Description),
+		}
+		current := permissionsByCode[p.Code]
+		if current == nil {
+			creates = append(creates, &Change{Action: ActionCreate, Kind: KindPermission, Key: p.Code, permission: desired})
+			continue
+		}
+		details := permissionDetails(current, desired)
+		if len(details) == 0 {
+			continue
+		}
+		if current.Namespace.Valid {
+			problem("permissions[%d]: %q is registered by namespace %q and can only be changed by RegisterPermissions", i, p.Code, current.Namespace.String)
+			continue
+		}
+		if !s.owns(current) {
+			problem("permissions[%d]: %q is a global permission and can only be changed from the default tenant", i, p.Code)
+			continue
+		}
+		desired.Id = current.Id
+		desired.TenantId = current.TenantId
+		creates = append(creates, &Change{Action: ActionUpdate, Kind: KindPermission, Key: p.Code, Details: details, permission: desired, oldPermission: current})
+	}
+	permissionKnown := func(code string) bool {
+		return declaredPermissions[code] || permissionsByCode[code] != nil
+	}
+
+	// 角色及其授权
+	declaredRoles := make(map[string]bool, len(m.Roles))
+	for _, r := range m.Roles {
+		declaredRoles[r.Code] = true
+	}
+	var grantChanges []*Change
+	for i, r := range m.Roles {
+		desired := &model.Roles{
+			Name:        r.Name,
+			Code:        r.Code,
+			Description: nullString(r.Description),
+			SortOrder:   r.SortOrder,
+			DataScope:   r.DataScope,
+		}
+		current := rolesByCode[r.Code]
+		if owner := rolesByName[r.Name]; owner != nil && owner.Code != r.Code && !declaredRoles[owner.Code] {
+			problem("roles[%d]: name %q is already used by role %q", i, r.Name, owner.Code)
+		}
+		if current == nil {
+			creates = append(creates, &Change{Action: ActionCreate, Kind: KindRole, Key: r.Code, role: desired})
+			if r.DataScope == model.DataScopeCustom {
+				warn("role %q uses custom data scope, set its departments with SetRoleDataScope", r.Code)
+			}
+		} else {
+			details := roleDetails(current, desired)
+			if len(details) > 0 {
+				desired.Id = current.Id
+				desired.TenantId = current.TenantId
+				creates = append(creates, &Change{Action: ActionUpdate, Kind: KindRole, Key: r.Code, Details: details, role: desired, oldRole: current})
+			}
+			if r.DataScope == model.DataScopeCustom && current.DataScope != model.DataScopeCustom {
+				warn("role %q uses custom data scope, set its departments with SetRoleDataScope", r.Code)
+			}
+		}
+
+		// 授权
+		var existing map[int64]*model.RolePermissions
+		if current != nil {
+			existing = make(map[int64]*model.RolePermissions)
+			for _, g := range grantsByRole[current.Id] {
+				existing[g.PermissionId] = g
+			}
+		}
+		granted := make(map[int64]bool, len(r.Permissions))
+		for j, g := range r.Permissions {
+			if !permissionKnown(g.Code) {
+				problem("roles[%d].permissions[%d]: unknown permission %q", i, j, g.Code)
+				continue
+			}
+			key := r.Code + "/" + g.Code
+			var currentGrant *model.RolePermissions
+			if p := permissionsByCode[g.Code]; p != nil && existing != nil {
+				currentGrant = existing[p.Id]
+				granted[p.Id] = true
+			}
+			if currentGrant == nil {
+				grantChanges = append(grantChanges, &Change{
+					Action: ActionCreate, Kind: KindGrant, Key: key, Details: grantSummary(g),
+					grant:    &model.RolePermissions{Effect: g.Effect, Condition: g.Condition},
+					roleCode: r.Code, permissionCode: g.Code,
+				})
+				continue
+			}
+			desiredGrant := *currentGrant
+			desiredGrant.Effect = g.Effect
+			desiredGrant.Condition = g.Condition
+			desiredGrant.ValidFrom = sql.NullTime{}
+			desiredGrant.ValidUntil = sql.NullTime{}
+			if details := grantDetails(currentGrant, &desiredGrant); len(details) > 0 {
+				grantChanges = append(grantChanges, &Change{
+					Action: ActionUpdate, Kind: KindGrant, Key: key, Details: details,
+					grant: &desiredGrant, roleCode: r.Code, permissionCode: g.Code,
+				})
+			}
+		}
+		if prune && current != nil {
+			for _, g := range grantsByRole[current.Id] {
+				if granted[g.PermissionId] {
+					continue
+				}
+				code := permissionCode(permissionsById, g.PermissionId)
+				deletes = append(deletes, &Change{
+					Action: ActionDelete, Kind: KindGrant, Key: r.Code + "/" + code,
+					grant: g, roleCode: r.Code, permissionCode: code,
+				})
+			}
+		}
+	}
+	creates = append(creates, grantChanges...)
+
+	// 用户的直接角色
+	var bindingDeletes []*Change
+	for i, u := range m.Users {
+		user := s.users[u.Username]
+		if user == nil {
+			problem("users[%d]: unknown user %q", i, u.Username)
+			continue
+		}
+		existing := make(map[int64]*model.UserRoles)
+		for _, ur := range s.userRoles[user.Id] {
+			existing[ur.RoleId] = ur
+		}
+		assigned := make(map[int64]bool, len(u.Roles))
+		for j, code := range u.Roles {
+			role := rolesByCode[code]
+			if !declaredRoles[code] && (role == nil || role.DeletedAt.Valid) {
+				problem("users[%d].roles[%d]: unknown role %q", i, j, code)
+				continue
+			}
+			if role != nil && existing[role.Id] != nil {
+				assigned[role.Id] = true
+				continue
+			}
+			creates = append(creates, &Change{
+				Action: ActionCreate, Kind: KindBinding, Key: u.Username + "/" + code,
+				binding: &model.UserRoles{UserId: user.Id}, roleCode: code,
+			})
+		}
+		if !prune {
+			continue
+		}
+		for _, ur := range s.userRoles[user.Id] {
+			if assigned[ur.RoleId] {
+				continue
+			}
+			code := roleCode(s.roles, ur.RoleId)
+			bindingDeletes = append(bindingDeletes, &Change{
+				Action: ActionDelete, Kind: KindBinding, Key: u.Username + "/" + code,
+				binding: ur, roleCode: code,
+			})
+		}
+	}
+	deletes = append(bindingDeletes, deletes...)
+
+	if len(problems) > 0 {
+		return nil, invalid(problems)
+	}
+
+	if prune {
+		// 清理未声明的角色，删除角色时一并删除其授权
+		deletedGrants := make(map[int64]bool)
+		for _, change := range deletes {
+			if change.Kind == KindGrant {
+				deletedGrants[change.grant.Id] = true
+			}
+		}
+		for _, r := range s.roles {
+			if declaredRoles[r.Code] || !prunableRole(r) {
+				continue
+			}
+			if s.rolesInUse[r.Id] {
+				warn("role %q is not declared but still used by users, groups or resource bindings, skipped", r.Code)
+				continue
+			}
+			change := &Change{Action: ActionDelete, Kind: KindRole, Key: r.Code, role: r}
+			if grants := grantsByRole[r.Id]; len(grants) > 0 {
+				for _, g := range grants {
+					deletedGrants[g.Id] = true
+				}
+				change.Details = []string{fmt.Sprintf("%d grants removed", len(grants))}
+			}
+			deletes = append(deletes, change)
+		}
+
+		// 清理未声明的权限，仍被保留的授权或其他租户引用的权限跳过
+		stillGranted := make(map[int64]bool)
+		for _, g := range s.grants {
+			if !deletedGrants[g.Id] {
+				stillGranted[g.PermissionId] = true
+			}
+		}
+		for _, p := range s.permissions {
+			if declaredPermissions[p.Code] || !s.prunablePermission(p) {
+				continue
+			}
+			if stillGranted[p.Id] {
+				warn("permission %q is not declared but still granted to undeclared roles, skipped", p.Code)
+				continue
+			}
+			if s.foreignGrants[p.Id] {
+				warn("permission %q is not declared but still granted in other tenants, skipped", p.Code)
+				continue
+			}
+			deletes = append(deletes, &Change{Action: ActionDelete, Kind: KindPermission, Key: p.Code, permission: p})
+		}
+	}
+
+	plan.Changes = append(creates, deletes...)
+	return plan, nil
+}
+
+// apply 在事务中按顺序执行变更，createdBy记录为新建授权和用户角色的创建人
+func (p *Plan) apply(ctx context.Context, tx *model.PolicyTx, createdBy sql.NullInt64) error {
+	for _, change := range p.Changes {
+		if err := p.applyChange(ctx, tx, change, createdBy); err != nil {
+			return fmt.Errorf("%s %s %q: %w", change.Action, change.Kind, change.Key, err)
+		}
+	}
+	return nil
+}
+
+func (p *Plan) applyChange(ctx context.Context, tx *model.PolicyTx, change *Change, createdBy sql.NullInt64) error {
+	switch change.Kind + ":" + change.Action {
+	case KindPermission + ":" + ActionCreate:
+		if err := tx.InsertPermission(ctx, change.permission); err != nil {
+			return err
+		}
+		p.permissionIds[change.Key] = change.permission.Id
+		return nil
+	case KindPermission + ":" + ActionUpdate:
+		return tx.UpdatePermission(ctx, change.oldPermission, change.permission)
+	case KindPermission + ":" + ActionDelete:
+		return tx.DeletePermission(ctx, change.permission)
+	case KindRole + ":" + ActionCreate:
+		if err := tx.InsertRole(ctx, change.role); err != nil {
+			return err
+		}
+		p.roleIds[change.Key] = change.role.Id
+		return nil
+	case KindRole + ":" + ActionUpdate:
+		return tx.UpdateRole(ctx, change.oldRole, change.role)
+	case KindRole + ":" + ActionDelete:
+		return tx.SoftDeleteRole(ctx, change.role)
+	case KindGrant + ":" + ActionCreate:
+		change.grant.RoleId = p.roleIds[change.roleCode]
+		change.grant.PermissionId = p.permissionIds[change.permissionCode]
+		change.grant.CreatedBy = createdBy
+		return tx.InsertGrant(ctx, change.grant)
+	case KindGrant + ":" + ActionUpdate:
+		return tx.UpdateGrant(ctx, change.grant)
+	case KindGrant + ":" + ActionDelete:
+		return tx.DeleteGrant(ctx, change.grant)
+	case KindBinding + ":" + ActionCreate:
+		change.binding.RoleId = p.roleIds[change.roleCode]
+		change.binding.CreatedBy = createdBy
+		return tx.InsertUserRole(ctx, change.binding)
+	case KindBinding + ":" + ActionDelete:
+		return tx.DeleteUserRole(ctx, change.binding)
+	}
+	return fmt.Errorf("unsupported change")
+}
+
+// permissionDetails 权限的字段变化
+func permissionDetails(current, desired *model.Permissions) []string {
+	var details []string
+	details = appendDetail(details, "name", current.Name, desired.Name)
+	details = appendDetail(details, "type", current.Type, desired.Type)
+	details = appendDetail(details, "resource", current.Resource, desired.Resource)
+	details = appendDetail(details, "action", current.Action, desired.Action)
+	details = appendDetail(details, "http_method", current.HttpMethod.String, desired.HttpMethod.String)
+	details = appendDetail(details, "description", current.Description.String, desired.Description.String)
+	return details
+}
+
+// roleDetails 角色的字段变化，已删除的角色会被恢复
+func roleDetails(current, desired *model.Roles) []string {
+	var details []string
+	if current.DeletedAt.Valid {
+		details = append(details, "restore deleted role")
+	}
+	details = appendDetail(details, "name", current.Name, desired.Name)
+	details = appendDetail(details, "description", current.Description.String, desired.Description.String)
+	details = appendDetail(details, "sort_order", fmt.Sprint(current.SortOrder), fmt.Sprint(desired.SortOrder))
+	details = appendDetail(details, "data_scope", current.DataScope, desired.DataScope)
+	return details
+}
+
+// grantDetails 授权的字段变化
+func grantDetails(current, desired *model.RolePermissions) []string {
+	var details []string
+	details = appendDetail(details, "effect", current.Effect, desired.Effect)
+	details = appendDetail(details, "condition", current.Condition, desired.Condition)
+	if current.ValidFrom.Valid || current.ValidUntil.Valid {
+		details = append(details, "validity cleared")
+	}
+	return details
+}
+
+// grantSummary 新建授权的说明
+func grantSummary(g Grant) []string {
+	details := []string{"effect: " + g.Effect}
+	if g.Condition != "" {
+		details = append(details, "condition: "+g.Condition)
+	}
+	return details
+}
+
+func appendDetail(details []string, field, current, desired string) []string {
+	if current == desired {
+		return details
+	}
+	return append(details, fmt.Sprintf("%s: %q -> %q", field, current, desired))
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// permissionCode 按ID查找权限编码，找不到时返回ID
+func permissionCode(permissions map[int64]*model.Permissions, id int64) string {
+	if p := permissions[id]; p != nil {
+		return p.Code
+	}
+	return fmt.Sprintf("#%d", id)
+}
+
+// roleCode 按ID查找角色编码，找不到时返回ID
+func roleCode(roles []*model.Roles, id int64) string {
+	for _, r := range roles {
+		if r.Id == id {
+			return r.Code
+		}
+	}
+	return fmt.Sprintf("#%d", id)
+}
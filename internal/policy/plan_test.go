@@ -0,0 +1,190 @@
+package policy
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// testState 默认租户的现状：clerk拥有order:read，legacy未声明且无人使用，iam_admin为内置角色
+func testState() *state {
+	return &state{
+		tenantId: model.DefaultTenantId,
+		permissions: []*model.Permissions{
+			{Id: 1, Code: "order:read", Name: "Read orders", Type: "button", Resource: "order", Action: "read"},
+			{Id: 2, Code: "order:export", Name: "Export orders", Type: "button", Resource: "order", Action: "export"},
+			{Id: 3, Code: "iam:user:read", Name: "Read users", Type: "button", Resource: "user", Action: "read"},
+			{Id: 4, Code: "billing:invoice:read", Name: "Read invoices", Type: "button", Resource: "invoice", Action: "read",
+				Namespace: sql.NullString{String: "billing", Valid: true}},
+		},
+		roles: []*model.Roles{
+			{Id: 10, Code: "clerk", Name: "Clerk", DataScope: model.DataScopeSelf},
+			{Id: 11, Code: "legacy", Name: "Legacy", DataScope: model.DataScopeSelf},
+			{Id: 12, Code: builtinRoleCode, Name: "IAM admin", DataScope: model.DataScopeAll},
+		},
+		grants: []*model.RolePermissions{
+			{Id: 100, RoleId: 10, PermissionId: 1, Effect: model.EffectAllow},
+			{Id: 101, RoleId: 11, PermissionId: 2, Effect: model.EffectAllow},
+			{Id: 102, RoleId: 12, PermissionId: 3, Effect: model.EffectAllow},
+		},
+		users:         map[string]*model.Users{"alice": {Id: 1, Username: "alice"}},
+		userRoles:     map[int64][]*model.UserRoles{1: {{Id: 200, UserId: 1, RoleId: 11}}},
+		rolesInUse:    map[int64]bool{},
+		foreignGrants: map[int64]bool{},
+	}
+}
+
+func testManifest() *Manifest {
+	m := &Manifest{
+		Permissions: []Permission{
+			{Code: "order:read", Name: "Read orders", Type: "button", Resource: "order", Action: "read"},
+		},
+		Roles: []Role{
+			{Code: "clerk", Name: "Clerk", Permissions: []Grant{{Code: "order:read"}}},
+		},
+	}
+	m.normalize()
+	return m
+}
+
+// changeKeys 变更的 动作 类型 键 列表，按执行顺序
+func changeKeys(plan *Plan) []string {
+	keys := make([]string, 0, len(plan.Changes))
+	for _, c := range plan.Changes {
+		keys = append(keys, c.Action+" "+c.Kind+" "+c.Key)
+	}
+	return keys
+}
+
+func TestDiffNoChanges(t *testing.T) {
+	plan, err := diff(testManifest(), testState(), false)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	if len(plan.Changes) != 0 {
+		t.Fatalf("unexpected changes %v", changeKeys(plan))
+	}
+}
+
+func TestDiffCreateAndUpdate(t *testing.T) {
+	m := testManifest()
+	m.Permissions[0].Name = "View orders"
+	m.Permissions = append(m.Permissions, Permission{Code: "order:create", Name: "Create orders", Type: "button", Resource: "order", Action: "create"})
+	m.Roles[0].Permissions = append(m.Roles[0].Permissions, Grant{Code: "order:create"})
+	m.Roles = append(m.Roles, Role{Code: "auditor", Name: "Auditor", Permissions: []Grant{{Code: "order:*"}}})
+	m.Permissions = append(m.Permissions, Permission{Code: "order:*", Name: "All orders", Type: "button", Resource: "order", Action: "*"})
+	m.Users = []User{{Username: "alice", Roles: []string{"legacy", "auditor"}}}
+	m.normalize()
+
+	st := testState()
+	st.grants[0].ValidUntil = sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true}
+	plan, err := diff(m, st, false)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	want := []string{
+		"update permission order:read",
+		"create permission order:create",
+		"create permission order:*",
+		"create role auditor",
+		"update grant clerk/order:read",
+		"create grant clerk/order:create",
+		"create grant auditor/order:*",
+		"create binding alice/auditor",
+	}
+	if got := changeKeys(plan); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("changes =\n%v\nwant\n%v", got, want)
+	}
+	// 清单声明的授权为永久授权
+	if details := plan.Changes[4].Details; len(details) != 1 || details[0] != "validity cleared" {
+		t.Fatalf("grant update details = %v", details)
+	}
+}
+
+func TestDiffPrune(t *testing.T) {
+	m := testManifest()
+	m.Users = []User{{Username: "alice"}}
+	plan, err := diff(m, testState(), true)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	// 先删除用户角色，再删除角色，最后删除不再被授予的权限；内置角色、IAM权限和服务注册的权限保留
+	want := []string{
+		"delete binding alice/legacy",
+		"delete role legacy",
+		"delete permission order:export",
+	}
+	if got := changeKeys(plan); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("changes = %v, want %v", got, want)
+	}
+}
+
+func TestDiffPruneSkipsUsedObjects(t *testing.T) {
+	st := testState()
+	st.rolesInUse[11] = true
+	plan, err := diff(testManifest(), st, true)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	if len(plan.Changes) != 0 {
+		t.Fatalf("used role or its permission was pruned: %v", changeKeys(plan))
+	}
+	if len(plan.Warnings) != 2 {
+		t.Fatalf("warnings = %v, want role and permission skipped", plan.Warnings)
+	}
+
+	st = testState()
+	st.grants = st.grants[:1]
+	st.foreignGrants[2] = true
+	plan, err = diff(testManifest(), st, true)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	for _, c := range plan.Changes {
+		if c.Kind == KindPermission {
+			t.Fatalf("permission granted in other tenants was pruned: %v", changeKeys(plan))
+		}
+	}
+}
+
+func TestDiffProblems(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(m *Manifest, st *state)
+		problem string
+	}{
+		{"namespaced permission", func(m *Manifest, _ *state) {
+			m.Permissions = append(m.Permissions, Permission{Code: "billing:invoice:read", Name: "Changed", Type: "button", Resource: "invoice", Action: "read"})
+		}, "registered by namespace"},
+		{"global permission from tenant", func(m *Manifest, st *state) {
+			st.tenantId = 2
+			m.Permissions[0].Name = "Changed"
+		}, "global permission"},
+		{"unknown permission", func(m *Manifest, _ *state) {
+			m.Roles[0].Permissions = append(m.Roles[0].Permissions, Grant{Code: "order:void", Effect: model.EffectAllow})
+		}, "unknown permission"},
+		{"unknown user", func(m *Manifest, _ *state) {
+			m.Users = []User{{Username: "bob"}}
+		}, "unknown user"},
+		{"unknown role", func(m *Manifest, _ *state) {
+			m.Users = []User{{Username: "alice", Roles: []string{"ghost"}}}
+		}, "unknown role"},
+		{"role name taken", func(m *Manifest, _ *state) {
+			m.Roles = append(m.Roles, Role{Code: "clerk2", Name: "Legacy", DataScope: model.DataScopeSelf})
+		}, "already used by role"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, st := testManifest(), testState()
+			tt.edit(m, st)
+			_, err := diff(m, st, false)
+			if !errors.Is(err, ErrInvalid) || !strings.Contains(err.Error(), tt.problem) {
+				t.Fatalf("diff = %v, want problem %q", err, tt.problem)
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package policy
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/condition"
+	"github.com/ziptako/iam/internal/sod"
+)
+
+// ErrConstraint 应用清单会违反职责分离约束
+var ErrConstraint = errors.New("separation of duty constraint violated")
+
+// Service 策略即代码：导出当前租户的策略清单，计算清单与数据库的差异并在事务中应用
+type Service struct {
+	permissionsModel      model.PermissionsModel
+	rolesModel            model.RolesModel
+	rolePermissionsModel  model.RolePermissionsModel
+	usersModel            model.UsersModel
+	userRolesModel        model.UserRolesModel
+	groupRolesModel       model.GroupRolesModel
+	resourceBindingsModel model.ResourceBindingsModel
+	policyModel           model.PolicyModel
+	conditions            *condition.Evaluator
+	constraints           *sod.Checker
+}
+
+// NewService 创建策略即代码服务
+func NewService(permissionsModel model.PermissionsModel, rolesModel model.RolesModel, rolePermissionsModel model.RolePermissionsModel,
+	usersModel model.UsersModel, userRolesModel model.UserRolesModel, groupRolesModel model.GroupRolesModel,
+	resourceBindingsModel model.ResourceBindingsModel, policyModel model.PolicyModel,
+	conditions *condition.Evaluator, constraints *sod.Checker) *Service {
+	return &Service{
+		permissionsModel:      permissionsModel,
+		rolesModel:            rolesModel,
+		rolePermissionsModel:  rolePermissionsModel,
+		usersModel:            usersModel,
+		userRolesModel:        userRolesModel,
+		groupRolesModel:       groupRolesModel,
+		resourceBindingsModel: resourceBindingsModel,
+		policyModel:           policyModel,
+		conditions:            conditions,
+		constraints:           constraints,
+	}
+}
+
+// Export 导出当前租户的策略清单：本租户维护且非服务注册的权限、未删除的角色及其未过期的授权
+// includeUsers为true时同时导出用户未过期的直接角色，授权和用户角色的有效期不导出
+func (s *Service) Export(ctx context.Context, includeUsers bool) (*Manifest, error) {
+	st := &state{tenantId: model.TenantIdFromContext(ctx)}
+	permissions, err := s.permissionsModel.FindAllVisible(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("find permissions: %w", err)
+	}
+	roles, err := s.rolesModel.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("find roles: %w", err)
+	}
+
+	m := &Manifest{Version: Version}
+	permissionsById := make(map[int64]*model.Permissions, len(permissions))
+	for _, p := range permissions {
+		permissionsById[p.Id] = p
+		if !st.owns(p) || p.Namespace.Valid {
+			continue
+		}
+		m.Permissions = append(m.Permissions, Permission{
+			Code:        p.Code,
+			Name:        p.Name,
+			Type:        p.Type,
+			Resource:    p.Resource,
+			Action:      p.Action,
+			HttpMethod:  p.HttpMethod.String,
+			Description: p.Description.String,
+		})
+	}
+	slices.SortFunc(m.Permissions, func(a, b Permission) int {
+		return cmp.Compare(a.Code, b.Code)
+	})
+
+	activeRoles := make(map[int64]*model.Roles, len(roles))
+	roleIds := make([]int64, 0, len(roles))
+	for _, r := range roles {
+		if r.DeletedAt.Valid {
+			continue
+		}
+		activeRoles[r.Id] = r
+		roleIds = append(roleIds, r.Id)
+	}
+	grants, err := s.rolePermissionsModel.FindAllByRoleIds(ctx, roleIds)
+	if err != nil {
+		return nil, fmt.Errorf("find role permissions: %w", err)
+	}
+	now := time.Now()
+	grantsByRole := make(map[int64][]Grant)
+	for _, g := range grants {
+		p := permissionsById[g.PermissionId]
+		if p == nil || g.Validity().Expired(now) {
+			continue
+		}
+		grantsByRole[g.RoleId] = append(grantsByRole[g.RoleId], Grant{Code: p.Code, Effect: g.Effect, Condition: g.Condition})
+	}
+	for _, id := range roleIds {
+		r := activeRoles[id]
+		roleGrants := grantsByRole[id]
+		slices.SortFunc(roleGrants, func(a, b Grant) int {
+			return cmp.Compare(a.Code, b.Code)
+		})
+		m.Roles = append(m.Roles, Role{
+			Code:        r.Code,
+			Name:        r.Name,
+			Description: r.Description.String,
+			SortOrder:   r.SortOrder,
+			DataScope:   r.DataScope,
+			Permissions: roleGrants,
+		})
+	}
+
+	if !includeUsers {
+		return m, nil
+	}
+	userRoles, err := s.userRolesModel.FindAllUnexpired(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("find user roles: %w", err)
+	}
+	rolesByUser := make(map[int64][]string)
+	var userIds []int64
+	for _, ur := range userRoles {
+		r := activeRoles[ur.RoleId]
+		if r == nil {
+			continue
+		}
+		if _, ok := rolesByUser[ur.UserId]; !ok {
+			userIds = append(userIds, ur.UserId)
+		}
+		rolesByUser[ur.UserId] = append(rolesByUser[ur.UserId], r.Code)
+	}
+	for _, userId := range userIds {
+		user, err := s.usersModel.FindById(ctx, userId)
+		if errors.Is(err, model.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("find user %d: %w", userId, err)
+		}
+		codes := rolesByUser[userId]
+		slices.Sort(codes)
+		m.Users = append(m.Users, User{Username: user.Username, Roles: codes})
+	}
+	slices.SortFunc(m.Users, func(a, b User) int {
+		return cmp.Compare(a.Username, b.Username)
+	})
+	return m, nil
+}
+
+// Plan 计算清单与当前租户数据库现状的差异，清单引用不存在的对象或修改其他租户维护的权限时返回ErrInvalid
+func (s *Service) Plan(ctx context.Context, m *Manifest, prune bool) (*Plan, error) {
+	if problems := s.validateConditions(m); len(problems) > 0 {
+		return nil, invalid(problems)
+	}
+	st, err := s.load(ctx, m, prune)
+	if err != nil {
+		return nil, err
+	}
+	return diff(m, st, prune)
+}
+
+// Apply 在一个事务中计算差异并应用，任一变更失败时全部回滚
+// 同一租户的应用在事务锁内串行执行，差异在锁内计算；要修改的记录在计算后被其他调用修改或删除时返回model.ErrPolicyConflict
+// 新增的用户角色需满足职责分离约束，违反时返回ErrConstraint；createdBy记录为新建授权和用户角色的创建人
+func (s *Service) Apply(ctx context.Context, m *Manifest, prune bool, createdBy sql.NullInt64) (*Plan, error) {
+	var plan *Plan
+	err := s.policyModel.Transact(ctx, func(ctx context.Context, tx *model.PolicyTx) error {
+		if err := tx.Lock(ctx); err != nil {
+			return fmt.Errorf("lock policy: %w", err)
+		}
+		// 职责分离锁持有到事务提交，约束校验与新增用户角色的写入之间不会插入其他授予
+		if err := tx.LockConstraints(ctx); err != nil {
+			return fmt.Errorf("lock constraints: %w", err)
+		}
+		p, err := s.Plan(ctx, m, prune)
+		if err != nil {
+			return err
+		}
+		if err = s.checkConstraints(ctx, p); err != nil {
+			return err
+		}
+		plan = p
+		return p.apply(ctx, tx, createdBy)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// validateConditions 校验授权条件表达式
+func (s *Service) validateConditions(m *Manifest) []string {
+	var problems []string
+	for i, r := range m.Roles {
+		for j, g := range r.Permissions {
+			if g.Condition == "" {
+				continue
+			}
+			if err := s.conditions.Validate(g.Condition); err != nil {
+				problems = append(problems, fmt.Sprintf("roles[%d].permissions[%d]: invalid condition: %v", i, j, err))
+			}
+		}
+	}
+	return problems
+}
+
+// checkConstraints 校验新增的用户角色是否违反职责分离约束，新建的角色尚无约束，不参与校验
+func (s *Service) checkConstraints(ctx context.Context, plan *Plan) error {
+	var grants []sod.Grant
+	for _, change := range plan.Changes {
+		if change.Kind != KindBinding || change.Action != ActionCreate {
+			continue
+		}
+		if roleId := plan.roleIds[change.roleCode]; roleId > 0 {
+			grants = append(grants, sod.Grant{UserId: change.binding.UserId, RoleId: roleId})
+		}
+	}
+	if len(grants) == 0 {
+		return nil
+	}
+	violation, err := s.constraints.Check(ctx, grants)
+	if err != nil {
+		return fmt.Errorf("check constraints: %w", err)
+	}
+	if violation != nil {
+		return fmt.Errorf("%w: %s", ErrConstraint, violation.Error())
+	}
+	return nil
+}
+
+// load 读取与清单相关的数据库现状，prune为true时同时查询待清理对象的使用情况
+func (s *Service) load(ctx context.Context, m *Manifest, prune bool) (*state, error) {
+	st := &state{
+		tenantId:      model.TenantIdFromContext(ctx),
+		users:         make(map[string]*model.Users, len(m.Users)),
+		userRoles:     make(map[int64][]*model.UserRoles, len(m.Users)),
+		rolesInUse:    make(map[int64]bool),
+		foreignGrants: make(map[int64]bool),
+	}
+	var err error
+	if st.permissions, err = s.permissionsModel.FindAllVisible(ctx); err != nil {
+		return nil, fmt.Errorf("find permissions: %w", err)
+	}
+	if st.roles, err = s.rolesModel.FindAll(ctx); err != nil {
+		return nil, fmt.Errorf("find roles: %w", err)
+	}
+	roleIds := make([]int64, len(st.roles))
+	for i, r := range st.roles {
+		roleIds[i] = r.Id
+	}
+	if st.grants, err = s.rolePermissionsModel.FindAllByRoleIds(ctx, roleIds); err != nil {
+		return nil, fmt.Errorf("find role permissions: %w", err)
+	}
+
+	for _, u := range m.Users {
+		user, err := s.usersModel.FindByUsername(ctx, u.Username)
+		if errors.Is(err, model.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("find user %q: %w", u.Username, err)
+		}
+		st.users[u.Username] = user
+		if st.userRoles[user.Id], err = s.userRolesModel.FindAllByUserId(ctx, user.Id); err != nil {
+			return nil, fmt.Errorf("find roles of user %q: %w", u.Username, err)
+		}
+	}
+
+	if !prune {
+		return st, nil
+	}
+
+	declaredRoles := make(map[string]bool, len(m.Roles))
+	for _, r := range m.Roles {
+		declaredRoles[r.Code] = true
+	}
+	for _, r := range st.roles {
+		if declaredRoles[r.Code] || !prunableRole(r) {
+			continue
+		}
+		inUse, err := s.roleInUse(ctx, r.Id)
+		if err != nil {
+			return nil, fmt.Errorf("check usage of role %q: %w", r.Code, err)
+		}
+		st.rolesInUse[r.Id] = inUse
+	}
+
+	declaredPermissions := make(map[string]bool, len(m.Permissions))
+	for _, p := range m.Permissions {
+		declaredPermissions[p.Code] = true
+	}
+	localGrants := make(map[int64]int64)
+	for _, g := range st.grants {
+		localGrants[g.PermissionId]++
+	}
+	for _, p := range st.permissions {
+		if !p.IsGlobal() || declaredPermissions[p.Code] || !st.prunablePermission(p) {
+			continue
+		}
+		count, err := s.rolePermissionsModel.CountRolesByPermissionIdAcrossTenants(ctx, p.Id)
+		if err != nil {
+			return nil, fmt.Errorf("check usage of permission %q: %w", p.Code, err)
+		}
+		st.foreignGrants[p.Id] = count > localGrants[p.Id]
+	}
+	return st, nil
+}
+
+// roleInUse 角色是否仍被用户、用户组或资源绑定使用，与DeleteRole的检查一致
+func (s *Service) roleInUse(ctx context.Context, roleId int64) (bool, error) {
+	count, err := s.userRolesModel.CountUsersByRoleId(ctx, roleId)
+	if err != nil || count > 0 {
+		return count > 0, err
+	}
+	count, err = s.groupRolesModel.CountGroupsByRoleId(ctx, roleId)
+	if err != nil || count > 0 {
+		return count > 0, err
+	}
+	count, err = s.resourceBindingsModel.CountByRoleId(ctx, roleId)
+	return count > 0, err
+}
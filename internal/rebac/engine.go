@@ -0,0 +1,447 @@
+package rebac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/config"
+)
+
+var (
+	ErrUnknownNamespace = errors.New("namespace is not defined")
+	ErrUnknownRelation  = errors.New("relation is not defined in namespace")
+	ErrNotDirect        = errors.New("relation does not accept direct tuples")
+	ErrInvalidSubject   = errors.New("invalid subject")
+	ErrMaxDepthExceeded = errors.New("relation graph exceeds max depth")
+)
+
+// Subject 关系元组的主体，Relation为空时表示具体主体（如 user:1），否则表示主体集合（如 folder:x#viewer）
+type Subject struct {
+	Namespace string
+	Id        string
+	Relation  string
+}
+
+// IsSet 是否为主体集合
+func (s Subject) IsSet() bool {
+	return s.Relation != ""
+}
+
+// String 返回主体的文本形式，如 user:1、role:teacher#member
+func (s Subject) String() string {
+	if s.IsSet() {
+		return fmt.Sprintf("%s:%s#%s", s.Namespace, s.Id, s.Relation)
+	}
+	return fmt.Sprintf("%s:%s", s.Namespace, s.Id)
+}
+
+// UserSubject 返回用户主体
+func UserSubject(userId int64) Subject {
+	return Subject{Namespace: UserNamespace, Id: strconv.FormatInt(userId, 10)}
+}
+
+// SubjectOf 返回元组的主体
+func SubjectOf(t *model.RelationTuples) Subject {
+	return Subject{Namespace: t.SubjectNamespace, Id: t.SubjectId, Relation: t.SubjectRelation}
+}
+
+// Tree 关系展开树节点
+type Tree struct {
+	Operation string // union、this、computed_userset或tuple_to_userset
+	Namespace string
+	ObjectId  string
+	Relation  string
+	Subjects  []Subject // this节点直接写入的主体，主体集合不继续展开
+	Children  []*Tree
+}
+
+// Engine 根据命名空间配置和关系元组计算关系，role命名空间由用户角色和用户组角色推导
+type Engine struct {
+	schema          *Schema
+	maxDepth        int
+	tuplesModel     model.RelationTuplesModel
+	usersModel      model.UsersModel
+	rolesModel      model.RolesModel
+	userRolesModel  model.UserRolesModel
+	groupRolesModel model.GroupRolesModel
+}
+
+// NewEngine 创建关系计算引擎
+func NewEngine(c config.RebacConf, schema *Schema, tuplesModel model.RelationTuplesModel, usersModel model.UsersModel,
+	rolesModel model.RolesModel, userRolesModel model.UserRolesModel, groupRolesModel model.GroupRolesModel) *Engine {
+	return &Engine{
+		schema:          schema,
+		maxDepth:        c.MaxDepth,
+		tuplesModel:     tuplesModel,
+		usersModel:      usersModel,
+		rolesModel:      rolesModel,
+		userRolesModel:  userRolesModel,
+		groupRolesModel: groupRolesModel,
+	}
+}
+
+// ValidateRelation 校验关系已在命名空间配置中定义，内置的 role#member 也视为已定义
+func (e *Engine) ValidateRelation(namespace, relation string) error {
+	if namespace == RoleNamespace {
+		if relation != RoleMemberRelation {
+			return ErrUnknownRelation
+		}
+		return nil
+	}
+	if _, ok := e.schema.Namespaces[namespace]; !ok {
+		return ErrUnknownNamespace
+	}
+	if _, ok := e.schema.Relation(namespace, relation); !ok {
+		return ErrUnknownRelation
+	}
+	return nil
+}
+
+// ValidateSubject 校验主体：用户主体的ID必须为用户ID，角色主体必须为 role:<code>#member，其他主体的命名空间和关系必须已定义
+func (e *Engine) ValidateSubject(subject Subject) error {
+	if subject.Id == "" {
+		return ErrInvalidSubject
+	}
+	switch subject.Namespace {
+	case UserNamespace:
+		if subject.IsSet() {
+			return ErrInvalidSubject
+		}
+		if id, err := strconv.ParseInt(subject.Id, 10, 64); err != nil || id <= 0 {
+			return ErrInvalidSubject
+		}
+		return nil
+	case RoleNamespace:
+		if subject.Relation != RoleMemberRelation {
+			return ErrInvalidSubject
+		}
+		return nil
+	}
+	if _, ok := e.schema.Namespaces[subject.Namespace]; !ok {
+		return ErrInvalidSubject
+	}
+	if subject.IsSet() {
+		if _, ok := e.schema.Relation(subject.Namespace, subject.Relation); !ok {
+			return ErrInvalidSubject
+		}
+	}
+	return nil
+}
+
+// ValidateTuple 校验待写入的关系元组，元组只能写入改写规则包含this的关系
+func (e *Engine) ValidateTuple(namespace, relation string, subject Subject) error {
+	if namespace == RoleNamespace {
+		// 角色成员由用户角色维护，不能通过元组写入
+		return ErrNotDirect
+	}
+	if err := e.ValidateRelation(namespace, relation); err != nil {
+		return err
+	}
+	if r, _ := e.schema.Relation(namespace, relation); !r.Direct() {
+		return ErrNotDirect
+	}
+	return e.ValidateSubject(subject)
+}
+
+// Check 检查主体是否与对象存在指定关系
+func (e *Engine) Check(ctx context.Context, namespace, objectId, relation string, subject Subject) (bool, error) {
+	return e.check(ctx, namespace, objectId, relation, subject, 0, make(map[Subject]bool))
+}
+
+// check 递归检查关系，visited记录本次检查已到达的主体集合
+// 元组或改写规则成环时再次到达同一主体集合不会得到新的结果，按false处理而不是报错，以免影响其他分支
+func (e *Engine) check(ctx context.Context, namespace, objectId, relation string, subject Subject, depth int, visited map[Subject]bool) (bool, error) {
+	if depth > e.maxDepth {
+		return false, ErrMaxDepthExceeded
+	}
+	key := Subject{Namespace: namespace, Id: objectId, Relation: relation}
+	if subject == key {
+		return true, nil
+	}
+	if visited[key] {
+		return false, nil
+	}
+	visited[key] = true
+	if namespace == RoleNamespace {
+		return e.checkRole(ctx, objectId, relation, subject)
+	}
+
+	r, ok := e.schema.Relation(namespace, relation)
+	if !ok {
+		// 沿tuple_to_userset找到的对象所在命名空间可能未定义该关系
+		return false, nil
+	}
+	for _, op := range r.Rewrite {
+		switch op.Kind {
+		case OperandThis:
+			tuples, err := e.tuplesModel.FindByObjectRelation(ctx, namespace, objectId, relation)
+			if err != nil {
+				return false, err
+			}
+			for _, t := range tuples {
+				s := SubjectOf(t)
+				if s == subject {
+					return true, nil
+				}
+				if !s.IsSet() {
+					continue
+				}
+				ok, err := e.check(ctx, s.Namespace, s.Id, s.Relation, subject, depth+1, visited)
+				if err != nil || ok {
+					return ok, err
+				}
+			}
+		case OperandComputedUserset:
+			ok, err := e.check(ctx, namespace, objectId, op.Relation, subject, depth+1, visited)
+			if err != nil || ok {
+				return ok, err
+			}
+		case OperandTupleToUserset:
+			tuples, err := e.tuplesModel.FindByObjectRelation(ctx, namespace, objectId, op.Tupleset)
+			if err != nil {
+				return false, err
+			}
+			for _, t := range tuples {
+				ok, err := e.check(ctx, t.SubjectNamespace, t.SubjectId, op.Relation, subject, depth+1, visited)
+				if err != nil || ok {
+					return ok, err
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// checkRole 检查用户是否直接拥有或通过用户组继承指定角色，角色不存在或已禁用时返回false
+func (e *Engine) checkRole(ctx context.Context, code, relation string, subject Subject) (bool, error) {
+	if relation != RoleMemberRelation || subject.Namespace != UserNamespace || subject.IsSet() {
+		return false, nil
+	}
+	userId, err := strconv.ParseInt(subject.Id, 10, 64)
+	if err != nil {
+		return false, nil
+	}
+	role, err := e.rolesModel.FindActiveByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	ok, err := e.userRolesModel.HasRole(ctx, userId, role.Id)
+	if err != nil || ok {
+		return ok, err
+	}
+	groupRoles, err := e.groupRolesModel.FindByUserId(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+	for _, gr := range groupRoles {
+		if gr.RoleId == role.Id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Expand 展开对象上指定关系的改写规则，返回关系展开树；直接写入的主体集合作为叶子返回，不继续展开
+func (e *Engine) Expand(ctx context.Context, namespace, objectId, relation string) (*Tree, error) {
+	return e.expand(ctx, namespace, objectId, relation, 0)
+}
+
+func (e *Engine) expand(ctx context.Context, namespace, objectId, relation string, depth int) (*Tree, error) {
+	if depth > e.maxDepth {
+		return nil, ErrMaxDepthExceeded
+	}
+	tree := &Tree{Operation: "union", Namespace: namespace, ObjectId: objectId, Relation: relation}
+	if namespace == RoleNamespace {
+		node, err := e.expandRole(ctx, objectId, relation)
+		if err != nil {
+			return nil, err
+		}
+		tree.Children = append(tree.Children, node)
+		return tree, nil
+	}
+
+	r, ok := e.schema.Relation(namespace, relation)
+	if !ok {
+		return tree, nil
+	}
+	for _, op := range r.Rewrite {
+		switch op.Kind {
+		case OperandThis:
+			tuples, err := e.tuplesModel.FindByObjectRelation(ctx, namespace, objectId, relation)
+			if err != nil {
+				return nil, err
+			}
+			node := &Tree{Operation: OperandThis, Namespace: namespace, ObjectId: objectId, Relation: relation}
+			for _, t := range tuples {
+				node.Subjects = append(node.Subjects, SubjectOf(t))
+			}
+			tree.Children = append(tree.Children, node)
+		case OperandComputedUserset:
+			node, err := e.expand(ctx, namespace, objectId, op.Relation, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			node.Operation = OperandComputedUserset
+			tree.Children = append(tree.Children, node)
+		case OperandTupleToUserset:
+			tuples, err := e.tuplesModel.FindByObjectRelation(ctx, namespace, objectId, op.Tupleset)
+			if err != nil {
+				return nil, err
+			}
+			node := &Tree{Operation: OperandTupleToUserset, Namespace: namespace, ObjectId: objectId, Relation: op.String()}
+			for _, t := range tuples {
+				child, err := e.expand(ctx, t.SubjectNamespace, t.SubjectId, op.Relation, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				node.Children = append(node.Children, child)
+			}
+			tree.Children = append(tree.Children, node)
+		}
+	}
+	return tree, nil
+}
+
+// expandRole 将 role:<code>#member 展开为拥有该角色的用户
+func (e *Engine) expandRole(ctx context.Context, code, relation string) (*Tree, error) {
+	node := &Tree{Operation: OperandThis, Namespace: RoleNamespace, ObjectId: code, Relation: relation}
+	userIds, err := e.roleMemberIds(ctx, code, relation)
+	if err != nil {
+		return nil, err
+	}
+	for _, userId := range userIds {
+		node.Subjects = append(node.Subjects, UserSubject(userId))
+	}
+	return node, nil
+}
+
+// roleMemberIds 查询直接拥有或通过用户组继承角色的活跃用户ID
+func (e *Engine) roleMemberIds(ctx context.Context, code, relation string) ([]int64, error) {
+	if relation != RoleMemberRelation {
+		return nil, nil
+	}
+	role, err := e.rolesModel.FindActiveByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return e.usersModel.FindActiveIdsByRoleId(ctx, role.Id)
+}
+
+// ListObjects 查询主体与之存在指定关系的对象ID
+func (e *Engine) ListObjects(ctx context.Context, namespace, relation string, subject Subject) ([]string, error) {
+	objectIds, err := e.tuplesModel.FindObjectIds(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]string, 0)
+	for _, objectId := range objectIds {
+		ok, err := e.Check(ctx, namespace, objectId, relation, subject)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			resp = append(resp, objectId)
+		}
+	}
+	return resp, nil
+}
+
+// ListSubjects 查询与对象存在指定关系的所有活跃用户ID，主体集合和角色会被完全展开
+func (e *Engine) ListSubjects(ctx context.Context, namespace, objectId, relation string) ([]int64, error) {
+	found := make(map[int64]bool)
+	if err := e.collect(ctx, namespace, objectId, relation, 0, make(map[Subject]bool), found); err != nil {
+		return nil, err
+	}
+
+	resp := make([]int64, 0, len(found))
+	for userId := range found {
+		_, err := e.usersModel.FindActiveById(ctx, userId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				// 用户可能已被删除或禁用，跳过
+				continue
+			}
+			return nil, err
+		}
+		resp = append(resp, userId)
+	}
+	slices.Sort(resp)
+	return resp, nil
+}
+
+// collect 递归收集主体集合中的用户，visited用于跳过已展开的主体集合
+func (e *Engine) collect(ctx context.Context, namespace, objectId, relation string, depth int, visited map[Subject]bool, found map[int64]bool) error {
+	if depth > e.maxDepth {
+		return ErrMaxDepthExceeded
+	}
+	key := Subject{Namespace: namespace, Id: objectId, Relation: relation}
+	if visited[key] {
+		return nil
+	}
+	visited[key] = true
+
+	if namespace == RoleNamespace {
+		userIds, err := e.roleMemberIds(ctx, objectId, relation)
+		if err != nil {
+			return err
+		}
+		for _, userId := range userIds {
+			found[userId] = true
+		}
+		return nil
+	}
+
+	r, ok := e.schema.Relation(namespace, relation)
+	if !ok {
+		return nil
+	}
+	for _, op := range r.Rewrite {
+		switch op.Kind {
+		case OperandThis:
+			tuples, err := e.tuplesModel.FindByObjectRelation(ctx, namespace, objectId, relation)
+			if err != nil {
+				return err
+			}
+			for _, t := range tuples {
+				s := SubjectOf(t)
+				if s.IsSet() {
+					if err := e.collect(ctx, s.Namespace, s.Id, s.Relation, depth+1, visited, found); err != nil {
+						return err
+					}
+					continue
+				}
+				if s.Namespace == UserNamespace {
+					if userId, err := strconv.ParseInt(s.Id, 10, 64); err == nil {
+						found[userId] = true
+					}
+				}
+			}
+		case OperandComputedUserset:
+			if err := e.collect(ctx, namespace, objectId, op.Relation, depth+1, visited, found); err != nil {
+				return err
+			}
+		case OperandTupleToUserset:
+			tuples, err := e.tuplesModel.FindByObjectRelation(ctx, namespace, objectId, op.Tupleset)
+			if err != nil {
+				return err
+			}
+			for _, t := range tuples {
+				if err := e.collect(ctx, t.SubjectNamespace, t.SubjectId, op.Relation, depth+1, visited, found); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
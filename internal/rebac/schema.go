@@ -0,0 +1,360 @@
+package rebac
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+)
+
+const (
+	// UserNamespace 用户主体命名空间，主体ID为用户ID
+	UserNamespace = "user"
+	// RoleNamespace 内置角色命名空间，对象ID为角色编码
+	RoleNamespace = "role"
+	// RoleMemberRelation 角色成员关系，role:<code>#member 表示直接拥有或通过用户组继承该角色的所有用户
+	RoleMemberRelation = "member"
+)
+
+// 关系改写的操作数类型
+const (
+	OperandThis            = "this"             // 直接写入该关系的元组
+	OperandComputedUserset = "computed_userset" // 同一对象上另一个关系的主体
+	OperandTupleToUserset  = "tuple_to_userset" // 沿tupleset关系找到的对象上指定关系的主体
+)
+
+// Operand 关系改写中的一个操作数，多个操作数之间取并集
+type Operand struct {
+	Kind     string // 操作数类型
+	Relation string // computed_userset和tuple_to_userset要计算的关系
+	Tupleset string // tuple_to_userset用于查找关联对象的关系
+}
+
+// String 返回操作数在命名空间配置中的写法
+func (o Operand) String() string {
+	switch o.Kind {
+	case OperandComputedUserset:
+		return o.Relation
+	case OperandTupleToUserset:
+		return o.Tupleset + "->" + o.Relation
+	default:
+		return OperandThis
+	}
+}
+
+// Relation 命名空间中定义的关系
+type Relation struct {
+	Name    string
+	Rewrite []Operand // 关系改写规则，各操作数取并集
+}
+
+// Direct 关系是否接受直接写入的元组
+func (r *Relation) Direct() bool {
+	for _, op := range r.Rewrite {
+		if op.Kind == OperandThis {
+			return true
+		}
+	}
+	return false
+}
+
+// Namespace 对象命名空间，如folder、document
+type Namespace struct {
+	Name      string
+	Relations map[string]*Relation
+}
+
+// Schema 命名空间配置
+type Schema struct {
+	Namespaces map[string]*Namespace
+}
+
+// Relation 查询命名空间中的关系定义
+func (s *Schema) Relation(namespace, relation string) (*Relation, bool) {
+	ns, ok := s.Namespaces[namespace]
+	if !ok {
+		return nil, false
+	}
+	r, ok := ns.Relations[relation]
+	return r, ok
+}
+
+// LoadSchema 从文件加载命名空间配置，path为空时返回空配置
+func LoadSchema(path string) (*Schema, error) {
+	if path == "" {
+		return &Schema{Namespaces: map[string]*Namespace{}}, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read namespace file: %w", err)
+	}
+	return ParseSchema(string(data))
+}
+
+// ParseSchema 解析命名空间配置，语法如下：
+//
+//	namespace folder {
+//	  relation parent
+//	  relation owner
+//	  relation viewer { this | owner | parent->viewer }
+//	}
+//
+// 关系未写改写规则时等同于 { this }；// 开头的内容为注释。
+func ParseSchema(src string) (*Schema, error) {
+	p := &parser{tokens: tokenize(src)}
+	schema := &Schema{Namespaces: map[string]*Namespace{}}
+	for !p.done() {
+		ns, err := p.parseNamespace()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := schema.Namespaces[ns.Name]; ok {
+			return nil, fmt.Errorf("namespace %q is defined more than once", ns.Name)
+		}
+		schema.Namespaces[ns.Name] = ns
+	}
+	if err := schema.validate(); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// validate 校验关系改写引用的关系都已定义
+func (s *Schema) validate() error {
+	for _, ns := range s.Namespaces {
+		for _, r := range ns.Relations {
+			for _, op := range r.Rewrite {
+				switch op.Kind {
+				case OperandComputedUserset:
+					if _, ok := ns.Relations[op.Relation]; !ok {
+						return fmt.Errorf("%s#%s: relation %q is not defined", ns.Name, r.Name, op.Relation)
+					}
+				case OperandTupleToUserset:
+					if _, ok := ns.Relations[op.Tupleset]; !ok {
+						return fmt.Errorf("%s#%s: tupleset relation %q is not defined", ns.Name, r.Name, op.Tupleset)
+					}
+					if !s.definesRelation(op.Relation) {
+						return fmt.Errorf("%s#%s: relation %q is not defined in any namespace", ns.Name, r.Name, op.Relation)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// definesRelation 是否有命名空间定义了指定关系，内置角色命名空间定义了member
+func (s *Schema) definesRelation(relation string) bool {
+	if relation == RoleMemberRelation {
+		return true
+	}
+	for _, ns := range s.Namespaces {
+		if _, ok := ns.Relations[relation]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// token 词法单元
+type token struct {
+	text string
+	line int
+}
+
+// tokenize 将配置拆分为标识符和符号 { } | ->
+func tokenize(src string) []token {
+	var tokens []token
+	for i, line := range strings.Split(src, "\n") {
+		if idx := strings.Index(line, "//"); idx >= 0 {
+			line = line[:idx]
+		}
+		for j := 0; j < len(line); {
+			c := rune(line[j])
+			switch {
+			case unicode.IsSpace(c):
+				j++
+			case c == '{' || c == '}' || c == '|':
+				tokens = append(tokens, token{text: string(c), line: i + 1})
+				j++
+			case strings.HasPrefix(line[j:], "->"):
+				tokens = append(tokens, token{text: "->", line: i + 1})
+				j += 2
+			default:
+				k := j
+				for k < len(line) && isIdentChar(rune(line[k])) {
+					k++
+				}
+				if k == j {
+					// 非法字符单独作为一个词法单元，由语法分析报错
+					k = j + 1
+				}
+				tokens = append(tokens, token{text: line[j:k], line: i + 1})
+				j = k
+			}
+		}
+	}
+	return tokens
+}
+
+func isIdentChar(c rune) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// isIdent 是否为合法的命名空间或关系名称
+func isIdent(s string) bool {
+	if s == "" || unicode.IsDigit(rune(s[0])) {
+		return false
+	}
+	for _, c := range s {
+		if !isIdentChar(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// parser 命名空间配置的递归下降解析器
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() string {
+	if p.done() {
+		return ""
+	}
+	return p.tokens[p.pos].text
+}
+
+func (p *parser) next() token {
+	if p.done() {
+		line := 0
+		if len(p.tokens) > 0 {
+			line = p.tokens[len(p.tokens)-1].line
+		}
+		return token{line: line}
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t
+}
+
+func (p *parser) expect(text string) error {
+	t := p.next()
+	if t.text != text {
+		return p.errorf(t, "expected %q", text)
+	}
+	return nil
+}
+
+func (p *parser) ident() (string, error) {
+	t := p.next()
+	if !isIdent(t.text) {
+		return "", p.errorf(t, "expected identifier")
+	}
+	return t.text, nil
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	found := t.text
+	if found == "" {
+		found = "end of input"
+	}
+	return fmt.Errorf("line %d: %s, found %q", t.line, fmt.Sprintf(format, args...), found)
+}
+
+// parseNamespace namespace <name> { relation... }
+func (p *parser) parseNamespace() (*Namespace, error) {
+	if err := p.expect("namespace"); err != nil {
+		return nil, err
+	}
+	nameTok := p.tokens[p.pos-1]
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if name == UserNamespace || name == RoleNamespace {
+		return nil, fmt.Errorf("line %d: namespace %q is reserved", nameTok.line, name)
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	ns := &Namespace{Name: name, Relations: map[string]*Relation{}}
+	for p.peek() != "}" {
+		r, err := p.parseRelation()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := ns.Relations[r.Name]; ok {
+			return nil, fmt.Errorf("line %d: relation %q is defined more than once in namespace %q", p.tokens[p.pos-1].line, r.Name, name)
+		}
+		ns.Relations[r.Name] = r
+	}
+	p.next()
+	return ns, nil
+}
+
+// parseRelation relation <name> [{ operand | operand ... }]
+func (p *parser) parseRelation() (*Relation, error) {
+	if err := p.expect("relation"); err != nil {
+		return nil, err
+	}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	r := &Relation{Name: name}
+	if p.peek() != "{" {
+		r.Rewrite = []Operand{{Kind: OperandThis}}
+		return r, nil
+	}
+	p.next()
+
+	// 空改写规则等同于this
+	if p.peek() == "}" {
+		p.next()
+		r.Rewrite = []Operand{{Kind: OperandThis}}
+		return r, nil
+	}
+	for {
+		op, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		r.Rewrite = append(r.Rewrite, op)
+
+		t := p.next()
+		if t.text == "}" {
+			return r, nil
+		}
+		if t.text != "|" {
+			return nil, p.errorf(t, "expected \"|\" or \"}\"")
+		}
+	}
+}
+
+// parseOperand this | <relation> | <tupleset>-><relation>
+func (p *parser) parseOperand() (Operand, error) {
+	name, err := p.ident()
+	if err != nil {
+		return Operand{}, err
+	}
+	if name == OperandThis {
+		return Operand{Kind: OperandThis}, nil
+	}
+	if p.peek() != "->" {
+		return Operand{Kind: OperandComputedUserset, Relation: name}, nil
+	}
+	p.next()
+	relation, err := p.ident()
+	if err != nil {
+		return Operand{}, err
+	}
+	return Operand{Kind: OperandTupleToUserset, Relation: relation, Tupleset: name}, nil
+}
@@ -0,0 +1,111 @@
+package rebac
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSchema(t *testing.T) {
+	schema, err := ParseSchema(`
+// 文件夹
+namespace folder {
+  relation parent
+  relation owner {}
+  relation viewer { this | owner | parent->viewer } // 继承上级
+}
+namespace document {
+  relation parent
+  relation reader { parent->viewer | role->member }
+  relation role
+}
+`)
+	if err != nil {
+		t.Fatalf("ParseSchema: %v", err)
+	}
+	viewer, ok := schema.Relation("folder", "viewer")
+	if !ok {
+		t.Fatalf("folder#viewer not defined")
+	}
+	want := []Operand{
+		{Kind: OperandThis},
+		{Kind: OperandComputedUserset, Relation: "owner"},
+		{Kind: OperandTupleToUserset, Relation: "viewer", Tupleset: "parent"},
+	}
+	if !reflect.DeepEqual(viewer.Rewrite, want) {
+		t.Fatalf("folder#viewer = %+v, want %+v", viewer.Rewrite, want)
+	}
+	var ops []string
+	for _, op := range viewer.Rewrite {
+		ops = append(ops, op.String())
+	}
+	if strings.Join(ops, " | ") != "this | owner | parent->viewer" {
+		t.Fatalf("operand strings = %v", ops)
+	}
+
+	// 未写改写规则或空改写规则都等同于this
+	for _, name := range []string{"parent", "owner"} {
+		if r, _ := schema.Relation("folder", name); !r.Direct() {
+			t.Fatalf("folder#%s is not direct", name)
+		}
+	}
+	if r, _ := schema.Relation("document", "reader"); r.Direct() {
+		t.Fatalf("document#reader accepts direct tuples")
+	}
+	if _, ok := schema.Relation("document", "owner"); ok {
+		t.Fatalf("document#owner should not be defined")
+	}
+	if _, ok := schema.Relation("project", "owner"); ok {
+		t.Fatalf("undefined namespace found")
+	}
+}
+
+func TestParseSchemaRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		problem string
+	}{
+		{"keyword", "folder { relation a }", `expected "namespace"`},
+		{"reserved", "namespace user { relation a }", "reserved"},
+		{"duplicate namespace", "namespace a { }\nnamespace a { }", "more than once"},
+		{"duplicate relation", "namespace a {\n relation r\n relation r\n}", "line 3"},
+		{"unclosed", "namespace a {\n relation r", "end of input"},
+		{"bad identifier", "namespace 1a { }", "expected identifier"},
+		{"bad character", "namespace a { relation r { this & r } }", `expected "|" or "}"`},
+		{"undefined relation", "namespace a { relation r { owner } }", `relation "owner" is not defined`},
+		{"undefined tupleset", "namespace a { relation r { parent->r } }", `tupleset relation "parent"`},
+		{"undefined target", "namespace a { relation p\n relation r { p->viewer } }", "in any namespace"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSchema(tt.src)
+			if err == nil || !strings.Contains(err.Error(), tt.problem) {
+				t.Fatalf("ParseSchema = %v, want problem %q", err, tt.problem)
+			}
+		})
+	}
+}
+
+func TestLoadSchema(t *testing.T) {
+	schema, err := LoadSchema("")
+	if err != nil || len(schema.Namespaces) != 0 {
+		t.Fatalf("LoadSchema empty path = %v, %v", schema, err)
+	}
+
+	path := filepath.Join(t.TempDir(), "namespaces.conf")
+	if err := os.WriteFile(path, []byte("namespace folder { relation owner }"), 0o600); err != nil {
+		t.Fatalf("write schema: %v", err)
+	}
+	if schema, err = LoadSchema(path); err != nil {
+		t.Fatalf("LoadSchema: %v", err)
+	}
+	if _, ok := schema.Relation("folder", "owner"); !ok {
+		t.Fatalf("folder#owner not loaded")
+	}
+	if _, err = LoadSchema(filepath.Join(t.TempDir(), "missing.conf")); err == nil {
+		t.Fatalf("missing file accepted")
+	}
+}
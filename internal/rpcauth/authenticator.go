@@ -0,0 +1,123 @@
+package rpcauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// MetadataKey 携带服务令牌或用户令牌的gRPC metadata键，值为 Bearer <token>
+	MetadataKey = "authorization"
+
+	bearerPrefix = "Bearer "
+)
+
+// Authenticator 认证IAM接口的调用方，依次尝试mTLS客户端证书、服务令牌和用户令牌
+type Authenticator struct {
+	c             config.RpcAuthConf
+	tokens        *TokenIssuer
+	usersModel    model.UsersModel
+	sessionsModel model.SessionsModel
+}
+
+// NewAuthenticator 创建调用方认证器
+func NewAuthenticator(c config.RpcAuthConf, tokens *TokenIssuer, usersModel model.UsersModel,
+	sessionsModel model.SessionsModel) *Authenticator {
+	return &Authenticator{
+		c:             c,
+		tokens:        tokens,
+		usersModel:    usersModel,
+		sessionsModel: sessionsModel,
+	}
+}
+
+// Authenticate 认证当前调用的调用方，失败时返回gRPC状态错误
+func (a *Authenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	if principal := a.fromPeer(ctx); principal != nil {
+		return principal, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(MetadataKey)
+	if len(values) == 0 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "[RPA001] Caller credentials are required")
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(values[0], bearerPrefix))
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "[RPA001] Caller credentials are required")
+	}
+
+	for _, service := range a.c.Services {
+		if service.Token != "" && subtle.ConstantTimeCompare([]byte(service.Token), []byte(token)) == 1 {
+			return &Principal{Kind: PrincipalService, Name: service.Name, Roles: service.Roles}, nil
+		}
+	}
+	return a.fromUserToken(ctx, token)
+}
+
+// fromPeer 按已校验的mTLS客户端证书匹配服务调用方，证书的CN或任一DNS SAN与配置的Subject相同即匹配
+func (a *Authenticator) fromPeer(ctx context.Context) *Principal {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	cert := tlsInfo.State.VerifiedChains[0][0]
+	for _, service := range a.c.Services {
+		if service.Subject == "" {
+			continue
+		}
+		if service.Subject == cert.Subject.CommonName || slices.Contains(cert.DNSNames, service.Subject) {
+			return &Principal{Kind: PrincipalMtls, Name: service.Name, Roles: service.Roles}
+		}
+	}
+	return nil
+}
+
+// fromUserToken 校验用户令牌，令牌需属于当前租户且对应的会话未撤销、用户仍处于活跃状态
+func (a *Authenticator) fromUserToken(ctx context.Context, token string) (*Principal, error) {
+	claims, err := a.tokens.Parse(token)
+	if err != nil {
+		logx.WithContext(ctx).Infof("[RPA002] 调用方凭证无效: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "[RPA002] Invalid caller credentials")
+	}
+	if claims.TenantId != model.TenantIdFromContext(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "[RPA003] Token does not belong to the tenant")
+	}
+
+	session, err := a.sessionsModel.FindActiveById(ctx, claims.SessionId)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		eInfo := "[RPA004] 查询会话失败"
+		logx.WithContext(ctx).Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if session == nil || session.UserId != claims.UserId {
+		return nil, status.Error(codes.Unauthenticated, "[RPA005] Session has been revoked")
+	}
+
+	user, err := a.usersModel.FindActiveById(ctx, claims.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "[RPA006] User not found or disabled")
+		}
+		eInfo := "[RPA007] 查询用户失败"
+		logx.WithContext(ctx).Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return &Principal{Kind: PrincipalUser, Name: user.Username, UserId: user.Id, SessionId: session.Id}, nil
+}
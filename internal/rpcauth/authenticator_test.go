@@ -0,0 +1,115 @@
+package rpcauth
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/iam"
+	"github.com/ziptako/iam/internal/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newTestAuthenticator() (*Authenticator, *TokenIssuer, *modeltest.Sessions) {
+	c := config.RpcAuthConf{
+		Enabled:     true,
+		TokenSecret: "secret",
+		TokenTTL:    60,
+		Services:    []config.ServicePrincipalConf{{Name: "gateway", Token: "service-token", Roles: []string{"reader"}}},
+	}
+	tokens := NewTokenIssuer(c)
+	sessions := &modeltest.Sessions{Rows: []*model.Sessions{{Id: 7, UserId: 1}}}
+	users := &modeltest.Users{Rows: []*model.Users{{Id: 1, Username: "alice", TenantId: 1}}}
+	return NewAuthenticator(c, tokens, users, sessions), tokens, sessions
+}
+
+func withBearer(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, bearerPrefix+token))
+}
+
+func requireCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if status.Code(err) != code {
+		t.Fatalf("error = %v, want %s", err, code)
+	}
+}
+
+func TestAuthenticateServiceToken(t *testing.T) {
+	a, _, _ := newTestAuthenticator()
+	principal, err := a.Authenticate(withBearer("service-token"))
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if principal.Kind != PrincipalService || principal.Name != "gateway" || len(principal.Roles) != 1 {
+		t.Fatalf("unexpected principal %+v", principal)
+	}
+
+	_, err = a.Authenticate(context.Background())
+	requireCode(t, err, codes.Unauthenticated)
+	_, err = a.Authenticate(withBearer(" "))
+	requireCode(t, err, codes.Unauthenticated)
+	_, err = a.Authenticate(withBearer("wrong-token"))
+	requireCode(t, err, codes.Unauthenticated)
+}
+
+func TestAuthenticateUserToken(t *testing.T) {
+	a, tokens, sessions := newTestAuthenticator()
+	token, _, err := tokens.Issue(7, 1, 1, time.Now())
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+
+	principal, err := a.Authenticate(withBearer(token))
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if principal.Kind != PrincipalUser || principal.UserId != 1 || principal.SessionId != 7 || principal.Name != "alice" {
+		t.Fatalf("unexpected principal %+v", principal)
+	}
+
+	// 令牌只在签发的租户内有效
+	_, err = a.Authenticate(model.WithTenantId(withBearer(token), 2))
+	requireCode(t, err, codes.PermissionDenied)
+
+	// 会话撤销后令牌失效
+	_, _ = sessions.RevokeAllByUserId(context.Background(), 1, model.SessionRevokeManual)
+	_, err = a.Authenticate(withBearer(token))
+	requireCode(t, err, codes.Unauthenticated)
+
+	// 用户被禁用后令牌失效
+	sessions.Rows = append(sessions.Rows, &model.Sessions{Id: 8, UserId: 9})
+	token, _, _ = tokens.Issue(8, 9, 1, time.Now())
+	_, err = a.Authenticate(withBearer(token))
+	requireCode(t, err, codes.Unauthenticated)
+}
+
+// TestMethodPermissionsCoverAllMethods 每个IAM接口都要定义所需权限，且权限编码已在 db/init.sql 中初始化
+func TestMethodPermissionsCoverAllMethods(t *testing.T) {
+	initSql, err := os.ReadFile("../../db/init.sql")
+	if err != nil {
+		t.Fatalf("read init.sql: %v", err)
+	}
+
+	services := iam.File_iam_proto.Services()
+	for i := 0; i < services.Len(); i++ {
+		service := services.Get(i)
+		methods := service.Methods()
+		for j := 0; j < methods.Len(); j++ {
+			fullMethod := "/" + string(service.FullName()) + "/" + string(methods.Get(j).Name())
+			code, ok := MethodPermissions[fullMethod]
+			if !ok {
+				t.Errorf("%s has no permission defined", fullMethod)
+				continue
+			}
+			if !strings.Contains(string(initSql), "'"+code+"'") {
+				t.Errorf("permission %s of %s is not seeded in init.sql", code, fullMethod)
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package rpcauth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/condition"
+	"github.com/ziptako/iam/internal/decision"
+)
+
+// Authorizer 按IAM自身的角色和授权判断调用方能否调用接口
+// 服务调用方使用配置的角色，用户调用方使用直接拥有和通过用户组继承的角色；拒绝授权优先
+type Authorizer struct {
+	usersModel           model.UsersModel
+	rolesModel           model.RolesModel
+	userRolesModel       model.UserRolesModel
+	groupRolesModel      model.GroupRolesModel
+	rolePermissionsModel model.RolePermissionsModel
+	permissionsModel     model.PermissionsModel
+	conditions           *condition.Evaluator
+}
+
+// NewAuthorizer 创建调用方授权器
+func NewAuthorizer(usersModel model.UsersModel, rolesModel model.RolesModel, userRolesModel model.UserRolesModel,
+	groupRolesModel model.GroupRolesModel, rolePermissionsModel model.RolePermissionsModel,
+	permissionsModel model.PermissionsModel, conditions *condition.Evaluator) *Authorizer {
+	return &Authorizer{
+		usersModel:           usersModel,
+		rolesModel:           rolesModel,
+		userRolesModel:       userRolesModel,
+		groupRolesModel:      groupRolesModel,
+		rolePermissionsModel: rolePermissionsModel,
+		permissionsModel:     permissionsModel,
+		conditions:           conditions,
+	}
+}
+
+// Allowed 判断调用方是否拥有权限编码，method为接口方法全名，作为条件求值时的 request.method
+func (a *Authorizer) Allowed(ctx context.Context, principal *Principal, method, code string) (bool, error) {
+	roleIds, err := a.roleIds(ctx, principal)
+	if err != nil {
+		return false, err
+	}
+	if len(roleIds) == 0 {
+		return false, nil
+	}
+	rolePermissions, err := a.rolePermissionsModel.FindByRoleIds(ctx, roleIds)
+	if err != nil {
+		return false, err
+	}
+
+	codes, err := a.grantCodes(ctx, rolePermissions)
+	if err != nil {
+		return false, err
+	}
+
+	// 与用户权限检查使用相同的求值规则，只在存在带条件的授权时查询调用方属性
+	attrs := condition.Attributes{}
+	if decision.Conditional(rolePermissions) {
+		if attrs, err = a.attributes(ctx, principal, method); err != nil {
+			return false, err
+		}
+	}
+	return decision.Decide(a.conditions, rolePermissions, codes, code, attrs).Allowed(), nil
+}
+
+// grantCodes 查询授权对应的权限编码，已删除的权限不包含在结果中
+func (a *Authorizer) grantCodes(ctx context.Context, rolePermissions []*model.RolePermissions) (map[int64]string, error) {
+	codes := make(map[int64]string)
+	for _, rp := range rolePermissions {
+		if _, ok := codes[rp.PermissionId]; ok {
+			continue
+		}
+		permission, err := a.permissionsModel.FindOne(ctx, rp.PermissionId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		codes[rp.PermissionId] = permission.Code
+	}
+	return codes, nil
+}
+
+// roleIds 查询调用方在当前租户拥有的角色
+func (a *Authorizer) roleIds(ctx context.Context, principal *Principal) ([]int64, error) {
+	roleIds := make([]int64, 0)
+	if principal.Kind != PrincipalUser {
+		for _, code := range principal.Roles {
+			role, err := a.rolesModel.FindActiveByCode(ctx, code)
+			if err != nil {
+				if errors.Is(err, model.ErrNotFound) {
+					continue
+				}
+				return nil, err
+			}
+			roleIds = append(roleIds, role.Id)
+		}
+		return roleIds, nil
+	}
+
+	userRoles, err := a.userRolesModel.FindRolesByUserId(ctx, principal.UserId)
+	if err != nil {
+		return nil, err
+	}
+	for _, ur := range userRoles {
+		roleIds = append(roleIds, ur.RoleId)
+	}
+	groupRoles, err := a.groupRolesModel.FindByUserId(ctx, principal.UserId)
+	if err != nil {
+		return nil, err
+	}
+	for _, gr := range groupRoles {
+		roleIds = append(roleIds, gr.RoleId)
+	}
+	return roleIds, nil
+}
+
+// attributes 构造条件求值的属性，服务调用方没有用户属性
+func (a *Authorizer) attributes(ctx context.Context, principal *Principal, method string) (condition.Attributes, error) {
+	attrs := condition.Attributes{
+		User: map[string]any{},
+		Request: map[string]string{
+			"method":         method,
+			"principal":      principal.Name,
+			"principal_kind": principal.Kind,
+		},
+		Now: time.Now(),
+	}
+	if principal.Kind != PrincipalUser {
+		return attrs, nil
+	}
+	user, err := a.usersModel.FindActiveById(ctx, principal.UserId)
+	if err != nil {
+		return condition.Attributes{}, err
+	}
+	attrs.User = map[string]any{
+		"id":            user.Id,
+		"username":      user.Username,
+		"tenant_id":     user.TenantId,
+		"department_id": user.DepartmentId.Int64,
+	}
+	return attrs, nil
+}
@@ -0,0 +1,129 @@
+package rpcauth
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/internal/condition"
+)
+
+const (
+	roleReader  = 1 // 拥有 iam:user:*
+	roleAuditor = 2 // 拥有 iam，但拒绝 iam:user:delete
+	roleScoped  = 3 // 只允许通过指定接口读取用户
+)
+
+type authzFixture struct {
+	users       *modeltest.Users
+	rolePerms   *modeltest.RolePermissions
+	authorizer  *Authorizer
+	permissions *modeltest.Permissions
+}
+
+func newAuthzFixture(t *testing.T) *authzFixture {
+	t.Helper()
+	conditions, err := condition.NewEvaluator()
+	if err != nil {
+		t.Fatalf("NewEvaluator: %v", err)
+	}
+	f := &authzFixture{
+		users: &modeltest.Users{Rows: []*model.Users{
+			{Id: 1, Username: "alice", TenantId: 1},
+			{Id: 2, Username: "bob", TenantId: 1},
+		}},
+		rolePerms: &modeltest.RolePermissions{Rows: []*model.RolePermissions{
+			{RoleId: roleReader, PermissionId: 10, Effect: model.EffectAllow},
+			{RoleId: roleAuditor, PermissionId: 11, Effect: model.EffectAllow},
+			{RoleId: roleAuditor, PermissionId: 12, Effect: model.EffectDeny},
+			{RoleId: roleScoped, PermissionId: 13, Effect: model.EffectAllow, Condition: `request.method == "/iam.UserService/GetUser"`},
+		}},
+		permissions: &modeltest.Permissions{Rows: []*model.Permissions{
+			{Id: 10, Code: "iam:user:*"}, {Id: 11, Code: "iam"}, {Id: 12, Code: PermUserDelete}, {Id: 13, Code: PermUserRead},
+		}},
+	}
+	roles := &modeltest.Roles{Rows: []*model.Roles{
+		{Id: roleReader, Code: "reader"}, {Id: roleAuditor, Code: "auditor"}, {Id: roleScoped, Code: "scoped"},
+	}}
+	userRoles := &modeltest.UserRoles{Rows: []*model.UserRoles{{Id: 1, UserId: 1, RoleId: roleReader}}}
+	groupRoles := &modeltest.GroupRoles{Rows: []*model.GroupRoles{{GroupId: 100, RoleId: roleAuditor}},
+		Members: &modeltest.GroupMembers{Rows: []*model.GroupMembers{{GroupId: 100, UserId: 2}}}}
+	f.authorizer = NewAuthorizer(f.users, roles, userRoles, groupRoles, f.rolePerms, f.permissions, conditions)
+	return f
+}
+
+func (f *authzFixture) allowed(t *testing.T, principal *Principal, method, code string) bool {
+	t.Helper()
+	ok, err := f.authorizer.Allowed(context.Background(), principal, method, code)
+	if err != nil {
+		t.Fatalf("Allowed(%s, %s): %v", principal.Name, code, err)
+	}
+	return ok
+}
+
+func TestAllowedService(t *testing.T) {
+	f := newAuthzFixture(t)
+	svc := &Principal{Kind: PrincipalService, Name: "gateway", Roles: []string{"reader", "missing"}}
+
+	if !f.allowed(t, svc, "", PermUserRead) {
+		t.Fatalf("service with wildcard grant denied")
+	}
+	if f.allowed(t, svc, "", PermRoleRead) {
+		t.Fatalf("service allowed outside its grant")
+	}
+	if f.allowed(t, &Principal{Kind: PrincipalMtls, Name: "batch"}, "", PermUserRead) {
+		t.Fatalf("service without roles allowed")
+	}
+	// 无条件授权不查询调用方属性
+	if f.users.Lookups != 0 {
+		t.Fatalf("unconditional grants looked up %d users", f.users.Lookups)
+	}
+}
+
+func TestAllowedUserDenyOverrides(t *testing.T) {
+	f := newAuthzFixture(t)
+	alice := &Principal{Kind: PrincipalUser, Name: "alice", UserId: 1}
+	bob := &Principal{Kind: PrincipalUser, Name: "bob", UserId: 2}
+
+	if !f.allowed(t, alice, "", PermUserDelete) {
+		t.Fatalf("user with direct role denied")
+	}
+	// 通过用户组继承的角色：iam 覆盖所有编码，但拒绝授权优先
+	if !f.allowed(t, bob, "", PermRoleRead) {
+		t.Fatalf("user with group role denied")
+	}
+	if f.allowed(t, bob, "", PermUserDelete) {
+		t.Fatalf("deny grant did not override allow")
+	}
+
+	// 已删除的权限对应的授权被忽略
+	f.permissions.Rows = slices.DeleteFunc(f.permissions.Rows, func(p *model.Permissions) bool { return p.Id == 12 })
+	if !f.allowed(t, bob, "", PermUserDelete) {
+		t.Fatalf("grant of a deleted permission still applied")
+	}
+}
+
+func TestAllowedCondition(t *testing.T) {
+	f := newAuthzFixture(t)
+	svc := &Principal{Kind: PrincipalService, Name: "portal", Roles: []string{"scoped"}}
+
+	if !f.allowed(t, svc, "/iam.UserService/GetUser", PermUserRead) {
+		t.Fatalf("condition on request.method not satisfied")
+	}
+	if f.allowed(t, svc, "/iam.UserService/ListUsers", PermUserRead) {
+		t.Fatalf("conditional grant allowed another method")
+	}
+
+	// 用户调用方的条件可以使用用户属性
+	f.rolePerms.Rows = append(f.rolePerms.Rows,
+		&model.RolePermissions{RoleId: roleReader, PermissionId: 12, Effect: model.EffectDeny, Condition: `user.username == "alice"`})
+	alice := &Principal{Kind: PrincipalUser, Name: "alice", UserId: 1}
+	if f.allowed(t, alice, "", PermUserDelete) {
+		t.Fatalf("conditional deny on user attributes ignored")
+	}
+	if f.users.Lookups == 0 {
+		t.Fatalf("user attributes were not loaded for a conditional grant")
+	}
+}
@@ -0,0 +1,51 @@
+package rpcauth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// servicePrefix IAM接口方法全名的前缀，健康检查和反射等其他接口不做认证
+const servicePrefix = "/iam."
+
+// UnaryServerInterceptor 认证IAM接口的调用方，并校验其拥有接口对应的内置权限编码
+func UnaryServerInterceptor(authn *Authenticator, authz *Authorizer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !strings.HasPrefix(info.FullMethod, servicePrefix) {
+			return handler(ctx, req)
+		}
+
+		principal, err := authn.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		code, ok := MethodPermissions[info.FullMethod]
+		if !ok {
+			return nil, status.Error(codes.PermissionDenied, "[RPA008] No permission is defined for the method")
+		}
+		allowed, err := authz.Allowed(ctx, principal, info.FullMethod, code)
+		if err != nil {
+			eInfo := "[RPA009] 校验调用方权限失败"
+			logx.WithContext(ctx).Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		if !allowed {
+			logx.WithContext(ctx).Infof("rpc auth denied %s for %s %s", info.FullMethod, principal.Kind, principal.Name)
+			return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("[RPA010] Permission required: %s", code))
+		}
+
+		ctx = WithPrincipal(ctx, principal)
+		ctx = logx.ContextWithFields(ctx,
+			logx.Field("principal", principal.Name),
+			logx.Field("principal_kind", principal.Kind),
+		)
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,196 @@
+package rpcauth
+
+import "github.com/ziptako/iam/iam"
+
+// 内置的IAM接口权限编码，与 db/init.sql 中初始化的权限一致
+const (
+	PermUserCreate           = "iam:user:create"
+	PermUserRead             = "iam:user:read"
+	PermUserUpdate           = "iam:user:update"
+	PermUserDelete           = "iam:user:delete"
+	PermUserAssignRole       = "iam:user:assign-role"
+	PermUserCheck            = "iam:user:check"
+	PermUserAuthenticate     = "iam:user:authenticate"
+	PermUserChangePassword   = "iam:user:change-password"
+	PermUserVerify           = "iam:user:verify"
+	PermUserSync             = "iam:user:sync"
+	PermUserImpersonate      = "iam:user:impersonate"
+	PermSessionCreate        = "iam:session:create"
+	PermSessionRead          = "iam:session:read"
+	PermSessionTouch         = "iam:session:touch"
+	PermSessionRevoke        = "iam:session:revoke"
+	PermAdminScopeRead       = "iam:admin-scope:read"
+	PermAdminScopeWrite      = "iam:admin-scope:write"
+	PermRoleCreate           = "iam:role:create"
+	PermRoleRead             = "iam:role:read"
+	PermRoleUpdate           = "iam:role:update"
+	PermRoleDelete           = "iam:role:delete"
+	PermRoleGrantPermission  = "iam:role:grant-permission"
+	PermRoleConstraintRead   = "iam:role-constraint:read"
+	PermRoleConstraintWrite  = "iam:role-constraint:write"
+	PermUsageRead            = "iam:usage:read"
+	PermPermissionCreate     = "iam:permission:create"
+	PermPermissionRead       = "iam:permission:read"
+	PermPermissionUpdate     = "iam:permission:update"
+	PermPermissionDelete     = "iam:permission:delete"
+	PermPermissionRegister   = "iam:permission:register"
+	PermPolicySimulate       = "iam:policy:simulate"
+	PermGroupCreate          = "iam:group:create"
+	PermGroupRead            = "iam:group:read"
+	PermGroupUpdate          = "iam:group:update"
+	PermGroupDelete          = "iam:group:delete"
+	PermGroupManageMembers   = "iam:group:manage-members"
+	PermGroupAssignRole      = "iam:group:assign-role"
+	PermBindingRead          = "iam:binding:read"
+	PermBindingWrite         = "iam:binding:write"
+	PermDepartmentCreate     = "iam:department:create"
+	PermDepartmentRead       = "iam:department:read"
+	PermDepartmentUpdate     = "iam:department:update"
+	PermDepartmentDelete     = "iam:department:delete"
+	PermDepartmentAssignUser = "iam:department:assign-user"
+	PermRelationRead         = "iam:relation:read"
+	PermRelationWrite        = "iam:relation:write"
+	PermRoleApproverRead     = "iam:role-approver:read"
+	PermRoleApproverWrite    = "iam:role-approver:write"
+	PermAccessRequestCreate  = "iam:access-request:create"
+	PermAccessRequestRead    = "iam:access-request:read"
+	PermAccessRequestApprove = "iam:access-request:approve"
+	PermAccessRequestCancel  = "iam:access-request:cancel"
+	PermAccessRequestComment = "iam:access-request:comment"
+	PermAccessReviewCreate   = "iam:access-review:create"
+	PermAccessReviewRead     = "iam:access-review:read"
+	PermAccessReviewDecide   = "iam:access-review:decide"
+	PermAccessReviewClose    = "iam:access-review:close"
+	PermPolicyExport         = "iam:policy:export"
+	PermPolicyPlan           = "iam:policy:plan"
+	PermPolicyApply          = "iam:policy:apply"
+)
+
+// MethodPermissions 接口方法全名到所需权限编码的映射，未列出的IAM接口一律拒绝调用
+var MethodPermissions = map[string]string{
+	// 用户服务
+	iam.UserService_CreateUser_FullMethodName:                  PermUserCreate,
+	iam.UserService_GetUser_FullMethodName:                     PermUserRead,
+	iam.UserService_UpdateUser_FullMethodName:                  PermUserUpdate,
+	iam.UserService_DeleteUser_FullMethodName:                  PermUserDelete,
+	iam.UserService_ListUsers_FullMethodName:                   PermUserRead,
+	iam.UserService_AssignUserRole_FullMethodName:              PermUserAssignRole,
+	iam.UserService_AssignUserRoles_FullMethodName:             PermUserAssignRole,
+	iam.UserService_ExtendUserRole_FullMethodName:              PermUserAssignRole,
+	iam.UserService_RemoveUserRole_FullMethodName:              PermUserAssignRole,
+	iam.UserService_RemoveUserRoles_FullMethodName:             PermUserAssignRole,
+	iam.UserService_GetUserRoles_FullMethodName:                PermUserRead,
+	iam.UserService_CheckUserRole_FullMethodName:               PermUserCheck,
+	iam.UserService_CheckUserPermission_FullMethodName:         PermUserCheck,
+	iam.UserService_CheckUserResourcePermission_FullMethodName: PermUserCheck,
+	iam.UserService_GetUserByUsername_FullMethodName:           PermUserRead,
+	iam.UserService_VerifyPassword_FullMethodName:              PermUserAuthenticate,
+	iam.UserService_ChangePassword_FullMethodName:              PermUserChangePassword,
+	iam.UserService_SyncLdapUsers_FullMethodName:               PermUserSync,
+	iam.UserService_CreateSession_FullMethodName:               PermSessionCreate,
+	iam.UserService_TouchSession_FullMethodName:                PermSessionTouch,
+	iam.UserService_ListUserSessions_FullMethodName:            PermSessionRead,
+	iam.UserService_RevokeSession_FullMethodName:               PermSessionRevoke,
+	iam.UserService_RevokeAllUserSessions_FullMethodName:       PermSessionRevoke,
+	iam.UserService_Impersonate_FullMethodName:                 PermUserImpersonate,
+	iam.UserService_SendVerification_FullMethodName:            PermUserVerify,
+	iam.UserService_ConfirmVerification_FullMethodName:         PermUserVerify,
+	iam.UserService_Authenticate_FullMethodName:                PermUserAuthenticate,
+	iam.UserService_GetUserDataScope_FullMethodName:            PermUserCheck,
+	iam.UserService_GetUserPermissions_FullMethodName:          PermUserCheck,
+	iam.UserService_SetAdminScope_FullMethodName:               PermAdminScopeWrite,
+	iam.UserService_GetAdminScope_FullMethodName:               PermAdminScopeRead,
+	iam.UserService_DeleteAdminScope_FullMethodName:            PermAdminScopeWrite,
+	iam.UserService_ExplainUserPermission_FullMethodName:       PermUserCheck,
+	iam.UserService_SimulateChange_FullMethodName:              PermPolicySimulate,
+
+	// 角色服务
+	iam.RoleService_CreateRole_FullMethodName:            PermRoleCreate,
+	iam.RoleService_GetRole_FullMethodName:               PermRoleRead,
+	iam.RoleService_UpdateRole_FullMethodName:            PermRoleUpdate,
+	iam.RoleService_DeleteRole_FullMethodName:            PermRoleDelete,
+	iam.RoleService_ListRoles_FullMethodName:             PermRoleRead,
+	iam.RoleService_AssignRolePermission_FullMethodName:  PermRoleGrantPermission,
+	iam.RoleService_AssignRolePermissions_FullMethodName: PermRoleGrantPermission,
+	iam.RoleService_RemoveRolePermission_FullMethodName:  PermRoleGrantPermission,
+	iam.RoleService_RemoveRolePermissions_FullMethodName: PermRoleGrantPermission,
+	iam.RoleService_GetRolePermissions_FullMethodName:    PermRoleRead,
+	iam.RoleService_CheckRolePermission_FullMethodName:   PermRoleRead,
+	iam.RoleService_SetRoleDataScope_FullMethodName:      PermRoleUpdate,
+	iam.RoleService_CreateRoleConstraint_FullMethodName:  PermRoleConstraintWrite,
+	iam.RoleService_DeleteRoleConstraint_FullMethodName:  PermRoleConstraintWrite,
+	iam.RoleService_ListRoleConstraints_FullMethodName:   PermRoleConstraintRead,
+	iam.RoleService_ValidateConstraints_FullMethodName:   PermRoleConstraintRead,
+	iam.RoleService_ListUnusedGrants_FullMethodName:      PermUsageRead,
+	iam.RoleService_ListUnusedRoles_FullMethodName:       PermUsageRead,
+	iam.RoleService_RecommendRoleTrim_FullMethodName:     PermUsageRead,
+
+	// 权限服务
+	iam.PermissionService_CreatePermission_FullMethodName:    PermPermissionCreate,
+	iam.PermissionService_GetPermission_FullMethodName:       PermPermissionRead,
+	iam.PermissionService_UpdatePermission_FullMethodName:    PermPermissionUpdate,
+	iam.PermissionService_DeletePermission_FullMethodName:    PermPermissionDelete,
+	iam.PermissionService_ListPermissions_FullMethodName:     PermPermissionRead,
+	iam.PermissionService_RegisterPermissions_FullMethodName: PermPermissionRegister,
+
+	// 用户组服务
+	iam.GroupService_CreateGroup_FullMethodName:        PermGroupCreate,
+	iam.GroupService_GetGroup_FullMethodName:           PermGroupRead,
+	iam.GroupService_UpdateGroup_FullMethodName:        PermGroupUpdate,
+	iam.GroupService_DeleteGroup_FullMethodName:        PermGroupDelete,
+	iam.GroupService_ListGroups_FullMethodName:         PermGroupRead,
+	iam.GroupService_AddGroupMembers_FullMethodName:    PermGroupManageMembers,
+	iam.GroupService_RemoveGroupMembers_FullMethodName: PermGroupManageMembers,
+	iam.GroupService_ListGroupMembers_FullMethodName:   PermGroupRead,
+	iam.GroupService_ListUserGroups_FullMethodName:     PermGroupRead,
+	iam.GroupService_AssignGroupRoles_FullMethodName:   PermGroupAssignRole,
+	iam.GroupService_RemoveGroupRoles_FullMethodName:   PermGroupAssignRole,
+	iam.GroupService_GetGroupRoles_FullMethodName:      PermGroupRead,
+
+	// 资源绑定服务
+	iam.BindingService_CreateResourceBinding_FullMethodName: PermBindingWrite,
+	iam.BindingService_DeleteResourceBinding_FullMethodName: PermBindingWrite,
+	iam.BindingService_ListResourceAccess_FullMethodName:    PermBindingRead,
+	iam.BindingService_ListUserResources_FullMethodName:     PermBindingRead,
+
+	// 部门服务
+	iam.DepartmentService_CreateDepartment_FullMethodName:  PermDepartmentCreate,
+	iam.DepartmentService_GetDepartment_FullMethodName:     PermDepartmentRead,
+	iam.DepartmentService_UpdateDepartment_FullMethodName:  PermDepartmentUpdate,
+	iam.DepartmentService_DeleteDepartment_FullMethodName:  PermDepartmentDelete,
+	iam.DepartmentService_ListDepartments_FullMethodName:   PermDepartmentRead,
+	iam.DepartmentService_SetUserDepartment_FullMethodName: PermDepartmentAssignUser,
+
+	// 关系服务
+	iam.RelationService_WriteRelationTuples_FullMethodName:  PermRelationWrite,
+	iam.RelationService_DeleteRelationTuples_FullMethodName: PermRelationWrite,
+	iam.RelationService_Check_FullMethodName:                PermRelationRead,
+	iam.RelationService_Expand_FullMethodName:               PermRelationRead,
+	iam.RelationService_ListObjects_FullMethodName:          PermRelationRead,
+	iam.RelationService_ListSubjects_FullMethodName:         PermRelationRead,
+
+	// 访问申请服务
+	iam.AccessRequestService_SetRoleApprovers_FullMethodName:     PermRoleApproverWrite,
+	iam.AccessRequestService_GetRoleApprovers_FullMethodName:     PermRoleApproverRead,
+	iam.AccessRequestService_CreateAccessRequest_FullMethodName:  PermAccessRequestCreate,
+	iam.AccessRequestService_GetAccessRequest_FullMethodName:     PermAccessRequestRead,
+	iam.AccessRequestService_ListAccessRequests_FullMethodName:   PermAccessRequestRead,
+	iam.AccessRequestService_ApproveAccessRequest_FullMethodName: PermAccessRequestApprove,
+	iam.AccessRequestService_RejectAccessRequest_FullMethodName:  PermAccessRequestApprove,
+	iam.AccessRequestService_CancelAccessRequest_FullMethodName:  PermAccessRequestCancel,
+	iam.AccessRequestService_CommentAccessRequest_FullMethodName: PermAccessRequestComment,
+
+	// 访问复核服务
+	iam.AccessReviewService_CreateReviewCampaign_FullMethodName: PermAccessReviewCreate,
+	iam.AccessReviewService_GetReviewCampaign_FullMethodName:    PermAccessReviewRead,
+	iam.AccessReviewService_ListReviewCampaigns_FullMethodName:  PermAccessReviewRead,
+	iam.AccessReviewService_ListReviewItems_FullMethodName:      PermAccessReviewRead,
+	iam.AccessReviewService_ExportReviewReport_FullMethodName:   PermAccessReviewRead,
+	iam.AccessReviewService_DecideReviewItem_FullMethodName:     PermAccessReviewDecide,
+	iam.AccessReviewService_CloseReviewCampaign_FullMethodName:  PermAccessReviewClose,
+
+	// 策略服务
+	iam.PolicyService_ExportPolicy_FullMethodName: PermPolicyExport,
+	iam.PolicyService_PlanPolicy_FullMethodName:   PermPolicyPlan,
+	iam.PolicyService_ApplyPolicy_FullMethodName:  PermPolicyApply,
+}
@@ -0,0 +1,32 @@
+package rpcauth
+
+import "context"
+
+// 调用方类型
+const (
+	PrincipalService = "service" // 通过服务令牌认证的服务
+	PrincipalMtls    = "mtls"    // 通过mTLS客户端证书认证的服务
+	PrincipalUser    = "user"    // 通过用户令牌认证的用户
+)
+
+// Principal 已认证的调用方
+type Principal struct {
+	Kind      string   // 调用方类型
+	Name      string   // 服务名称或用户名
+	UserId    int64    // 用户ID，仅用户调用方有效
+	SessionId int64    // 会话ID，仅用户调用方有效
+	Roles     []string // 服务拥有的角色编码，仅服务调用方有效
+}
+
+type principalKey struct{}
+
+// WithPrincipal 将调用方写入上下文
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext 读取当前调用的调用方，未启用认证时返回false
+func FromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
+}
@@ -0,0 +1,39 @@
+package rpcauth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+
+	"github.com/ziptako/iam/internal/config"
+	"google.golang.org/grpc/credentials"
+)
+
+// MtlsEnabled 是否配置了mTLS所需的证书文件
+func MtlsEnabled(c config.RpcAuthConf) bool {
+	return c.CertFile != "" && c.KeyFile != "" && c.ClientCAFile != ""
+}
+
+// ServerCredentials 创建mTLS服务端凭证
+// 客户端证书为可选：出示的证书必须由ClientCAFile签发，未出示证书的调用方可以改用令牌认证
+func ServerCredentials(c config.RpcAuthConf) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	caPem, err := os.ReadFile(c.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPem) {
+		return nil, errors.New("no client CA certificate found in " + c.ClientCAFile)
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
@@ -0,0 +1,74 @@
+package rpcauth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/internal/config"
+)
+
+func TestTokenRoundTrip(t *testing.T) {
+	issuer := NewTokenIssuer(config.RpcAuthConf{TokenSecret: "secret", TokenTTL: 60})
+	now := time.Now()
+	token, expiresAt, err := issuer.Issue(7, 3, 1, now)
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	if !expiresAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("expiresAt = %v, want %v", expiresAt, now.Add(time.Minute))
+	}
+	claims, err := issuer.Parse(token)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if claims.SessionId != 7 || claims.UserId != 3 || claims.TenantId != 1 {
+		t.Fatalf("unexpected claims %+v", claims)
+	}
+}
+
+func TestTokenRejected(t *testing.T) {
+	issuer := NewTokenIssuer(config.RpcAuthConf{TokenSecret: "secret", TokenTTL: 60})
+
+	other, _, err := NewTokenIssuer(config.RpcAuthConf{TokenSecret: "other", TokenTTL: 60}).Issue(7, 3, 1, time.Now())
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	if _, err := issuer.Parse(other); err == nil {
+		t.Fatalf("token signed with another secret accepted")
+	}
+
+	expired, _, err := issuer.Issue(7, 3, 1, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	if _, err := issuer.Parse(expired); err == nil {
+		t.Fatalf("expired token accepted")
+	}
+
+	// 缺少会话、用户或租户的令牌视为格式错误
+	malformed, _, err := issuer.Issue(0, 3, 1, time.Now())
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	if _, err := issuer.Parse(malformed); err == nil {
+		t.Fatalf("token without session accepted")
+	}
+
+	if _, err := issuer.Parse("not-a-token"); err == nil {
+		t.Fatalf("garbage token accepted")
+	}
+}
+
+func TestTokenDisabled(t *testing.T) {
+	issuer := NewTokenIssuer(config.RpcAuthConf{TokenTTL: 60})
+	if issuer.Enabled() {
+		t.Fatalf("issuer without secret is enabled")
+	}
+	if _, _, err := issuer.Issue(7, 3, 1, time.Now()); !errors.Is(err, ErrTokenDisabled) {
+		t.Fatalf("Issue = %v, want ErrTokenDisabled", err)
+	}
+	if _, err := issuer.Parse("token"); !errors.Is(err, ErrTokenDisabled) {
+		t.Fatalf("Parse = %v, want ErrTokenDisabled", err)
+	}
+}
@@ -0,0 +1,361 @@
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// 过滤表达式语法（RFC 7644 3.4.2.2 的子集）：
+//
+//	filter = or
+//	or     = and *("or" and)
+//	and    = unary *("and" unary)
+//	unary  = "not" "(" filter ")" / "(" filter ")" / attrPath "pr" / attrPath op value
+//	op     = "eq" / "ne" / "co" / "sw" / "ew" / "gt" / "ge" / "lt" / "le"
+//	value  = string / number / "true" / "false" / "null"
+
+type (
+	filterExpr interface{ isFilter() }
+
+	logicalExpr struct {
+		op          string // and / or
+		left, right filterExpr
+	}
+
+	notExpr struct {
+		expr filterExpr
+	}
+
+	compareExpr struct {
+		attr  string
+		op    string
+		value any // string, float64, bool 或 nil
+	}
+)
+
+func (logicalExpr) isFilter() {}
+func (notExpr) isFilter()     {}
+func (compareExpr) isFilter() {}
+
+// parseFilter 解析SCIM过滤表达式
+func parseFilter(input string) (filterExpr, error) {
+	p := &filterParser{tokens: tokenize(input)}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token %q", p.tokens[p.pos])
+	}
+	return expr, nil
+}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *filterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *filterParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *filterParser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalExpr{op: "or", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalExpr{op: "and", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterExpr, error) {
+	tok := p.next()
+	switch {
+	case tok == "":
+		return nil, fmt.Errorf("unexpected end of filter")
+	case strings.EqualFold(tok, "not"):
+		if p.next() != "(" {
+			return nil, fmt.Errorf("expected ( after not")
+		}
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("missing )")
+		}
+		return notExpr{expr: expr}, nil
+	case tok == "(":
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("missing )")
+		}
+		return expr, nil
+	}
+
+	attr := tok
+	op := strings.ToLower(p.next())
+	if op == "pr" {
+		return compareExpr{attr: attr, op: op}, nil
+	}
+	switch op {
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("unsupported operator %q", op)
+	}
+
+	raw := p.next()
+	if raw == "" {
+		return nil, fmt.Errorf("missing value for %s", attr)
+	}
+	value, err := parseValue(raw)
+	if err != nil {
+		return nil, err
+	}
+	return compareExpr{attr: attr, op: op, value: value}, nil
+}
+
+func parseValue(raw string) (any, error) {
+	switch {
+	case strings.HasPrefix(raw, `"`):
+		return strconv.Unquote(raw)
+	case strings.EqualFold(raw, "true"):
+		return true, nil
+	case strings.EqualFold(raw, "false"):
+		return false, nil
+	case strings.EqualFold(raw, "null"):
+		return nil, nil
+	}
+	n, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q", raw)
+	}
+	return n, nil
+}
+
+// tokenize 将过滤表达式拆分为词法单元，字符串保留引号
+func tokenize(input string) []string {
+	var tokens []string
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, string(r))
+			i++
+		case r == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != '"' {
+				if runes[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(runes) {
+				j = len(runes) - 1
+			}
+			tokens = append(tokens, string(runes[i:j+1]))
+			i = j + 1
+		default:
+			j := i
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && runes[j] != '(' && runes[j] != ')' {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		}
+	}
+	return tokens
+}
+
+// attrKind 属性的取值类型
+type attrKind int
+
+const (
+	kindString attrKind = iota
+	kindInt
+	kindTime
+)
+
+// attrMapping 描述SCIM属性到数据库条件的映射
+type attrMapping struct {
+	column          string
+	kind            attrKind
+	caseInsensitive bool
+	// custom 不为nil时由其生成条件，用于无法直接映射到列的属性
+	custom func(op string, value any, bind func(any) string) (string, error)
+}
+
+// sqlBuilder 将过滤表达式编译为SQL条件
+type sqlBuilder struct {
+	attrs map[string]attrMapping // key为小写的属性路径
+	args  []any
+}
+
+func (b *sqlBuilder) bind(v any) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+func (b *sqlBuilder) compile(expr filterExpr) (string, error) {
+	switch e := expr.(type) {
+	case logicalExpr:
+		left, err := b.compile(e.left)
+		if err != nil {
+			return "", err
+		}
+		right, err := b.compile(e.right)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s %s %s)", left, e.op, right), nil
+	case notExpr:
+		inner, err := b.compile(e.expr)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(not %s)", inner), nil
+	case compareExpr:
+		return b.compileCompare(e)
+	}
+	return "", fmt.Errorf("unknown expression")
+}
+
+func (b *sqlBuilder) compileCompare(e compareExpr) (string, error) {
+	m, ok := b.attrs[strings.ToLower(e.attr)]
+	if !ok {
+		return "", fmt.Errorf("unsupported filter attribute %q", e.attr)
+	}
+	if m.custom != nil {
+		return m.custom(e.op, e.value, b.bind)
+	}
+
+	col := m.column
+	if e.op == "pr" {
+		if m.kind == kindString {
+			return fmt.Sprintf("(%s IS NOT NULL and %s <> '')", col, col), nil
+		}
+		return fmt.Sprintf("%s IS NOT NULL", col), nil
+	}
+	if e.value == nil {
+		switch e.op {
+		case "eq":
+			return fmt.Sprintf("%s IS NULL", col), nil
+		case "ne":
+			return fmt.Sprintf("%s IS NOT NULL", col), nil
+		}
+		return "", fmt.Errorf("operator %s does not accept null", e.op)
+	}
+
+	switch m.kind {
+	case kindInt:
+		var n int64
+		switch v := e.value.(type) {
+		case float64:
+			n = int64(v)
+		case string:
+			parsed, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				// 非数字的ID不会匹配任何资源
+				return "false", nil
+			}
+			n = parsed
+		default:
+			return "", fmt.Errorf("invalid value for %s", e.attr)
+		}
+		return compareOp(col, e.op, b.bind(n))
+	case kindTime:
+		s, ok := e.value.(string)
+		if !ok {
+			return "", fmt.Errorf("invalid value for %s", e.attr)
+		}
+		return compareOp(col, e.op, b.bind(s)+"::timestamptz")
+	}
+
+	s, ok := e.value.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid value for %s", e.attr)
+	}
+	if m.caseInsensitive {
+		col = "lower(" + col + ")"
+		s = strings.ToLower(s)
+	}
+	switch e.op {
+	case "co":
+		return fmt.Sprintf("%s LIKE %s", col, b.bind("%"+escapeLike(s)+"%")), nil
+	case "sw":
+		return fmt.Sprintf("%s LIKE %s", col, b.bind(escapeLike(s)+"%")), nil
+	case "ew":
+		return fmt.Sprintf("%s LIKE %s", col, b.bind("%"+escapeLike(s))), nil
+	}
+	return compareOp(col, e.op, b.bind(s))
+}
+
+func compareOp(col, op, placeholder string) (string, error) {
+	sqlOps := map[string]string{"eq": "=", "ne": "<>", "gt": ">", "ge": ">=", "lt": "<", "le": "<="}
+	sqlOp, ok := sqlOps[op]
+	if !ok {
+		return "", fmt.Errorf("operator %s is not supported for this attribute", op)
+	}
+	return fmt.Sprintf("%s %s %s", col, sqlOp, placeholder), nil
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// buildConditions 解析过滤表达式并生成模型层条件
+func buildConditions(filter string, attrs map[string]attrMapping) ([]string, []any, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil, nil
+	}
+	expr, err := parseFilter(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	b := &sqlBuilder{attrs: attrs}
+	cond, err := b.compile(expr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []string{cond}, b.args, nil
+}
@@ -0,0 +1,99 @@
+package scim
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	got := tokenize(`userName eq "a \"b\" c" and (active eq true)`)
+	want := []string{"userName", "eq", `"a \"b\" c"`, "and", "(", "active", "eq", "true", ")"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("tokenize = %q, want %q", got, want)
+	}
+}
+
+func TestBuildUserConditions(t *testing.T) {
+	tests := []struct {
+		filter string
+		cond   string
+		args   []any
+	}{
+		{`userName eq "Alice"`, "lower(username) = $1", []any{"alice"}},
+		{`USERNAME Eq "alice"`, "lower(username) = $1", []any{"alice"}},
+		{`externalId eq "x-1"`, "external_id = $1", []any{"x-1"}},
+		{`emails.value co "50%_"`, "lower(email) LIKE $1", []any{`%50\%\_%`}},
+		{`displayName sw "Al"`, "nickname LIKE $1", []any{"Al%"}},
+		{`id eq "12"`, "id = $1", []any{int64(12)}},
+		{`id eq "abc"`, "false", nil},
+		{`id gt 3`, "id > $1", []any{int64(3)}},
+		{`externalId pr`, "(external_id IS NOT NULL and external_id <> '')", nil},
+		{`externalId eq null`, "external_id IS NULL", nil},
+		{`active eq true`, "disabled_at IS NULL", nil},
+		{`active ne true`, "disabled_at IS NOT NULL", nil},
+		{`meta.lastModified gt "2024-01-01T00:00:00Z"`, "updated_at > $1::timestamptz", []any{"2024-01-01T00:00:00Z"}},
+		{`userName eq "a" or userName eq "b" and active eq false`,
+			"(lower(username) = $1 or (lower(username) = $2 and disabled_at IS NOT NULL))", []any{"a", "b"}},
+		{`not (userName eq "a")`, "(not lower(username) = $1)", []any{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			conds, args, err := buildConditions(tt.filter, userAttrs)
+			if err != nil {
+				t.Fatalf("buildConditions: %v", err)
+			}
+			if len(conds) != 1 || conds[0] != tt.cond {
+				t.Fatalf("conditions = %q, want %q", conds, tt.cond)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Fatalf("args = %#v, want %#v", args, tt.args)
+			}
+		})
+	}
+}
+
+func TestBuildConditionsEmpty(t *testing.T) {
+	conds, args, err := buildConditions("  ", userAttrs)
+	if err != nil || conds != nil || args != nil {
+		t.Fatalf("empty filter = %v, %v, %v", conds, args, err)
+	}
+}
+
+func TestBuildConditionsRejects(t *testing.T) {
+	for _, filter := range []string{
+		`userName`,
+		`userName eq`,
+		`userName like "a"`,
+		`password eq "secret"`,
+		`(userName eq "a"`,
+		`userName eq "a" userName`,
+		`not userName eq "a"`,
+		`userName eq 'a'`,
+		`userName co null`,
+		`userName eq 1`,
+		`active eq "yes"`,
+		`meta.created gt 1`,
+	} {
+		t.Run(filter, func(t *testing.T) {
+			if _, _, err := buildConditions(filter, userAttrs); err == nil {
+				t.Fatalf("buildConditions(%q) succeeded", filter)
+			}
+		})
+	}
+}
+
+func TestBuildGroupMemberConditions(t *testing.T) {
+	conds, args, err := buildConditions(`members.value eq "7"`, groupAttrs)
+	if err != nil {
+		t.Fatalf("buildConditions: %v", err)
+	}
+	if !reflect.DeepEqual(args, []any{int64(7)}) || conds[0] == "false" {
+		t.Fatalf("members filter = %q %v", conds, args)
+	}
+	if conds, _, _ = buildConditions(`members eq "x"`, groupAttrs); conds[0] != "false" {
+		t.Fatalf("non-numeric member id matched: %q", conds)
+	}
+	if _, _, err = buildConditions(`members co "7"`, groupAttrs); err == nil {
+		t.Fatalf("members co accepted")
+	}
+}
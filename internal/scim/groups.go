@@ -0,0 +1,516 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/sod"
+)
+
+// roleCodeMaxLen 角色编码最大长度，与roles.code列定义一致
+const roleCodeMaxLen = 50
+
+// groupAttrs 组过滤属性到数据库列的映射
+var groupAttrs = map[string]attrMapping{
+	"id":                {column: "id", kind: kindInt},
+	"displayname":       {column: "name", caseInsensitive: true},
+	"externalid":        {column: "code"},
+	"meta.created":      {column: "created_at", kind: kindTime},
+	"meta.lastmodified": {column: "updated_at", kind: kindTime},
+	"members":           {custom: memberCondition},
+	"members.value":     {custom: memberCondition},
+}
+
+func memberCondition(op string, value any, bind func(any) string) (string, error) {
+	if op == "pr" {
+		return "id IN (select role_id from iam.user_roles where " + model.GrantEffectiveClause + ")", nil
+	}
+	s, ok := value.(string)
+	if op != "eq" || !ok {
+		return "", errors.New("members only supports eq with string value")
+	}
+	userId, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return "false", nil
+	}
+	return "id IN (select role_id from iam.user_roles where user_id = " + bind(userId) + " and " + model.GrantEffectiveClause + ")", nil
+}
+
+// toGroup 将角色转换为SCIM组资源，withMembers为false时不查询成员
+func (s *Server) toGroup(r *http.Request, role *model.Roles, withMembers bool) (*Group, error) {
+	res := &Group{
+		Schemas:     []string{SchemaGroup},
+		Id:          strconv.FormatInt(role.Id, 10),
+		ExternalId:  role.Code,
+		DisplayName: role.Name,
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      formatTime(role.CreatedAt),
+			LastModified: formatTime(role.UpdatedAt),
+			Location:     s.location(r, "Groups", role.Id),
+			Version:      etag(role.UpdatedAt),
+		},
+	}
+	if !withMembers {
+		return res, nil
+	}
+
+	userRoles, err := s.userRolesModel.FindUsersByRoleId(r.Context(), role.Id)
+	if err != nil {
+		return nil, err
+	}
+	for _, ur := range userRoles {
+		u, err := s.usersModel.FindById(r.Context(), ur.UserId)
+		if errors.Is(err, model.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		res.Members = append(res.Members, Member{
+			Value:   strconv.FormatInt(u.Id, 10),
+			Display: u.Username,
+			Ref:     s.location(r, "Users", u.Id),
+		})
+	}
+	return res, nil
+}
+
+// excludesMembers 判断请求是否通过excludedAttributes排除成员列表
+func excludesMembers(r *http.Request) bool {
+	for _, attr := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attr), "members") {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) {
+	conditions, args, err := buildConditions(r.URL.Query().Get("filter"), groupAttrs)
+	if err != nil {
+		writeError(w, newError(http.StatusBadRequest, "invalidFilter", err.Error()))
+		return
+	}
+	startIndex, count := pagination(r)
+
+	total, err := s.rolesModel.CountActiveWithConditions(r.Context(), conditions, args)
+	if err != nil {
+		internalError(w, "count groups failed", err)
+		return
+	}
+	resources := make([]any, 0, count)
+	if count > 0 {
+		roles, err := s.rolesModel.FindActiveWithConditions(r.Context(), conditions, args, count, startIndex-1)
+		if err != nil {
+			internalError(w, "query groups failed", err)
+			return
+		}
+		for _, role := range roles {
+			group, err := s.toGroup(r, role, !excludesMembers(r))
+			if err != nil {
+				internalError(w, "query group members failed", err)
+				return
+			}
+			resources = append(resources, group)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request) {
+	role, e := s.loadRole(r)
+	if e != nil {
+		writeError(w, e)
+		return
+	}
+	if !checkPrecondition(w, r, etag(role.UpdatedAt)) {
+		return
+	}
+	s.writeGroup(w, r, http.StatusOK, role.Id)
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	var in Group
+	if e := decodeBody(r, &in); e != nil {
+		writeError(w, e)
+		return
+	}
+	role := &model.Roles{}
+	if e := s.saveGroup(r.Context(), role, &in, nil); e != nil {
+		writeError(w, e)
+		return
+	}
+	s.writeGroup(w, r, http.StatusCreated, role.Id)
+}
+
+func (s *Server) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	role, e := s.loadRole(r)
+	if e != nil {
+		writeError(w, e)
+		return
+	}
+	if !checkPrecondition(w, r, etag(role.UpdatedAt)) {
+		return
+	}
+	var in Group
+	if e := decodeBody(r, &in); e != nil {
+		writeError(w, e)
+		return
+	}
+	current, err := s.toGroup(r, role, true)
+	if err != nil {
+		internalError(w, "query group members failed", err)
+		return
+	}
+	if e := s.saveGroup(r.Context(), role, &in, current.Members); e != nil {
+		writeError(w, e)
+		return
+	}
+	s.writeGroup(w, r, http.StatusOK, role.Id)
+}
+
+func (s *Server) patchGroup(w http.ResponseWriter, r *http.Request) {
+	role, e := s.loadRole(r)
+	if e != nil {
+		writeError(w, e)
+		return
+	}
+	if !checkPrecondition(w, r, etag(role.UpdatedAt)) {
+		return
+	}
+	var req PatchRequest
+	if e := decodeBody(r, &req); e != nil {
+		writeError(w, e)
+		return
+	}
+
+	current, err := s.toGroup(r, role, true)
+	if err != nil {
+		internalError(w, "query group members failed", err)
+		return
+	}
+	desired := *current
+	desired.Members = append([]Member(nil), current.Members...)
+	for _, op := range req.Operations {
+		if e := applyGroupOp(&desired, op); e != nil {
+			writeError(w, e)
+			return
+		}
+	}
+	if e := s.saveGroup(r.Context(), role, &desired, current.Members); e != nil {
+		writeError(w, e)
+		return
+	}
+	s.writeGroup(w, r, http.StatusOK, role.Id)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	role, e := s.loadRole(r)
+	if e != nil {
+		writeError(w, e)
+		return
+	}
+	if !checkPrecondition(w, r, etag(role.UpdatedAt)) {
+		return
+	}
+	if err := s.userRolesModel.RemoveAllRoleUsers(r.Context(), role.Id); err != nil {
+		internalError(w, "remove group members failed", err)
+		return
+	}
+	if err := s.rolePermissionsModel.RemoveAllRolePermissions(r.Context(), role.Id); err != nil {
+		internalError(w, "remove group permissions failed", err)
+		return
+	}
+	if err := s.rolesModel.SoftDelete(r.Context(), role.Id); err != nil {
+		internalError(w, "delete group failed", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) writeGroup(w http.ResponseWriter, r *http.Request, code int, id int64) {
+	role, err := s.rolesModel.FindActiveById(r.Context(), id)
+	if err != nil {
+		internalError(w, "load group failed", err)
+		return
+	}
+	res, err := s.toGroup(r, role, !excludesMembers(r))
+	if err != nil {
+		internalError(w, "query group members failed", err)
+		return
+	}
+	writeResource(w, code, res.Meta, res)
+}
+
+func (s *Server) loadRole(r *http.Request) (*model.Roles, *Error) {
+	id, e := pathId(r)
+	if e != nil {
+		return nil, e
+	}
+	role, err := s.rolesModel.FindActiveById(r.Context(), id)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, newError(http.StatusNotFound, "", "group "+r.PathValue("id")+" not found")
+	}
+	if err != nil {
+		return nil, newError(http.StatusInternalServerError, "", "query group failed")
+	}
+	return role, nil
+}
+
+// saveGroup 保存组属性并按差异同步成员，role.Id为0时新建
+// 在职责分离锁内完成约束校验和成员写入，避免与并发的授予交错
+func (s *Server) saveGroup(ctx context.Context, role *model.Roles, in *Group, currentMembers []Member) *Error {
+	var failed *Error
+	err := s.constraints.Locked(ctx, func(ctx context.Context) error {
+		if failed = s.storeGroup(ctx, role, in, currentMembers); failed != nil {
+			return failed
+		}
+		return nil
+	})
+	if failed != nil {
+		return failed
+	}
+	if err != nil {
+		return newError(http.StatusInternalServerError, "", "lock constraints failed")
+	}
+	return nil
+}
+
+// storeGroup 校验并写入组属性和成员差异，需在职责分离锁内调用
+func (s *Server) storeGroup(ctx context.Context, role *model.Roles, in *Group, currentMembers []Member) *Error {
+	name := strings.TrimSpace(in.DisplayName)
+	if name == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	if len([]rune(name)) > roleCodeMaxLen {
+		return newError(http.StatusBadRequest, "invalidValue", "displayName is too long")
+	}
+	code := strings.TrimSpace(in.ExternalId)
+	if code == "" {
+		code = role.Code
+	}
+	if code == "" {
+		code = slugify(name)
+	}
+	if len(code) > roleCodeMaxLen {
+		return newError(http.StatusBadRequest, "invalidValue", "externalId is too long")
+	}
+
+	// 解析成员ID并校验用户存在
+	desired := make(map[int64]struct{}, len(in.Members))
+	for _, m := range in.Members {
+		userId, err := strconv.ParseInt(m.Value, 10, 64)
+		if err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "invalid member "+m.Value)
+		}
+		if _, err := s.usersModel.FindById(ctx, userId); err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return newError(http.StatusBadRequest, "invalidValue", "member "+m.Value+" not found")
+			}
+			return newError(http.StatusInternalServerError, "", "query member failed")
+		}
+		desired[userId] = struct{}{}
+	}
+
+	if name != role.Name {
+		exists, err := s.rolesModel.ExistsByName(ctx, name, role.Id)
+		if err != nil {
+			return newError(http.StatusInternalServerError, "", "check displayName failed")
+		}
+		if exists {
+			return newError(http.StatusConflict, "uniqueness", "displayName already exists")
+		}
+	}
+	if code != role.Code {
+		exists, err := s.rolesModel.ExistsByCode(ctx, code, role.Id)
+		if err != nil {
+			return newError(http.StatusInternalServerError, "", "check externalId failed")
+		}
+		if exists {
+			return newError(http.StatusConflict, "uniqueness", "externalId already exists")
+		}
+	}
+
+	// 新增成员需满足职责分离约束，违反时拒绝整个请求，新建的组尚无约束
+	current := make(map[int64]struct{}, len(currentMembers))
+	for _, m := range currentMembers {
+		if userId, err := strconv.ParseInt(m.Value, 10, 64); err == nil {
+			current[userId] = struct{}{}
+		}
+	}
+	if role.Id != 0 {
+		var grants []sod.Grant
+		for userId := range desired {
+			if _, ok := current[userId]; !ok {
+				grants = append(grants, sod.Grant{UserId: userId, RoleId: role.Id})
+			}
+		}
+		violation, err := s.constraints.Check(ctx, grants)
+		if err != nil {
+			return newError(http.StatusInternalServerError, "", "check constraints failed")
+		}
+		if violation != nil {
+			logx.WithContext(ctx).Infof("scim group %d membership rejected: %v", role.Id, violation)
+			return newError(http.StatusConflict, "", "separation of duty constraint violated: "+violation.Error())
+		}
+	}
+
+	changed := role.Id == 0 || name != role.Name || code != role.Code
+	role.Name, role.Code = name, code
+	switch {
+	case role.Id == 0:
+		if _, err := s.rolesModel.Insert(ctx, role); err != nil {
+			return newError(http.StatusInternalServerError, "", "create group failed")
+		}
+	case changed:
+		if err := s.rolesModel.Update(ctx, role); err != nil {
+			return newError(http.StatusInternalServerError, "", "update group failed")
+		}
+	}
+
+	// 成员差异同步
+	membersChanged := false
+	for userId := range desired {
+		if _, ok := current[userId]; ok {
+			continue
+		}
+		if err := s.userRolesModel.AssignRole(ctx, userId, role.Id, sql.NullInt64{}, model.Validity{}); err != nil {
+			return newError(http.StatusInternalServerError, "", "add group member failed")
+		}
+		membersChanged = true
+	}
+	for userId := range current {
+		if _, ok := desired[userId]; ok {
+			continue
+		}
+		if err := s.userRolesModel.RemoveRole(ctx, userId, role.Id); err != nil {
+			return newError(http.StatusInternalServerError, "", "remove group member failed")
+		}
+		membersChanged = true
+	}
+
+	// 成员变化也需要更新版本号
+	if membersChanged && !changed {
+		if err := s.rolesModel.Touch(ctx, role.Id); err != nil {
+			return newError(http.StatusInternalServerError, "", "update group version failed")
+		}
+	}
+	return nil
+}
+
+// applyGroupOp 在SCIM组资源上应用单个PATCH操作
+func applyGroupOp(g *Group, op PatchOperation) *Error {
+	kind := strings.ToLower(op.Op)
+	if kind != "add" && kind != "replace" && kind != "remove" {
+		return newError(http.StatusBadRequest, "invalidSyntax", "unsupported op "+op.Op)
+	}
+
+	if op.Path == "" {
+		if kind == "remove" {
+			return newError(http.StatusBadRequest, "noTarget", "remove requires path")
+		}
+		attrs, ok := op.Value.(map[string]any)
+		if !ok {
+			return newError(http.StatusBadRequest, "invalidValue", "value must be an object when path is omitted")
+		}
+		for k, v := range attrs {
+			if e := applyGroupOp(g, PatchOperation{Op: kind, Path: k, Value: v}); e != nil {
+				return e
+			}
+		}
+		return nil
+	}
+
+	path := strings.ToLower(op.Path)
+	switch {
+	case path == "displayname":
+		return setString(&g.DisplayName, op.Value, kind == "remove")
+	case path == "externalid":
+		if kind == "remove" {
+			return newError(http.StatusBadRequest, "mutability", "externalId cannot be removed")
+		}
+		return setString(&g.ExternalId, op.Value, false)
+	case path == "members":
+		var members []Member
+		if op.Value != nil {
+			if e := decodeValue(op.Value, &members); e != nil {
+				return e
+			}
+		}
+		switch kind {
+		case "add":
+			g.Members = append(g.Members, members...)
+		case "replace":
+			g.Members = members
+		case "remove":
+			if len(members) == 0 {
+				g.Members = nil
+			} else {
+				for _, m := range members {
+					g.Members = removeMember(g.Members, m.Value)
+				}
+			}
+		}
+	case strings.HasPrefix(path, "members["):
+		value, ok := memberFilterValue(op.Path)
+		if !ok || kind != "remove" {
+			return newError(http.StatusBadRequest, "invalidPath", "unsupported path "+op.Path)
+		}
+		g.Members = removeMember(g.Members, value)
+	case path == "schemas" || path == "id" || path == "meta":
+		// 只读属性忽略
+	default:
+		return newError(http.StatusBadRequest, "invalidPath", "unsupported path "+op.Path)
+	}
+	return nil
+}
+
+func removeMember(members []Member, value string) []Member {
+	res := members[:0]
+	for _, m := range members {
+		if m.Value != value {
+			res = append(res, m)
+		}
+	}
+	return res
+}
+
+// slugify 由组名生成角色编码，非字母数字字符替换为下划线
+func slugify(name string) string {
+	var b strings.Builder
+	lastUnderscore := false
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+			lastUnderscore = false
+			continue
+		}
+		if !lastUnderscore && b.Len() > 0 {
+			b.WriteByte('_')
+			lastUnderscore = true
+		}
+	}
+	code := strings.Trim(b.String(), "_")
+	if code == "" {
+		code = "group"
+	}
+	if len(code) > roleCodeMaxLen-5 {
+		code = code[:roleCodeMaxLen-5]
+	}
+	return "scim_" + code
+}
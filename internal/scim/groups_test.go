@@ -0,0 +1,52 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/internal/config"
+	"github.com/ziptako/iam/internal/sod"
+)
+
+// TestSaveGroupConstraints 角色10与13互斥，用户1已持有角色13
+func TestSaveGroupConstraints(t *testing.T) {
+	users := &modeltest.Users{Rows: []*model.Users{{Id: 1, Username: "alice"}, {Id: 2, Username: "bob"}}}
+	roles := &modeltest.Roles{Rows: []*model.Roles{{Id: 10, Name: "Makers", Code: "maker"}, {Id: 13, Name: "Checkers", Code: "checker"}}}
+	userRoles := &modeltest.UserRoles{Rows: []*model.UserRoles{{Id: 1, UserId: 1, RoleId: 13}}}
+	constraints := &modeltest.RoleConstraints{Rows: []*model.RoleConstraints{
+		{Id: 1, Code: "maker_checker", ConstraintType: model.RoleConstraintExclusive, Enforcement: model.RoleConstraintStatic, MaxCount: 1},
+	}}
+	constraintRoles := &modeltest.RoleConstraintRoles{Rows: []*model.RoleConstraintRoles{{ConstraintId: 1, RoleId: 10}, {ConstraintId: 1, RoleId: 13}}}
+	checker := sod.NewChecker(constraints, constraintRoles, userRoles, &modeltest.GroupRoles{Members: &modeltest.GroupMembers{}})
+	s := NewServer(config.ScimConf{}, users, roles, userRoles, nil, &modeltest.Sessions{}, checker)
+	ctx := context.Background()
+
+	makers, _ := roles.FindOne(ctx, 10)
+	err := s.saveGroup(ctx, makers, &Group{DisplayName: "Makers", Members: []Member{{Value: "1"}, {Value: "2"}}}, nil)
+	if err == nil || err.code != http.StatusConflict {
+		t.Fatalf("saveGroup with conflicting member = %+v, want conflict", err)
+	}
+	if ok, _ := userRoles.HasRole(ctx, 2, 10); ok {
+		t.Fatalf("member added despite violation")
+	}
+
+	if err = s.saveGroup(ctx, makers, &Group{DisplayName: "Makers", Members: []Member{{Value: "2"}}}, nil); err != nil {
+		t.Fatalf("saveGroup: %+v", err)
+	}
+	if ok, _ := userRoles.HasRole(ctx, 2, 10); !ok {
+		t.Fatalf("member 2 not added")
+	}
+
+	// 已是成员的用户不重复校验，移除成员不受约束
+	userRoles.Rows = append(userRoles.Rows, &model.UserRoles{Id: 9, UserId: 1, RoleId: 10})
+	current := []Member{{Value: "1"}, {Value: "2"}}
+	if err = s.saveGroup(ctx, makers, &Group{DisplayName: "Makers", Members: []Member{{Value: "1"}}}, current); err != nil {
+		t.Fatalf("saveGroup keeping existing member: %+v", err)
+	}
+	if ok, _ := userRoles.HasRole(ctx, 2, 10); ok {
+		t.Fatalf("member 2 not removed")
+	}
+}
@@ -0,0 +1,93 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// decodeValue 将PATCH操作中的任意值转换为目标结构
+func decodeValue(value any, target any) *Error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return newError(http.StatusBadRequest, "invalidValue", err.Error())
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return newError(http.StatusBadRequest, "invalidValue", err.Error())
+	}
+	return nil
+}
+
+func setString(target *string, value any, remove bool) *Error {
+	if remove {
+		*target = ""
+		return nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return newError(http.StatusBadRequest, "invalidValue", "value must be a string")
+	}
+	*target = s
+	return nil
+}
+
+// boolValue 兼容部分身份源以字符串传递布尔值（如 "True"）
+func boolValue(value any) (bool, *Error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		switch strings.ToLower(v) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+	return false, newError(http.StatusBadRequest, "invalidValue", "value must be a boolean")
+}
+
+// setMultiValue 设置多值属性，value可以是字符串、单个对象或对象数组
+func setMultiValue(target *[]MultiValue, value any, remove bool) *Error {
+	if remove {
+		*target = nil
+		return nil
+	}
+	switch v := value.(type) {
+	case string:
+		*target = []MultiValue{{Value: v, Primary: true}}
+		return nil
+	case map[string]any:
+		var mv MultiValue
+		if e := decodeValue(v, &mv); e != nil {
+			return e
+		}
+		*target = []MultiValue{mv}
+		return nil
+	}
+	var values []MultiValue
+	if e := decodeValue(value, &values); e != nil {
+		return e
+	}
+	*target = values
+	return nil
+}
+
+// memberFilterValue 解析 members[value eq "x"] 形式路径中的成员ID
+func memberFilterValue(path string) (string, bool) {
+	_, rest, ok := strings.Cut(path, "[")
+	if !ok {
+		return "", false
+	}
+	rest = strings.TrimSuffix(strings.TrimSpace(rest), "]")
+	expr, err := parseFilter(rest)
+	if err != nil {
+		return "", false
+	}
+	cmp, ok := expr.(compareExpr)
+	if !ok || !strings.EqualFold(cmp.attr, "value") || cmp.op != "eq" {
+		return "", false
+	}
+	s, ok := cmp.value.(string)
+	return s, ok
+}
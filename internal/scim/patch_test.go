@@ -0,0 +1,127 @@
+package scim
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestApplyUserOp(t *testing.T) {
+	u := &User{UserName: "alice", ExternalId: "ext-1", DisplayName: "Alice"}
+	ops := []PatchOperation{
+		{Op: "Replace", Path: "userName", Value: "alice2"},
+		{Op: "replace", Path: "active", Value: "False"},
+		{Op: "add", Path: `emails[type eq "work"].value`, Value: "alice@example.com"},
+		{Op: "remove", Path: "displayName"},
+		{Op: "replace", Path: "meta", Value: "ignored"},
+		// 无path时value中的每个属性单独应用
+		{Op: "replace", Value: map[string]any{"externalId": "ext-2", "phoneNumbers": []any{map[string]any{"value": "123", "primary": true}}}},
+	}
+	for _, op := range ops {
+		if e := applyUserOp(u, op); e != nil {
+			t.Fatalf("applyUserOp(%+v): %v", op, e)
+		}
+	}
+	if u.UserName != "alice2" || u.ExternalId != "ext-2" || u.DisplayName != "" {
+		t.Fatalf("unexpected user %+v", u)
+	}
+	if u.Active == nil || *u.Active {
+		t.Fatalf("active = %v, want false", u.Active)
+	}
+	if primaryValue(u.Emails) != "alice@example.com" || primaryValue(u.PhoneNumbers) != "123" {
+		t.Fatalf("emails = %+v, phoneNumbers = %+v", u.Emails, u.PhoneNumbers)
+	}
+}
+
+func TestApplyUserOpRejects(t *testing.T) {
+	tests := []struct {
+		op       PatchOperation
+		scimType string
+	}{
+		{PatchOperation{Op: "move", Path: "userName", Value: "x"}, "invalidSyntax"},
+		{PatchOperation{Op: "remove"}, "noTarget"},
+		{PatchOperation{Op: "add", Value: "x"}, "invalidValue"},
+		{PatchOperation{Op: "remove", Path: "active"}, "mutability"},
+		{PatchOperation{Op: "replace", Path: "active", Value: "yes"}, "invalidValue"},
+		{PatchOperation{Op: "replace", Path: "userName", Value: 1}, "invalidValue"},
+		{PatchOperation{Op: "replace", Path: "title", Value: "x"}, "invalidPath"},
+	}
+	for _, tt := range tests {
+		e := applyUserOp(&User{}, tt.op)
+		if e == nil || e.ScimType != tt.scimType {
+			t.Fatalf("applyUserOp(%+v) = %v, want %s", tt.op, e, tt.scimType)
+		}
+	}
+}
+
+func TestApplyGroupOp(t *testing.T) {
+	g := &Group{DisplayName: "Ops", Members: []Member{{Value: "1"}, {Value: "2"}}}
+	ops := []PatchOperation{
+		{Op: "add", Path: "members", Value: []any{map[string]any{"value": "3"}}},
+		{Op: "remove", Path: `members[value eq "1"]`},
+		{Op: "remove", Path: "members", Value: []any{map[string]any{"value": "2"}}},
+		{Op: "replace", Value: map[string]any{"displayName": "Operations"}},
+	}
+	for _, op := range ops {
+		if e := applyGroupOp(g, op); e != nil {
+			t.Fatalf("applyGroupOp(%+v): %v", op, e)
+		}
+	}
+	if g.DisplayName != "Operations" || !reflect.DeepEqual(g.Members, []Member{{Value: "3"}}) {
+		t.Fatalf("unexpected group %+v", g)
+	}
+
+	// 不带value的remove清空全部成员
+	if e := applyGroupOp(g, PatchOperation{Op: "remove", Path: "members"}); e != nil || g.Members != nil {
+		t.Fatalf("remove all members = %v, members %+v", e, g.Members)
+	}
+
+	for _, op := range []PatchOperation{
+		{Op: "add", Path: `members[value eq "1"]`},
+		{Op: "remove", Path: `members[display eq "x"]`},
+		{Op: "remove", Path: "externalId"},
+		{Op: "replace", Path: "owner", Value: "x"},
+	} {
+		if e := applyGroupOp(g, op); e == nil {
+			t.Fatalf("applyGroupOp(%+v) succeeded", op)
+		}
+	}
+}
+
+func TestMemberFilterValue(t *testing.T) {
+	tests := []struct {
+		path  string
+		value string
+		ok    bool
+	}{
+		{`members[value eq "42"]`, "42", true},
+		{`members[ Value eq "42" ]`, "42", true},
+		{`members[value ne "42"]`, "", false},
+		{`members[value eq "1" or value eq "2"]`, "", false},
+		{`members[value eq 42]`, "", false},
+		{`members`, "", false},
+	}
+	for _, tt := range tests {
+		value, ok := memberFilterValue(tt.path)
+		if value != tt.value || ok != tt.ok {
+			t.Fatalf("memberFilterValue(%q) = %q, %v", tt.path, value, ok)
+		}
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	tests := map[string]string{
+		"Sales Team":  "scim_sales_team",
+		"  R&D / Ops": "scim_r_d_ops",
+		"财务部":         "scim_group",
+		"Ops-2024!":   "scim_ops_2024",
+	}
+	for name, want := range tests {
+		if got := slugify(name); got != want {
+			t.Fatalf("slugify(%q) = %q, want %q", name, got, want)
+		}
+	}
+	if got := slugify(strings.Repeat("a", 100)); len(got) != roleCodeMaxLen {
+		t.Fatalf("slugify long name length = %d, want %d", len(got), roleCodeMaxLen)
+	}
+}
@@ -0,0 +1,223 @@
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/config"
+	"github.com/ziptako/iam/internal/sod"
+)
+
+// Server SCIM 2.0 HTTP服务，实现 service.Service 接口以便与rpc服务一起启动
+type Server struct {
+	c                    config.ScimConf
+	usersModel           model.UsersModel
+	rolesModel           model.RolesModel
+	userRolesModel       model.UserRolesModel
+	rolePermissionsModel model.RolePermissionsModel
+	sessionsModel        model.SessionsModel
+	constraints          *sod.Checker
+	srv                  *http.Server
+}
+
+// NewServer 创建SCIM服务
+func NewServer(c config.ScimConf, usersModel model.UsersModel, rolesModel model.RolesModel,
+	userRolesModel model.UserRolesModel, rolePermissionsModel model.RolePermissionsModel, sessionsModel model.SessionsModel,
+	constraints *sod.Checker) *Server {
+	s := &Server{
+		c:                    c,
+		usersModel:           usersModel,
+		rolesModel:           rolesModel,
+		userRolesModel:       userRolesModel,
+		rolePermissionsModel: rolePermissionsModel,
+		sessionsModel:        sessionsModel,
+		constraints:          constraints,
+	}
+	s.srv = &http.Server{
+		Addr:              c.ListenOn,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return s
+}
+
+// Handler 返回带鉴权的路由
+func (s *Server) Handler() http.Handler {
+	base := strings.TrimSuffix(s.c.BasePath, "/")
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+base+"/ServiceProviderConfig", s.serviceProviderConfig)
+
+	mux.HandleFunc("GET "+base+"/Users", s.listUsers)
+	mux.HandleFunc("POST "+base+"/Users", s.createUser)
+	mux.HandleFunc("GET "+base+"/Users/{id}", s.getUser)
+	mux.HandleFunc("PUT "+base+"/Users/{id}", s.replaceUser)
+	mux.HandleFunc("PATCH "+base+"/Users/{id}", s.patchUser)
+	mux.HandleFunc("DELETE "+base+"/Users/{id}", s.deleteUser)
+
+	mux.HandleFunc("GET "+base+"/Groups", s.listGroups)
+	mux.HandleFunc("POST "+base+"/Groups", s.createGroup)
+	mux.HandleFunc("GET "+base+"/Groups/{id}", s.getGroup)
+	mux.HandleFunc("PUT "+base+"/Groups/{id}", s.replaceGroup)
+	mux.HandleFunc("PATCH "+base+"/Groups/{id}", s.patchGroup)
+	mux.HandleFunc("DELETE "+base+"/Groups/{id}", s.deleteGroup)
+
+	return s.authenticate(mux)
+}
+
+// Start 启动HTTP服务，阻塞直到服务关闭
+func (s *Server) Start() {
+	logx.Infof("Starting scim server at %s%s...", s.c.ListenOn, s.c.BasePath)
+	if err := s.srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logx.Errorf("scim server stopped: %v", err)
+	}
+}
+
+// Stop 优雅关闭HTTP服务
+func (s *Server) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.srv.Shutdown(ctx); err != nil {
+		logx.Errorf("scim server shutdown: %v", err)
+	}
+}
+
+// authenticate 校验Bearer Token，未配置Token时拒绝所有请求；通过后将请求归属到配置的租户
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || !s.validToken(strings.TrimSpace(token)) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			writeError(w, newError(http.StatusUnauthorized, "", "authorization failure"))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(model.WithTenantId(r.Context(), s.c.TenantId)))
+	})
+}
+
+func (s *Server) validToken(token string) bool {
+	if token == "" {
+		return false
+	}
+	matched := 0
+	for _, t := range s.c.Tokens {
+		matched |= subtle.ConstantTimeCompare([]byte(t), []byte(token))
+	}
+	return matched == 1
+}
+
+func (s *Server) serviceProviderConfig(w http.ResponseWriter, _ *http.Request) {
+	supported := func(ok bool) map[string]bool { return map[string]bool{"supported": ok} }
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{SchemaServiceProviderConfig},
+		"patch":          supported(true),
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxCount},
+		"changePassword": supported(true),
+		"sort":           supported(false),
+		"etag":           supported(true),
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication scheme using a static bearer token",
+		}},
+	})
+}
+
+// location 生成资源地址
+func (s *Server) location(r *http.Request, resource string, id int64) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + r.Host + strings.TrimSuffix(s.c.BasePath, "/") + "/" + resource + "/" + strconv.FormatInt(id, 10)
+}
+
+// pagination 解析startIndex和count参数（startIndex从1开始）
+func pagination(r *http.Request) (startIndex, count int32) {
+	startIndex, count = 1, defaultCount
+	if v, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && v > 1 {
+		startIndex = int32(v)
+	}
+	if v, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && v >= 0 {
+		count = int32(min(v, maxCount))
+	}
+	return startIndex, count
+}
+
+// pathId 解析路径中的资源ID
+func pathId(r *http.Request) (int64, *Error) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, newError(http.StatusNotFound, "", "resource not found")
+	}
+	return id, nil
+}
+
+// checkPrecondition 处理If-Match / If-None-Match，返回false时已写出响应
+func checkPrecondition(w http.ResponseWriter, r *http.Request, version string) bool {
+	if match := r.Header.Get("If-Match"); match != "" && match != "*" && !etagListContains(match, version) {
+		writeError(w, newError(http.StatusPreconditionFailed, "", "resource version mismatch"))
+		return false
+	}
+	if r.Method == http.MethodGet {
+		if noneMatch := r.Header.Get("If-None-Match"); noneMatch != "" && etagListContains(noneMatch, version) {
+			w.Header().Set("ETag", version)
+			w.WriteHeader(http.StatusNotModified)
+			return false
+		}
+	}
+	return true
+}
+
+func etagListContains(list, version string) bool {
+	for _, v := range strings.Split(list, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(v), "W/") == strings.TrimPrefix(version, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeBody(r *http.Request, v any) *Error {
+	dec := json.NewDecoder(http.MaxBytesReader(nil, r.Body, 1<<20))
+	if err := dec.Decode(v); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "request body is not valid JSON")
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logx.Errorf("scim write response: %v", err)
+	}
+}
+
+// writeResource 输出资源并附带ETag和Location
+func writeResource(w http.ResponseWriter, code int, meta *Meta, v any) {
+	w.Header().Set("ETag", meta.Version)
+	w.Header().Set("Location", meta.Location)
+	writeJSON(w, code, v)
+}
+
+func writeError(w http.ResponseWriter, e *Error) {
+	writeJSON(w, e.code, e)
+}
+
+// internalError 记录内部错误并返回500
+func internalError(w http.ResponseWriter, msg string, err error) {
+	logx.Errorf("%v: %v", msg, err)
+	writeError(w, newError(http.StatusInternalServerError, "", msg))
+}
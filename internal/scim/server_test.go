@@ -0,0 +1,103 @@
+package scim
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/config"
+)
+
+func TestAuthenticate(t *testing.T) {
+	s := &Server{c: config.ScimConf{Tokens: []string{"token-a", "token-b"}, TenantId: 3}}
+	var tenantId int64
+	handler := s.authenticate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenantId = model.TenantIdFromContext(r.Context())
+	}))
+
+	tests := []struct {
+		header string
+		code   int
+	}{
+		{"Bearer token-b", http.StatusOK},
+		{"Bearer token-c", http.StatusUnauthorized},
+		{"Bearer ", http.StatusUnauthorized},
+		{"Basic token-a", http.StatusUnauthorized},
+		{"", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/scim/v2/Users", nil)
+		if tt.header != "" {
+			r.Header.Set("Authorization", tt.header)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		if w.Code != tt.code {
+			t.Fatalf("Authorization %q = %d, want %d", tt.header, w.Code, tt.code)
+		}
+		if tt.code == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+			t.Fatalf("Authorization %q: missing WWW-Authenticate", tt.header)
+		}
+	}
+	// 请求归属到配置的租户
+	if tenantId != 3 {
+		t.Fatalf("tenant id = %d, want 3", tenantId)
+	}
+
+	// 未配置Token时拒绝所有请求
+	s.c.Tokens = nil
+	if s.validToken("") || s.validToken("token-a") {
+		t.Fatalf("token accepted without configured tokens")
+	}
+}
+
+func TestCheckPrecondition(t *testing.T) {
+	const version = `W/"100"`
+	tests := []struct {
+		method string
+		header string
+		value  string
+		ok     bool
+		code   int
+	}{
+		{http.MethodPut, "If-Match", `W/"100"`, true, 0},
+		{http.MethodPut, "If-Match", `"99", "100"`, true, 0},
+		{http.MethodPut, "If-Match", "*", true, 0},
+		{http.MethodPut, "If-Match", `W/"99"`, false, http.StatusPreconditionFailed},
+		{http.MethodGet, "If-None-Match", `W/"100"`, false, http.StatusNotModified},
+		{http.MethodGet, "If-None-Match", `W/"99"`, true, 0},
+		{http.MethodPut, "If-None-Match", `W/"100"`, true, 0},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(tt.method, "/scim/v2/Users/1", nil)
+		r.Header.Set(tt.header, tt.value)
+		w := httptest.NewRecorder()
+		if ok := checkPrecondition(w, r, version); ok != tt.ok {
+			t.Fatalf("%s %s: %s = %v, want %v", tt.method, tt.header, tt.value, ok, tt.ok)
+		}
+		if !tt.ok && w.Code != tt.code {
+			t.Fatalf("%s %s: %s status = %d, want %d", tt.method, tt.header, tt.value, w.Code, tt.code)
+		}
+	}
+}
+
+func TestPagination(t *testing.T) {
+	tests := []struct {
+		query      string
+		startIndex int32
+		count      int32
+	}{
+		{"", 1, defaultCount},
+		{"startIndex=0&count=-1", 1, defaultCount},
+		{"startIndex=11&count=0", 11, 0},
+		{"count=100000", 1, maxCount},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/scim/v2/Users?"+tt.query, nil)
+		startIndex, count := pagination(r)
+		if startIndex != tt.startIndex || count != tt.count {
+			t.Fatalf("pagination(%q) = %d, %d, want %d, %d", tt.query, startIndex, count, tt.startIndex, tt.count)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package scim
+
+import (
+	"fmt"
+	"time"
+)
+
+// SCIM 2.0 schema URN（RFC 7643 / RFC 7644）
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	contentType = "application/scim+json"
+
+	defaultCount = 100 // 未指定count时的默认分页大小
+	maxCount     = 500 // 单页最大数量
+)
+
+// Meta 资源元数据
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+// MultiValue 多值属性（emails, phoneNumbers）
+type MultiValue struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// Name 用户姓名
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// User SCIM用户资源
+type User struct {
+	Schemas      []string     `json:"schemas"`
+	Id           string       `json:"id,omitempty"`
+	ExternalId   string       `json:"externalId,omitempty"`
+	UserName     string       `json:"userName"`
+	Name         *Name        `json:"name,omitempty"`
+	DisplayName  string       `json:"displayName,omitempty"`
+	Emails       []MultiValue `json:"emails,omitempty"`
+	PhoneNumbers []MultiValue `json:"phoneNumbers,omitempty"`
+	Active       *bool        `json:"active,omitempty"`
+	Password     string       `json:"password,omitempty"` // 只写属性，响应中不返回
+	Meta         *Meta        `json:"meta,omitempty"`
+}
+
+// primaryValue 返回多值属性中的主值，没有primary标记时取第一个
+func primaryValue(values []MultiValue) string {
+	for _, v := range values {
+		if v.Primary {
+			return v.Value
+		}
+	}
+	if len(values) > 0 {
+		return values[0].Value
+	}
+	return ""
+}
+
+// Member 组成员
+type Member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// Group SCIM组资源，对应iam角色
+type Group struct {
+	Schemas     []string `json:"schemas"`
+	Id          string   `json:"id,omitempty"`
+	ExternalId  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// ListResponse 列表响应
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int64    `json:"totalResults"`
+	StartIndex   int32    `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// PatchOperation 单个PATCH操作
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// PatchRequest PATCH请求体
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// Error SCIM错误响应
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+
+	code int
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("scim %d %s: %s", e.code, e.ScimType, e.Detail)
+}
+
+// newError 创建SCIM错误
+func newError(code int, scimType, detail string) *Error {
+	return &Error{
+		Schemas:  []string{SchemaError},
+		Status:   fmt.Sprintf("%d", code),
+		ScimType: scimType,
+		Detail:   detail,
+		code:     code,
+	}
+}
+
+// formatTime 按RFC3339输出时间
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// etag 以更新时间生成弱ETag
+func etag(updatedAt time.Time) string {
+	return fmt.Sprintf(`W/"%d"`, updatedAt.UnixNano())
+}
@@ -0,0 +1,437 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/utils"
+)
+
+// userAttrs 用户过滤属性到数据库列的映射
+var userAttrs = map[string]attrMapping{
+	"id":                 {column: "id", kind: kindInt},
+	"username":           {column: "username", caseInsensitive: true},
+	"externalid":         {column: "external_id"},
+	"displayname":        {column: "nickname"},
+	"name.formatted":     {column: "nickname"},
+	"emails":             {column: "email", caseInsensitive: true},
+	"emails.value":       {column: "email", caseInsensitive: true},
+	"phonenumbers":       {column: "phone"},
+	"phonenumbers.value": {column: "phone"},
+	"meta.created":       {column: "created_at", kind: kindTime},
+	"meta.lastmodified":  {column: "updated_at", kind: kindTime},
+	"active": {custom: func(op string, value any, _ func(any) string) (string, error) {
+		active, ok := value.(bool)
+		if op == "pr" {
+			return "true", nil
+		}
+		if !ok || (op != "eq" && op != "ne") {
+			return "", errors.New("active only supports eq/ne with boolean value")
+		}
+		if active == (op == "eq") {
+			return "disabled_at IS NULL", nil
+		}
+		return "disabled_at IS NOT NULL", nil
+	}},
+}
+
+// toUser 将数据库用户转换为SCIM资源
+func (s *Server) toUser(r *http.Request, u *model.Users) *User {
+	active := !u.DisabledAt.Valid
+	res := &User{
+		Schemas:     []string{SchemaUser},
+		Id:          strconv.FormatInt(u.Id, 10),
+		UserName:    u.Username,
+		DisplayName: u.Nickname.String,
+		Active:      &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      formatTime(u.CreatedAt),
+			LastModified: formatTime(u.UpdatedAt),
+			Location:     s.location(r, "Users", u.Id),
+			Version:      etag(u.UpdatedAt),
+		},
+	}
+	if u.ExternalId.Valid && u.Source != model.UserSourceLdap {
+		res.ExternalId = u.ExternalId.String
+	}
+	if u.Nickname.Valid {
+		res.Name = &Name{Formatted: u.Nickname.String}
+	}
+	if u.Email.Valid {
+		res.Emails = []MultiValue{{Value: u.Email.String, Type: "work", Primary: true}}
+	}
+	if u.Phone.Valid {
+		res.PhoneNumbers = []MultiValue{{Value: u.Phone.String, Type: "work", Primary: true}}
+	}
+	return res
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	conditions, args, err := buildConditions(r.URL.Query().Get("filter"), userAttrs)
+	if err != nil {
+		writeError(w, newError(http.StatusBadRequest, "invalidFilter", err.Error()))
+		return
+	}
+	startIndex, count := pagination(r)
+
+	total, err := s.usersModel.CountWithConditions(r.Context(), conditions, args)
+	if err != nil {
+		internalError(w, "count users failed", err)
+		return
+	}
+	resources := make([]any, 0, count)
+	if count > 0 {
+		users, err := s.usersModel.FindWithConditions(r.Context(), conditions, args, count, startIndex-1)
+		if err != nil {
+			internalError(w, "query users failed", err)
+			return
+		}
+		for _, u := range users {
+			resources = append(resources, s.toUser(r, u))
+		}
+	}
+
+	writeJSON(w, http.StatusOK, &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	u, e := s.loadUser(r)
+	if e != nil {
+		writeError(w, e)
+		return
+	}
+	res := s.toUser(r, u)
+	if !checkPrecondition(w, r, res.Meta.Version) {
+		return
+	}
+	writeResource(w, http.StatusOK, res.Meta, res)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	var in User
+	if e := decodeBody(r, &in); e != nil {
+		writeError(w, e)
+		return
+	}
+
+	u := &model.Users{Source: model.UserSourceScim}
+	if e := s.saveUser(r.Context(), u, &in); e != nil {
+		writeError(w, e)
+		return
+	}
+
+	created, err := s.usersModel.FindById(r.Context(), u.Id)
+	if err != nil {
+		internalError(w, "load created user failed", err)
+		return
+	}
+	res := s.toUser(r, created)
+	writeResource(w, http.StatusCreated, res.Meta, res)
+}
+
+func (s *Server) replaceUser(w http.ResponseWriter, r *http.Request) {
+	u, e := s.loadUser(r)
+	if e != nil {
+		writeError(w, e)
+		return
+	}
+	if !checkPrecondition(w, r, etag(u.UpdatedAt)) {
+		return
+	}
+	var in User
+	if e := decodeBody(r, &in); e != nil {
+		writeError(w, e)
+		return
+	}
+	s.updateUser(w, r, u, &in)
+}
+
+func (s *Server) patchUser(w http.ResponseWriter, r *http.Request) {
+	u, e := s.loadUser(r)
+	if e != nil {
+		writeError(w, e)
+		return
+	}
+	if !checkPrecondition(w, r, etag(u.UpdatedAt)) {
+		return
+	}
+	var req PatchRequest
+	if e := decodeBody(r, &req); e != nil {
+		writeError(w, e)
+		return
+	}
+
+	// 在当前资源表示上应用操作，再整体保存
+	res := s.toUser(r, u)
+	for _, op := range req.Operations {
+		if e := applyUserOp(res, op); e != nil {
+			writeError(w, e)
+			return
+		}
+	}
+	s.updateUser(w, r, u, res)
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request, u *model.Users, in *User) {
+	if e := s.saveUser(r.Context(), u, in); e != nil {
+		writeError(w, e)
+		return
+	}
+	updated, err := s.usersModel.FindById(r.Context(), u.Id)
+	if err != nil {
+		internalError(w, "load updated user failed", err)
+		return
+	}
+	res := s.toUser(r, updated)
+	writeResource(w, http.StatusOK, res.Meta, res)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	u, e := s.loadUser(r)
+	if e != nil {
+		writeError(w, e)
+		return
+	}
+	if !checkPrecondition(w, r, etag(u.UpdatedAt)) {
+		return
+	}
+	if err := s.usersModel.SoftDelete(r.Context(), u.Id); err != nil {
+		internalError(w, "delete user failed", err)
+		return
+	}
+	if err := s.userRolesModel.RemoveAllUserRoles(r.Context(), u.Id); err != nil {
+		internalError(w, "remove user roles failed", err)
+		return
+	}
+	if _, err := s.sessionsModel.RevokeAllByUserId(r.Context(), u.Id, model.SessionRevokeUserDeleted); err != nil {
+		internalError(w, "revoke user sessions failed", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) loadUser(r *http.Request) (*model.Users, *Error) {
+	id, e := pathId(r)
+	if e != nil {
+		return nil, e
+	}
+	u, err := s.usersModel.FindById(r.Context(), id)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, newError(http.StatusNotFound, "", "user "+r.PathValue("id")+" not found")
+	}
+	if err != nil {
+		return nil, newError(http.StatusInternalServerError, "", "query user failed")
+	}
+	return u, nil
+}
+
+// saveUser 将SCIM资源写入数据库，u.Id为0时新建
+func (s *Server) saveUser(ctx context.Context, u *model.Users, in *User) *Error {
+	username := strings.TrimSpace(in.UserName)
+	if username == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "userName is required")
+	}
+	if u.Source == model.UserSourceLdap {
+		return newError(http.StatusConflict, "mutability", "user is managed by LDAP directory")
+	}
+	email := strings.TrimSpace(primaryValue(in.Emails))
+	phone := strings.TrimSpace(primaryValue(in.PhoneNumbers))
+	nickname := strings.TrimSpace(in.DisplayName)
+	if nickname == "" && in.Name != nil {
+		nickname = strings.TrimSpace(in.Name.Formatted)
+		if nickname == "" {
+			nickname = strings.TrimSpace(in.Name.GivenName + " " + in.Name.FamilyName)
+		}
+	}
+
+	// 唯一性检查
+	if username != u.Username {
+		exists, err := s.usersModel.ExistsByUsername(ctx, username, u.Id)
+		if err != nil {
+			return newError(http.StatusInternalServerError, "", "check username failed")
+		}
+		if exists {
+			return newError(http.StatusConflict, "uniqueness", "userName already exists")
+		}
+	}
+	if email != "" && email != u.Email.String {
+		exists, err := s.usersModel.ExistsByEmail(ctx, email, u.Id)
+		if err != nil {
+			return newError(http.StatusInternalServerError, "", "check email failed")
+		}
+		if exists {
+			return newError(http.StatusConflict, "uniqueness", "email already exists")
+		}
+	}
+	if phone != "" && phone != u.Phone.String {
+		exists, err := s.usersModel.ExistsByPhone(ctx, phone, u.Id)
+		if err != nil {
+			return newError(http.StatusInternalServerError, "", "check phone failed")
+		}
+		if exists {
+			return newError(http.StatusConflict, "uniqueness", "phone already exists")
+		}
+	}
+
+	u.Username = username
+	u.SetEmail(email)
+	u.SetPhone(phone)
+	u.Nickname = sql.NullString{String: nickname, Valid: nickname != ""}
+	// externalId在SCIM中可选，但非本地用户必须有外部标识：未提供时沿用原值，新用户以userName作为外部标识
+	externalId := strings.TrimSpace(in.ExternalId)
+	if externalId == "" && u.Source != model.UserSourceLocal {
+		externalId = u.ExternalId.String
+		if externalId == "" {
+			externalId = username
+		}
+	}
+	if externalId != "" && externalId != u.ExternalId.String {
+		owner, err := s.usersModel.FindByExternalId(ctx, u.Source, externalId)
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
+			return newError(http.StatusInternalServerError, "", "check externalId failed")
+		}
+		if owner != nil && owner.Id != u.Id {
+			return newError(http.StatusConflict, "uniqueness", "externalId already exists")
+		}
+	}
+	u.ExternalId = sql.NullString{String: externalId, Valid: externalId != ""}
+	disabling := false
+	if in.Active != nil {
+		switch {
+		case *in.Active:
+			u.DisabledAt = sql.NullTime{}
+		case !u.DisabledAt.Valid:
+			u.DisabledAt = sql.NullTime{Time: time.Now(), Valid: true}
+			disabling = u.Id != 0
+		}
+	}
+
+	// 未提供密码的新用户使用不可用的随机密码，只能通过外部身份源登录或后续重置
+	password := in.Password
+	if password == "" && u.Id == 0 {
+		random, err := utils.GenerateSalt()
+		if err != nil {
+			return newError(http.StatusInternalServerError, "", "generate password failed")
+		}
+		password = random
+	}
+	if password != "" {
+		salt, err := utils.GenerateSalt()
+		if err != nil {
+			return newError(http.StatusInternalServerError, "", "generate salt failed")
+		}
+		u.Salt = salt
+		u.PasswordHash = utils.HashPasswordWithSalt(password, salt)
+		u.PasswordChangedAt = time.Now()
+		u.FailedLoginAttempts = 0
+		u.LockedUntil = sql.NullTime{}
+	}
+
+	if u.Id == 0 {
+		if _, err := s.usersModel.Insert(ctx, u); err != nil {
+			return newError(http.StatusInternalServerError, "", "create user failed")
+		}
+		return nil
+	}
+	if err := s.usersModel.Update(ctx, u); err != nil {
+		return newError(http.StatusInternalServerError, "", "update user failed")
+	}
+
+	// 禁用或修改密码后撤销已有会话
+	reason := ""
+	switch {
+	case disabling:
+		reason = model.SessionRevokeUserDisabled
+	case in.Password != "":
+		reason = model.SessionRevokePasswordChanged
+	}
+	if reason != "" {
+		if _, err := s.sessionsModel.RevokeAllByUserId(ctx, u.Id, reason); err != nil {
+			return newError(http.StatusInternalServerError, "", "revoke user sessions failed")
+		}
+	}
+	return nil
+}
+
+// applyUserOp 在SCIM用户资源上应用单个PATCH操作
+func applyUserOp(u *User, op PatchOperation) *Error {
+	kind := strings.ToLower(op.Op)
+	if kind != "add" && kind != "replace" && kind != "remove" {
+		return newError(http.StatusBadRequest, "invalidSyntax", "unsupported op "+op.Op)
+	}
+
+	// 无path时value为属性对象，逐个属性应用
+	if op.Path == "" {
+		if kind == "remove" {
+			return newError(http.StatusBadRequest, "noTarget", "remove requires path")
+		}
+		attrs, ok := op.Value.(map[string]any)
+		if !ok {
+			return newError(http.StatusBadRequest, "invalidValue", "value must be an object when path is omitted")
+		}
+		for k, v := range attrs {
+			if e := applyUserOp(u, PatchOperation{Op: kind, Path: k, Value: v}); e != nil {
+				return e
+			}
+		}
+		return nil
+	}
+
+	remove := kind == "remove"
+	path := strings.ToLower(op.Path)
+	// emails[type eq "work"].value 形式的路径按整个属性处理（仅保存一个主值）
+	attr, _, _ := strings.Cut(path, "[")
+	attr, _, _ = strings.Cut(attr, ".value")
+
+	switch attr {
+	case "username":
+		return setString(&u.UserName, op.Value, remove)
+	case "displayname", "name.formatted":
+		return setString(&u.DisplayName, op.Value, remove)
+	case "name":
+		if remove {
+			u.Name, u.DisplayName = nil, ""
+			return nil
+		}
+		var name Name
+		if e := decodeValue(op.Value, &name); e != nil {
+			return e
+		}
+		u.Name, u.DisplayName = &name, ""
+	case "externalid":
+		return setString(&u.ExternalId, op.Value, remove)
+	case "password":
+		return setString(&u.Password, op.Value, remove)
+	case "active":
+		if remove {
+			return newError(http.StatusBadRequest, "mutability", "active cannot be removed")
+		}
+		active, e := boolValue(op.Value)
+		if e != nil {
+			return e
+		}
+		u.Active = &active
+	case "emails":
+		return setMultiValue(&u.Emails, op.Value, remove)
+	case "phonenumbers":
+		return setMultiValue(&u.PhoneNumbers, op.Value, remove)
+	case "schemas", "id", "meta":
+		// 只读属性忽略
+	default:
+		return newError(http.StatusBadRequest, "invalidPath", "unsupported path "+op.Path)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/internal/config"
+)
+
+func TestSaveUserExternalId(t *testing.T) {
+	users := &modeltest.Users{Rows: []*model.Users{
+		{Id: 1, Username: "alice", Source: model.UserSourceScim, ExternalId: modeltest.NullString("ext-a")},
+		{Id: 2, Username: "bob", Source: model.UserSourceLocal},
+	}}
+	s := NewServer(config.ScimConf{}, users, nil, nil, nil, &modeltest.Sessions{}, nil)
+	ctx := context.Background()
+
+	// 新用户未提供externalId时以userName作为外部标识
+	if err := s.saveUser(ctx, &model.Users{Source: model.UserSourceScim}, &User{UserName: "carol"}); err != nil {
+		t.Fatalf("create user: %+v", err)
+	}
+	if u := users.RowByUsername("carol"); u == nil || u.ExternalId.String != "carol" {
+		t.Fatalf("created user = %+v, want externalId carol", u)
+	}
+
+	// 已有用户未提供externalId时沿用原值
+	alice, _ := users.FindOne(ctx, 1)
+	if err := s.saveUser(ctx, alice, &User{UserName: "alice", DisplayName: "Alice"}); err != nil {
+		t.Fatalf("update user: %+v", err)
+	}
+	if u := users.Row(1); u.ExternalId.String != "ext-a" || u.Nickname.String != "Alice" {
+		t.Fatalf("updated user = %+v, want externalId ext-a", u)
+	}
+
+	// 本地用户不要求外部标识
+	bob, _ := users.FindOne(ctx, 2)
+	if err := s.saveUser(ctx, bob, &User{UserName: "bob"}); err != nil {
+		t.Fatalf("update local user: %+v", err)
+	}
+	if u := users.Row(2); u.ExternalId.Valid {
+		t.Fatalf("local user externalId = %q, want empty", u.ExternalId.String)
+	}
+
+	// 外部标识冲突
+	err := s.saveUser(ctx, &model.Users{Source: model.UserSourceScim}, &User{UserName: "dave", ExternalId: "ext-a"})
+	if err == nil || err.code != http.StatusConflict || err.ScimType != "uniqueness" {
+		t.Fatalf("duplicate externalId = %+v, want uniqueness conflict", err)
+	}
+	carol, _ := users.FindByUsername(ctx, "carol")
+	if err = s.saveUser(ctx, carol, &User{UserName: "carol", ExternalId: " ext-a "}); err == nil || err.code != http.StatusConflict {
+		t.Fatalf("update to duplicate externalId = %+v, want conflict", err)
+	}
+	if users.RowByUsername("dave") != nil {
+		t.Fatalf("user created despite conflict")
+	}
+}
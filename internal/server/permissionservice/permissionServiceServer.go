@@ -52,3 +52,9 @@ func (s *PermissionServiceServer) ListPermissions(ctx context.Context, in *iam.L
 	l := permissionservicelogic.NewListPermissionsLogic(ctx, s.svcCtx)
 	return l.ListPermissions(in)
 }
+
+// RegisterPermissions 服务按命名空间注册完整的权限目录，幂等地新建和更新权限，目录中不再包含的权限标记为弃用
+func (s *PermissionServiceServer) RegisterPermissions(ctx context.Context, in *iam.RegisterPermissionsRequest) (*iam.RegisterPermissionsResponse, error) {
+	l := permissionservicelogic.NewRegisterPermissionsLogic(ctx, s.svcCtx)
+	return l.RegisterPermissions(in)
+}
@@ -53,7 +53,7 @@ func (s *RoleServiceServer) ListRoles(ctx context.Context, in *iam.ListRolesRequ
 	return l.ListRoles(in)
 }
 
-// AssignRolePermission 为角色分配单个权限
+// AssignRolePermission 为角色分配单个权限，可附带授权条件；拒绝授权优先于其他角色的允许授权
 func (s *RoleServiceServer) AssignRolePermission(ctx context.Context, in *iam.AssignRolePermissionRequest) (*iam.AssignRolePermissionResponse, error) {
 	l := roleservicelogic.NewAssignRolePermissionLogic(ctx, s.svcCtx)
 	return l.AssignRolePermission(in)
@@ -88,3 +88,51 @@ func (s *RoleServiceServer) CheckRolePermission(ctx context.Context, in *iam.Che
 	l := roleservicelogic.NewCheckRolePermissionLogic(ctx, s.svcCtx)
 	return l.CheckRolePermission(in)
 }
+
+// SetRoleDataScope 设置角色的数据权限范围，自定义范围时同时设置部门列表
+func (s *RoleServiceServer) SetRoleDataScope(ctx context.Context, in *iam.SetRoleDataScopeRequest) (*iam.SetRoleDataScopeResponse, error) {
+	l := roleservicelogic.NewSetRoleDataScopeLogic(ctx, s.svcCtx)
+	return l.SetRoleDataScope(in)
+}
+
+// CreateRoleConstraint 创建职责分离约束，角色分配、用户组继承和访问申请授权时校验
+func (s *RoleServiceServer) CreateRoleConstraint(ctx context.Context, in *iam.CreateRoleConstraintRequest) (*iam.RoleConstraint, error) {
+	l := roleservicelogic.NewCreateRoleConstraintLogic(ctx, s.svcCtx)
+	return l.CreateRoleConstraint(in)
+}
+
+// DeleteRoleConstraint 删除职责分离约束
+func (s *RoleServiceServer) DeleteRoleConstraint(ctx context.Context, in *iam.DeleteRoleConstraintRequest) (*iam.DeleteRoleConstraintResponse, error) {
+	l := roleservicelogic.NewDeleteRoleConstraintLogic(ctx, s.svcCtx)
+	return l.DeleteRoleConstraint(in)
+}
+
+// ListRoleConstraints 查询所有职责分离约束
+func (s *RoleServiceServer) ListRoleConstraints(ctx context.Context, in *iam.ListRoleConstraintsRequest) (*iam.ListRoleConstraintsResponse, error) {
+	l := roleservicelogic.NewListRoleConstraintsLogic(ctx, s.svcCtx)
+	return l.ListRoleConstraints(in)
+}
+
+// ValidateConstraints 检查已有数据中违反职责分离约束的情况
+func (s *RoleServiceServer) ValidateConstraints(ctx context.Context, in *iam.ValidateConstraintsRequest) (*iam.ValidateConstraintsResponse, error) {
+	l := roleservicelogic.NewValidateConstraintsLogic(ctx, s.svcCtx)
+	return l.ValidateConstraints(in)
+}
+
+// ListUnusedGrants 查询最近N天内未被权限检查使用过的允许授权，可按角色或用户过滤
+func (s *RoleServiceServer) ListUnusedGrants(ctx context.Context, in *iam.ListUnusedGrantsRequest) (*iam.ListUnusedGrantsResponse, error) {
+	l := roleservicelogic.NewListUnusedGrantsLogic(ctx, s.svcCtx)
+	return l.ListUnusedGrants(in)
+}
+
+// ListUnusedRoles 查询最近N天内没有任何授权被权限检查使用过的角色
+func (s *RoleServiceServer) ListUnusedRoles(ctx context.Context, in *iam.ListUnusedRolesRequest) (*iam.ListUnusedRolesResponse, error) {
+	l := roleservicelogic.NewListUnusedRolesLogic(ctx, s.svcCtx)
+	return l.ListUnusedRoles(in)
+}
+
+// RecommendRoleTrim 根据使用记录和授权覆盖关系，建议从角色中移除的权限
+func (s *RoleServiceServer) RecommendRoleTrim(ctx context.Context, in *iam.RecommendRoleTrimRequest) (*iam.RecommendRoleTrimResponse, error) {
+	l := roleservicelogic.NewRecommendRoleTrimLogic(ctx, s.svcCtx)
+	return l.RecommendRoleTrim(in)
+}
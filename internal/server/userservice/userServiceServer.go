@@ -65,6 +65,12 @@ func (s *UserServiceServer) AssignUserRoles(ctx context.Context, in *iam.AssignU
 	return l.AssignUserRoles(in)
 }
 
+// ExtendUserRole 调整用户直接分配角色的失效时间
+func (s *UserServiceServer) ExtendUserRole(ctx context.Context, in *iam.ExtendUserRoleRequest) (*iam.ExtendUserRoleResponse, error) {
+	l := userservicelogic.NewExtendUserRoleLogic(ctx, s.svcCtx)
+	return l.ExtendUserRole(in)
+}
+
 // RemoveUserRole 移除用户的单个角色
 func (s *UserServiceServer) RemoveUserRole(ctx context.Context, in *iam.RemoveUserRoleRequest) (*iam.RemoveUserRoleResponse, error) {
 	l := userservicelogic.NewRemoveUserRoleLogic(ctx, s.svcCtx)
@@ -89,19 +95,25 @@ func (s *UserServiceServer) CheckUserRole(ctx context.Context, in *iam.CheckUser
 	return l.CheckUserRole(in)
 }
 
-// CheckUserPermission 检查用户是否具备指定权限
+// CheckUserPermission 检查用户是否具备指定权限，带条件的授权根据请求上下文求值，任一角色拒绝即不具备
 func (s *UserServiceServer) CheckUserPermission(ctx context.Context, in *iam.CheckUserPermissionRequest) (*iam.CheckUserPermissionResponse, error) {
 	l := userservicelogic.NewCheckUserPermissionLogic(ctx, s.svcCtx)
 	return l.CheckUserPermission(in)
 }
 
+// CheckUserResourcePermission 检查用户在指定资源上是否具备权限，合并全局角色与该资源上的绑定角色
+func (s *UserServiceServer) CheckUserResourcePermission(ctx context.Context, in *iam.CheckUserResourcePermissionRequest) (*iam.CheckUserPermissionResponse, error) {
+	l := userservicelogic.NewCheckUserResourcePermissionLogic(ctx, s.svcCtx)
+	return l.CheckUserResourcePermission(in)
+}
+
 // GetUserByUsername 根据用户名获取用户详情
 func (s *UserServiceServer) GetUserByUsername(ctx context.Context, in *iam.GetUserByUsernameRequest) (*iam.User, error) {
 	l := userservicelogic.NewGetUserByUsernameLogic(ctx, s.svcCtx)
 	return l.GetUserByUsername(in)
 }
 
-// VerifyPassword 验证用户密码
+// VerifyPassword 验证用户密码，与Authenticate共用失败次数和账号锁定
 func (s *UserServiceServer) VerifyPassword(ctx context.Context, in *iam.VerifyPasswordRequest) (*iam.VerifyPasswordResponse, error) {
 	l := userservicelogic.NewVerifyPasswordLogic(ctx, s.svcCtx)
 	return l.VerifyPassword(in)
@@ -112,3 +124,105 @@ func (s *UserServiceServer) ChangePassword(ctx context.Context, in *iam.ChangePa
 	l := userservicelogic.NewChangePasswordLogic(ctx, s.svcCtx)
 	return l.ChangePassword(in)
 }
+
+// SyncLdapUsers 从LDAP目录同步用户及组角色映射
+func (s *UserServiceServer) SyncLdapUsers(ctx context.Context, in *iam.SyncLdapUsersRequest) (*iam.SyncLdapUsersResponse, error) {
+	l := userservicelogic.NewSyncLdapUsersLogic(ctx, s.svcCtx)
+	return l.SyncLdapUsers(in)
+}
+
+// CreateSession 登录成功后记录用户会话
+func (s *UserServiceServer) CreateSession(ctx context.Context, in *iam.CreateSessionRequest) (*iam.Session, error) {
+	l := userservicelogic.NewCreateSessionLogic(ctx, s.svcCtx)
+	return l.CreateSession(in)
+}
+
+// TouchSession 刷新会话最后活跃时间，并返回会话是否仍有效
+func (s *UserServiceServer) TouchSession(ctx context.Context, in *iam.TouchSessionRequest) (*iam.TouchSessionResponse, error) {
+	l := userservicelogic.NewTouchSessionLogic(ctx, s.svcCtx)
+	return l.TouchSession(in)
+}
+
+// ListUserSessions 分页查询用户的会话
+func (s *UserServiceServer) ListUserSessions(ctx context.Context, in *iam.ListUserSessionsRequest) (*iam.ListUserSessionsResponse, error) {
+	l := userservicelogic.NewListUserSessionsLogic(ctx, s.svcCtx)
+	return l.ListUserSessions(in)
+}
+
+// RevokeSession 撤销单个会话
+func (s *UserServiceServer) RevokeSession(ctx context.Context, in *iam.RevokeSessionRequest) (*iam.RevokeSessionResponse, error) {
+	l := userservicelogic.NewRevokeSessionLogic(ctx, s.svcCtx)
+	return l.RevokeSession(in)
+}
+
+// RevokeAllUserSessions 撤销用户的所有活跃会话
+func (s *UserServiceServer) RevokeAllUserSessions(ctx context.Context, in *iam.RevokeAllUserSessionsRequest) (*iam.RevokeAllUserSessionsResponse, error) {
+	l := userservicelogic.NewRevokeAllUserSessionsLogic(ctx, s.svcCtx)
+	return l.RevokeAllUserSessions(in)
+}
+
+// Impersonate 管理员以指定用户身份访问，签发限时模拟凭证
+func (s *UserServiceServer) Impersonate(ctx context.Context, in *iam.ImpersonateRequest) (*iam.ImpersonateResponse, error) {
+	l := userservicelogic.NewImpersonateLogic(ctx, s.svcCtx)
+	return l.Impersonate(in)
+}
+
+// SendVerification 向用户邮箱或手机号发送验证码
+func (s *UserServiceServer) SendVerification(ctx context.Context, in *iam.SendVerificationRequest) (*iam.SendVerificationResponse, error) {
+	l := userservicelogic.NewSendVerificationLogic(ctx, s.svcCtx)
+	return l.SendVerification(in)
+}
+
+// ConfirmVerification 校验验证码并标记邮箱或手机号已验证
+func (s *UserServiceServer) ConfirmVerification(ctx context.Context, in *iam.ConfirmVerificationRequest) (*iam.ConfirmVerificationResponse, error) {
+	l := userservicelogic.NewConfirmVerificationLogic(ctx, s.svcCtx)
+	return l.ConfirmVerification(in)
+}
+
+// Authenticate 使用用户名、邮箱或手机号加密码登录认证
+func (s *UserServiceServer) Authenticate(ctx context.Context, in *iam.AuthenticateRequest) (*iam.AuthenticateResponse, error) {
+	l := userservicelogic.NewAuthenticateLogic(ctx, s.svcCtx)
+	return l.Authenticate(in)
+}
+
+// GetUserDataScope 获取用户的数据权限范围，合并用户所有角色的数据权限并解析为部门ID列表
+func (s *UserServiceServer) GetUserDataScope(ctx context.Context, in *iam.GetUserDataScopeRequest) (*iam.GetUserDataScopeResponse, error) {
+	l := userservicelogic.NewGetUserDataScopeLogic(ctx, s.svcCtx)
+	return l.GetUserDataScope(in)
+}
+
+// GetUserPermissions 获取用户拥有的全部具体权限，通配授权展开为其覆盖的权限
+func (s *UserServiceServer) GetUserPermissions(ctx context.Context, in *iam.GetUserPermissionsRequest) (*iam.GetUserPermissionsResponse, error) {
+	l := userservicelogic.NewGetUserPermissionsLogic(ctx, s.svcCtx)
+	return l.GetUserPermissions(in)
+}
+
+// SetAdminScope 设置委派管理员的管理范围，覆盖原有配置
+func (s *UserServiceServer) SetAdminScope(ctx context.Context, in *iam.SetAdminScopeRequest) (*iam.AdminScope, error) {
+	l := userservicelogic.NewSetAdminScopeLogic(ctx, s.svcCtx)
+	return l.SetAdminScope(in)
+}
+
+// GetAdminScope 获取委派管理员的管理范围
+func (s *UserServiceServer) GetAdminScope(ctx context.Context, in *iam.GetAdminScopeRequest) (*iam.AdminScope, error) {
+	l := userservicelogic.NewGetAdminScopeLogic(ctx, s.svcCtx)
+	return l.GetAdminScope(in)
+}
+
+// DeleteAdminScope 删除委派管理员的管理范围，删除后该用户不能再调用管理接口
+func (s *UserServiceServer) DeleteAdminScope(ctx context.Context, in *iam.DeleteAdminScopeRequest) (*iam.DeleteAdminScopeResponse, error) {
+	l := userservicelogic.NewDeleteAdminScopeLogic(ctx, s.svcCtx)
+	return l.DeleteAdminScope(in)
+}
+
+// ExplainUserPermission 解释用户权限检查的决策过程，返回结构化的决策轨迹和可读文本
+func (s *UserServiceServer) ExplainUserPermission(ctx context.Context, in *iam.ExplainUserPermissionRequest) (*iam.ExplainUserPermissionResponse, error) {
+	l := userservicelogic.NewExplainUserPermissionLogic(ctx, s.svcCtx)
+	return l.ExplainUserPermission(in)
+}
+
+// SimulateChange 模拟一组策略变更，返回受影响用户有效权限的差异；只在内存中求值，不修改任何数据
+func (s *UserServiceServer) SimulateChange(ctx context.Context, in *iam.SimulateChangeRequest) (*iam.SimulateChangeResponse, error) {
+	l := userservicelogic.NewSimulateChangeLogic(ctx, s.svcCtx)
+	return l.SimulateChange(in)
+}
@@ -0,0 +1,318 @@
+package sod
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// Constraint 职责分离约束及其角色集合
+type Constraint struct {
+	*model.RoleConstraints
+	RoleIds []int64
+	roleSet map[int64]bool
+}
+
+// Violation 违反约束的情况，用户级约束填充UserId和RoleIds，角色级约束填充RoleId和UserIds
+type Violation struct {
+	Constraint *Constraint
+	UserId     int64
+	RoleIds    []int64
+	RoleId     int64
+	UserIds    []int64
+	Count      int // 计入的数量，动态约束为同一时刻重叠的最大数量
+}
+
+// Error 违反约束的说明
+func (v *Violation) Error() string {
+	c := v.Constraint
+	switch c.ConstraintType {
+	case model.RoleConstraintExclusive:
+		return fmt.Sprintf("constraint %q (%s, %s): user %d holds %d of roles %v, at most %d allowed", c.Code, c.ConstraintType, c.Enforcement, v.UserId, v.Count, c.RoleIds, c.MaxCount)
+	case model.RoleConstraintRoleCardinality:
+		return fmt.Sprintf("constraint %q (%s, %s): role %d has %d holders, at most %d allowed", c.Code, c.ConstraintType, c.Enforcement, v.RoleId, v.Count, c.MaxCount)
+	default:
+		return fmt.Sprintf("constraint %q (%s, %s): user %d holds %d roles, at most %d allowed", c.Code, c.ConstraintType, c.Enforcement, v.UserId, v.Count, c.MaxCount)
+	}
+}
+
+// Checker 职责分离约束校验器（RBAC2）
+// 直接分配和通过用户组继承的角色都计入持有，继承的角色视为永久持有；已过期的分配不计入
+// SCIM开通的组成员变更和LDAP同步新增的角色同样校验，存量数据的违规通过Validate发现
+// 校验与写入通过Grant或Locked在当前租户的职责分离锁内完成，并发的授予不能各自通过校验
+type Checker struct {
+	constraintsModel     model.RoleConstraintsModel
+	constraintRolesModel model.RoleConstraintRolesModel
+	userRolesModel       model.UserRolesModel
+	groupRolesModel      model.GroupRolesModel
+}
+
+// NewChecker 创建职责分离约束校验器
+func NewChecker(constraintsModel model.RoleConstraintsModel, constraintRolesModel model.RoleConstraintRolesModel, userRolesModel model.UserRolesModel, groupRolesModel model.GroupRolesModel) *Checker {
+	return &Checker{
+		constraintsModel:     constraintsModel,
+		constraintRolesModel: constraintRolesModel,
+		userRolesModel:       userRolesModel,
+		groupRolesModel:      groupRolesModel,
+	}
+}
+
+// Constraints 查询当前租户的所有约束及其角色集合
+func (c *Checker) Constraints(ctx context.Context) ([]*Constraint, error) {
+	rows, err := c.constraintsModel.FindAll(ctx)
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	constraintRoles, err := c.constraintRolesModel.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	constraints := make([]*Constraint, 0, len(rows))
+	byId := make(map[int64]*Constraint, len(rows))
+	for _, row := range rows {
+		constraint := &Constraint{RoleConstraints: row, roleSet: make(map[int64]bool)}
+		constraints = append(constraints, constraint)
+		byId[row.Id] = constraint
+	}
+	for _, cr := range constraintRoles {
+		if constraint, ok := byId[cr.ConstraintId]; ok {
+			constraint.RoleIds = append(constraint.RoleIds, cr.RoleId)
+			constraint.roleSet[cr.RoleId] = true
+		}
+	}
+	return constraints, nil
+}
+
+// Check 校验授予是否违反约束，返回第一条违规
+// 只拦截由本次授予引起或加重的违规，与本次授予无关的存量违规不影响授予
+func (c *Checker) Check(ctx context.Context, grants []Grant) (*Violation, error) {
+	if len(grants) == 0 {
+		return nil, nil
+	}
+	constraints, err := c.Constraints(ctx)
+	if err != nil || len(constraints) == 0 {
+		return nil, err
+	}
+
+	// 本次授予涉及的用户和角色
+	grantedRoles := make(map[int64]map[int64]bool) // userId -> 授予的角色
+	roleGranted := make(map[int64]bool)
+	var userIds []int64
+	for _, g := range grants {
+		if _, ok := grantedRoles[g.UserId]; !ok {
+			grantedRoles[g.UserId] = make(map[int64]bool)
+			userIds = append(userIds, g.UserId)
+		}
+		grantedRoles[g.UserId][g.RoleId] = true
+		roleGranted[g.RoleId] = true
+	}
+	sort.Slice(userIds, func(i, j int) bool { return userIds[i] < userIds[j] })
+
+	// 角色数量约束需要加载授予角色的所有持有人
+	var cardinalityRoleIds []int64
+	seen := make(map[int64]bool)
+	for _, constraint := range constraints {
+		if constraint.ConstraintType != model.RoleConstraintRoleCardinality {
+			continue
+		}
+		for _, roleId := range constraint.RoleIds {
+			if roleGranted[roleId] && !seen[roleId] {
+				seen[roleId] = true
+				cardinalityRoleIds = append(cardinalityRoleIds, roleId)
+			}
+		}
+	}
+
+	before, err := c.load(ctx, userIds, cardinalityRoleIds)
+	if err != nil {
+		return nil, err
+	}
+	after := before.clone()
+	after.apply(grants)
+
+	for _, constraint := range constraints {
+		switch constraint.ConstraintType {
+		case model.RoleConstraintRoleCardinality:
+			for _, roleId := range constraint.RoleIds {
+				if !roleGranted[roleId] {
+					continue
+				}
+				if v := checkRole(constraint, after, roleId); v != nil && v.Count > countRole(constraint, before, roleId) {
+					return v, nil
+				}
+			}
+		default:
+			for _, userId := range userIds {
+				if !touches(constraint, grantedRoles[userId]) {
+					continue
+				}
+				if v := checkUser(constraint, after, userId); v != nil && v.Count > countUser(constraint, before, userId) {
+					return v, nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// Locked 在当前租户的职责分离锁内执行fn，用于需要在锁内先查询再校验和写入授予的场景
+// 锁内不能再调用Grant或Locked
+func (c *Checker) Locked(ctx context.Context, fn func(ctx context.Context) error) error {
+	return c.constraintsModel.Locked(ctx, fn)
+}
+
+// Grant 在当前租户的职责分离锁内校验授予，未违反约束时执行grant写入，违反时不执行
+// 校验与写入在同一把锁内完成，并发的授予不能各自通过校验后同时写入；grant返回的错误原样返回
+func (c *Checker) Grant(ctx context.Context, grants []Grant, grant func(ctx context.Context) error) (*Violation, error) {
+	var violation *Violation
+	err := c.Locked(ctx, func(ctx context.Context) error {
+		var err error
+		violation, err = c.Check(ctx, grants)
+		if err != nil || violation != nil {
+			return err
+		}
+		return grant(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return violation, nil
+}
+
+// Validate 检查当前租户已有数据中违反约束的情况
+func (c *Checker) Validate(ctx context.Context) ([]*Violation, error) {
+	constraints, err := c.Constraints(ctx)
+	if err != nil || len(constraints) == 0 {
+		return nil, err
+	}
+
+	userRoles, err := c.userRolesModel.FindAllUnexpired(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inherited, err := c.groupRolesModel.FindAllInherited(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hs := make(holdings)
+	fill(hs, userRoles, inherited)
+
+	userIds := make([]int64, 0, len(hs))
+	for userId := range hs {
+		userIds = append(userIds, userId)
+	}
+	sort.Slice(userIds, func(i, j int) bool { return userIds[i] < userIds[j] })
+
+	var violations []*Violation
+	for _, constraint := range constraints {
+		switch constraint.ConstraintType {
+		case model.RoleConstraintRoleCardinality:
+			for _, roleId := range constraint.RoleIds {
+				if v := checkRole(constraint, hs, roleId); v != nil {
+					violations = append(violations, v)
+				}
+			}
+		default:
+			for _, userId := range userIds {
+				if v := checkUser(constraint, hs, userId); v != nil {
+					violations = append(violations, v)
+				}
+			}
+		}
+	}
+	return violations, nil
+}
+
+// load 加载指定用户持有的所有角色和指定角色的所有持有人
+func (c *Checker) load(ctx context.Context, userIds, roleIds []int64) (holdings, error) {
+	hs := make(holdings)
+
+	userRoles, err := c.userRolesModel.FindUnexpiredByUserIds(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+	inherited, err := c.groupRolesModel.FindInheritedByUserIds(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+	fill(hs, userRoles, inherited)
+
+	if len(roleIds) > 0 {
+		userRoles, err = c.userRolesModel.FindUnexpiredByRoleIds(ctx, roleIds)
+		if err != nil {
+			return nil, err
+		}
+		inherited, err = c.groupRolesModel.FindInheritedByRoleIds(ctx, roleIds)
+		if err != nil {
+			return nil, err
+		}
+		fill(hs, userRoles, inherited)
+	}
+	return hs, nil
+}
+
+// fill 将直接分配和继承的角色写入快照
+func fill(hs holdings, userRoles []*model.UserRoles, inherited []*model.InheritedRole) {
+	for _, ur := range userRoles {
+		hs.addDirect(ur.UserId, ur.RoleId, ur.Validity())
+	}
+	for _, ir := range inherited {
+		hs.addInherited(ir.UserId, ir.RoleId)
+	}
+}
+
+// touches 授予的角色是否计入用户级约束
+func touches(constraint *Constraint, roles map[int64]bool) bool {
+	if constraint.ConstraintType == model.RoleConstraintUserCardinality {
+		return len(roles) > 0
+	}
+	for roleId := range roles {
+		if constraint.roleSet[roleId] {
+			return true
+		}
+	}
+	return false
+}
+
+// userScope 用户级约束计入的角色集合，为nil表示所有角色
+func userScope(constraint *Constraint) map[int64]bool {
+	if constraint.ConstraintType == model.RoleConstraintUserCardinality {
+		return nil
+	}
+	return constraint.roleSet
+}
+
+// countUser 用户在约束下的计数
+func countUser(constraint *Constraint, hs holdings, userId int64) int {
+	_, spans := hs.userRoles(userId, userScope(constraint))
+	return count(spans, constraint.Enforcement)
+}
+
+// checkUser 检查用户是否违反用户级约束
+func checkUser(constraint *Constraint, hs holdings, userId int64) *Violation {
+	roleIds, spans := hs.userRoles(userId, userScope(constraint))
+	n := count(spans, constraint.Enforcement)
+	if int64(n) <= constraint.MaxCount {
+		return nil
+	}
+	return &Violation{Constraint: constraint, UserId: userId, RoleIds: roleIds, Count: n}
+}
+
+// countRole 角色在约束下的计数
+func countRole(constraint *Constraint, hs holdings, roleId int64) int {
+	_, spans := hs.roleHolders(roleId)
+	return count(spans, constraint.Enforcement)
+}
+
+// checkRole 检查角色是否违反角色数量约束
+func checkRole(constraint *Constraint, hs holdings, roleId int64) *Violation {
+	userIds, spans := hs.roleHolders(roleId)
+	n := count(spans, constraint.Enforcement)
+	if int64(n) <= constraint.MaxCount {
+		return nil
+	}
+	return &Violation{Constraint: constraint, RoleId: roleId, UserIds: userIds, Count: n}
+}
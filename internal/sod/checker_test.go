@@ -0,0 +1,175 @@
+package sod
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+)
+
+// newTestChecker 约束：
+//
+//	maker_checker 角色10和11互斥（静态）
+//	shift         角色12和13不能同时生效（动态）
+//	single_owner  角色20最多1个持有人
+//	max_roles     用户最多持有3个角色
+//
+// inherited中的每条继承角色通过单独的用户组授予
+func newTestChecker(userRoles []*model.UserRoles, inherited []*model.InheritedRole) *Checker {
+	members := &modeltest.GroupMembers{}
+	groupRoles := &modeltest.GroupRoles{Members: members}
+	for i, ir := range inherited {
+		groupId := int64(100 + i)
+		members.Rows = append(members.Rows, &model.GroupMembers{GroupId: groupId, UserId: ir.UserId})
+		groupRoles.Rows = append(groupRoles.Rows, &model.GroupRoles{GroupId: groupId, RoleId: ir.RoleId})
+	}
+	constraints := &modeltest.RoleConstraints{Rows: []*model.RoleConstraints{
+		{Id: 1, Code: "maker_checker", ConstraintType: model.RoleConstraintExclusive, Enforcement: model.RoleConstraintStatic, MaxCount: 1},
+		{Id: 2, Code: "shift", ConstraintType: model.RoleConstraintExclusive, Enforcement: model.RoleConstraintDynamic, MaxCount: 1},
+		{Id: 3, Code: "single_owner", ConstraintType: model.RoleConstraintRoleCardinality, Enforcement: model.RoleConstraintStatic, MaxCount: 1},
+		{Id: 4, Code: "max_roles", ConstraintType: model.RoleConstraintUserCardinality, Enforcement: model.RoleConstraintStatic, MaxCount: 3},
+	}}
+	constraintRoles := &modeltest.RoleConstraintRoles{Rows: []*model.RoleConstraintRoles{
+		{ConstraintId: 1, RoleId: 10}, {ConstraintId: 1, RoleId: 11},
+		{ConstraintId: 2, RoleId: 12}, {ConstraintId: 2, RoleId: 13},
+		{ConstraintId: 3, RoleId: 20},
+	}}
+	return NewChecker(constraints, constraintRoles, &modeltest.UserRoles{Rows: userRoles}, groupRoles)
+}
+
+func userRole(userId, roleId int64, v model.Validity) *model.UserRoles {
+	return &model.UserRoles{UserId: userId, RoleId: roleId, ValidFrom: v.From, ValidUntil: v.Until}
+}
+
+func TestCheck(t *testing.T) {
+	userRoles := []*model.UserRoles{
+		userRole(1, 10, model.Validity{}),
+		userRole(1, 12, validity(0, 10)),
+		userRole(2, 20, model.Validity{}),
+	}
+	inherited := []*model.InheritedRole{{UserId: 3, RoleId: 11}}
+	tests := []struct {
+		name       string
+		grants     []Grant
+		constraint string
+	}{
+		{"exclusive", []Grant{{UserId: 1, RoleId: 11}}, "maker_checker"},
+		{"exclusive inherited", []Grant{{UserId: 3, RoleId: 10}}, "maker_checker"},
+		{"exclusive via group", []Grant{{UserId: 1, RoleId: 11, Inherited: true}}, "maker_checker"},
+		{"dynamic overlap", []Grant{{UserId: 1, RoleId: 13, Validity: validity(5, 15)}}, "shift"},
+		{"dynamic disjoint", []Grant{{UserId: 1, RoleId: 13, Validity: validity(10, 20)}}, ""},
+		{"role cardinality", []Grant{{UserId: 4, RoleId: 20}}, "single_owner"},
+		{"existing holder", []Grant{{UserId: 2, RoleId: 20, Validity: validity(0, 5)}}, ""},
+		{"user cardinality", []Grant{{UserId: 1, RoleId: 30}, {UserId: 1, RoleId: 31}}, "max_roles"},
+		{"unrelated", []Grant{{UserId: 4, RoleId: 30}}, ""},
+		{"no grants", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := newTestChecker(userRoles, inherited).Check(context.Background(), tt.grants)
+			if err != nil {
+				t.Fatalf("Check: %v", err)
+			}
+			if tt.constraint == "" {
+				if v != nil {
+					t.Fatalf("unexpected violation: %v", v.Error())
+				}
+				return
+			}
+			if v == nil || v.Constraint.Code != tt.constraint {
+				t.Fatalf("violation = %v, want %s", v, tt.constraint)
+			}
+		})
+	}
+}
+
+// TestCheckExistingViolation 与本次授予无关或未加重的存量违规不拦截授予
+func TestCheckExistingViolation(t *testing.T) {
+	userRoles := []*model.UserRoles{
+		userRole(1, 10, model.Validity{}),
+		userRole(1, 11, model.Validity{}),
+		userRole(2, 20, model.Validity{}),
+		userRole(3, 20, model.Validity{}),
+	}
+	c := newTestChecker(userRoles, nil)
+	for _, grants := range [][]Grant{
+		{{UserId: 1, RoleId: 30}},
+		{{UserId: 1, RoleId: 11, Validity: validity(0, 10)}},
+		{{UserId: 2, RoleId: 20, Validity: validity(0, 10)}},
+	} {
+		v, err := c.Check(context.Background(), grants)
+		if err != nil || v != nil {
+			t.Fatalf("Check(%+v) = %v, %v", grants, v, err)
+		}
+	}
+	// 新增持有人加重了存量违规
+	v, err := c.Check(context.Background(), []Grant{{UserId: 4, RoleId: 20}})
+	if err != nil || v == nil || v.Count != 3 || !slices.Equal(v.UserIds, []int64{2, 3, 4}) {
+		t.Fatalf("Check = %+v, %v", v, err)
+	}
+	if !strings.Contains(v.Error(), "role 20 has 3 holders, at most 1 allowed") {
+		t.Fatalf("violation message = %q", v.Error())
+	}
+}
+
+func TestGrant(t *testing.T) {
+	c := newTestChecker([]*model.UserRoles{userRole(1, 10, model.Validity{})}, nil)
+	ctx := context.Background()
+
+	// 违反约束时不执行写入
+	granted := 0
+	grant := func(context.Context) error {
+		granted++
+		return nil
+	}
+	v, err := c.Grant(ctx, []Grant{{UserId: 1, RoleId: 11}}, grant)
+	if err != nil || v == nil || v.Constraint.Code != "maker_checker" || granted != 0 {
+		t.Fatalf("Grant conflicting = %v, %v, granted %d", v, err, granted)
+	}
+
+	v, err = c.Grant(ctx, []Grant{{UserId: 2, RoleId: 11}}, grant)
+	if err != nil || v != nil || granted != 1 {
+		t.Fatalf("Grant = %v, %v, granted %d", v, err, granted)
+	}
+	if locks := c.constraintsModel.(*modeltest.RoleConstraints).Locks; locks != 2 {
+		t.Fatalf("locks = %d, want 2", locks)
+	}
+
+	// 写入失败时原样返回错误
+	wantErr := errors.New("write failed")
+	if _, err = c.Grant(ctx, []Grant{{UserId: 3, RoleId: 11}}, func(context.Context) error { return wantErr }); !errors.Is(err, wantErr) {
+		t.Fatalf("Grant err = %v, want %v", err, wantErr)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	userRoles := []*model.UserRoles{
+		userRole(1, 10, model.Validity{}),
+		userRole(1, 12, validity(0, 10)),
+		userRole(1, 13, validity(10, 20)),
+		userRole(2, 20, model.Validity{}),
+		userRole(3, 20, model.Validity{}),
+	}
+	inherited := []*model.InheritedRole{{UserId: 1, RoleId: 11}}
+	violations, err := newTestChecker(userRoles, inherited).Validate(context.Background())
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	var got []string
+	for _, v := range violations {
+		got = append(got, v.Constraint.Code)
+	}
+	// 角色12和13的有效期不重叠，不违反动态约束；违规按约束编码排序
+	want := []string{"maker_checker", "max_roles", "single_owner"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("violations = %v, want %v", got, want)
+	}
+	if v := violations[0]; v.UserId != 1 || !slices.Equal(v.RoleIds, []int64{10, 11}) ||
+		!strings.Contains(v.Error(), "user 1 holds 2 of roles [10 11], at most 1 allowed") {
+		t.Fatalf("exclusive violation = %+v: %s", v, v.Error())
+	}
+}
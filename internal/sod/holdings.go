@@ -0,0 +1,163 @@
+package sod
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// Grant 一次待生效的角色授予，Inherited为true表示通过用户组继承（视为永久持有）
+type Grant struct {
+	UserId    int64
+	RoleId    int64
+	Validity  model.Validity
+	Inherited bool
+}
+
+// holding 用户对某个角色的持有情况
+type holding struct {
+	direct    *model.Validity // 直接分配的有效期，为空表示未直接分配
+	inherited bool            // 是否通过用户组继承
+}
+
+// span 持有区间，通过用户组继承时为永久
+func (h *holding) span() model.Validity {
+	if h.inherited || h.direct == nil {
+		return model.Validity{}
+	}
+	return *h.direct
+}
+
+// holdings 用户持有角色的快照，userId -> roleId -> 持有情况
+type holdings map[int64]map[int64]*holding
+
+func (hs holdings) get(userId, roleId int64) *holding {
+	roles, ok := hs[userId]
+	if !ok {
+		roles = make(map[int64]*holding)
+		hs[userId] = roles
+	}
+	h, ok := roles[roleId]
+	if !ok {
+		h = &holding{}
+		roles[roleId] = h
+	}
+	return h
+}
+
+// addDirect 记录直接分配，同一用户同一角色只有一条直接分配，新值覆盖旧值
+func (hs holdings) addDirect(userId, roleId int64, validity model.Validity) {
+	hs.get(userId, roleId).direct = &validity
+}
+
+// addInherited 记录通过用户组继承的角色
+func (hs holdings) addInherited(userId, roleId int64) {
+	hs.get(userId, roleId).inherited = true
+}
+
+// apply 应用待生效的授予，已有直接分配时与分配角色的规则一致，合并为覆盖两者的有效期
+func (hs holdings) apply(grants []Grant) {
+	now := time.Now()
+	for _, g := range grants {
+		if g.Inherited {
+			hs.addInherited(g.UserId, g.RoleId)
+			continue
+		}
+		validity := g.Validity
+		if h := hs.get(g.UserId, g.RoleId); h.direct != nil {
+			validity = h.direct.Widen(g.Validity, now)
+		}
+		hs.addDirect(g.UserId, g.RoleId, validity)
+	}
+}
+
+// clone 深拷贝快照，用于比较授予前后的差异
+func (hs holdings) clone() holdings {
+	out := make(holdings, len(hs))
+	for userId, roles := range hs {
+		copied := make(map[int64]*holding, len(roles))
+		for roleId, h := range roles {
+			c := *h
+			copied[roleId] = &c
+		}
+		out[userId] = copied
+	}
+	return out
+}
+
+// userRoles 用户持有的角色，roleSet为空时返回全部角色，结果按角色ID排序
+func (hs holdings) userRoles(userId int64, roleSet map[int64]bool) ([]int64, []model.Validity) {
+	roles := hs[userId]
+	roleIds := make([]int64, 0, len(roles))
+	for roleId := range roles {
+		if roleSet == nil || roleSet[roleId] {
+			roleIds = append(roleIds, roleId)
+		}
+	}
+	sort.Slice(roleIds, func(i, j int) bool { return roleIds[i] < roleIds[j] })
+	spans := make([]model.Validity, len(roleIds))
+	for i, roleId := range roleIds {
+		spans[i] = roles[roleId].span()
+	}
+	return roleIds, spans
+}
+
+// roleHolders 持有角色的用户，结果按用户ID排序
+func (hs holdings) roleHolders(roleId int64) ([]int64, []model.Validity) {
+	var userIds []int64
+	for userId, roles := range hs {
+		if _, ok := roles[roleId]; ok {
+			userIds = append(userIds, userId)
+		}
+	}
+	sort.Slice(userIds, func(i, j int) bool { return userIds[i] < userIds[j] })
+	spans := make([]model.Validity, len(userIds))
+	for i, userId := range userIds {
+		spans[i] = hs[userId][roleId].span()
+	}
+	return userIds, spans
+}
+
+// count 按校验方式计数：静态约束计入所有区间，动态约束计入同一时刻重叠的最大区间数
+func count(spans []model.Validity, enforcement string) int {
+	if enforcement != model.RoleConstraintDynamic {
+		return len(spans)
+	}
+	return maxOverlap(spans)
+}
+
+// maxOverlap 计算同一时刻重叠的最大区间数，区间为左闭右开
+func maxOverlap(spans []model.Validity) int {
+	type edge struct {
+		at    int64
+		delta int
+	}
+	edges := make([]edge, 0, len(spans)*2)
+	for _, v := range spans {
+		from, until := int64(math.MinInt64), int64(math.MaxInt64)
+		if v.From.Valid {
+			from = v.From.Time.UnixNano()
+		}
+		if v.Until.Valid {
+			until = v.Until.Time.UnixNano()
+		}
+		edges = append(edges, edge{from, 1}, edge{until, -1})
+	}
+	// 同一时刻先结束后开始
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].at != edges[j].at {
+			return edges[i].at < edges[j].at
+		}
+		return edges[i].delta < edges[j].delta
+	})
+	current, peak := 0, 0
+	for _, e := range edges {
+		current += e.delta
+		if current > peak {
+			peak = current
+		}
+	}
+	return peak
+}
@@ -0,0 +1,92 @@
+package sod
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+)
+
+// baseTime 以明天为基准，测试中的授权都尚未过期
+var baseTime = time.Now().AddDate(0, 0, 1)
+
+// validity 以天为单位的有效期，负数表示不限
+func validity(fromDay, untilDay int) model.Validity {
+	var v model.Validity
+	if fromDay >= 0 {
+		v.From = sql.NullTime{Time: baseTime.AddDate(0, 0, fromDay), Valid: true}
+	}
+	if untilDay >= 0 {
+		v.Until = sql.NullTime{Time: baseTime.AddDate(0, 0, untilDay), Valid: true}
+	}
+	return v
+}
+
+func TestMaxOverlap(t *testing.T) {
+	tests := []struct {
+		name  string
+		spans []model.Validity
+		want  int
+	}{
+		{"empty", nil, 0},
+		{"disjoint", []model.Validity{validity(0, 10), validity(20, 30)}, 1},
+		// 区间左闭右开，前一个结束时后一个开始不算重叠
+		{"adjacent", []model.Validity{validity(0, 10), validity(10, 20)}, 1},
+		{"overlapping", []model.Validity{validity(0, 10), validity(5, 20), validity(8, 9)}, 3},
+		{"unbounded", []model.Validity{validity(-1, -1), validity(100, 200), validity(-1, 5)}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maxOverlap(tt.spans); got != tt.want {
+				t.Fatalf("maxOverlap = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCount(t *testing.T) {
+	spans := []model.Validity{validity(0, 10), validity(20, 30)}
+	if n := count(spans, model.RoleConstraintStatic); n != 2 {
+		t.Fatalf("static count = %d, want 2", n)
+	}
+	if n := count(spans, model.RoleConstraintDynamic); n != 1 {
+		t.Fatalf("dynamic count = %d, want 1", n)
+	}
+}
+
+func TestHoldings(t *testing.T) {
+	hs := make(holdings)
+	hs.addDirect(1, 10, validity(0, 10))
+	hs.addInherited(1, 10)
+	hs.addDirect(1, 11, validity(0, 10))
+	hs.addDirect(2, 10, validity(5, -1))
+
+	// 通过用户组继承的角色视为永久持有
+	roleIds, spans := hs.userRoles(1, nil)
+	if len(roleIds) != 2 || roleIds[0] != 10 || spans[0] != (model.Validity{}) || spans[1] != validity(0, 10) {
+		t.Fatalf("userRoles = %v %v", roleIds, spans)
+	}
+	if roleIds, _ = hs.userRoles(1, map[int64]bool{11: true}); len(roleIds) != 1 || roleIds[0] != 11 {
+		t.Fatalf("scoped userRoles = %v", roleIds)
+	}
+	if userIds, _ := hs.roleHolders(10); len(userIds) != 2 || userIds[0] != 1 || userIds[1] != 2 {
+		t.Fatalf("roleHolders = %v", userIds)
+	}
+
+	// 克隆后应用授予不影响原快照
+	after := hs.clone()
+	after.apply([]Grant{{UserId: 3, RoleId: 10}, {UserId: 2, RoleId: 10, Inherited: true}})
+	if userIds, _ := hs.roleHolders(10); len(userIds) != 2 || hs[2][10].inherited {
+		t.Fatalf("clone shares state with original")
+	}
+	if userIds, _ := after.roleHolders(10); len(userIds) != 3 || !after[2][10].inherited {
+		t.Fatalf("grants not applied: %v", userIds)
+	}
+
+	// 重复授予已直接持有的角色时保留覆盖范围更大的有效期
+	after.apply([]Grant{{UserId: 1, RoleId: 11, Validity: validity(5, 20)}})
+	if got := *after[1][11].direct; got != validity(0, 20) {
+		t.Fatalf("widened validity = %v, want %v", got, validity(0, 20))
+	}
+}
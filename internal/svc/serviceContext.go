@@ -2,28 +2,138 @@ package svc
 
 import (
 	_ "github.com/lib/pq" // PostgreSQL driver
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/condition"
 	"github.com/ziptako/iam/internal/config"
+	"github.com/ziptako/iam/internal/delegation"
+	"github.com/ziptako/iam/internal/expiry"
+	"github.com/ziptako/iam/internal/impersonation"
+	"github.com/ziptako/iam/internal/ldap"
+	"github.com/ziptako/iam/internal/notify"
+	"github.com/ziptako/iam/internal/policy"
+	"github.com/ziptako/iam/internal/rebac"
+	"github.com/ziptako/iam/internal/rpcauth"
+	"github.com/ziptako/iam/internal/sod"
+	"github.com/ziptako/iam/internal/usage"
+	"github.com/ziptako/iam/internal/verification"
 )
 
 type ServiceContext struct {
-	Config               config.Config
-	PermissionsModel     model.PermissionsModel
-	UsersModel           model.UsersModel
-	RolesModel           model.RolesModel
-	UserRolesModel       model.UserRolesModel
-	RolePermissionsModel model.RolePermissionsModel
+	Config                    config.Config
+	PermissionsModel          model.PermissionsModel
+	UsersModel                model.UsersModel
+	RolesModel                model.RolesModel
+	UserRolesModel            model.UserRolesModel
+	RolePermissionsModel      model.RolePermissionsModel
+	SessionsModel             model.SessionsModel
+	ImpersonationsModel       model.ImpersonationsModel
+	AuditLogsModel            model.AuditLogsModel
+	VerificationCodesModel    model.VerificationCodesModel
+	GroupsModel               model.GroupsModel
+	GroupMembersModel         model.GroupMembersModel
+	GroupRolesModel           model.GroupRolesModel
+	TenantsModel              model.TenantsModel
+	DepartmentsModel          model.DepartmentsModel
+	RoleDepartmentsModel      model.RoleDepartmentsModel
+	ResourceBindingsModel     model.ResourceBindingsModel
+	RelationTuplesModel       model.RelationTuplesModel
+	RoleApproversModel        model.RoleApproversModel
+	AccessRequestsModel       model.AccessRequestsModel
+	AccessRequestEventsModel  model.AccessRequestEventsModel
+	RoleConstraintsModel      model.RoleConstraintsModel
+	RoleConstraintRolesModel  model.RoleConstraintRolesModel
+	AdminScopesModel          model.AdminScopesModel
+	AdminScopeRolesModel      model.AdminScopeRolesModel
+	ReviewCampaignsModel      model.ReviewCampaignsModel
+	ReviewItemsModel          model.ReviewItemsModel
+	ReviewItemReviewersModel  model.ReviewItemReviewersModel
+	PermissionUsageUsersModel model.PermissionUsageUsersModel
+	PermissionUsageRolesModel model.PermissionUsageRolesModel
+	PolicyModel               model.PolicyModel
+
+	Impersonation *impersonation.Manager
+	Verification  *verification.Service
+	LdapConnector *ldap.Connector // LDAP未启用时为nil
+	LdapSyncer    *ldap.Syncer    // LDAP未启用时为nil
+	Rebac         *rebac.Engine
+	Conditions    *condition.Evaluator   // 角色权限授权条件求值
+	ExpirySweeper *expiry.Sweeper        // 限时授权到期清理
+	Constraints   *sod.Checker           // 职责分离约束校验
+	Delegation    *delegation.Guard      // 委派管理范围校验
+	RpcTokens     *rpcauth.TokenIssuer   // 用户令牌签发
+	RpcAuthn      *rpcauth.Authenticator // IAM接口调用方认证
+	RpcAuthz      *rpcauth.Authorizer    // IAM接口调用方授权
+	Usage         *usage.Recorder        // 权限使用记录
+	Policy        *policy.Service        // 策略即代码
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	conn := sqlx.NewSqlConn("postgres", c.DataSource)
-	return &ServiceContext{
-		Config:               c,
-		UsersModel:           model.NewUsersModel(conn, c.Cache),
-		RolesModel:           model.NewRolesModel(conn, c.Cache),
-		PermissionsModel:     model.NewPermissionsModel(conn, c.Cache),
-		UserRolesModel:       model.NewUserRolesModel(conn, c.Cache),
-		RolePermissionsModel: model.NewRolePermissionsModel(conn, c.Cache),
+	ctx := &ServiceContext{
+		Config:                    c,
+		UsersModel:                model.NewUsersModel(conn, c.Cache),
+		RolesModel:                model.NewRolesModel(conn, c.Cache),
+		PermissionsModel:          model.NewPermissionsModel(conn, c.Cache),
+		UserRolesModel:            model.NewUserRolesModel(conn, c.Cache),
+		RolePermissionsModel:      model.NewRolePermissionsModel(conn, c.Cache),
+		SessionsModel:             model.NewSessionsModel(conn, c.Cache),
+		ImpersonationsModel:       model.NewImpersonationsModel(conn, c.Cache),
+		AuditLogsModel:            model.NewAuditLogsModel(conn, c.Cache),
+		VerificationCodesModel:    model.NewVerificationCodesModel(conn, c.Cache),
+		GroupsModel:               model.NewGroupsModel(conn, c.Cache),
+		GroupMembersModel:         model.NewGroupMembersModel(conn, c.Cache),
+		GroupRolesModel:           model.NewGroupRolesModel(conn, c.Cache),
+		TenantsModel:              model.NewTenantsModel(conn, c.Cache),
+		DepartmentsModel:          model.NewDepartmentsModel(conn, c.Cache),
+		RoleDepartmentsModel:      model.NewRoleDepartmentsModel(conn, c.Cache),
+		ResourceBindingsModel:     model.NewResourceBindingsModel(conn, c.Cache),
+		RelationTuplesModel:       model.NewRelationTuplesModel(conn, c.Cache),
+		RoleApproversModel:        model.NewRoleApproversModel(conn, c.Cache),
+		AccessRequestsModel:       model.NewAccessRequestsModel(conn, c.Cache),
+		AccessRequestEventsModel:  model.NewAccessRequestEventsModel(conn, c.Cache),
+		RoleConstraintsModel:      model.NewRoleConstraintsModel(conn, c.Cache),
+		RoleConstraintRolesModel:  model.NewRoleConstraintRolesModel(conn, c.Cache),
+		AdminScopesModel:          model.NewAdminScopesModel(conn, c.Cache),
+		AdminScopeRolesModel:      model.NewAdminScopeRolesModel(conn, c.Cache),
+		ReviewCampaignsModel:      model.NewReviewCampaignsModel(conn, c.Cache),
+		ReviewItemsModel:          model.NewReviewItemsModel(conn, c.Cache),
+		ReviewItemReviewersModel:  model.NewReviewItemReviewersModel(conn, c.Cache),
+		PermissionUsageUsersModel: model.NewPermissionUsageUsersModel(conn, c.Cache),
+		PermissionUsageRolesModel: model.NewPermissionUsageRolesModel(conn, c.Cache),
+		PolicyModel:               model.NewPolicyModel(conn, c.Cache),
+		Impersonation:             impersonation.NewManager(c.Impersonation),
+	}
+
+	notifier, err := notify.NewNotifier(c.Verification.Notifier)
+	logx.Must(err)
+	ctx.Verification = verification.NewService(c.Verification, ctx.UsersModel, ctx.VerificationCodesModel, notifier)
+
+	ctx.Conditions, err = condition.NewEvaluator()
+	logx.Must(err)
+
+	schema, err := rebac.LoadSchema(c.Rebac.NamespaceFile)
+	logx.Must(err)
+	ctx.Rebac = rebac.NewEngine(c.Rebac, schema, ctx.RelationTuplesModel, ctx.UsersModel, ctx.RolesModel, ctx.UserRolesModel, ctx.GroupRolesModel)
+
+	ctx.ExpirySweeper = expiry.NewSweeper(c.Expiry, ctx.UserRolesModel, ctx.RolePermissionsModel, ctx.AuditLogsModel)
+	ctx.Constraints = sod.NewChecker(ctx.RoleConstraintsModel, ctx.RoleConstraintRolesModel, ctx.UserRolesModel, ctx.GroupRolesModel)
+	ctx.Delegation = delegation.NewGuard(c.Delegation, ctx.UsersModel, ctx.RolesModel, ctx.UserRolesModel, ctx.GroupRolesModel,
+		ctx.GroupMembersModel, ctx.ResourceBindingsModel, ctx.DepartmentsModel, ctx.SessionsModel, ctx.AdminScopesModel,
+		ctx.AdminScopeRolesModel)
+	ctx.RpcTokens = rpcauth.NewTokenIssuer(c.RpcAuth)
+	ctx.RpcAuthn = rpcauth.NewAuthenticator(c.RpcAuth, ctx.RpcTokens, ctx.UsersModel, ctx.SessionsModel)
+	ctx.RpcAuthz = rpcauth.NewAuthorizer(ctx.UsersModel, ctx.RolesModel, ctx.UserRolesModel, ctx.GroupRolesModel,
+		ctx.RolePermissionsModel, ctx.PermissionsModel, ctx.Conditions)
+	ctx.Usage = usage.NewRecorder(c.Usage, ctx.PermissionUsageUsersModel, ctx.PermissionUsageRolesModel)
+	ctx.Policy = policy.NewService(ctx.PermissionsModel, ctx.RolesModel, ctx.RolePermissionsModel, ctx.UsersModel, ctx.UserRolesModel,
+		ctx.GroupRolesModel, ctx.ResourceBindingsModel, ctx.PolicyModel, ctx.Conditions, ctx.Constraints)
+
+	if c.Ldap.Enabled {
+		ctx.LdapConnector = ldap.NewConnector(c.Ldap)
+		ctx.LdapSyncer = ldap.NewSyncer(ctx.LdapConnector, ctx.UsersModel, ctx.RolesModel, ctx.UserRolesModel, ctx.SessionsModel, ctx.Constraints)
 	}
+
+	return ctx
 }
@@ -0,0 +1,52 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataKey 携带租户ID的gRPC metadata键
+const MetadataKey = "x-tenant-id"
+
+// UnaryServerInterceptor 从metadata读取租户ID并校验租户有效，之后的模型查询都按该租户隔离
+// 未携带租户ID时，Required为false则归属默认租户，否则拒绝调用
+func UnaryServerInterceptor(c config.TenantConf, tenantsModel model.TenantsModel) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(MetadataKey)
+		if len(values) == 0 || strings.TrimSpace(values[0]) == "" {
+			if c.Required {
+				return nil, status.Error(codes.InvalidArgument, "[TEN001] Tenant ID is required")
+			}
+			return handler(model.WithTenantId(ctx, model.DefaultTenantId), req)
+		}
+
+		tenantId, err := strconv.ParseInt(strings.TrimSpace(values[0]), 10, 64)
+		if err != nil || tenantId <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "[TEN002] Invalid tenant ID")
+		}
+		_, err = tenantsModel.FindActiveById(ctx, tenantId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil, status.Error(codes.PermissionDenied, "[TEN003] Tenant not found or disabled")
+			}
+			eInfo := "[TEN004] 查询租户失败"
+			logx.WithContext(ctx).Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+
+		ctx = model.WithTenantId(ctx, tenantId)
+		ctx = logx.ContextWithFields(ctx, logx.Field("tenant_id", tenantId))
+		return handler(ctx, req)
+	}
+}
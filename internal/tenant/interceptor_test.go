@@ -0,0 +1,70 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeTenants 活跃租户，id为99时模拟数据库错误
+type fakeTenants struct {
+	model.TenantsModel
+	active map[int64]bool
+}
+
+func (m *fakeTenants) FindActiveById(_ context.Context, id int64) (*model.Tenants, error) {
+	if id == 99 {
+		return nil, errors.New("connection refused")
+	}
+	if !m.active[id] {
+		return nil, model.ErrNotFound
+	}
+	return &model.Tenants{Id: id}, nil
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	tenants := &fakeTenants{active: map[int64]bool{1: true, 2: true}}
+	tests := []struct {
+		name     string
+		required bool
+		tenant   string
+		code     codes.Code
+		tenantId int64
+	}{
+		{"default tenant", false, "", codes.OK, model.DefaultTenantId},
+		{"required", true, "", codes.InvalidArgument, 0},
+		{"blank", true, " ", codes.InvalidArgument, 0},
+		{"tenant", true, " 2 ", codes.OK, 2},
+		{"not a number", false, "abc", codes.InvalidArgument, 0},
+		{"negative", false, "-2", codes.InvalidArgument, 0},
+		{"disabled", false, "3", codes.PermissionDenied, 0},
+		{"lookup error", false, "99", codes.Internal, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := UnaryServerInterceptor(config.TenantConf{Required: tt.required}, tenants)
+			ctx := context.Background()
+			if tt.tenant != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, tt.tenant))
+			}
+			var tenantId int64
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				tenantId = model.TenantIdFromContext(ctx)
+				return nil, nil
+			})
+			if status.Code(err) != tt.code {
+				t.Fatalf("error = %v, want %s", err, tt.code)
+			}
+			if tenantId != tt.tenantId {
+				t.Fatalf("tenant id = %d, want %d", tenantId, tt.tenantId)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package usage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/config"
+)
+
+// userKey 用户授权使用记录的缓冲键
+type userKey struct {
+	tenantId     int64
+	userId       int64
+	permissionId int64
+}
+
+// roleKey 角色授权使用记录的缓冲键
+type roleKey struct {
+	tenantId     int64
+	roleId       int64
+	permissionId int64
+}
+
+// hit 缓冲期间累计的使用情况
+type hit struct {
+	lastUsedAt time.Time
+	count      int64
+}
+
+// Grant 使权限检查通过的一条允许授权
+type Grant struct {
+	RoleId       int64
+	PermissionId int64
+}
+
+// Recorder 在内存中缓冲权限检查命中的授权，定时批量写入使用记录，检查路径上只做一次加锁的内存更新
+// 缓冲的键数量达到上限时立即触发写入，写入完成前新出现的键被丢弃，已有键继续累计
+type Recorder struct {
+	c                         config.UsageConf
+	permissionUsageUsersModel model.PermissionUsageUsersModel
+	permissionUsageRolesModel model.PermissionUsageRolesModel
+
+	lock    sync.Mutex
+	users   map[userKey]*hit
+	roles   map[roleKey]*hit
+	dropped int64
+
+	flushLock sync.Mutex    // 防止定时写入与停止时的写入并发执行
+	full      chan struct{} // 缓冲已满时通知立即写入
+	done      chan struct{}
+	stopped   chan struct{}
+}
+
+// NewRecorder 创建权限使用记录器
+func NewRecorder(c config.UsageConf, permissionUsageUsersModel model.PermissionUsageUsersModel, permissionUsageRolesModel model.PermissionUsageRolesModel) *Recorder {
+	return &Recorder{
+		c:                         c,
+		permissionUsageUsersModel: permissionUsageUsersModel,
+		permissionUsageRolesModel: permissionUsageRolesModel,
+		users:                     make(map[userKey]*hit),
+		roles:                     make(map[roleKey]*hit),
+		full:                      make(chan struct{}, 1),
+	}
+}
+
+// Enabled 是否记录权限使用情况，写入间隔为0时视为未启用
+func (r *Recorder) Enabled() bool {
+	return r.c.Enabled && r.c.FlushInterval > 0
+}
+
+// Record 记录一次通过的权限检查，grants为使检查通过的允许授权，租户取自ctx
+func (r *Recorder) Record(ctx context.Context, userId int64, grants []Grant) {
+	if !r.Enabled() || len(grants) == 0 {
+		return
+	}
+
+	tenantId := model.TenantIdFromContext(ctx)
+	now := time.Now()
+
+	r.lock.Lock()
+	full := false
+	for _, g := range grants {
+		full = r.touchUser(userKey{tenantId, userId, g.PermissionId}, now) || full
+		full = r.touchRole(roleKey{tenantId, g.RoleId, g.PermissionId}, now) || full
+	}
+	r.lock.Unlock()
+
+	if full {
+		select {
+		case r.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// touchUser 累计用户授权的使用，缓冲已满且是新键时丢弃并返回true，调用方需持有锁
+func (r *Recorder) touchUser(key userKey, now time.Time) bool {
+	if h, ok := r.users[key]; ok {
+		h.lastUsedAt = now
+		h.count++
+		return false
+	}
+	if r.pending() >= r.c.MaxPending {
+		r.dropped++
+		return true
+	}
+	r.users[key] = &hit{lastUsedAt: now, count: 1}
+	return false
+}
+
+// touchRole 累计角色授权的使用，缓冲已满且是新键时丢弃并返回true，调用方需持有锁
+func (r *Recorder) touchRole(key roleKey, now time.Time) bool {
+	if h, ok := r.roles[key]; ok {
+		h.lastUsedAt = now
+		h.count++
+		return false
+	}
+	if r.pending() >= r.c.MaxPending {
+		r.dropped++
+		return true
+	}
+	r.roles[key] = &hit{lastUsedAt: now, count: 1}
+	return false
+}
+
+// pending 缓冲中的键数量，调用方需持有锁
+func (r *Recorder) pending() int {
+	return len(r.users) + len(r.roles)
+}
+
+// Start 按配置的间隔启动定时写入，未启用时不启动
+func (r *Recorder) Start() {
+	if !r.Enabled() {
+		return
+	}
+
+	r.done = make(chan struct{})
+	r.stopped = make(chan struct{})
+	ticker := time.NewTicker(time.Duration(r.c.FlushInterval) * time.Second)
+	threading.GoSafe(func() {
+		defer close(r.stopped)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.Flush(context.Background())
+			case <-r.full:
+				r.Flush(context.Background())
+			case <-r.done:
+				return
+			}
+		}
+	})
+}
+
+// Stop 停止定时写入，并写入缓冲中剩余的记录
+func (r *Recorder) Stop() {
+	if r.done != nil {
+		close(r.done)
+		<-r.stopped
+		r.done = nil
+	}
+	r.Flush(context.Background())
+}
+
+// Flush 将缓冲中的记录写入数据库，单条写入失败只记录日志，该条记录被丢弃
+func (r *Recorder) Flush(ctx context.Context) {
+	r.flushLock.Lock()
+	defer r.flushLock.Unlock()
+
+	r.lock.Lock()
+	users, roles, dropped := r.users, r.roles, r.dropped
+	r.users = make(map[userKey]*hit)
+	r.roles = make(map[roleKey]*hit)
+	r.dropped = 0
+	r.lock.Unlock()
+
+	if len(users) == 0 && len(roles) == 0 && dropped == 0 {
+		return
+	}
+	if dropped > 0 {
+		logx.Errorf("[USAGE] 使用记录缓冲已满，丢弃 %d 条记录", dropped)
+	}
+
+	var failed int
+	for key, h := range users {
+		err := r.permissionUsageUsersModel.Touch(ctx, &model.PermissionUsageUsers{
+			TenantId:     key.tenantId,
+			UserId:       key.userId,
+			PermissionId: key.permissionId,
+			LastUsedAt:   h.lastUsedAt,
+			UseCount:     h.count,
+		})
+		if err != nil {
+			failed++
+			logx.Errorf("[USAGE] 写入用户权限使用记录失败 user_id=%d permission_id=%d: %v", key.userId, key.permissionId, err)
+		}
+	}
+	for key, h := range roles {
+		err := r.permissionUsageRolesModel.Touch(ctx, &model.PermissionUsageRoles{
+			TenantId:     key.tenantId,
+			RoleId:       key.roleId,
+			PermissionId: key.permissionId,
+			LastUsedAt:   h.lastUsedAt,
+			UseCount:     h.count,
+		})
+		if err != nil {
+			failed++
+			logx.Errorf("[USAGE] 写入角色权限使用记录失败 role_id=%d permission_id=%d: %v", key.roleId, key.permissionId, err)
+		}
+	}
+	if failed > 0 {
+		logx.Errorf("[USAGE] 本次写入失败 %d 条，共 %d 条", failed, len(users)+len(roles))
+	}
+}
@@ -0,0 +1,116 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/internal/config"
+)
+
+func newTestRecorder(c config.UsageConf) (*Recorder, *modeltest.PermissionUsageUsers, *modeltest.PermissionUsageRoles) {
+	users, roles := &modeltest.PermissionUsageUsers{}, &modeltest.PermissionUsageRoles{}
+	return NewRecorder(c, users, roles), users, roles
+}
+
+// failingUserUsage 写入用户授权使用记录总是失败
+type failingUserUsage struct {
+	model.PermissionUsageUsersModel
+}
+
+func (failingUserUsage) Touch(context.Context, *model.PermissionUsageUsers) error {
+	return errors.New("connection reset")
+}
+
+func TestRecordAggregates(t *testing.T) {
+	r, users, roles := newTestRecorder(config.UsageConf{Enabled: true, FlushInterval: 10, MaxPending: 100})
+	ctx := context.Background()
+
+	r.Record(ctx, 1, []Grant{{RoleId: 10, PermissionId: 100}})
+	r.Record(ctx, 1, []Grant{{RoleId: 10, PermissionId: 100}, {RoleId: 11, PermissionId: 100}})
+	r.Record(ctx, 2, []Grant{{RoleId: 10, PermissionId: 100}})
+	r.Record(ctx, 3, nil)
+	r.Flush(ctx)
+
+	userCounts := make(map[int64]int64)
+	for _, u := range users.Rows {
+		userCounts[u.UserId] = u.UseCount
+	}
+	// 用户记录按授权累计，同一次检查中同一权限的多条授权各计一次
+	if len(users.Rows) != 2 || userCounts[1] != 3 || userCounts[2] != 1 {
+		t.Fatalf("unexpected user usage %v", userCounts)
+	}
+	roleCounts := make(map[int64]int64)
+	for _, rr := range roles.Rows {
+		roleCounts[rr.RoleId] = rr.UseCount
+	}
+	if len(roles.Rows) != 2 || roleCounts[10] != 3 || roleCounts[11] != 1 {
+		t.Fatalf("unexpected role usage %v", roleCounts)
+	}
+
+	// 写入后缓冲被清空，再次写入不重复累加
+	r.Flush(ctx)
+	if users.Rows[0].UseCount != userCounts[users.Rows[0].UserId] || roles.Rows[0].UseCount != roleCounts[roles.Rows[0].RoleId] {
+		t.Fatalf("flush wrote records twice")
+	}
+}
+
+func TestRecordDisabled(t *testing.T) {
+	for _, c := range []config.UsageConf{
+		{Enabled: false, FlushInterval: 10, MaxPending: 100},
+		{Enabled: true, FlushInterval: 0, MaxPending: 100},
+	} {
+		r, users, roles := newTestRecorder(c)
+		r.Record(context.Background(), 1, []Grant{{RoleId: 10, PermissionId: 100}})
+		r.Flush(context.Background())
+		if len(users.Rows) != 0 || len(roles.Rows) != 0 {
+			t.Fatalf("disabled recorder %+v wrote records", c)
+		}
+	}
+}
+
+func TestRecordDropsNewKeysWhenFull(t *testing.T) {
+	r, users, roles := newTestRecorder(config.UsageConf{Enabled: true, FlushInterval: 10, MaxPending: 2})
+	ctx := context.Background()
+
+	r.Record(ctx, 1, []Grant{{RoleId: 10, PermissionId: 100}})
+	r.Record(ctx, 2, []Grant{{RoleId: 10, PermissionId: 100}})
+	// 已有键继续累计
+	r.Record(ctx, 1, []Grant{{RoleId: 10, PermissionId: 100}})
+
+	select {
+	case <-r.full:
+	default:
+		t.Fatalf("full buffer did not request a flush")
+	}
+
+	r.Flush(ctx)
+	if len(users.Rows) != 1 || users.Rows[0].UserId != 1 || users.Rows[0].UseCount != 2 {
+		t.Fatalf("unexpected user usage %+v", users.Rows)
+	}
+	if len(roles.Rows) != 1 || roles.Rows[0].UseCount != 3 {
+		t.Fatalf("unexpected role usage %+v", roles.Rows)
+	}
+}
+
+func TestFlushContinuesAfterError(t *testing.T) {
+	roles := &modeltest.PermissionUsageRoles{}
+	r := NewRecorder(config.UsageConf{Enabled: true, FlushInterval: 10, MaxPending: 100}, failingUserUsage{}, roles)
+	r.Record(context.Background(), 1, []Grant{{RoleId: 10, PermissionId: 100}})
+	r.Flush(context.Background())
+	if len(roles.Rows) != 1 {
+		t.Fatalf("role usage not written after user usage failed")
+	}
+}
+
+func TestStopFlushesPending(t *testing.T) {
+	r, users, _ := newTestRecorder(config.UsageConf{Enabled: true, FlushInterval: 3600, MaxPending: 100})
+	r.Start()
+	r.Record(context.Background(), 1, []Grant{{RoleId: 10, PermissionId: 100}})
+	r.Stop()
+	if len(users.Rows) != 1 {
+		t.Fatalf("Stop did not flush pending records")
+	}
+}
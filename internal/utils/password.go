@@ -3,6 +3,7 @@ package utils
 import (
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/hex"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -39,7 +40,7 @@ func HashPasswordWithSalt(password, salt string) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
-// VerifyPasswordWithSalt 验证使用盐值哈希的密码
+// VerifyPasswordWithSalt 验证使用盐值哈希的密码，使用常量时间比较避免时序攻击
 func VerifyPasswordWithSalt(password, salt, hash string) bool {
-	return HashPasswordWithSalt(password, salt) == hash
+	return subtle.ConstantTimeCompare([]byte(HashPasswordWithSalt(password, salt)), []byte(hash)) == 1
 }
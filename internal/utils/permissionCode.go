@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 权限编码由':'分隔的段组成，如 order:read、order:*、GET:/user/:id
+const (
+	PermissionCodeSeparator = ":"
+	PermissionCodeWildcard  = "*"
+)
+
+// ValidPermissionCode 检查权限编码是否合法：各段非空且不含空白，'*'只能单独作为一段
+func ValidPermissionCode(code string) bool {
+	if code == "" {
+		return false
+	}
+	for _, segment := range strings.Split(code, PermissionCodeSeparator) {
+		if segment == "" || strings.IndexFunc(segment, unicode.IsSpace) >= 0 {
+			return false
+		}
+		if segment != PermissionCodeWildcard && strings.Contains(segment, PermissionCodeWildcard) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsWildcardPermissionCode 权限编码是否包含通配段
+func IsWildcardPermissionCode(code string) bool {
+	for _, segment := range strings.Split(code, PermissionCodeSeparator) {
+		if segment == PermissionCodeWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// PermissionCodeCovers 检查授权编码是否覆盖目标编码
+// 授权编码的每一段依次与目标编码对应的段相同或为'*'即覆盖，因此 order 覆盖 order 及 order:read，
+// order:* 覆盖 order:read 及 order:read:own 但不覆盖 order 本身
+func PermissionCodeCovers(grant, code string) bool {
+	grantSegments := strings.Split(grant, PermissionCodeSeparator)
+	codeSegments := strings.Split(code, PermissionCodeSeparator)
+	if len(grantSegments) > len(codeSegments) {
+		return false
+	}
+	for i, segment := range grantSegments {
+		if segment != PermissionCodeWildcard && segment != codeSegments[i] {
+			return false
+		}
+	}
+	return true
+}
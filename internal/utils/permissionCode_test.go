@@ -0,0 +1,63 @@
+package utils
+
+import "testing"
+
+func TestValidPermissionCode(t *testing.T) {
+	tests := map[string]bool{
+		"order:read":    true,
+		"order:*":       true,
+		"*":             true,
+		"GET:/user/:id": true,
+		"":              false,
+		"order:":        false,
+		"order::read":   false,
+		"order:re ad":   false,
+		"order:read*":   false,
+		"order:**":      false,
+	}
+	for code, want := range tests {
+		if got := ValidPermissionCode(code); got != want {
+			t.Fatalf("ValidPermissionCode(%q) = %v, want %v", code, got, want)
+		}
+	}
+}
+
+func TestIsWildcardPermissionCode(t *testing.T) {
+	tests := map[string]bool{
+		"order:*":      true,
+		"*:read":       true,
+		"order:read":   false,
+		"order:read*x": false,
+	}
+	for code, want := range tests {
+		if got := IsWildcardPermissionCode(code); got != want {
+			t.Fatalf("IsWildcardPermissionCode(%q) = %v, want %v", code, got, want)
+		}
+	}
+}
+
+func TestPermissionCodeCovers(t *testing.T) {
+	tests := []struct {
+		grant string
+		code  string
+		want  bool
+	}{
+		{"order:read", "order:read", true},
+		{"order", "order", true},
+		{"order", "order:read", true},
+		{"order:*", "order:read", true},
+		{"order:*", "order:read:own", true},
+		{"order:*", "order", false},
+		{"*", "iam:user:read", true},
+		{"*:read", "order:read", true},
+		{"*:read", "order:write", false},
+		{"order:read", "order", false},
+		{"order:read", "order:write", false},
+		{"order", "orders:read", false},
+	}
+	for _, tt := range tests {
+		if got := PermissionCodeCovers(tt.grant, tt.code); got != tt.want {
+			t.Fatalf("PermissionCodeCovers(%q, %q) = %v, want %v", tt.grant, tt.code, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package verification
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/internal/config"
+	"github.com/ziptako/iam/internal/notify"
+)
+
+var (
+	ErrInvalidChannel  = errors.New("invalid verification channel")
+	ErrNoTarget        = errors.New("user has no address for this channel")
+	ErrTooFrequent     = errors.New("verification code requested too frequently")
+	ErrNoPendingCode   = errors.New("no pending verification code")
+	ErrCodeExpired     = errors.New("verification code expired")
+	ErrTooManyAttempts = errors.New("too many verification attempts")
+	ErrTargetChanged   = errors.New("address changed after the code was sent")
+)
+
+// Service 负责验证码的生成、发送与校验
+type Service struct {
+	c          config.VerificationConf
+	usersModel model.UsersModel
+	codesModel model.VerificationCodesModel
+	notifier   notify.Notifier
+}
+
+// NewService 创建验证服务
+func NewService(c config.VerificationConf, usersModel model.UsersModel, codesModel model.VerificationCodesModel, notifier notify.Notifier) *Service {
+	return &Service{
+		c:          c,
+		usersModel: usersModel,
+		codesModel: codesModel,
+		notifier:   notifier,
+	}
+}
+
+// Send 生成验证码并通过通知渠道发送，之前未使用的验证码将作废
+func (s *Service) Send(ctx context.Context, user *model.Users, channel string) (time.Time, error) {
+	target, err := targetOf(user, channel)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	latest, err := s.codesModel.FindLatestPending(ctx, user.Id, channel)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return time.Time{}, err
+	}
+	if latest != nil && latest.Target == target &&
+		time.Since(latest.CreatedAt) < time.Duration(s.c.ResendInterval)*time.Second {
+		return time.Time{}, ErrTooFrequent
+	}
+	if err := s.codesModel.InvalidatePending(ctx, user.Id, channel); err != nil {
+		return time.Time{}, err
+	}
+
+	code, err := generateCode(s.c.CodeLength)
+	if err != nil {
+		return time.Time{}, err
+	}
+	expiresAt := time.Now().Add(time.Duration(s.c.CodeTTL) * time.Second)
+	_, err = s.codesModel.Insert(ctx, &model.VerificationCodes{
+		UserId:    user.Id,
+		Channel:   channel,
+		Target:    target,
+		CodeHash:  hashCode(user.Id, channel, code),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	msg := &notify.Message{
+		Channel: channel,
+		To:      target,
+		Subject: "验证码",
+		Body:    fmt.Sprintf("您的验证码是 %s，%d 分钟内有效。", code, max(s.c.CodeTTL/60, 1)),
+	}
+	if err := s.notifier.Send(ctx, msg); err != nil {
+		return time.Time{}, fmt.Errorf("send verification code: %w", err)
+	}
+	return expiresAt, nil
+}
+
+// Confirm 校验验证码，正确时标记对应字段已验证；验证码错误返回false
+func (s *Service) Confirm(ctx context.Context, user *model.Users, channel, code string) (bool, error) {
+	target, err := targetOf(user, channel)
+	if err != nil {
+		return false, err
+	}
+
+	pending, err := s.codesModel.FindLatestPending(ctx, user.Id, channel)
+	if errors.Is(err, model.ErrNotFound) {
+		return false, ErrNoPendingCode
+	}
+	if err != nil {
+		return false, err
+	}
+	if time.Now().After(pending.ExpiresAt) {
+		return false, ErrCodeExpired
+	}
+	if pending.Target != target {
+		_ = s.codesModel.InvalidatePending(ctx, user.Id, channel)
+		return false, ErrTargetChanged
+	}
+	if pending.Attempts >= s.c.MaxAttempts {
+		_ = s.codesModel.InvalidatePending(ctx, user.Id, channel)
+		return false, ErrTooManyAttempts
+	}
+
+	expected := []byte(pending.CodeHash)
+	actual := []byte(hashCode(user.Id, channel, code))
+	if subtle.ConstantTimeCompare(expected, actual) != 1 {
+		if err := s.codesModel.IncrementAttempts(ctx, pending.Id); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+
+	consumed, err := s.codesModel.Consume(ctx, pending.Id)
+	if err != nil {
+		return false, err
+	}
+	if !consumed {
+		return false, ErrNoPendingCode
+	}
+
+	var marked bool
+	if channel == model.VerificationChannelEmail {
+		marked, err = s.usersModel.MarkEmailVerified(ctx, user.Id, target)
+	} else {
+		marked, err = s.usersModel.MarkPhoneVerified(ctx, user.Id, target)
+	}
+	if err != nil {
+		return false, err
+	}
+	if !marked {
+		return false, ErrTargetChanged
+	}
+	return true, nil
+}
+
+// targetOf 返回用户在该渠道的地址
+func targetOf(user *model.Users, channel string) (string, error) {
+	var target string
+	switch channel {
+	case model.VerificationChannelEmail:
+		target = user.Email.String
+	case model.VerificationChannelPhone:
+		target = user.Phone.String
+	default:
+		return "", ErrInvalidChannel
+	}
+	if target == "" {
+		return "", ErrNoTarget
+	}
+	return target, nil
+}
+
+// generateCode 生成指定位数的数字验证码
+func generateCode(length int) (string, error) {
+	if length <= 0 {
+		length = 6
+	}
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(length)), nil)
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", length, n), nil
+}
+
+// hashCode 计算验证码哈希，混入用户和渠道避免跨用户复用
+func hashCode(userId int64, channel, code string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d:%s:%s", userId, channel, code)))
+	return hex.EncodeToString(sum[:])
+}
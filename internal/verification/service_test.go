@@ -0,0 +1,197 @@
+package verification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/ziptako/iam/db/model"
+	"github.com/ziptako/iam/db/model/modeltest"
+	"github.com/ziptako/iam/internal/config"
+	"github.com/ziptako/iam/internal/notify"
+)
+
+// fakeCodes 内存中的验证码表
+type fakeCodes struct {
+	model.VerificationCodesModel
+	codes []*model.VerificationCodes
+}
+
+func (m *fakeCodes) Insert(_ context.Context, data *model.VerificationCodes) (sql.Result, error) {
+	data.Id = int64(len(m.codes) + 1)
+	data.CreatedAt = time.Now()
+	m.codes = append(m.codes, data)
+	return nil, nil
+}
+
+func (m *fakeCodes) FindLatestPending(_ context.Context, userId int64, channel string) (*model.VerificationCodes, error) {
+	for i := len(m.codes) - 1; i >= 0; i-- {
+		c := m.codes[i]
+		if c.UserId == userId && c.Channel == channel && !c.ConsumedAt.Valid {
+			return c, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m *fakeCodes) IncrementAttempts(_ context.Context, id int64) error {
+	m.codes[id-1].Attempts++
+	return nil
+}
+
+func (m *fakeCodes) Consume(_ context.Context, id int64) (bool, error) {
+	c := m.codes[id-1]
+	if c.ConsumedAt.Valid {
+		return false, nil
+	}
+	c.ConsumedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	return true, nil
+}
+
+func (m *fakeCodes) InvalidatePending(_ context.Context, userId int64, channel string) error {
+	for _, c := range m.codes {
+		if c.UserId == userId && c.Channel == channel && !c.ConsumedAt.Valid {
+			c.ConsumedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		}
+	}
+	return nil
+}
+
+// fakeNotifier 记录发送的通知
+type fakeNotifier struct {
+	messages []*notify.Message
+}
+
+func (n *fakeNotifier) Send(_ context.Context, msg *notify.Message) error {
+	n.messages = append(n.messages, msg)
+	return nil
+}
+
+var codePattern = regexp.MustCompile(`\d{6}`)
+
+func newTestService() (*Service, *fakeCodes, *modeltest.Users, *fakeNotifier) {
+	c := config.VerificationConf{CodeLength: 6, CodeTTL: 600, MaxAttempts: 2, ResendInterval: 60}
+	codes, users, notifier := &fakeCodes{}, &modeltest.Users{Rows: []*model.Users{testUser()}}, &fakeNotifier{}
+	return NewService(c, users, codes, notifier), codes, users, notifier
+}
+
+func testUser() *model.Users {
+	return &model.Users{Id: 1, Email: sql.NullString{String: "alice@example.com", Valid: true}}
+}
+
+// sentCode 取出最近一次通知中的验证码
+func sentCode(t *testing.T, n *fakeNotifier) string {
+	t.Helper()
+	code := codePattern.FindString(n.messages[len(n.messages)-1].Body)
+	if code == "" {
+		t.Fatalf("no code in %q", n.messages[len(n.messages)-1].Body)
+	}
+	return code
+}
+
+func TestSendAndConfirm(t *testing.T) {
+	s, codes, users, notifier := newTestService()
+	ctx := context.Background()
+	user := testUser()
+
+	if _, err := s.Send(ctx, user, model.VerificationChannelEmail); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if msg := notifier.messages[0]; msg.To != "alice@example.com" || msg.Channel != model.VerificationChannelEmail {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+	// 验证码只保存哈希
+	code := sentCode(t, notifier)
+	if codes.codes[0].CodeHash == code {
+		t.Fatalf("code stored in plain text")
+	}
+
+	if _, err := s.Send(ctx, user, model.VerificationChannelEmail); !errors.Is(err, ErrTooFrequent) {
+		t.Fatalf("resend = %v, want ErrTooFrequent", err)
+	}
+
+	ok, err := s.Confirm(ctx, user, model.VerificationChannelEmail, code)
+	if err != nil || !ok {
+		t.Fatalf("Confirm = %v, %v", ok, err)
+	}
+	if !users.Row(1).EmailVerifiedAt.Valid {
+		t.Fatalf("email not marked verified")
+	}
+	if _, err := s.Confirm(ctx, user, model.VerificationChannelEmail, code); !errors.Is(err, ErrNoPendingCode) {
+		t.Fatalf("reused code = %v, want ErrNoPendingCode", err)
+	}
+}
+
+func TestConfirmRejects(t *testing.T) {
+	s, codes, _, notifier := newTestService()
+	ctx := context.Background()
+	user := testUser()
+
+	if _, err := s.Send(ctx, user, model.VerificationChannelEmail); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	code := sentCode(t, notifier)
+
+	// 错误验证码计入尝试次数，超过上限后验证码作废
+	for i := 0; i < 2; i++ {
+		if ok, err := s.Confirm(ctx, user, model.VerificationChannelEmail, "000000x"); ok || err != nil {
+			t.Fatalf("wrong code = %v, %v", ok, err)
+		}
+	}
+	if _, err := s.Confirm(ctx, user, model.VerificationChannelEmail, code); !errors.Is(err, ErrTooManyAttempts) {
+		t.Fatalf("after max attempts = %v, want ErrTooManyAttempts", err)
+	}
+	if _, err := s.Confirm(ctx, user, model.VerificationChannelEmail, code); !errors.Is(err, ErrNoPendingCode) {
+		t.Fatalf("after invalidation = %v, want ErrNoPendingCode", err)
+	}
+
+	// 重新发送前修改邮箱
+	codes.codes[0].CreatedAt = time.Now().Add(-time.Hour)
+	if _, err := s.Send(ctx, user, model.VerificationChannelEmail); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	code = sentCode(t, notifier)
+	changed := testUser()
+	changed.Email.String = "bob@example.com"
+	if _, err := s.Confirm(ctx, changed, model.VerificationChannelEmail, code); !errors.Is(err, ErrTargetChanged) {
+		t.Fatalf("changed email = %v, want ErrTargetChanged", err)
+	}
+
+	// 过期的验证码
+	if _, err := s.Send(ctx, user, model.VerificationChannelEmail); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	codes.codes[len(codes.codes)-1].ExpiresAt = time.Now().Add(-time.Second)
+	if _, err := s.Confirm(ctx, user, model.VerificationChannelEmail, sentCode(t, notifier)); !errors.Is(err, ErrCodeExpired) {
+		t.Fatalf("expired code = %v, want ErrCodeExpired", err)
+	}
+}
+
+func TestTargetOf(t *testing.T) {
+	user := testUser()
+	if _, err := targetOf(user, "fax"); !errors.Is(err, ErrInvalidChannel) {
+		t.Fatalf("invalid channel = %v", err)
+	}
+	if _, err := targetOf(user, model.VerificationChannelPhone); !errors.Is(err, ErrNoTarget) {
+		t.Fatalf("missing phone = %v", err)
+	}
+}
+
+func TestGenerateCode(t *testing.T) {
+	for _, length := range []int{0, 4, 8} {
+		code, err := generateCode(length)
+		if err != nil {
+			t.Fatalf("generateCode: %v", err)
+		}
+		want := length
+		if want == 0 {
+			want = 6
+		}
+		if !regexp.MustCompile(`^\d+$`).MatchString(code) || len(code) != want {
+			t.Fatalf("generateCode(%d) = %q", length, code)
+		}
+	}
+}